@@ -1,9 +1,9 @@
 package actionlint
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -20,6 +20,10 @@ type ActionMetadataInput struct {
 	Name string `json:"name"`
 	// Required is true when this input is mandatory to run the action.
 	Required bool `json:"required"`
+	// Type is a type of this input declared with "type" key. It is "boolean", "number", or "string".
+	// It is empty when the type is not declared. Note that "type" is not a part of the official
+	// metadata syntax. Only local actions in the repository may declare it.
+	Type string `json:"type,omitempty"`
 }
 
 // ActionMetadataInputs is a map from input ID to its metadata. Keys are in lower case since input
@@ -36,6 +40,7 @@ func (inputs *ActionMetadataInputs) UnmarshalYAML(n *yaml.Node) error {
 	type actionInputMetadata struct {
 		Required bool    `yaml:"required"`
 		Default  *string `yaml:"default"`
+		Type     string  `yaml:"type"`
 	}
 
 	md := make(ActionMetadataInputs, len(n.Content)/2)
@@ -52,7 +57,7 @@ func (inputs *ActionMetadataInputs) UnmarshalYAML(n *yaml.Node) error {
 			return fmt.Errorf("input %q is duplicated", k)
 		}
 
-		md[id] = &ActionMetadataInput{k, m.Required && m.Default == nil}
+		md[id] = &ActionMetadataInput{k, m.Required && m.Default == nil, m.Type}
 	}
 
 	*inputs = md
@@ -169,10 +174,14 @@ func (md *ActionMetadata) Path() string {
 // This cache is not available across multiple repositories. One LocalActionsCache instance needs
 // to be created per one repository.
 type LocalActionsCache struct {
-	mu    sync.RWMutex
-	proj  *Project // might be nil
-	cache map[string]*ActionMetadata
-	dbg   io.Writer
+	mu     sync.RWMutex
+	proj   *Project // might be nil
+	cache  map[string]*ActionMetadata
+	dbg    io.Writer
+	remote *RemoteFetcher
+	// ctx is the context of fetching remote actions. Canceling it aborts the requests in flight.
+	// context.Background() is used when this value is nil.
+	ctx context.Context
 }
 
 // NewLocalActionsCache creates new LocalActionsCache instance for the given project.
@@ -185,8 +194,8 @@ func NewLocalActionsCache(proj *Project, dbg io.Writer) *LocalActionsCache {
 }
 
 func newNullLocalActionsCache(dbg io.Writer) *LocalActionsCache {
-	// Null cache. Cache never hits. It is used when project is not found
-	return &LocalActionsCache{dbg: dbg}
+	// Null cache. Cache never hits for local actions. It is used when project is not found
+	return &LocalActionsCache{cache: map[string]*ActionMetadata{}, dbg: dbg}
 }
 
 func (c *LocalActionsCache) debug(format string, args ...interface{}) {
@@ -211,12 +220,19 @@ func (c *LocalActionsCache) writeCache(key string, val *ActionMetadata) {
 }
 
 // FindMetadata finds metadata for given spec. The spec should indicate for local action hence it
-// should start with "./". The first return value can be nil even if error did not occur.
+// should start with "./". As an exception, when fetching remote actions is enabled by EnableRemote
+// method, the spec "owner/repo@ref" or "owner/repo/path@ref" is also resolved by fetching the
+// metadata file from the remote repository. The first return value can be nil even if error did
+// not occur.
 // LocalActionCache caches that the action was not found. At first search, it returns an error that
 // the action was not found. But at the second search, it does not return an error even if the result
 // is nil. This behavior prevents repeating to report the same error from multiple places.
 // Calling this method is thread-safe.
 func (c *LocalActionsCache) FindMetadata(spec string) (*ActionMetadata, bool, error) {
+	if c.remote != nil && !strings.HasPrefix(spec, "./") && !strings.HasPrefix(spec, "docker://") && !ContainsExpression(spec) {
+		return c.findRemoteMetadata(spec)
+	}
+
 	if c.proj == nil || !strings.HasPrefix(spec, "./") {
 		return nil, false, nil
 	}
@@ -252,10 +268,87 @@ func (c *LocalActionsCache) FindMetadata(spec string) (*ActionMetadata, bool, er
 	return &meta, false, nil
 }
 
+func (c *LocalActionsCache) findRemoteMetadata(spec string) (*ActionMetadata, bool, error) {
+	if m, ok := c.readCache(spec); ok {
+		c.debug("Cache hit for %s: %v", spec, m)
+		return m, true, nil
+	}
+
+	slug, dir, ref, ok := splitRepoActionSpec(spec)
+	if !ok {
+		return nil, false, nil // Invalid format is reported by "action" rule
+	}
+
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var b []byte
+	var f string
+	for _, name := range []string{"action.yml", "action.yaml"} {
+		p := name
+		if dir != "" {
+			p = dir + "/" + name
+		}
+		src, err := c.remote.FetchContext(ctx, slug, ref, p)
+		if err != nil {
+			c.writeCache(spec, nil)
+			return nil, false, fmt.Errorf("could not fetch action metadata of %q: %w", spec, err)
+		}
+		if src != nil {
+			b, f = src, name
+			break
+		}
+	}
+	if b == nil {
+		c.debug("No action metadata found for remote action %s", spec)
+		c.writeCache(spec, nil)
+		return nil, false, nil
+	}
+
+	var meta ActionMetadata
+	if err := yaml.Unmarshal(b, &meta); err != nil {
+		c.writeCache(spec, nil) // Remember action was invalid
+		msg := strings.ReplaceAll(err.Error(), "\n", " ")
+		return nil, false, fmt.Errorf("could not parse action metadata of %q: %s", spec, msg)
+	}
+	meta.file = f
+
+	c.debug("New metadata parsed from remote action %s: %v", spec, &meta)
+	c.writeCache(spec, &meta)
+	return &meta, false, nil
+}
+
+// EnableRemote enables fetching metadata of actions in remote repositories with the given fetcher.
+// Setting nil disables fetching remote actions.
+func (c *LocalActionsCache) EnableRemote(f *RemoteFetcher) {
+	c.remote = f
+}
+
+// splitRepoActionSpec splits the action spec "owner/repo/path@ref" into the repository slug
+// "owner/repo", the directory path in the repository "path", and the ref "ref". The path is empty
+// when the action is at the root of the repository.
+func splitRepoActionSpec(spec string) (string, string, string, bool) {
+	at := strings.IndexRune(spec, '@')
+	if at <= 0 || at == len(spec)-1 {
+		return "", "", "", false
+	}
+	s, ref := spec[:at], spec[at+1:]
+	i := strings.IndexRune(s, '/')
+	if i <= 0 || i == len(s)-1 {
+		return "", "", "", false
+	}
+	slug, dir := s, ""
+	if j := strings.IndexRune(s[i+1:], '/'); j >= 0 {
+		slug, dir = s[:i+1+j], strings.Trim(s[i+2+j:], "/")
+	}
+	return slug, dir, ref, true
+}
+
 func (c *LocalActionsCache) readLocalActionMetadataFile(dir string) ([]byte, string, bool) {
 	for _, f := range []string{"action.yaml", "action.yml"} {
 		p := filepath.Join(dir, f)
-		if b, err := os.ReadFile(p); err == nil {
+		if b, err := c.proj.fileSystem().ReadFile(p); err == nil {
 			return b, f, true
 		}
 	}
@@ -18,9 +18,9 @@ func testGetWantedActionMetadata() *ActionMetadata {
 		Name:        "My action",
 		Description: "my action",
 		Inputs: ActionMetadataInputs{
-			"name":     {"name", false},
-			"message":  {"message", true},
-			"addition": {"addition", false},
+			"name":     {"name", false, ""},
+			"message":  {"message", true, ""},
+			"addition": {"addition", false, ""},
 		},
 		Outputs: ActionMetadataOutputs{
 			"user_id": {"user_id"},
@@ -81,7 +81,7 @@ func testCheckCachedFlag(t *testing.T, want, have bool) {
 
 func TestLocalActionsFindMetadataOK(t *testing.T) {
 	testdir := filepath.Join("testdata", "action_metadata")
-	proj := &Project{testdir, nil}
+	proj := &Project{testdir, nil, nil}
 	c := NewLocalActionsCache(proj, nil)
 
 	want := testGetWantedActionMetadata()
@@ -174,7 +174,7 @@ func TestLocalActionsFindMetadataOK(t *testing.T) {
 
 func TestLocalActionsFindConcurrently(t *testing.T) {
 	n := 10
-	proj := &Project{filepath.Join("testdata", "action_metadata"), nil}
+	proj := &Project{filepath.Join("testdata", "action_metadata"), nil, nil}
 	c := NewLocalActionsCache(proj, nil)
 	ret := make(chan *ActionMetadata)
 	err := make(chan error)
@@ -227,12 +227,12 @@ func TestLocalActionsParsingSkipped(t *testing.T) {
 		},
 		{
 			what: "not a local action",
-			proj: &Project{"", nil},
+			proj: &Project{"", nil, nil},
 			spec: "actions/checkout@v3",
 		},
 		{
 			what: "action does not exist (#25, #40)",
-			proj: &Project{filepath.Join("testdata", "action_metadata"), nil},
+			proj: &Project{filepath.Join("testdata", "action_metadata"), nil, nil},
 			spec: "./this-action-does-not-exist",
 		},
 	}
@@ -253,7 +253,7 @@ func TestLocalActionsParsingSkipped(t *testing.T) {
 }
 
 func TestLocalActionsIgnoreRemoteActions(t *testing.T) {
-	proj := &Project{filepath.Join("testdata", "action_metadata"), nil}
+	proj := &Project{filepath.Join("testdata", "action_metadata"), nil, nil}
 	c := NewLocalActionsCache(proj, nil)
 	for _, spec := range []string{"actions/checkout@v2", "docker://example.com/foo/bar"} {
 		m, cached, err := c.FindMetadata(spec)
@@ -270,7 +270,7 @@ func TestLocalActionsIgnoreRemoteActions(t *testing.T) {
 func TestLocalActionsLogCacheHit(t *testing.T) {
 	dbg := &bytes.Buffer{}
 	testdir := filepath.Join("testdata", "action_metadata")
-	proj := &Project{testdir, nil}
+	proj := &Project{testdir, nil, nil}
 	c := NewLocalActionsCache(proj, dbg)
 
 	want := testGetWantedActionMetadata()
@@ -320,7 +320,7 @@ func TestLocalActionsBrokenMetadata(t *testing.T) {
 		},
 	}
 
-	proj := &Project{filepath.Join("testdata", "action_metadata"), nil}
+	proj := &Project{filepath.Join("testdata", "action_metadata"), nil, nil}
 	c := NewLocalActionsCache(proj, nil)
 
 	for _, tc := range tests {
@@ -349,7 +349,7 @@ func TestLocalActionsBrokenMetadata(t *testing.T) {
 }
 
 func TestLocalActionsDuplicateInputsOutputs(t *testing.T) {
-	proj := &Project{filepath.Join("testdata", "action_metadata"), nil}
+	proj := &Project{filepath.Join("testdata", "action_metadata"), nil, nil}
 	c := NewLocalActionsCache(proj, nil)
 
 	for _, tc := range []struct {
@@ -381,7 +381,7 @@ func TestLocalActionsDuplicateInputsOutputs(t *testing.T) {
 
 func TestLocalActionsConcurrentFailures(t *testing.T) {
 	n := 10
-	proj := &Project{filepath.Join("testdata", "action_metadata"), nil}
+	proj := &Project{filepath.Join("testdata", "action_metadata"), nil, nil}
 	c := NewLocalActionsCache(proj, nil)
 	errC := make(chan error)
 
@@ -415,7 +415,7 @@ func TestLocalActionsConcurrentFailures(t *testing.T) {
 }
 
 func TestLocalActionsConcurrentMultipleMetadataAndFailures(t *testing.T) {
-	proj := &Project{filepath.Join("testdata", "action_metadata"), nil}
+	proj := &Project{filepath.Join("testdata", "action_metadata"), nil, nil}
 	c := NewLocalActionsCache(proj, nil)
 
 	inputs := []string{
@@ -548,11 +548,34 @@ inputs:
 			want: ActionMetadata{
 				Name: "Test",
 				Inputs: ActionMetadataInputs{
-					"input1": {"input1", false},
-					"input2": {"input2", false},
-					"input3": {"input3", false},
-					"input4": {"input4", false},
-					"input5": {"input5", true},
+					"input1": {"input1", false, ""},
+					"input2": {"input2", false, ""},
+					"input3": {"input3", false, ""},
+					"input4": {"input4", false, ""},
+					"input5": {"input5", true, ""},
+				},
+			},
+		},
+		{
+			what: "input types",
+			input: `name: Test
+inputs:
+  input1:
+    description: test
+    type: boolean
+  input2:
+    description: test
+    type: number
+    default: 3
+  input3:
+    description: test
+    default: 'false'`,
+			want: ActionMetadata{
+				Name: "Test",
+				Inputs: ActionMetadataInputs{
+					"input1": {"input1", false, "boolean"},
+					"input2": {"input2", false, "number"},
+					"input3": {"input3", false, ""},
 				},
 			},
 		},
@@ -630,10 +653,10 @@ outputs: "foo"`,
 
 func TestLocalActionsCacheFactory(t *testing.T) {
 	f := NewLocalActionsCacheFactory(io.Discard)
-	p1 := &Project{"path/to/project1", nil}
+	p1 := &Project{"path/to/project1", nil, nil}
 	c1 := f.GetCache(p1)
 
-	p2 := &Project{"path/to/project2", nil}
+	p2 := &Project{"path/to/project2", nil, nil}
 	c2 := f.GetCache(p2)
 	if c1 == c2 {
 		t.Errorf("different cache was not created: %v", c1)
@@ -0,0 +1,268 @@
+// Package actionlinttest provides helpers to test rules of actionlint. It is useful for authors of
+// custom rules to write concise tests: running a single rule against a workflow snippet, asserting
+// the reported errors, and comparing errors with golden files.
+package actionlinttest
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/rhysd/actionlint"
+)
+
+// FilePath is the file path set to errors returned from the helpers in this package.
+const FilePath = "test.yaml"
+
+// UpdateGoldenEnv is the name of the environment variable to update golden files. When it is set
+// to a non-empty value, CheckGolden writes the errors to the golden file instead of comparing them.
+const UpdateGoldenEnv = "ACTIONLINT_UPDATE_GOLDEN"
+
+// WorkflowWithSteps returns a minimal workflow source containing the given steps in the "steps:"
+// section of a single job. The steps are indented to be put in the section. The first line of the
+// steps is at line 6 in the returned source.
+//
+//	src := actionlinttest.WorkflowWithSteps("- run: echo hello")
+func WorkflowWithSteps(steps string) string {
+	var b strings.Builder
+	b.WriteString("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n")
+	for _, l := range strings.Split(strings.TrimRight(steps, "\n"), "\n") {
+		if l != "" {
+			b.WriteString("      ")
+			b.WriteString(l)
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Config parses the content of actionlint config file (actionlint.yaml). The test fails when the
+// content is invalid.
+func Config(t testing.TB, src string) *actionlint.Config {
+	t.Helper()
+	cfg, err := actionlint.ParseConfig([]byte(src), "actionlint.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+func parseWorkflow(t testing.TB, src string) *actionlint.Workflow {
+	t.Helper()
+	w, errs := actionlint.Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatalf("could not parse the workflow:\n%s", formatErrors(errs))
+	}
+	return w
+}
+
+// RunRule parses the workflow source and applies only the rule to it. The test fails when the
+// source cannot be parsed. It returns the errors reported by the rule sorted by their positions.
+// The file paths of the errors are set to FilePath. The rule must be a new instance since rules
+// have their states while visiting the syntax tree.
+func RunRule(t testing.TB, rule actionlint.Rule, src string) []*actionlint.Error {
+	t.Helper()
+	return RunRuleWithConfig(t, rule, nil, src)
+}
+
+// RunRuleWithConfig is the same as RunRule but the configuration is set to the rule. The cfg
+// parameter can be nil. Use Config to create the configuration from YAML source.
+func RunRuleWithConfig(t testing.TB, rule actionlint.Rule, cfg *actionlint.Config, src string) []*actionlint.Error {
+	t.Helper()
+	w := parseWorkflow(t, src)
+	rule.SetConfig(cfg)
+
+	v := actionlint.NewVisitor()
+	v.AddPass(rule)
+	if err := v.Visit(w); err != nil {
+		t.Fatalf("fatal error while applying rule %q: %s", rule.Name(), err)
+	}
+
+	errs := rule.Errs()
+	for _, err := range errs {
+		err.Filepath = FilePath
+	}
+	sort.Stable(actionlint.ByErrorPosition(errs))
+	return errs
+}
+
+// Lint checks the workflow source with all rules enabled by the options like actionlint command.
+// The opts parameter can be nil. The test fails when the linter cannot run. Syntax errors are not
+// a failure and they are returned as errors.
+func Lint(t testing.TB, src string, opts *actionlint.LinterOptions) []*actionlint.Error {
+	t.Helper()
+	if opts == nil {
+		opts = &actionlint.LinterOptions{}
+	}
+	l, err := actionlint.NewLinter(io.Discard, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs, err := l.Lint(FilePath, []byte(src), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return errs
+}
+
+var reExpectation = regexp.MustCompile(`^(\d+):(\d+): (.*?)(?: \[([a-z0-9-]+)\])?$`)
+
+type expectation struct {
+	line    int
+	col     int
+	msg     string
+	re      *regexp.Regexp
+	kind    string
+	pattern string
+}
+
+func parseExpectation(s string) (*expectation, error) {
+	m := reExpectation.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("expectation %q must be in format \"<line>:<col>: <message> [<kind>]\"", s)
+	}
+	l, _ := strconv.Atoi(m[1])
+	c, _ := strconv.Atoi(m[2])
+	e := &expectation{line: l, col: c, msg: m[3], kind: m[4], pattern: s}
+	if len(e.msg) >= 2 && strings.HasPrefix(e.msg, "/") && strings.HasSuffix(e.msg, "/") {
+		re, err := regexp.Compile(e.msg[1 : len(e.msg)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression in expectation %q: %w", s, err)
+		}
+		e.re = re
+	}
+	return e, nil
+}
+
+func (e *expectation) match(err *actionlint.Error) bool {
+	if err.Line != e.line || err.Column != e.col {
+		return false
+	}
+	if e.kind != "" && err.Kind != e.kind {
+		return false
+	}
+	if e.re != nil {
+		return e.re.MatchString(err.Message)
+	}
+	return strings.Contains(err.Message, e.msg)
+}
+
+// AssertErrors asserts the errors match the expectations in order. Each expectation is in format
+// "<line>:<col>: <message> [<kind>]". The message matches when it is a substring of the error
+// message. When the message is surrounded by slashes like "/^unknown .+ key$/", it is matched as
+// a regular expression. The "[<kind>]" part is optional. The number of errors must be the same as
+// the number of expectations. Pass no expectation to assert no error is reported.
+//
+//	actionlinttest.AssertErrors(t, errs,
+//		`6:9: step name must not be empty [step-name]`,
+//		`8:15: /^".+" is not available/`,
+//	)
+func AssertErrors(t testing.TB, errs []*actionlint.Error, want ...string) {
+	t.Helper()
+
+	exps := make([]*expectation, 0, len(want))
+	for _, w := range want {
+		e, err := parseExpectation(w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exps = append(exps, e)
+	}
+
+	if len(errs) != len(exps) {
+		t.Fatalf("%d errors are expected but actually got %d errors:\n%s", len(exps), len(errs), formatErrors(errs))
+	}
+	for i, e := range exps {
+		if !e.match(errs[i]) {
+			t.Errorf("%s error does not match the expectation\n  want: %s\n  have: %d:%d: %s [%s]", ordinal(i+1), e.pattern, errs[i].Line, errs[i].Column, errs[i].Message, errs[i].Kind)
+		}
+	}
+}
+
+// CheckGolden compares the errors with the golden file. Each line of the golden file is the
+// string representation of an error like "test.yaml:1:2: message [kind]". Lines surrounded by
+// slashes like "/^test.yaml:1:2: .+/" are matched as regular expressions. When the environment
+// variable named UpdateGoldenEnv is set, the golden file is updated with the errors instead.
+func CheckGolden(t testing.TB, path string, errs []*actionlint.Error) {
+	t.Helper()
+
+	have := make([]string, 0, len(errs))
+	for _, err := range errs {
+		e := *err
+		e.Filepath = filepath.ToSlash(e.Filepath) // For Windows
+		have = append(have, e.Error())
+	}
+
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		s := strings.Join(have, "\n")
+		if s != "" {
+			s += "\n"
+		}
+		if err := os.WriteFile(path, []byte(s), 0644); err != nil {
+			t.Fatalf("could not update golden file: %s", err)
+		}
+		return
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("could not read golden file: %s", err)
+	}
+	want := []string{}
+	for _, l := range strings.Split(strings.ReplaceAll(string(b), "\r\n", "\n"), "\n") {
+		if l != "" {
+			want = append(want, l)
+		}
+	}
+
+	if len(want) != len(have) {
+		t.Fatalf("%d errors are expected by golden file %s but actually got %d errors:\n%s", len(want), path, len(have), strings.Join(have, "\n"))
+	}
+	for i, w := range want {
+		h := have[i]
+		if len(w) >= 2 && strings.HasPrefix(w, "/") && strings.HasSuffix(w, "/") {
+			re, err := regexp.Compile(w[1 : len(w)-1])
+			if err != nil {
+				t.Fatalf("invalid regular expression at line %d of golden file %s: %s", i+1, path, err)
+			}
+			if !re.MatchString(h) {
+				t.Errorf("%s error does not match to regular expression\n  want: %s\n  have: %q", ordinal(i+1), w, h)
+			}
+		} else if w != h {
+			t.Errorf("%s error does not match exactly\n  want: %q\n  have: %q", ordinal(i+1), w, h)
+		}
+	}
+}
+
+func formatErrors(errs []*actionlint.Error) string {
+	ms := make([]string, 0, len(errs))
+	for _, err := range errs {
+		ms = append(ms, err.Error())
+	}
+	return strings.Join(ms, "\n")
+}
+
+func ordinal(i int) string {
+	suffix := "th"
+	switch i % 10 {
+	case 1:
+		if i%100 != 11 {
+			suffix = "st"
+		}
+	case 2:
+		if i%100 != 12 {
+			suffix = "nd"
+		}
+	case 3:
+		if i%100 != 13 {
+			suffix = "rd"
+		}
+	}
+	return strconv.Itoa(i) + suffix
+}
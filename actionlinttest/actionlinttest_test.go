@@ -0,0 +1,171 @@
+package actionlinttest
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rhysd/actionlint"
+)
+
+type testRuleStepName struct {
+	actionlint.RuleBase
+}
+
+func (r *testRuleStepName) VisitStep(n *actionlint.Step) error {
+	if n.Name == nil {
+		r.Errorf(n.Pos, "every step must have its name")
+	}
+	return nil
+}
+
+func newTestRuleStepName() *testRuleStepName {
+	return &testRuleStepName{actionlint.NewRuleBase("step-name", "Checks every step has its name")}
+}
+
+// testRecorder records failures of assertions instead of failing the test
+type testRecorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *testRecorder) Helper() {}
+
+func (r *testRecorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *testRecorder) Fatalf(format string, args ...interface{}) {
+	r.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+func (r *testRecorder) Fatal(args ...interface{}) {
+	r.Fatalf("%s", fmt.Sprint(args...))
+}
+
+func testRecordFailures(t *testing.T, f func(t testing.TB)) []string {
+	r := &testRecorder{TB: t}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		f(r)
+	}()
+	wg.Wait()
+	return r.failures
+}
+
+func TestWorkflowWithSteps(t *testing.T) {
+	have := WorkflowWithSteps("- run: echo\n\n- name: Foo\n  run: echo\n")
+	want := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo
+
+      - name: Foo
+        run: echo
+`
+	if have != want {
+		t.Fatalf("wanted:\n%s\nbut got:\n%s", want, have)
+	}
+}
+
+func TestRunRuleAndAssertErrors(t *testing.T) {
+	src := WorkflowWithSteps(`
+- run: echo
+- name: Foo
+  run: echo
+- uses: actions/checkout@v4
+`)
+	errs := RunRule(t, newTestRuleStepName(), src)
+	AssertErrors(t, errs,
+		`7:9: every step must have its name [step-name]`,
+		`10:9: /^every .+ name$/`,
+	)
+	for _, err := range errs {
+		if err.Filepath != FilePath {
+			t.Errorf("file path is not set: %q", err.Filepath)
+		}
+	}
+
+	errs = RunRule(t, newTestRuleStepName(), WorkflowWithSteps("- name: Foo\n  run: echo"))
+	AssertErrors(t, errs)
+}
+
+func TestRunRuleWithConfig(t *testing.T) {
+	cfg := Config(t, "self-hosted-runner:\n  labels: [my-runner]\n")
+	src := `on: push
+jobs:
+  test:
+    runs-on: [self-hosted, my-runner]
+    steps:
+      - run: echo
+  test2:
+    runs-on: [self-hosted, unknown-runner]
+    steps:
+      - run: echo
+`
+	errs := RunRuleWithConfig(t, actionlint.NewRuleRunnerLabel(), cfg, src)
+	AssertErrors(t, errs, `8:28: label "unknown-runner" is unknown [runner-label]`)
+}
+
+func TestAssertErrorsFailure(t *testing.T) {
+	errs := RunRule(t, newTestRuleStepName(), WorkflowWithSteps("- run: echo"))
+
+	testCases := []struct {
+		what string
+		want []string
+		msg  string
+	}{
+		{"wrong count", nil, "0 errors are expected but actually got 1 errors"},
+		{"wrong line", []string{"7:9: every step"}, "1st error does not match"},
+		{"wrong message", []string{"6:9: foo"}, "1st error does not match"},
+		{"wrong kind", []string{"6:9: every step [foo]"}, "1st error does not match"},
+		{"wrong regexp", []string{"6:9: /^foo/"}, "1st error does not match"},
+		{"broken format", []string{"every step"}, "must be in format"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			fs := testRecordFailures(t, func(r testing.TB) {
+				AssertErrors(r, errs, tc.want...)
+			})
+			if len(fs) != 1 || !strings.Contains(fs[0], tc.msg) {
+				t.Fatalf("wanted a failure containing %q but got %q", tc.msg, fs)
+			}
+		})
+	}
+}
+
+func TestLintSnippet(t *testing.T) {
+	errs := Lint(t, "on: push\njobs:\n  test:\n    steps:\n      - run: echo\n", nil)
+	AssertErrors(t, errs, `3:3: "runs-on" section is missing in job "test" [syntax-check]`)
+}
+
+func TestCheckGolden(t *testing.T) {
+	errs := RunRule(t, newTestRuleStepName(), WorkflowWithSteps("- run: echo\n- run: echo"))
+	CheckGolden(t, filepath.Join("testdata", "golden.out"), errs)
+
+	fs := testRecordFailures(t, func(r testing.TB) {
+		CheckGolden(r, filepath.Join("testdata", "golden.out"), errs[:1])
+	})
+	if len(fs) != 1 || !strings.Contains(fs[0], "2 errors are expected by golden file") {
+		t.Fatalf("unexpected failures: %q", fs)
+	}
+}
+
+func TestCheckGoldenUpdate(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "1")
+	p := filepath.Join(t.TempDir(), "test.out")
+	errs := RunRule(t, newTestRuleStepName(), WorkflowWithSteps("- run: echo"))
+	CheckGolden(t, p, errs)
+
+	t.Setenv(UpdateGoldenEnv, "")
+	CheckGolden(t, p, errs)
+}
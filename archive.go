@@ -0,0 +1,335 @@
+package actionlint
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// archiveEntryMaxSize is the maximum size of content of one file in archives. Workflow files and
+// config files are much smaller than this.
+const archiveEntryMaxSize = 10 * 1024 * 1024
+
+// isArchiveFile returns true when the file path has an extension of archive files which can be
+// linted without extracting them.
+func isArchiveFile(p string) bool {
+	for _, ext := range []string{".zip", ".tar.gz", ".tgz", ".tar"} {
+		if strings.HasSuffix(p, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// archiveEntry is one regular file in an archive. The content is nil when it is not read by linter.
+type archiveEntry struct {
+	name string
+	data []byte
+}
+
+// isArchiveContentNeeded returns true when the content of the file in archives may be read by linter.
+// Workflows, action metadata, config files, and JSON schemas referenced by config files are YAML or
+// JSON files. Contents of other files are not read since only their existence is checked.
+func isArchiveContentNeeded(name string) bool {
+	switch path.Ext(name) {
+	case ".yml", ".yaml", ".json":
+		return true
+	default:
+		return path.Base(name) == "CODEOWNERS"
+	}
+}
+
+func readArchiveEntry(name string, r io.Reader) (archiveEntry, error) {
+	if !isArchiveContentNeeded(name) {
+		return archiveEntry{name, nil}, nil
+	}
+	b, err := io.ReadAll(io.LimitReader(r, archiveEntryMaxSize+1))
+	if err != nil {
+		return archiveEntry{}, fmt.Errorf("could not read %q: %w", name, err)
+	}
+	if len(b) > archiveEntryMaxSize {
+		return archiveEntry{}, fmt.Errorf("%q is too large. its size must be smaller than %d bytes", name, archiveEntryMaxSize)
+	}
+	return archiveEntry{name, b}, nil
+}
+
+func readZipEntries(p string) ([]archiveEntry, error) {
+	r, err := zip.OpenReader(p)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	es := make([]archiveEntry, 0, len(r.File))
+	for _, f := range r.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		if !isArchiveContentNeeded(f.Name) {
+			es = append(es, archiveEntry{f.Name, nil})
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		e, err := readArchiveEntry(f.Name, rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		es = append(es, e)
+	}
+	return es, nil
+}
+
+func readTarEntries(p string) ([]archiveEntry, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if !strings.HasSuffix(p, ".tar") {
+		g, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer g.Close()
+		r = g
+	}
+
+	es := []archiveEntry{}
+	t := tar.NewReader(r)
+	for {
+		h, err := t.Next()
+		if err == io.EOF {
+			return es, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		e, err := readArchiveEntry(h.Name, t)
+		if err != nil {
+			return nil, err
+		}
+		es = append(es, e)
+	}
+}
+
+// commonTopDir returns the top-level directory which all the entries are put in. Archives downloaded
+// from GitHub such as tarballs of repositories have the directory like "owner-repo-0123abc/".
+func commonTopDir(es []archiveEntry) string {
+	top := ""
+	for _, e := range es {
+		i := strings.IndexByte(e.name, '/')
+		if i <= 0 {
+			return ""
+		}
+		d := e.name[:i]
+		if top == "" {
+			top = d
+		} else if d != top {
+			return ""
+		}
+	}
+	return top
+}
+
+// addArchiveToFS reads the archive file and puts its content in the directory in the file system.
+// The directory is treated as the root of a repository. The common top-level directory of the
+// archive is stripped unless the archive has ".github" directory at its root.
+func addArchiveToFS(fsys *archiveFS, dir, p string) error {
+	var es []archiveEntry
+	var err error
+	if strings.HasSuffix(p, ".zip") {
+		es, err = readZipEntries(p)
+	} else {
+		es, err = readTarEntries(p)
+	}
+	if err != nil {
+		return fmt.Errorf("could not read archive %q: %w", p, err)
+	}
+
+	strip := ""
+	if d := commonTopDir(es); d != "" && d != ".github" {
+		strip = d + "/"
+	}
+	for _, e := range es {
+		n := path.Clean(strings.TrimPrefix(e.name, strip))
+		if !fs.ValidPath(n) || n == "." {
+			return fmt.Errorf("invalid file path %q in archive %q", e.name, p)
+		}
+		if err := fsys.addFile(path.Join(dir, n), e.data); err != nil {
+			return fmt.Errorf("invalid file path %q in archive %q: %w", e.name, p, err)
+		}
+	}
+	// Projects are detected with ".git" directory but archives usually don't contain it
+	return fsys.addDir(path.Join(dir, ".git"))
+}
+
+// newArchivesFS creates an in-memory file system which contains the files in the archives. Each
+// archive is put in the directory named with the file name of the archive like "repo.tar.gz" so
+// that errors are reported with paths such as "repo.tar.gz/.github/workflows/ci.yaml". Nothing is
+// extracted to disk. It returns the file system and the directories of the archives.
+func newArchivesFS(paths []string) (*archiveFS, []string, error) {
+	fsys := newArchiveFS()
+	dirs := make([]string, 0, len(paths))
+	seen := map[string]string{}
+	for _, p := range paths {
+		d := filepath.Base(p)
+		if prev, ok := seen[d]; ok {
+			return nil, nil, fmt.Errorf("archives %q and %q have the same file name", prev, p)
+		}
+		seen[d] = p
+		if err := addArchiveToFS(fsys, d, p); err != nil {
+			return nil, nil, err
+		}
+		dirs = append(dirs, d)
+	}
+	return fsys, dirs, nil
+}
+
+// archiveFS is a read-only in-memory file system implementing fs.FS for files in archives.
+// Directories are created implicitly for the paths of files.
+type archiveFS struct {
+	files map[string][]byte
+	dirs  map[string]map[string]struct{} // Directory name to names of its children
+}
+
+func newArchiveFS() *archiveFS {
+	return &archiveFS{
+		files: map[string][]byte{},
+		dirs:  map[string]map[string]struct{}{".": {}},
+	}
+}
+
+func (a *archiveFS) addDir(name string) error {
+	if _, ok := a.files[name]; ok {
+		return fmt.Errorf("file %q already exists", name)
+	}
+	if _, ok := a.dirs[name]; ok {
+		return nil
+	}
+	p := path.Dir(name)
+	if err := a.addDir(p); err != nil {
+		return err
+	}
+	a.dirs[name] = map[string]struct{}{}
+	a.dirs[p][path.Base(name)] = struct{}{}
+	return nil
+}
+
+func (a *archiveFS) addFile(name string, data []byte) error {
+	if _, ok := a.dirs[name]; ok {
+		return fmt.Errorf("directory %q already exists", name)
+	}
+	d := path.Dir(name)
+	if err := a.addDir(d); err != nil {
+		return err
+	}
+	a.files[name] = data
+	a.dirs[d][path.Base(name)] = struct{}{}
+	return nil
+}
+
+func (a *archiveFS) stat(name string) (*archiveFileInfo, bool) {
+	if b, ok := a.files[name]; ok {
+		return &archiveFileInfo{path.Base(name), int64(len(b)), 0644}, true
+	}
+	if _, ok := a.dirs[name]; ok {
+		return &archiveFileInfo{path.Base(name), 0, fs.ModeDir | 0755}, true
+	}
+	return nil, false
+}
+
+// Open implements fs.FS.
+func (a *archiveFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	i, ok := a.stat(name)
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	if !i.IsDir() {
+		return &archiveFile{i, bytes.NewReader(a.files[name])}, nil
+	}
+	cs := make([]string, 0, len(a.dirs[name]))
+	for c := range a.dirs[name] {
+		cs = append(cs, c)
+	}
+	sort.Strings(cs)
+	es := make([]fs.DirEntry, 0, len(cs))
+	for _, c := range cs {
+		ci, _ := a.stat(path.Join(name, c))
+		es = append(es, fs.FileInfoToDirEntry(ci))
+	}
+	return &archiveDir{i, es}, nil
+}
+
+type archiveFileInfo struct {
+	name string
+	size int64
+	mode fs.FileMode
+}
+
+func (i *archiveFileInfo) Name() string       { return i.name }
+func (i *archiveFileInfo) Size() int64        { return i.size }
+func (i *archiveFileInfo) Mode() fs.FileMode  { return i.mode }
+func (i *archiveFileInfo) ModTime() time.Time { return time.Time{} }
+func (i *archiveFileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i *archiveFileInfo) Sys() interface{}   { return nil }
+
+type archiveFile struct {
+	info *archiveFileInfo
+	r    *bytes.Reader
+}
+
+func (f *archiveFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *archiveFile) Read(b []byte) (int, error) { return f.r.Read(b) }
+func (f *archiveFile) Close() error               { return nil }
+
+type archiveDir struct {
+	info    *archiveFileInfo
+	entries []fs.DirEntry
+}
+
+func (d *archiveDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *archiveDir) Close() error               { return nil }
+
+func (d *archiveDir) Read(b []byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: fs.ErrInvalid}
+}
+
+// ReadDir implements fs.ReadDirFile.
+func (d *archiveDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if n <= 0 {
+		es := d.entries
+		d.entries = nil
+		return es, nil
+	}
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+	if n > len(d.entries) {
+		n = len(d.entries)
+	}
+	es := d.entries[:n]
+	d.entries = d.entries[n:]
+	return es, nil
+}
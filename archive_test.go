@@ -0,0 +1,240 @@
+package actionlint
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+var testArchiveFiles = [][2]string{
+	{".github/actionlint.yaml", "self-hosted-runner:\n  labels: [my-runner]\n"},
+	{".github/workflows/ok.yaml", "on: push\njobs:\n  test:\n    runs-on: my-runner\n    steps:\n      - run: echo\n"},
+	{".github/workflows/error.yaml", "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo ${{ github.evnt }}\n"},
+}
+
+func testWriteZipArchive(t *testing.T, path, prefix string) {
+	var b bytes.Buffer
+	w := zip.NewWriter(&b)
+	for _, f := range testArchiveFiles {
+		fw, err := w.Create(prefix + f[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(fw, f[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, b.Bytes(), 0640); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testWriteTarGzArchive(t *testing.T, path, prefix string) {
+	var b bytes.Buffer
+	g := gzip.NewWriter(&b)
+	w := tar.NewWriter(g)
+	if err := w.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: prefix, Mode: 0755}); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range testArchiveFiles {
+		h := &tar.Header{Typeflag: tar.TypeReg, Name: prefix + f[0], Mode: 0644, Size: int64(len(f[1]))}
+		if err := w.WriteHeader(h); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(w, f[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, b.Bytes(), 0640); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArchiveIsArchiveFile(t *testing.T) {
+	for _, p := range []string{"a.zip", "a.tar.gz", "a.tgz", "a.tar", "dir/a.tar.gz"} {
+		if !isArchiveFile(p) {
+			t.Errorf("%q should be an archive file", p)
+		}
+	}
+	for _, p := range []string{"a.yaml", "a.gz", "zip", "a.tar.gz.yml"} {
+		if isArchiveFile(p) {
+			t.Errorf("%q should not be an archive file", p)
+		}
+	}
+}
+
+func TestArchiveLintFiles(t *testing.T) {
+	dir := t.TempDir()
+	zipPath := filepath.Join(dir, "repo.zip")
+	tarPath := filepath.Join(dir, "owner-repo-0123abc.tar.gz")
+	testWriteZipArchive(t, zipPath, "")
+	// GitHub tarball has the top-level directory
+	testWriteTarGzArchive(t, tarPath, "owner-repo-0123abc/")
+
+	fsys, dirs, err := newArchivesFS([]string{zipPath, tarPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) != 2 || dirs[0] != "repo.zip" || dirs[1] != "owner-repo-0123abc.tar.gz" {
+		t.Fatalf("unexpected directories: %q", dirs)
+	}
+
+	l, err := NewLinter(io.Discard, &LinterOptions{FS: fsys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs, err := l.LintRepositories(dirs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 2 {
+		t.Fatalf("wanted 2 errors but got %d: %v", len(errs), errs)
+	}
+	for i, d := range dirs {
+		err := errs[i]
+		want := filepath.Join(d, ".github", "workflows", "error.yaml")
+		if err.Filepath != want {
+			t.Errorf("wanted file path %q but got %q", want, err.Filepath)
+		}
+		// The runner label is allowed by the config file in the archive
+		if err.Kind != "expression" || !strings.Contains(err.Message, `"evnt"`) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestArchiveSameFileNames(t *testing.T) {
+	dir := t.TempDir()
+	for _, d := range []string{"a", "b"} {
+		if err := os.Mkdir(filepath.Join(dir, d), 0750); err != nil {
+			t.Fatal(err)
+		}
+		testWriteZipArchive(t, filepath.Join(dir, d, "repo.zip"), "")
+	}
+
+	_, _, err := newArchivesFS([]string{filepath.Join(dir, "a", "repo.zip"), filepath.Join(dir, "b", "repo.zip")})
+	if err == nil || !strings.Contains(err.Error(), "have the same file name") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestArchiveBrokenFile(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "broken.tar.gz")
+	if err := os.WriteFile(p, []byte("this is not gzip"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err := newArchivesFS([]string{p})
+	if err == nil || !strings.Contains(err.Error(), "could not read archive") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestArchiveFS(t *testing.T) {
+	fsys := newArchiveFS()
+	for _, f := range testArchiveFiles {
+		if err := fsys.addFile(path.Join("repo", f[0]), []byte(f[1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := fsys.addDir("repo/.git"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fstest.TestFS(fsys, "repo/.git", "repo/.github/actionlint.yaml", "repo/.github/workflows/ok.yaml"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := fsys.addFile("repo/.github", nil); err == nil {
+		t.Error("file should not be added at path of directory")
+	}
+	if err := fsys.addFile("repo/.github/actionlint.yaml/foo", nil); err == nil {
+		t.Error("file should not be added under file")
+	}
+}
+
+func TestArchiveReadOnlyNeededFiles(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "repo.zip")
+	var b bytes.Buffer
+	w := zip.NewWriter(&b)
+	for _, n := range []string{".github/workflows/ci.yaml", "CODEOWNERS", "README.md", "dist/index.js"} {
+		fw, err := w.Create(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(fw, "content of "+n); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, b.Bytes(), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	fsys, _, err := newArchivesFS([]string{p})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []string{".github/workflows/ci.yaml", "CODEOWNERS"} {
+		b, err := fs.ReadFile(fsys, "repo.zip/"+n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "content of "+n {
+			t.Errorf("unexpected content of %q: %q", n, b)
+		}
+	}
+	// Other files exist but their contents are not read
+	for _, n := range []string{"README.md", "dist/index.js"} {
+		b, err := fs.ReadFile(fsys, "repo.zip/"+n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(b) != 0 {
+			t.Errorf("content of %q should not be read but got %q", n, b)
+		}
+	}
+}
+
+func TestArchiveTooLargeFile(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "repo.tar")
+	var b bytes.Buffer
+	w := tar.NewWriter(&b)
+	size := archiveEntryMaxSize + 1
+	h := &tar.Header{Typeflag: tar.TypeReg, Name: ".github/workflows/huge.yaml", Mode: 0644, Size: int64(size)}
+	if err := w.WriteHeader(h); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(bytes.Repeat([]byte{'#'}, size)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, b.Bytes(), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err := newArchivesFS([]string{p})
+	if err == nil || !strings.Contains(err.Error(), "is too large") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -578,7 +578,9 @@ type RawYAMLString struct {
 
 	// Value is string representation of the scalar node.
 	Value string
-	pos   *Pos
+	// Quoted represents the scalar is quoted with ' or " in the YAML source.
+	Quoted bool
+	pos    *Pos
 }
 
 // Kind returns kind of raw YAML value.
@@ -929,3 +931,317 @@ func (w *Workflow) FindWorkflowCallEvent() (*WorkflowCallEvent, bool) {
 	}
 	return nil, false
 }
+
+// ActionInput is an input of action defined in "inputs" section of action metadata.
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#inputs
+type ActionInput struct {
+	// Name is a name of the input.
+	Name *String
+	// Description is a description of the input.
+	Description *String
+	// Required is a flag whether the input is required. This field is nil when it is omitted.
+	Required *Bool
+	// Default is a default value of the input. This field is nil when it is omitted.
+	Default *String
+	// Type is a type of the input. It is one of "boolean", "number", or "string". This is not a part
+	// of the official metadata syntax but actionlint checks values at "with:" with it. This field is
+	// nil when it is omitted.
+	Type *String
+	// DeprecationMessage is a message to warn users that the input is deprecated. This field is nil
+	// when it is omitted.
+	DeprecationMessage *String
+}
+
+// ActionOutput is an output of action defined in "outputs" section of action metadata.
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#outputs-for-docker-container-and-javascript-actions
+type ActionOutput struct {
+	// Name is a name of the output.
+	Name *String
+	// Description is a description of the output.
+	Description *String
+	// Value is a value of the output. It is only available in composite actions. This field is nil
+	// when it is omitted.
+	// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#outputsoutput_idvalue
+	Value *String
+}
+
+// ActionRuns is "runs" section of action metadata. It defines how the action is run. Available
+// fields are different depending on the type of action at "using".
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#runs
+type ActionRuns struct {
+	// Using is a runner of the action such as "node20", "docker", or "composite".
+	Using *String
+	// Main is an entrypoint file of JavaScript action.
+	Main *String
+	// Pre is a script file run before the main entrypoint of JavaScript action.
+	Pre *String
+	// PreIf is a condition to run the pre script of JavaScript action.
+	PreIf *String
+	// Post is a script file run after the main entrypoint of JavaScript action.
+	Post *String
+	// PostIf is a condition to run the post script of JavaScript action.
+	PostIf *String
+	// Steps is steps run by composite action.
+	Steps []*Step
+	// Image is a Docker image or a path to Dockerfile of Docker action.
+	Image *String
+	// PreEntrypoint is a script run before the entrypoint of Docker action.
+	PreEntrypoint *String
+	// Entrypoint overrides the entrypoint of the Docker image of Docker action.
+	Entrypoint *String
+	// PostEntrypoint is a script run after the entrypoint of Docker action.
+	PostEntrypoint *String
+	// Args is arguments passed to the container of Docker action.
+	Args []*String
+	// Env is environment variables set in the container of Docker action.
+	Env *Env
+	// Pos is a position in source.
+	Pos *Pos
+}
+
+// ActionBranding is "branding" section of action metadata.
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#branding
+type ActionBranding struct {
+	// Icon is a name of Feather icon.
+	Icon *String
+	// Color is a background color of the badge.
+	Color *String
+}
+
+// Action is root of action metadata syntax tree, which represents one action.yml file.
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions
+type Action struct {
+	// Name is a name of the action.
+	Name *String
+	// Author is a name of the author of the action.
+	Author *String
+	// Description is a short description of the action.
+	Description *String
+	// Inputs is a mapping from input ID to the input. Keys are in lower case since they are
+	// case-insensitive.
+	Inputs map[string]*ActionInput
+	// Outputs is a mapping from output ID to the output. Keys are in lower case since they are
+	// case-insensitive.
+	Outputs map[string]*ActionOutput
+	// Runs is configuration of how to run the action.
+	Runs *ActionRuns
+	// Branding is configuration of the badge of the action on GitHub Marketplace.
+	Branding *ActionBranding
+}
+
+// DependabotSchedule is "schedule" section of update configuration in Dependabot configuration.
+// https://docs.github.com/en/code-security/dependabot/dependabot-version-updates/configuration-options-for-the-dependabot.yml-file#schedule-
+type DependabotSchedule struct {
+	// Interval is how often to check for new versions such as "daily" or "weekly".
+	Interval *String
+	// Day is a day of week to check for new versions when the interval is "weekly".
+	Day *String
+	// Time is a time of day to check for new versions in "hh:mm" format.
+	Time *String
+	// Timezone is a timezone of Time.
+	Timezone *String
+	// Cronjob is a cron expression to check for new versions when the interval is "cron".
+	Cronjob *String
+	// Pos is a position in source.
+	Pos *Pos
+}
+
+// DependabotGroup is a group of dependencies updated in one pull request. It is defined in "groups"
+// section of update configuration in Dependabot configuration.
+// https://docs.github.com/en/code-security/dependabot/dependabot-version-updates/configuration-options-for-the-dependabot.yml-file#groups--
+type DependabotGroup struct {
+	// Name is a name of the group.
+	Name *String
+	// AppliesTo is a kind of updates the group applies to. "version-updates" or "security-updates".
+	AppliesTo *String
+	// DependencyType is a type of dependencies in the group. "development" or "production".
+	DependencyType *String
+	// Patterns is patterns of dependency names included in the group.
+	Patterns []*String
+	// ExcludePatterns is patterns of dependency names excluded from the group.
+	ExcludePatterns []*String
+	// UpdateTypes is semantic versioning levels included in the group such as "minor" or "patch".
+	UpdateTypes []*String
+}
+
+// DependabotUpdate is one of update configurations in "updates" section of Dependabot
+// configuration.
+// https://docs.github.com/en/code-security/dependabot/dependabot-version-updates/configuration-options-for-the-dependabot.yml-file#configuration-options-for-updates
+type DependabotUpdate struct {
+	// PackageEcosystem is a package manager to update such as "npm" or "github-actions".
+	PackageEcosystem *String
+	// Directory is a location of package manifests. This field is nil when it is omitted.
+	Directory *String
+	// Directories is locations of package manifests. This field is nil when it is omitted.
+	Directories []*String
+	// Schedule is when to check for new versions.
+	Schedule *DependabotSchedule
+	// Groups is groups of dependencies. Keys are group names.
+	Groups map[string]*DependabotGroup
+	// Registries is names of private registries used for the updates. The name "*" means all
+	// registries.
+	Registries []*String
+	// TargetBranch is a branch to create pull requests against. This field is nil when it is
+	// omitted.
+	TargetBranch *String
+	// Pos is a position in source.
+	Pos *Pos
+}
+
+// DependabotRegistry is a private registry defined in "registries" section of Dependabot
+// configuration.
+// https://docs.github.com/en/code-security/dependabot/working-with-dependabot/configuring-access-to-private-registries-for-dependabot
+type DependabotRegistry struct {
+	// Name is a name of the registry referred from "registries" of update configurations.
+	Name *String
+	// Type is a type of the registry such as "npm-registry".
+	Type *String
+	// URL is a URL of the registry.
+	URL *String
+}
+
+// Dependabot is root of Dependabot configuration syntax tree, which represents one dependabot.yml
+// file.
+// https://docs.github.com/en/code-security/dependabot/dependabot-version-updates/configuration-options-for-the-dependabot.yml-file
+type Dependabot struct {
+	// Version is a version of the configuration file format. It must be 2.
+	Version *Int
+	// Updates is update configurations for package managers.
+	Updates []*DependabotUpdate
+	// Registries is a mapping from registry names to the private registries.
+	Registries map[string]*DependabotRegistry
+}
+
+// IssueFormOption is an option of "dropdown" or "checkboxes" element of issue form.
+type IssueFormOption struct {
+	// Label is a label of the option. For "dropdown" element, the option is written as a string.
+	Label *String
+	// Required is "required" of the option of "checkboxes" element. This field is nil when it is
+	// omitted.
+	Required *Bool
+	// Mapping is true when the option is written as a mapping like {label: ..., required: ...}.
+	Mapping bool
+	// Pos is a position in source.
+	Pos *Pos
+}
+
+// IssueFormAttributes is "attributes" section of an element of issue form. Available attributes
+// are different depending on the type of the element.
+// https://docs.github.com/en/communities/using-templates-to-encourage-useful-issues-and-pull-requests/syntax-for-githubs-form-schema
+type IssueFormAttributes struct {
+	// Keys is keys of the attributes in the section. It is used for checking the attributes are
+	// available for the type of the element.
+	Keys []*String
+	// Label is a label of the element.
+	Label *String
+	// Description is a description of the element.
+	Description *String
+	// Placeholder is a placeholder of "input" or "textarea" element.
+	Placeholder *String
+	// Value is a value of "markdown" element or a default value of "input" or "textarea" element.
+	Value *String
+	// Render is a language to render the value of "textarea" element.
+	Render *String
+	// Multiple is whether multiple options can be selected in "dropdown" element.
+	Multiple *Bool
+	// Options is options of "dropdown" or "checkboxes" element. This field is nil when it is
+	// omitted.
+	Options []*IssueFormOption
+	// Default is an index of the default option of "dropdown" element.
+	Default *Int
+	// Pos is a position in source.
+	Pos *Pos
+}
+
+// IssueFormElement is an element in "body" section of issue form.
+// https://docs.github.com/en/communities/using-templates-to-encourage-useful-issues-and-pull-requests/syntax-for-githubs-form-schema
+type IssueFormElement struct {
+	// Type is a type of the element such as "input" or "dropdown".
+	Type *String
+	// ID is an ID of the element. This field is nil when it is omitted.
+	ID *String
+	// Attributes is attributes of the element.
+	Attributes *IssueFormAttributes
+	// Required is "required" in "validations" section of the element. This field is nil when it
+	// is omitted.
+	Required *Bool
+	// Validations is a position of "validations" section. This field is nil when it is omitted.
+	Validations *Pos
+	// Pos is a position in source.
+	Pos *Pos
+}
+
+// IssueForm is root of issue form syntax tree, which represents one issue form file in
+// ".github/ISSUE_TEMPLATE" directory.
+// https://docs.github.com/en/communities/using-templates-to-encourage-useful-issues-and-pull-requests/syntax-for-issue-forms
+type IssueForm struct {
+	// Name is a name of the issue form.
+	Name *String
+	// Description is a description of the issue form.
+	Description *String
+	// Title is a default title of issues.
+	Title *String
+	// Labels is labels added to issues.
+	Labels []*String
+	// Assignees is users assigned to issues.
+	Assignees []*String
+	// Projects is projects which issues are added to in "{owner}/{number}" format.
+	Projects []*String
+	// Type is a type of issues.
+	Type *String
+	// Body is elements of the issue form.
+	Body []*IssueFormElement
+}
+
+// CodeownersRule is a rule in CODEOWNERS file. One line in the file represents one rule.
+type CodeownersRule struct {
+	// Pattern is a file pattern of the rule in gitignore syntax.
+	Pattern *String
+	// Owners is owners of the files matched to the pattern. Each owner is a user name like
+	// "@octocat", a team name like "@org/team", or an email address. This field is empty when
+	// the matched files have no owner.
+	Owners []*String
+	// Pos is a position in source.
+	Pos *Pos
+}
+
+// Codeowners is root of CODEOWNERS syntax tree, which represents one CODEOWNERS file.
+// https://docs.github.com/en/repositories/managing-your-repositorys-settings-and-features/customizing-your-repository/about-code-owners
+type Codeowners struct {
+	// Rules is rules in the file in order of appearance. The last matching rule takes precedence.
+	Rules []*CodeownersRule
+}
+
+// ReleaseNotesExclude is "exclude" section of release notes configuration. Pull requests matched
+// to the section are not shown in release notes.
+type ReleaseNotesExclude struct {
+	// Labels is labels of pull requests to be excluded.
+	Labels []*String
+	// Authors is authors of pull requests to be excluded.
+	Authors []*String
+	// Pos is a position in source.
+	Pos *Pos
+}
+
+// ReleaseNotesCategory is a category of changes in release notes.
+type ReleaseNotesCategory struct {
+	// Title is a title of the category.
+	Title *String
+	// Labels is labels of pull requests in the category. "*" matches all pull requests which did
+	// not match any previous category.
+	Labels []*String
+	// Exclude is "exclude" section of the category. This field is nil when it is omitted.
+	Exclude *ReleaseNotesExclude
+	// Pos is a position in source.
+	Pos *Pos
+}
+
+// ReleaseNotes is root of release notes configuration syntax tree, which represents one
+// .github/release.yml file.
+// https://docs.github.com/en/repositories/releasing-projects-on-github/automatically-generated-release-notes#configuring-automatically-generated-release-notes
+type ReleaseNotes struct {
+	// Exclude is "exclude" section at "changelog". This field is nil when it is omitted.
+	Exclude *ReleaseNotesExclude
+	// Categories is categories of changes at "changelog".
+	Categories []*ReleaseNotesCategory
+}
@@ -1,12 +1,19 @@
 package actionlint
 
 import (
+	"bytes"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"regexp"
 	"runtime"
 	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // These variables might be modified by ldflags on building release binaries by GoReleaser. Do not modify manually
@@ -24,8 +31,33 @@ const (
 	ExitStatusInvalidCommandOption = 2
 	// ExitStatusFailure is the exit status when the command stopped due to some fatal error while checking workflows.
 	ExitStatusFailure = 3
+	// ExitStatusSuccessWarningFound is the exit status when the command ran successfully and only warnings were found.
+	// Errors whose severities are other than "error" such as "warning", "info", and "style" are warnings.
+	ExitStatusSuccessWarningFound = 4
 )
 
+// isWarning returns true when the error is a warning. Errors reported by actionlint itself have no
+// severity and they are not warnings.
+func isWarning(err *Error) bool {
+	return err.Severity != "" && err.Severity != "error"
+}
+
+// exitStatusOf returns the exit status for the errors. When the failOn parameter is "error",
+// warnings do not make the command fail.
+func exitStatusOf(errs []*Error, failOn string) int {
+	warned := false
+	for _, err := range errs {
+		if !isWarning(err) {
+			return ExitStatusSuccessProblemFound // Linter found some issues, yay!
+		}
+		warned = true
+	}
+	if warned && failOn != "error" {
+		return ExitStatusSuccessWarningFound
+	}
+	return ExitStatusSuccessNoProblem
+}
+
 func printUsageHeader(out io.Writer) {
 	v := getCommandVersion()
 	b := "main"
@@ -51,11 +83,58 @@ func printUsageHeader(out io.Writer) {
 
     $ actionlint -
 
+  To check workflows in archives such as tarballs downloaded from GitHub, pass
+  .zip, .tar.gz, .tgz, or .tar files. They are not extracted to disk:
+
+    $ actionlint repo.tar.gz
+
   To serialize errors into JSON, use -format option. It allows to format error
   messages flexibly with Go template syntax.
 
     $ actionlint -format '{{json .}}'
 
+  To check an expression snippet which is not in workflow files, use
+  -lint-expression option. The type of the expression is output as well:
+
+    $ actionlint -lint-expression '${{ github.event.pull_request.title }}' -context pull_request
+
+  To debug the type of an expression in a workflow file, use -explain-at
+  option with the position of the expression:
+
+    $ actionlint -explain-at .github/workflows/ci.yaml:12:30
+
+  To know which contexts and special functions are available at each workflow
+  key, use -context-availability option. It outputs them as JSON:
+
+    $ actionlint -context-availability
+
+  To check when a CRON spec at "schedule:" runs, use -check-cron option. It
+  outputs the human-readable description and the next run times in UTC:
+
+    $ actionlint -check-cron '30 9 * * 1-5'
+
+  To list all actions and reusable workflows used in the repository with their
+  versions and pin status, use -list-actions option with the output format
+  "table", "json", or "csv":
+
+    $ actionlint -list-actions table
+
+  To list all secrets, configuration variables, and deployment environments
+  referred in the repository, use -list-secrets option in the same way:
+
+    $ actionlint -list-secrets table
+
+  To print the dependency graph of jobs in workflows, use -graph option with
+  the output format "dot" or "mermaid":
+
+    $ actionlint -graph dot | dot -Tsvg -o jobs.svg
+
+  To check many repositories at once, pass a file listing paths or glob
+  patterns of the repository checkouts to -repos option. The errors are
+  summarized per repository:
+
+    $ actionlint -repos repos.txt
+
 Documents:
 
   https://github.com/rhysd/actionlint/tree/%s/docs
@@ -87,7 +166,15 @@ type Command struct {
 	Stderr io.Writer
 }
 
-func (cmd *Command) runLinter(args []string, opts *LinterOptions, initConfig bool) ([]*Error, error) {
+func (cmd *Command) runLinter(args []string, opts *LinterOptions, initConfig, jsonSchema bool, expr, event, explainAt, listActions, listSecrets, graph, repos string) ([]*Error, error) {
+	archives, err := cmd.prepareArchives(args, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(archives) > 0 && (initConfig || jsonSchema || explainAt != "" || listActions != "" || listSecrets != "" || graph != "" || expr != "" || repos != "") {
+		return nil, fmt.Errorf("archive files can only be linted: %s", quotes(args))
+	}
+
 	l, err := NewLinter(cmd.Stdout, opts)
 	if err != nil {
 		return nil, err
@@ -97,6 +184,62 @@ func (cmd *Command) runLinter(args []string, opts *LinterOptions, initConfig boo
 		return nil, l.GenerateDefaultConfig(".")
 	}
 
+	if jsonSchema {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("file arguments cannot be given with -json-schema: %s", quotes(args))
+		}
+		return nil, l.PrintWorkflowJSONSchema(".")
+	}
+
+	if explainAt != "" {
+		path, line, col, err := parseExplainAtPosition(explainAt)
+		if err != nil {
+			return nil, err
+		}
+		return nil, l.ExplainAt(path, line, col)
+	}
+
+	if listActions != "" {
+		return nil, l.ListActions(args, listActions)
+	}
+
+	if listSecrets != "" {
+		return nil, l.ListSecrets(args, listSecrets)
+	}
+
+	if graph != "" {
+		return nil, l.PrintJobGraph(args, graph)
+	}
+
+	if expr != "" {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("file arguments cannot be given with -lint-expression: %s", quotes(args))
+		}
+		return l.LintExpression(expr, event)
+	}
+	if event != "" {
+		return nil, errors.New("-context option is only available with -lint-expression option")
+	}
+
+	if repos != "" {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("file arguments cannot be given with -repos: %s", quotes(args))
+		}
+		dirs, err := cmd.readRepositoryList(repos)
+		if err != nil {
+			return nil, err
+		}
+		return l.LintRepositories(dirs)
+	}
+
+	switch len(archives) {
+	case 0:
+	case 1:
+		return l.LintRepository(archives[0])
+	default:
+		return l.LintRepositories(archives)
+	}
+
 	if len(args) == 0 {
 		return l.LintRepository(".")
 	}
@@ -116,6 +259,97 @@ func (cmd *Command) runLinter(args []string, opts *LinterOptions, initConfig boo
 	return l.LintFiles(args, nil)
 }
 
+// prepareArchives sets the file system which contains the files in the archives given as arguments
+// to the options. It returns the directories where the archives are put in the file system. It
+// returns nil when no archive is given. The config file given by -config-file is read from the OS
+// file system and put at the root of the file system.
+func (cmd *Command) prepareArchives(args []string, opts *LinterOptions) ([]string, error) {
+	n := 0
+	for _, a := range args {
+		if isArchiveFile(a) {
+			n++
+		}
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	if n != len(args) {
+		return nil, fmt.Errorf("archive files cannot be given with other files: %s", quotes(args))
+	}
+
+	fsys, dirs, err := newArchivesFS(args)
+	if err != nil {
+		return nil, err
+	}
+	if opts.ConfigFile != "" {
+		b, err := os.ReadFile(opts.ConfigFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read config file %q: %w", opts.ConfigFile, err)
+		}
+		n := filepath.Base(opts.ConfigFile)
+		if err := fsys.addFile(n, b); err != nil {
+			return nil, fmt.Errorf("could not add config file %q: %w", opts.ConfigFile, err)
+		}
+		opts.ConfigFile = n
+	}
+	opts.FS = fsys
+	return dirs, nil
+}
+
+// readRepositoryList reads the list of repositories given by -repos. Each line is a path to a
+// repository directory or a glob pattern matching to the directories. Empty lines and lines
+// starting with "#" are ignored. "-" reads the list from stdin.
+func (cmd *Command) readRepositoryList(path string) ([]string, error) {
+	var b []byte
+	var err error
+	if path == "-" {
+		b, err = io.ReadAll(cmd.Stdin)
+	} else {
+		b, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read list of repositories %q: %w", path, err)
+	}
+
+	dirs := []string{}
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !strings.ContainsAny(line, "*?[") {
+			dirs = append(dirs, line)
+			continue
+		}
+		matches, err := filepath.Glob(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid glob pattern %q in list of repositories %q: %w", line, path, err)
+		}
+		for _, m := range matches {
+			if s, err := os.Stat(m); err == nil && s.IsDir() {
+				dirs = append(dirs, m)
+			}
+		}
+	}
+	if len(dirs) == 0 {
+		return nil, fmt.Errorf("no repository was found in list of repositories %q", path)
+	}
+	return dirs, nil
+}
+
+// parseExplainAtPosition parses the position given to -explain-at like "file.yaml:12:30".
+func parseExplainAtPosition(s string) (string, int, int, error) {
+	ss := strings.Split(s, ":")
+	if len(ss) >= 3 {
+		l, lerr := strconv.Atoi(ss[len(ss)-2])
+		c, cerr := strconv.Atoi(ss[len(ss)-1])
+		if lerr == nil && cerr == nil && l > 0 && c > 0 {
+			return strings.Join(ss[:len(ss)-2], ":"), l, c, nil
+		}
+	}
+	return "", 0, 0, fmt.Errorf("position %q at -explain-at must be in the form of \"{file}:{line}:{col}\" like \"ci.yaml:12:30\"", s)
+}
+
 type ignorePatternFlags []string
 
 func (i *ignorePatternFlags) String() string {
@@ -126,6 +360,16 @@ func (i *ignorePatternFlags) Set(v string) error {
 	return nil
 }
 
+type globPatternFlags []string
+
+func (g *globPatternFlags) String() string {
+	return "option for glob patterns"
+}
+func (g *globPatternFlags) Set(v string) error {
+	*g = append(*g, v)
+	return nil
+}
+
 // Main is main function of actionlint. It takes command line arguments as string slice and returns
 // exit status. The args should be entire arguments including the program name, usually given via
 // os.Args.
@@ -136,22 +380,82 @@ func (cmd *Command) Main(args []string) int {
 	var initConfig bool
 	var noColor bool
 	var color bool
+	var lintExpr string
+	var exprContext string
+	var explainAt string
+	var ctxAvail bool
+	var jsonSchema bool
+	var checkCron string
+	var listActions string
+	var listSecrets string
+	var graph string
+	var stats string
+	var failOn string
+	var repos string
+	var uploadSARIF bool
+	upload := NewSARIFUploadFromEnv()
+	var workflowPats globPatternFlags
+	var jobPats globPatternFlags
 
 	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
 	flags.SetOutput(cmd.Stderr)
 	flags.Var(&ignorePats, "ignore", "Regular expression matching to error messages you want to ignore. This flag is repeatable")
+	flags.Var(&workflowPats, "workflow", "Glob pattern to select workflow files to check like \"release-*\". It is matched with file names with and without the extension. This flag is repeatable")
+	flags.Var(&jobPats, "job", "Glob pattern to select jobs to check like \"deploy\". It is matched with job IDs and job names and only errors in the matched jobs are reported. This flag is repeatable")
 	flags.StringVar(&opts.Shellcheck, "shellcheck", "shellcheck", "Command name or file path of \"shellcheck\" external command. If empty, shellcheck integration will be disabled")
+	flags.StringVar(&opts.ShellcheckArgs, "shellcheck-args", "", "Extra command line arguments of \"shellcheck\" separated by whitespaces like \"-o all -e SC2086\". They take precedence over \"shellcheck\" section in config file")
+	flags.StringVar(&opts.PSScriptAnalyzer, "psscriptanalyzer", "pwsh", "Command name or file path of PowerShell \"pwsh\" to run PSScriptAnalyzer for PowerShell scripts. If empty or PSScriptAnalyzer module is not installed, PSScriptAnalyzer integration will be disabled")
 	flags.StringVar(&opts.Pyflakes, "pyflakes", "pyflakes", "Command name or file path of \"pyflakes\" external command. If empty, pyflakes integration will be disabled")
 	flags.BoolVar(&opts.Oneline, "oneline", false, "Use one line per one error. Useful for reading error messages from programs")
+	flags.IntVar(&opts.SnippetLines, "snippet-lines", 0, "Number of lines shown before and after the line of each error in source snippets")
+	flags.BoolVar(&opts.Quiet, "quiet", false, "Print only the paths of files which have at least one error")
+	flags.BoolVar(&opts.Group, "group", false, "Group errors by file. The file path and the number of errors are printed once before the errors in the file")
+	flags.StringVar(&opts.Sort, "sort", "", "Order of printed errors. One of \"file\", \"rule\", or \"severity\". By default, errors are printed in the order of checked files")
 	flags.StringVar(&opts.Format, "format", "", "Custom template to format error messages in Go template syntax. See https://github.com/rhysd/actionlint/tree/main/docs/usage.md#format")
+	flags.StringVar(&opts.PathPrefixStrip, "path-prefix-strip", "", "Prefix removed from file paths in the output. Useful when actionlint runs in a container where the repository is mounted at a different path from the host")
+	flags.StringVar(&opts.PathPrefixAdd, "path-prefix-add", "", "Prefix prepended to file paths in the output after removing -path-prefix-strip. Useful to match the paths with the checkout on the host")
 	flags.StringVar(&opts.ConfigFile, "config-file", "", "File path to config file")
 	flags.BoolVar(&initConfig, "init-config", false, "Generate default config file at .github/actionlint.yaml in current project")
 	flags.BoolVar(&noColor, "no-color", false, "Disable colorful output")
 	flags.BoolVar(&color, "color", false, "Always enable colorful output. This is useful to force colorful outputs")
+	flags.IntVar(&opts.MaxErrors, "max-errors", 0, "Maximum number of errors printed in one run. The number of the rest of errors is printed instead. Zero means no limit")
+	flags.IntVar(&opts.MaxErrorsPerFile, "max-errors-per-file", 0, "Maximum number of errors printed for each file. Zero means no limit")
+	flags.IntVar(&opts.Jobs, "j", 0, "Maximum number of workflow files and rules checked concurrently. Zero means the number of CPUs")
 	flags.BoolVar(&opts.Verbose, "verbose", false, "Enable verbose output")
 	flags.BoolVar(&opts.Debug, "debug", false, "Enable debug output (for development)")
 	flags.BoolVar(&ver, "version", false, "Show version and how this binary was installed")
 	flags.StringVar(&opts.StdinFileName, "stdin-filename", "", "File name when reading input from stdin")
+	flags.BoolVar(&opts.RemoteReusableWorkflows, "remote-workflows", false, "Fetch reusable workflows in remote repositories and validate workflow calls with them. Fetched files are cached on disk")
+	flags.BoolVar(&opts.RemoteActions, "remote-actions", false, "Fetch metadata of actions in remote repositories which are not in the popular actions data set and validate inputs at \"with:\" with them. Fetched files are cached on disk")
+	flags.BoolVar(&opts.RemoteCodeowners, "remote-codeowners", false, "Check owners in CODEOWNERS file exist on GitHub via REST API. Teams are checked only when $GITHUB_TOKEN is set")
+	flags.BoolVar(&opts.RemoteEnvironments, "remote-environments", false, "Check deployment environments at \"environment:\" exist in the repository via REST API. $GITHUB_TOKEN with read access to the repository is required")
+	flags.BoolVar(&opts.RemoteSecrets, "remote-secrets", false, "Check secrets and configuration variables at \"secrets.X\" and \"vars.Y\" are set in the repository via REST API. $GITHUB_TOKEN with read access to the repository is required")
+	flags.BoolVar(&opts.RemoteRunnerGroups, "remote-runner-groups", false, "Check runner groups at \"runs-on.group\" are available to the repository via REST API. $GITHUB_TOKEN with read access to the organization's runner groups is required")
+	flags.BoolVar(&opts.RemoteDockerImages, "remote-docker-images", false, "Check images of Docker actions at \"uses: docker://...\" exist in their registries. Results are not cached on disk")
+	flags.StringVar(&opts.CacheDir, "cache-dir", "", "Directory path to cache files fetched from remote. The default is \"actionlint\" in the user cache directory")
+	flags.BoolVar(&opts.CacheResults, "cache-results", false, "Cache lint results of workflow files in -cache-dir and skip checking unchanged files on the next run")
+	flags.DurationVar(&opts.CacheTTL, "cache-ttl", 24*time.Hour, "Time to live of files fetched from remote and cached on disk. Zero means the cache never expires")
+	flags.BoolVar(&opts.Offline, "offline", false, "Never fetch files from remote with -remote-actions or -remote-workflows and only use cached files. -remote-codeowners and -remote-docker-images are also disabled")
+	flags.StringVar(&opts.HTTPProxy, "http-proxy", "", "URL of the proxy server for network access like \"http://proxy.example.com:8080\". The default is configured with $HTTPS_PROXY, $HTTP_PROXY, and $NO_PROXY")
+	flags.DurationVar(&opts.HTTPTimeout, "http-timeout", 10*time.Second, "Timeout of each HTTP request for network access")
+	flags.BoolVar(&opts.Profile, "profile", false, "Measure wall time spent by each rule and number of errors reported by it for each workflow file, and output them with the aggregate after errors")
+	flags.StringVar(&failOn, "fail-on", "warning", "Minimum severity of errors which make the command fail. The value is \"warning\" or \"error\". With \"error\", the command exits successfully when only warnings are found")
+	flags.StringVar(&stats, "stats", "", "Output summary statistics of errors per rule, per file, and per severity with totals and elapsed time after errors. The value is an output format \"text\" or \"json\"")
+	flags.BoolVar(&opts.EstimateCost, "estimate-cost", false, "Estimate billable minutes of GitHub-hosted runners for each workflow and output them after errors. Average durations of jobs can be configured with \"cost-estimate\" in config file")
+	flags.StringVar(&lintExpr, "lint-expression", "", "Parse and type-check the given expression like \"${{ github.event_name == 'push' }}\" instead of workflow files")
+	flags.StringVar(&exprContext, "context", "", "Event name which triggers the workflow to type \"github.event\" of the expression given by -lint-expression such as \"pull_request\"")
+	flags.StringVar(&explainAt, "explain-at", "", "Explain the type of the expression at the position like \"ci.yaml:12:30\" and which contexts or action metadata contributed to it")
+	flags.StringVar(&listActions, "list-actions", "", "List all actions and reusable workflows used in workflows with their versions, pin status, and locations instead of linting. The value is an output format \"table\", \"json\", or \"csv\"")
+	flags.StringVar(&graph, "graph", "", "Print dependency graph of jobs connected by \"needs:\" and calls of reusable workflows instead of linting. The value is an output format \"dot\" or \"mermaid\"")
+	flags.StringVar(&listSecrets, "list-secrets", "", "List all secrets, configuration variables, and deployment environments referred in workflows with their locations instead of linting. The value is an output format \"table\", \"json\", or \"csv\"")
+	flags.StringVar(&repos, "repos", "", "File listing paths or glob patterns of repositories to check at once, one per line. Each repository is checked with its own config file and errors are summarized per repository. \"-\" reads the list from stdin")
+	flags.BoolVar(&jsonSchema, "json-schema", false, "Print JSON Schema of workflow files reflecting the syntax accepted by actionlint instead of linting. Runner labels and other extensions in config file are included. It is useful for completions with yaml-language-server")
+	flags.BoolVar(&uploadSARIF, "upload-sarif", false, "Upload errors in SARIF format to GitHub code scanning so that they appear in the Security tab. $GITHUB_TOKEN with \"security_events\" write permission is required. Errors are also printed to stdout")
+	flags.StringVar(&upload.Repository, "upload-sarif-repo", upload.Repository, "Repository like \"owner/repo\" to upload SARIF report with -upload-sarif. The default is $GITHUB_REPOSITORY")
+	flags.StringVar(&upload.Ref, "upload-sarif-ref", upload.Ref, "Full Git reference like \"refs/heads/main\" of the commit to upload SARIF report with -upload-sarif. The default is $GITHUB_REF")
+	flags.StringVar(&upload.CommitSHA, "upload-sarif-sha", upload.CommitSHA, "Full SHA of the commit to upload SARIF report with -upload-sarif. The default is $GITHUB_SHA")
+	flags.BoolVar(&ctxAvail, "context-availability", false, "Print which contexts and special functions are available at each workflow key as JSON")
+	flags.StringVar(&checkCron, "check-cron", "", "Print a human-readable description and the next run times in UTC of the CRON spec at \"schedule:\" like \"30 9 * * 1-5\" instead of linting")
 	flags.Usage = func() {
 		printUsageHeader(cmd.Stderr)
 		flags.PrintDefaults()
@@ -177,8 +481,27 @@ func (cmd *Command) Main(args []string) int {
 		return ExitStatusSuccessNoProblem
 	}
 
+	if ctxAvail {
+		if err := printContextAvailability(cmd.Stdout); err != nil {
+			fmt.Fprintln(cmd.Stderr, err.Error())
+			return ExitStatusFailure
+		}
+		return ExitStatusSuccessNoProblem
+	}
+
+	if checkCron != "" {
+		if err := printCronSchedule(cmd.Stdout, checkCron, time.Now()); err != nil {
+			fmt.Fprintln(cmd.Stderr, err.Error())
+			return ExitStatusFailure
+		}
+		return ExitStatusSuccessNoProblem
+	}
+
 	opts.IgnorePatterns = ignorePats
+	opts.WorkflowFilters = workflowPats
+	opts.JobFilters = jobPats
 	opts.LogWriter = cmd.Stderr
+	opts.Locale = localeFromEnv()
 
 	if color {
 		opts.Color = ColorOptionKindAlways
@@ -187,14 +510,73 @@ func (cmd *Command) Main(args []string) int {
 		opts.Color = ColorOptionKindNever
 	}
 
-	errs, err := cmd.runLinter(flags.Args(), &opts, initConfig)
+	if failOn != "warning" && failOn != "error" {
+		fmt.Fprintf(cmd.Stderr, "value of -fail-on must be \"warning\" or \"error\" but got %q\n", failOn)
+		return ExitStatusInvalidCommandOption
+	}
+	if stats != "" && stats != "text" && stats != "json" {
+		fmt.Fprintf(cmd.Stderr, "value of -stats must be \"text\" or \"json\" but got %q\n", stats)
+		return ExitStatusInvalidCommandOption
+	}
+	var report bytes.Buffer
+	if uploadSARIF {
+		if initConfig || jsonSchema || lintExpr != "" || explainAt != "" || listActions != "" || listSecrets != "" || graph != "" || repos != "" {
+			fmt.Fprintln(cmd.Stderr, "-upload-sarif option cannot be used with -init-config, -json-schema, -lint-expression, -explain-at, -list-actions, -list-secrets, -graph, or -repos option")
+			return ExitStatusInvalidCommandOption
+		}
+		if stats != "" || opts.Profile || opts.EstimateCost {
+			// These outputs are appended after errors and break the SARIF report
+			fmt.Fprintln(cmd.Stderr, "-upload-sarif option cannot be used with -stats, -profile, or -estimate-cost option")
+			return ExitStatusInvalidCommandOption
+		}
+		if opts.Offline {
+			fmt.Fprintln(cmd.Stderr, "-upload-sarif option cannot be used with -offline option")
+			return ExitStatusInvalidCommandOption
+		}
+		if err := upload.validate(); err != nil {
+			fmt.Fprintln(cmd.Stderr, err.Error())
+			return ExitStatusInvalidCommandOption
+		}
+		if opts.Format == "" {
+			opts.Format = sarifTemplate
+		}
+		// Copy the command not to modify the caller's writer
+		c := *cmd
+		c.Stdout = io.MultiWriter(cmd.Stdout, &report)
+		cmd = &c
+	}
+
+	checked := map[string]struct{}{}
+	if stats != "" {
+		opts.OnFileChecked = func(path string, errs []*Error) {
+			checked[path] = struct{}{}
+		}
+	}
+
+	start := time.Now()
+	errs, err := cmd.runLinter(flags.Args(), &opts, initConfig, jsonSchema, lintExpr, exprContext, explainAt, listActions, listSecrets, graph, repos)
 	if err != nil {
 		fmt.Fprintln(cmd.Stderr, err.Error())
 		return ExitStatusFailure
 	}
-	if len(errs) > 0 {
-		return ExitStatusSuccessProblemFound // Linter found some issues, yay!
+	if stats != "" && !initConfig && !jsonSchema && explainAt == "" && listActions == "" && listSecrets == "" && graph == "" {
+		if err := newLintStats(errs, len(checked), time.Since(start)).print(cmd.Stdout, stats); err != nil {
+			fmt.Fprintln(cmd.Stderr, err.Error())
+			return ExitStatusFailure
+		}
 	}
-
-	return ExitStatusSuccessNoProblem
+	if uploadSARIF {
+		client, err := newHTTPClient(opts.HTTPProxy, opts.HTTPTimeout)
+		if err != nil {
+			fmt.Fprintln(cmd.Stderr, err.Error())
+			return ExitStatusFailure
+		}
+		id, err := upload.Upload(client, report.Bytes())
+		if err != nil {
+			fmt.Fprintln(cmd.Stderr, err.Error())
+			return ExitStatusFailure
+		}
+		fmt.Fprintf(cmd.Stderr, "Uploaded SARIF report to code scanning of %s (id: %s)\n", upload.Repository, id)
+	}
+	return exitStatusOf(errs, failOn)
 }
@@ -2,6 +2,8 @@ package actionlint
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -11,6 +13,9 @@ import (
 func TestCommandMain(t *testing.T) {
 	var output bytes.Buffer
 
+	// Error messages are checked in English
+	t.Setenv("LC_ALL", "C")
+
 	// Create command instance populating stdin/stdout/stderr
 	cmd := Command{
 		Stdin:  os.Stdin,
@@ -42,3 +47,261 @@ func TestCommandMain(t *testing.T) {
 		t.Errorf("runner-label rule should be ignored by -ignore but it is included in output: %q", out)
 	}
 }
+
+func TestCommandParseExplainAtPosition(t *testing.T) {
+	path, line, col, err := parseExplainAtPosition("C:\\ci.yaml:12:30")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "C:\\ci.yaml" || line != 12 || col != 30 {
+		t.Fatalf("unexpected position: %q %d %d", path, line, col)
+	}
+
+	for _, input := range []string{"ci.yaml", "ci.yaml:12", "ci.yaml:a:30", "ci.yaml:0:30"} {
+		if _, _, _, err := parseExplainAtPosition(input); err == nil {
+			t.Errorf("error did not happen for %q", input)
+		}
+	}
+}
+
+func TestCommandContextAvailability(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	cmd := Command{
+		Stdin:  os.Stdin,
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}
+
+	status := cmd.Main([]string{"actionlint", "-context-availability"})
+	if status != ExitStatusSuccessNoProblem {
+		t.Fatalf("exit status should be %d but got %d: %s", ExitStatusSuccessNoProblem, status, stderr.String())
+	}
+
+	out := stdout.String()
+	for _, s := range []string{`"key": "jobs.<job_id>.steps.if"`, `"contexts": [`, `"special_functions": [`} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output should contain %q: %q", s, out)
+		}
+	}
+}
+
+func TestCommandJSONSchema(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	cmd := Command{
+		Stdin:  os.Stdin,
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}
+
+	cfg := filepath.Join("testdata", "projects", "user_defined_runner_label", "actionlint.yaml")
+	status := cmd.Main([]string{"actionlint", "-json-schema", "-config-file", cfg})
+	if status != ExitStatusSuccessNoProblem {
+		t.Fatalf("exit status should be %d but got %d: %s", ExitStatusSuccessNoProblem, status, stderr.String())
+	}
+
+	out := stdout.String()
+	for _, s := range []string{`"$schema": "http://json-schema.org/draft-07/schema#"`, `"label1"`, `"ubuntu-latest"`} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output should contain %q", s)
+		}
+	}
+
+	stderr.Reset()
+	status = cmd.Main([]string{"actionlint", "-json-schema", "test.yaml"})
+	if status != ExitStatusFailure {
+		t.Fatalf("exit status should be %d but got %d", ExitStatusFailure, status)
+	}
+	if out := stderr.String(); !strings.Contains(out, "file arguments cannot be given with -json-schema") {
+		t.Errorf("error message is unexpected: %q", out)
+	}
+}
+
+func TestCommandCheckCron(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	cmd := Command{
+		Stdin:  os.Stdin,
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}
+
+	status := cmd.Main([]string{"actionlint", "-check-cron", "30 9 * * 1-5"})
+	if status != ExitStatusSuccessNoProblem {
+		t.Fatalf("exit status should be %d but got %d: %s", ExitStatusSuccessNoProblem, status, stderr.String())
+	}
+	if out := stdout.String(); !strings.Contains(out, "Description: At 09:30 on Monday through Friday (UTC)\n") {
+		t.Errorf("description is not included in output: %q", out)
+	}
+
+	stdout.Reset()
+	status = cmd.Main([]string{"actionlint", "-check-cron", "30 9 * *"})
+	if status != ExitStatusFailure {
+		t.Fatalf("exit status should be %d but got %d", ExitStatusFailure, status)
+	}
+	if out := stderr.String(); !strings.Contains(out, `invalid CRON format "30 9 * *"`) {
+		t.Errorf("error message is unexpected: %q", out)
+	}
+}
+
+func TestCommandStats(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	cmd := Command{
+		Stdin:  os.Stdin,
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}
+
+	files := []string{
+		filepath.Join("testdata", "err", "one_error.yaml"),
+		filepath.Join("testdata", "ok", "minimal.yaml"),
+	}
+	args := append([]string{"actionlint", "-shellcheck=", "-pyflakes=", "-oneline", "-stats", "json"}, files...)
+	status := cmd.Main(args)
+	if status != ExitStatusSuccessProblemFound {
+		t.Fatalf("exit status should be %d but got %d: %s", ExitStatusSuccessProblemFound, status, stderr.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	var s lintStats
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &s); err != nil {
+		t.Fatalf("could not parse the last line as JSON: %s: %q", err, stdout.String())
+	}
+	if s.Files != 2 || s.FilesWithErrors != 1 || s.Errors != 1 {
+		t.Errorf("unexpected totals: %+v", s)
+	}
+	if s.Severities["error"] != 1 || len(s.Rules) != 1 || s.FileErrors[filepath.Join("testdata", "err", "one_error.yaml")] != 1 {
+		t.Errorf("unexpected counts: %+v", s)
+	}
+
+	stdout.Reset()
+	stderr.Reset()
+	args[5] = "text"
+	if status := cmd.Main(args); status != ExitStatusSuccessProblemFound {
+		t.Fatalf("exit status should be %d but got %d: %s", ExitStatusSuccessProblemFound, status, stderr.String())
+	}
+	out := stdout.String()
+	for _, s := range []string{"Found 1 errors in 1 files out of 2 checked files in ", "Errors by rule:\n", "Errors by file:\n", "Errors by severity:\n  error 1\n"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output should contain %q: %q", s, out)
+		}
+	}
+
+	stderr.Reset()
+	args[5] = "xml"
+	if status := cmd.Main(args); status != ExitStatusInvalidCommandOption {
+		t.Fatalf("exit status should be %d but got %d", ExitStatusInvalidCommandOption, status)
+	}
+	if out := stderr.String(); !strings.Contains(out, `value of -stats must be "text" or "json" but got "xml"`) {
+		t.Errorf("error message is unexpected: %q", out)
+	}
+}
+
+func TestCommandExitStatusOf(t *testing.T) {
+	e := &Error{Kind: "expression"}
+	sce := &Error{Kind: "shellcheck", Severity: "error"}
+	w := &Error{Kind: "shellcheck", Severity: "warning"}
+	i := &Error{Kind: "psscriptanalyzer", Severity: "info"}
+
+	testCases := []struct {
+		what   string
+		errs   []*Error
+		failOn string
+		want   int
+	}{
+		{"no error", nil, "warning", ExitStatusSuccessNoProblem},
+		{"error without severity", []*Error{e}, "warning", ExitStatusSuccessProblemFound},
+		{"error severity", []*Error{sce}, "warning", ExitStatusSuccessProblemFound},
+		{"only warnings", []*Error{w, i}, "warning", ExitStatusSuccessWarningFound},
+		{"errors and warnings", []*Error{w, e}, "warning", ExitStatusSuccessProblemFound},
+		{"no error with fail-on error", nil, "error", ExitStatusSuccessNoProblem},
+		{"only warnings with fail-on error", []*Error{w, i}, "error", ExitStatusSuccessNoProblem},
+		{"errors and warnings with fail-on error", []*Error{w, sce}, "error", ExitStatusSuccessProblemFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			if have := exitStatusOf(tc.errs, tc.failOn); have != tc.want {
+				t.Fatalf("wanted exit status %d but got %d", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestCommandInvalidFailOn(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	cmd := Command{
+		Stdin:  os.Stdin,
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}
+
+	status := cmd.Main([]string{"actionlint", "-fail-on", "info"})
+	if status != ExitStatusInvalidCommandOption {
+		t.Fatalf("exit status should be %d but got %d", ExitStatusInvalidCommandOption, status)
+	}
+	if out := stderr.String(); !strings.Contains(out, `value of -fail-on must be "warning" or "error" but got "info"`) {
+		t.Errorf("error message is unexpected: %q", out)
+	}
+}
+
+func TestCommandRepos(t *testing.T) {
+	dir := t.TempDir()
+	wf := "on: push\njobs:\n  test:\n    runs-on: my-runner\n    steps:\n      - run: echo\n"
+	for _, r := range []string{"api", "api-v2", "web"} {
+		d := filepath.Join(dir, r, ".github", "workflows")
+		if err := os.MkdirAll(d, 0750); err != nil {
+			t.Fatal(err)
+		}
+		testEnsureDotGitDir(filepath.Join(dir, r))
+		if err := os.WriteFile(filepath.Join(d, "ci.yaml"), []byte(wf), 0640); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Only "api" repository allows the self-hosted runner label
+	cfg := []byte("self-hosted-runner:\n  labels: [my-runner]\n")
+	if err := os.WriteFile(filepath.Join(dir, "api", ".github", "actionlint.yaml"), cfg, 0640); err != nil {
+		t.Fatal(err)
+	}
+	list := filepath.Join(dir, "repos.txt")
+	src := fmt.Sprintf("# repositories\n%s\n\n%s\n", filepath.Join(dir, "api*"), filepath.Join(dir, "web"))
+	if err := os.WriteFile(list, []byte(src), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := Command{
+		Stdin:  os.Stdin,
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}
+	status := cmd.Main([]string{"actionlint", "-shellcheck=", "-pyflakes=", "-oneline", "-repos", list})
+	if status != ExitStatusSuccessProblemFound {
+		t.Fatalf("exit status should be %d but got %d: %s", ExitStatusSuccessProblemFound, status, stderr.String())
+	}
+
+	out := stdout.String()
+	if n := strings.Count(out, "[runner-label]"); n != 2 {
+		t.Errorf("wanted 2 runner-label errors but got %d: %q", n, out)
+	}
+	if strings.Contains(out, filepath.Join("api", ".github", "workflows", "ci.yaml")+":") {
+		t.Errorf("config file in \"api\" repository was not applied: %q", out)
+	}
+	for _, s := range []string{
+		"Checked 3 repositories. 2 repositories have errors\n",
+		filepath.Join(dir, "api") + "    0 errors in 0/1 files\n",
+		filepath.Join(dir, "api-v2") + " 1 errors in 1/1 files\n",
+		filepath.Join(dir, "web") + "    1 errors in 1/1 files\n",
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output should contain %q: %q", s, out)
+		}
+	}
+
+	stderr.Reset()
+	status = cmd.Main([]string{"actionlint", "-repos", list, "foo.yaml"})
+	if status != ExitStatusFailure {
+		t.Fatalf("exit status should be %d but got %d", ExitStatusFailure, status)
+	}
+	if out := stderr.String(); !strings.Contains(out, `file arguments cannot be given with -repos: "foo.yaml"`) {
+		t.Errorf("error message is unexpected: %q", out)
+	}
+}
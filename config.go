@@ -1,10 +1,15 @@
 package actionlint
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"sync"
 
 	"gopkg.in/yaml.v3"
 )
@@ -16,12 +21,497 @@ type Config struct {
 	SelfHostedRunner struct {
 		// Labels is label names for self-hosted runner.
 		Labels []string `yaml:"labels"`
+		// Groups is a map from names of runner groups to label names of the runners in the groups.
+		// When this value is not empty, groups at `runs-on.group` must be defined here and labels at
+		// `runs-on.labels` must be available on the runners in the group.
+		Groups map[string][]string `yaml:"groups"`
 	} `yaml:"self-hosted-runner"`
 	// ConfigVariables is names of configuration variables used in the checked workflows. When this value is nil,
 	// property names of `vars` context will not be checked. Otherwise actionlint will report a name which is not
 	// listed here as undefined config variables.
 	// https://docs.github.com/en/actions/learn-github-actions/variables
 	ConfigVariables []string `yaml:"config-variables"`
+	// DiscoverFiles enables finding files to check other than workflow files in ".github/workflows"
+	// when checking files in the repository, such as action metadata files, workflow files in nested
+	// ".github/workflows" directories, and other configuration files for GitHub. See docs/usage.md
+	// for the list of files.
+	DiscoverFiles bool `yaml:"discover-files"`
+	// EnvShadowing enables "env-shadowing" rule which reports environment variables shadowing or
+	// redundantly redefining the ones defined at outer scopes.
+	EnvShadowing bool `yaml:"env-shadowing"`
+	// RequireTimeoutMinutes is configuration for "require-timeout-minutes" rule. When this value is nil,
+	// the rule is disabled.
+	RequireTimeoutMinutes *RequireTimeoutMinutesConfig `yaml:"require-timeout-minutes"`
+	// RecommendConcurrency is configuration for "recommend-concurrency" rule. When this value is nil,
+	// the rule is disabled.
+	RecommendConcurrency *RecommendConcurrencyConfig `yaml:"recommend-concurrency"`
+	// Complexity is configuration for "complexity" rule. When this value is nil, the rule is disabled.
+	Complexity *ComplexityConfig `yaml:"complexity"`
+	// RequireStepNames is configuration for "require-step-names" rule. When this value is nil, the
+	// rule is disabled.
+	RequireStepNames *RequireStepNamesConfig `yaml:"require-step-names"`
+	// NamingConvention is configuration for "naming-convention" rule. When this value is nil, the rule
+	// is disabled.
+	NamingConvention *NamingConventionConfig `yaml:"naming-convention"`
+	// SuggestMatrix enables "suggest-matrix" rule which reports sibling jobs which are identical
+	// except for a few values and can be merged into one job with matrix.
+	SuggestMatrix bool `yaml:"suggest-matrix"`
+	// DuplicateSteps is configuration for detecting duplicate sequences of steps across jobs and
+	// workflows. When this value is nil, the detection is disabled.
+	DuplicateSteps *DuplicateStepsConfig `yaml:"duplicate-steps"`
+	// CacheKey enables "cache-key" rule which reports keys of actions/cache which make the cache
+	// useless.
+	CacheKey bool `yaml:"cache-key"`
+	// CheckoutPerformance enables "checkout-performance" rule which reports slow usages of
+	// actions/checkout such as "fetch-depth: 0" in jobs which don't use the history.
+	CheckoutPerformance bool `yaml:"checkout-performance"`
+	// Presets is names of presets which enable groups of optional rules at once. Currently only
+	// "performance" preset is available. It enables "checkout-performance" and "cache-key" rules.
+	// Presets are applied when parsing the config file.
+	Presets []string `yaml:"presets"`
+	// MaxArtifactRetentionDays is the maximum value of "retention-days" input of actions/upload-artifact.
+	// When this value is zero, 90 days of GitHub.com is used. Set a larger value for GitHub Enterprise
+	// Server configured with the longer maximum retention period.
+	MaxArtifactRetentionDays int `yaml:"max-artifact-retention-days"`
+	// ContinueOnError is configuration for "continue-on-error" rule. When this value is nil, the rule
+	// is disabled.
+	ContinueOnError *ContinueOnErrorConfig `yaml:"continue-on-error"`
+	// PushFilters enables "push-filters" rule which reports "push" event without any branch, tag,
+	// or path filter.
+	PushFilters bool `yaml:"push-filters"`
+	// ScheduleDispatch enables "schedule-dispatch" rule which reports workflows triggered only by
+	// "schedule" event without "workflow_dispatch" event.
+	ScheduleDispatch bool `yaml:"schedule-dispatch"`
+	// RunnerPolicy is configuration for "runner-policy" rule. When this value is nil, the rule is
+	// disabled.
+	RunnerPolicy *RunnerPolicyConfig `yaml:"runner-policy"`
+	// RequireEnvironment is configuration for "require-environment" rule. When this value is nil, the
+	// rule is disabled.
+	RequireEnvironment *RequireEnvironmentConfig `yaml:"require-environment"`
+	// CostEstimate is configuration for the estimation of billable minutes enabled by -estimate-cost
+	// flag.
+	CostEstimate *CostEstimateConfig `yaml:"cost-estimate"`
+	// PinnedRunner enables "pinned-runner" rule which reports "-latest" runner labels such as
+	// "ubuntu-latest".
+	PinnedRunner bool `yaml:"pinned-runner"`
+	// GitHubEnterprise is configuration for workflows which run on GitHub Enterprise Server. When
+	// this value is nil, workflows are assumed to run on GitHub.com.
+	GitHubEnterprise *GitHubEnterpriseConfig `yaml:"github-enterprise"`
+	// RedundantPermissions enables "redundant-permissions" rule which reports job-level
+	// "permissions:" repeating workflow-level one.
+	RedundantPermissions bool `yaml:"redundant-permissions"`
+	// YAMLStyle is configuration for "yaml-style" rule. When this value is nil, the rule is disabled.
+	YAMLStyle *YAMLStyleConfig `yaml:"yaml-style"`
+	// VerifyHashFiles enables checking that patterns of hashFiles() match some files in the
+	// repository.
+	VerifyHashFiles bool `yaml:"verify-hash-files"`
+	// VerifyPaths enables checking that paths referenced by workflows exist in the repository. It
+	// enables "path-exists" rule and also the check enabled by VerifyHashFiles.
+	VerifyPaths bool `yaml:"verify-paths"`
+	// FromJSONSchemas is a mapping from arguments of fromJSON() like "vars.CONFIG" to file paths of
+	// JSON schemas of their values. The results of fromJSON() calls are typed with the schemas.
+	// Relative file paths are resolved from the repository root.
+	FromJSONSchemas map[string]string `yaml:"from-json-schemas"`
+	// MatrixSchemas is a mapping from arguments of fromJSON() like "needs.prep.outputs.matrix" to
+	// keys and types of matrices built dynamically with the fromJSON() calls. The type is one of
+	// "string", "number", "bool", "object", "array", and "any".
+	MatrixSchemas map[string]map[string]string `yaml:"matrix-schemas"`
+	// RepositoryDispatch is configuration for "repository_dispatch" event. Event types sent to the
+	// repository and the schema of their payloads are declared.
+	RepositoryDispatch *RepositoryDispatchConfig `yaml:"repository-dispatch"`
+	// StrictActionOutputs enables strict typing of "steps.<id>.outputs" of popular actions whose
+	// versions like "v4.1.2" are not in the data set. Their outputs are typed from the metadata of
+	// the same major version and unknown output names are reported.
+	StrictActionOutputs bool `yaml:"strict-action-outputs"`
+	// Actions is a mapping from action specs like "myorg/deploy-action@v2" to their input and
+	// output schemas. It is used for checking inputs and outputs of private or internal actions
+	// which are not in the popular actions data set. A spec without "@{ref}" matches any ref.
+	Actions map[string]*ActionSchemaConfig `yaml:"actions"`
+	// Shellcheck is configuration for "shellcheck" rule to customize options of shellcheck command.
+	Shellcheck *ShellcheckConfig `yaml:"shellcheck"`
+	// PythonChecker is configuration for the checker of Python scripts at "run:" with "shell: python".
+	// When this value is nil, pyflakes is used.
+	PythonChecker *PythonCheckerConfig `yaml:"python-checker"`
+	// CustomShells is a list of custom shells used via "shell:" with "{0}" like "deno run {0}". Scripts
+	// run by the shells are checked by the external checker commands.
+	CustomShells []*CustomShellConfig `yaml:"custom-shells"`
+	// Plugins is a list of external rules. Each plugin is an executable which receives the syntax
+	// tree of a workflow from stdin and reports errors to stdout.
+	Plugins []*PluginConfig `yaml:"plugins"`
+	// Locale is the language of error messages like "ja". It takes precedence over the locale set
+	// via environment variables. "en" disables translation. Kinds of errors are not translated.
+	Locale string `yaml:"locale"`
+}
+
+// ActionSchemaConfig is a schema of inputs and outputs of an action declared at "actions" in the
+// config file. The schema is written inline or is read from the action metadata file at File.
+type ActionSchemaConfig struct {
+	// Inputs is inputs of the action in the same format as "inputs" section of action.yml. When
+	// this value is nil, inputs of the action are not checked.
+	Inputs ActionMetadataInputs `yaml:"inputs"`
+	// Outputs is outputs of the action in the same format as "outputs" section of action.yml. When
+	// this value is nil, outputs of the action are typed loosely.
+	Outputs ActionMetadataOutputs `yaml:"outputs"`
+	// File is a file path of the action metadata file (action.yml) of the action. Relative file path
+	// is resolved from the repository root. It cannot be used with Inputs or Outputs.
+	File string `yaml:"file"`
+}
+
+// RepositoryDispatchConfig is configuration for "repository_dispatch" event declared at
+// "repository-dispatch" in the config file.
+type RepositoryDispatchConfig struct {
+	// Types is a list of event types sent to the repository via the "repository_dispatch" REST API.
+	// When this value is empty, event types are not checked.
+	Types []string `yaml:"types"`
+	// ClientPayloadSchema is a file path of JSON schema of "client_payload" sent with the events.
+	// "github.event.client_payload" is typed with the schema. Relative file path is resolved from the
+	// repository root.
+	ClientPayloadSchema string `yaml:"client-payload-schema"`
+}
+
+// RequireTimeoutMinutesConfig is configuration for "require-timeout-minutes" rule.
+type RequireTimeoutMinutesConfig struct {
+	// Steps requires "timeout-minutes" also on steps running shell scripts with "run:".
+	Steps bool `yaml:"steps"`
+}
+
+// RecommendConcurrencyConfig is configuration for "recommend-concurrency" rule.
+type RecommendConcurrencyConfig struct {
+	// Events is names of events which trigger workflows to be checked. When this value is empty,
+	// only "pull_request" event is checked.
+	Events []string `yaml:"events"`
+}
+
+// ComplexityConfig is configuration for "complexity" rule. When some threshold is zero, its default
+// value is used. When it is negative, the threshold is not checked.
+type ComplexityConfig struct {
+	// MaxJobs is the maximum number of jobs in one workflow.
+	MaxJobs int `yaml:"max-jobs"`
+	// MaxSteps is the maximum number of steps in one job.
+	MaxSteps int `yaml:"max-steps"`
+	// MaxRunLines is the maximum number of lines in one script at "run:".
+	MaxRunLines int `yaml:"max-run-lines"`
+}
+
+// RequireStepNamesConfig is configuration for "require-step-names" rule.
+type RequireStepNamesConfig struct {
+	// RunOnly requires "name:" only on steps running shell scripts with "run:".
+	RunOnly bool `yaml:"run-only"`
+	// MinRunLines requires "name:" only on steps running shell scripts which have this number of
+	// lines or more. Zero means no minimum.
+	MinRunLines int `yaml:"min-run-lines"`
+}
+
+// NamingConventionConfig is configuration for "naming-convention" rule. Each value is a regular
+// expression which names must match. An empty value means no convention.
+type NamingConventionConfig struct {
+	// JobID is a pattern of job IDs.
+	JobID string `yaml:"job-id"`
+	// StepID is a pattern of step IDs.
+	StepID string `yaml:"step-id"`
+	// Output is a pattern of job output names.
+	Output string `yaml:"output"`
+}
+
+// DuplicateStepsConfig is configuration for detecting duplicate sequences of steps.
+type DuplicateStepsConfig struct {
+	// MinLength is the minimum number of steps in a duplicate sequence. When this value is zero,
+	// the default value 3 is used.
+	MinLength int `yaml:"min-length"`
+}
+
+// RequireEnvironmentConfig is configuration for "require-environment" rule. Patterns are in glob
+// syntax supported by path.Match.
+type RequireEnvironmentConfig struct {
+	// Jobs is patterns of IDs or names of jobs which deploy to production. They are matched
+	// case-insensitively. When this value is empty, "*deploy*", "*release*", and "*publish*" are
+	// used.
+	Jobs []string `yaml:"jobs"`
+	// Branches is patterns of production branches. When this value is not empty, only jobs running
+	// on the branches are checked. A job runs on the branches when the workflow is triggered by
+	// "push" event to the branches or its "if:" condition compares "github.ref" with them.
+	Branches []string `yaml:"branches"`
+}
+
+// RunnerPolicyConfig is configuration for "runner-policy" rule. Patterns are in glob syntax
+// supported by path.Match and are matched case-insensitively.
+type RunnerPolicyConfig struct {
+	// Allowed is patterns of runner labels which are allowed. When this value is nil, all labels
+	// are allowed.
+	Allowed []string `yaml:"allowed"`
+	// Forbidden is patterns of runner labels which are forbidden.
+	Forbidden []string `yaml:"forbidden"`
+	// Required is a mapping from patterns of job IDs to runner labels which the jobs must run on.
+	Required map[string][]string `yaml:"required"`
+}
+
+// CostEstimateConfig is configuration for the estimation of billable minutes of workflows.
+type CostEstimateConfig struct {
+	// DefaultMinutes is an average duration of jobs in minutes. When this value is zero, 10 minutes
+	// is used.
+	DefaultMinutes int `yaml:"default-minutes"`
+	// Jobs is a mapping from job IDs to average durations of the jobs in minutes.
+	Jobs map[string]int `yaml:"jobs"`
+}
+
+// Features of GitHub Actions which can be disabled on GitHub Enterprise Server. Values are
+// descriptions of the features used in error messages.
+var gitHubEnterpriseFeatures = map[string]string{
+	"reusable-workflows": "calling reusable workflow",
+	"oidc":               "\"id-token\" permission for OpenID Connect",
+	"cache":              "actions/cache action",
+	"environments":       "deployment environment",
+	"concurrency":        "\"concurrency:\" section",
+}
+
+// GitHubEnterpriseConfig is configuration for workflows which run on GitHub Enterprise Server (GHES).
+type GitHubEnterpriseConfig struct {
+	// APIURL is the base URL of REST API of the GHES instance like "https://ghe.example.com/api/v3".
+	// It is used for fetching files in the instance such as remote reusable workflows.
+	APIURL string `yaml:"api-url"`
+	// Version is the version of the GHES instance in "MAJOR.MINOR" format like "3.12". When this
+	// value is set, features and actions which are not available on the version are reported.
+	Version string `yaml:"version"`
+	// HostedLabels is labels of GitHub-hosted runners which are available on the instance. When
+	// this value is empty, no GitHub-hosted runner is available.
+	HostedLabels []string `yaml:"hosted-labels"`
+	// DisabledFeatures is a list of features which are disabled on the instance. Available values
+	// are "reusable-workflows", "oidc", "cache", "environments", and "concurrency".
+	DisabledFeatures []string `yaml:"disabled-features"`
+}
+
+func (c *GitHubEnterpriseConfig) validate() error {
+	if c.APIURL != "" {
+		u, err := url.Parse(c.APIURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("\"api-url\" must be an HTTP or HTTPS URL but got %q", c.APIURL)
+		}
+	}
+	if c.Version != "" {
+		if _, ok := parseGitHubEnterpriseVersion(c.Version); !ok {
+			return fmt.Errorf("\"version\" must be in MAJOR.MINOR format like \"3.12\" but got %q", c.Version)
+		}
+	}
+	for _, f := range c.DisabledFeatures {
+		if _, ok := gitHubEnterpriseFeatures[f]; !ok {
+			fs := make([]string, 0, len(gitHubEnterpriseFeatures))
+			for f := range gitHubEnterpriseFeatures {
+				fs = append(fs, f)
+			}
+			return fmt.Errorf("unknown feature %q in \"disabled-features\". available features are %s", f, sortedQuotes(fs))
+		}
+	}
+	return nil
+}
+
+// featureDisabled returns true when the feature is disabled on the instance.
+func (c *GitHubEnterpriseConfig) featureDisabled(feature string) bool {
+	return contains(c.DisabledFeatures, feature)
+}
+
+// YAMLStyleConfig is configuration for "yaml-style" rule. When some value is zero, its check is
+// disabled except for Indentation.
+type YAMLStyleConfig struct {
+	// Indentation is the number of spaces of one indentation level. When this value is zero, the
+	// width is detected from the first indentation in the file and all indentations must be
+	// consistent with it. When it is negative, indentations are not checked.
+	Indentation int `yaml:"indentation"`
+	// LineLength is the maximum number of characters in one line.
+	LineLength int `yaml:"line-length"`
+	// Quotes is the preferred style of quoted strings. Available values are "single" and "double".
+	Quotes string `yaml:"quotes"`
+	// Truthy reports truthy values other than "true" and "false" such as "yes" and "on".
+	Truthy bool `yaml:"truthy"`
+	// TrailingSpaces reports trailing spaces at end of lines.
+	TrailingSpaces bool `yaml:"trailing-spaces"`
+}
+
+// ContinueOnErrorConfig is configuration for "continue-on-error" rule. Patterns are in glob syntax
+// supported by path.Match. When a list is nil, all jobs or steps are checked. When it is empty, no
+// job or step is checked.
+type ContinueOnErrorConfig struct {
+	// Jobs is patterns of job IDs to be checked.
+	Jobs []string `yaml:"jobs"`
+	// Steps is patterns of step IDs or names to be checked.
+	Steps []string `yaml:"steps"`
+}
+
+// Shell dialects which shellcheck can check.
+var shellcheckDialects = []string{"sh", "bash", "dash", "ksh"}
+
+// Severities of shellcheck in order of importance.
+var shellcheckSeverities = []string{"error", "warning", "info", "style"}
+
+var reShellcheckCode = regexp.MustCompile(`^SC\d+$`)
+
+// ShellcheckConfig is configuration for "shellcheck" rule. The values are passed to shellcheck
+// command as its options.
+type ShellcheckConfig struct {
+	// Exclude is a list of codes of shellcheck rules like "SC2086" which are not reported.
+	Exclude []string `yaml:"exclude"`
+	// Enable is a list of names of optional checks like "require-variable-braces". "all" enables
+	// all optional checks. Run `shellcheck --list-optional` to know the available checks.
+	Enable []string `yaml:"enable"`
+	// Severity is the minimum severity of errors to be reported. Available values are "error",
+	// "warning", "info", and "style". When this value is empty, all errors are reported.
+	Severity string `yaml:"severity"`
+	// Shells is a mapping from patterns of job IDs to shell dialects which shellcheck checks the
+	// scripts at "run:" in the jobs as. Available dialects are "sh", "bash", "dash", and "ksh".
+	// Patterns are in glob syntax supported by path.Match. It is useful when the shell
+	// "bash" or "sh" actually runs as some other shell in a container.
+	Shells map[string]string `yaml:"shells"`
+}
+
+func (c *ShellcheckConfig) validate() error {
+	for _, e := range c.Exclude {
+		if !reShellcheckCode.MatchString(e) {
+			return fmt.Errorf("code of shellcheck rule in \"exclude\" must be in \"SC1234\" format but got %q", e)
+		}
+	}
+	if c.Severity != "" && !contains(shellcheckSeverities, c.Severity) {
+		return fmt.Errorf("unknown severity %q at \"severity\". available severities are %s", c.Severity, sortedQuotes(shellcheckSeverities))
+	}
+	for p, s := range c.Shells {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid job ID pattern %q in \"shells\": %w", p, err)
+		}
+		if !contains(shellcheckDialects, s) {
+			return fmt.Errorf("unknown shell dialect %q for job ID pattern %q in \"shells\". available dialects are %s", s, p, sortedQuotes(shellcheckDialects))
+		}
+	}
+	return nil
+}
+
+// args returns command line arguments of shellcheck command for the configuration.
+func (c *ShellcheckConfig) args() []string {
+	args := []string{}
+	if len(c.Exclude) > 0 {
+		args = append(args, "-e", strings.Join(c.Exclude, ","))
+	}
+	if len(c.Enable) > 0 {
+		args = append(args, "-o", strings.Join(c.Enable, ","))
+	}
+	if c.Severity != "" {
+		args = append(args, "-S", c.Severity)
+	}
+	return args
+}
+
+// shellOf returns the shell dialect for the job ID. When no pattern matches the job ID, it returns
+// an empty string. When multiple patterns match, the longest pattern is used.
+func (c *ShellcheckConfig) shellOf(job string) string {
+	job = strings.ToLower(job)
+	sh, matched := "", ""
+	for p, s := range c.Shells {
+		if ok, _ := path.Match(strings.ToLower(p), job); ok && (len(p) > len(matched) || len(p) == len(matched) && p < matched) {
+			sh, matched = s, p
+		}
+	}
+	return sh
+}
+
+// PythonCheckerConfig is configuration for the checker of Python scripts at "run:".
+type PythonCheckerConfig struct {
+	// Name is a name of the checker. Available values are "pyflakes", "flake8", and "ruff". When
+	// this value is empty, "pyflakes" is used.
+	Name string `yaml:"name"`
+	// Executable is a command name or a file path of the checker. When this value is empty, Name is
+	// used as the command name. For pyflakes, the executable given by -pyflakes flag is used.
+	Executable string `yaml:"executable"`
+	// Args is extra command line arguments of the checker like ["--select", "E,F"].
+	Args []string `yaml:"args"`
+}
+
+func (c *PythonCheckerConfig) validate() error {
+	if c.Name == "" {
+		return nil
+	}
+	if _, ok := pythonCheckers[c.Name]; !ok {
+		ns := make([]string, 0, len(pythonCheckers))
+		for n := range pythonCheckers {
+			ns = append(ns, n)
+		}
+		return fmt.Errorf("unknown Python checker %q at \"name\". available checkers are %s", c.Name, sortedQuotes(ns))
+	}
+	return nil
+}
+
+// CustomShellConfig is a definition of a custom shell and the checker of scripts run by the shell.
+// The checker command reads a JSON object from stdin and writes a JSON array of errors to stdout.
+// See docs/checks.md for the details of the protocol.
+type CustomShellConfig struct {
+	// Shell is a value of "shell:" like "deno run {0}". It must contain "{0}". Whitespaces are
+	// normalized on matching with "shell:" in workflows.
+	Shell string `yaml:"shell"`
+	// Language is a language of the scripts like "typescript". It is passed to the checker.
+	Language string `yaml:"language"`
+	// Checker is a command line of the checker. The first element is a command name or a file path
+	// of the executable and the rest are its arguments.
+	Checker []string `yaml:"checker"`
+}
+
+func (c *CustomShellConfig) validate() error {
+	if !strings.Contains(c.Shell, "{0}") {
+		return fmt.Errorf("\"shell\" must contain \"{0}\" for the script file but got %q", c.Shell)
+	}
+	if c.Language == "" {
+		return fmt.Errorf("\"language\" is missing for shell %q", c.Shell)
+	}
+	if len(c.Checker) == 0 || c.Checker[0] == "" {
+		return fmt.Errorf("\"checker\" is missing for shell %q", c.Shell)
+	}
+	return nil
+}
+
+// normalizeShell normalizes whitespaces in the value of "shell:".
+func normalizeShell(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// PluginConfig is a definition of an external rule. The command reads a JSON object which contains
+// the syntax tree of a workflow from stdin and writes a JSON array of errors to stdout. See
+// docs/usage.md for the details of the protocol.
+type PluginConfig struct {
+	// Name is a name of the rule. It is used as the kind of errors reported by the plugin.
+	Name string `yaml:"name"`
+	// Command is a command line of the plugin. The first element is a command name or a file path
+	// of the executable and the rest are its arguments.
+	Command []string `yaml:"command"`
+	// WASM is a file path of the plugin compiled to WebAssembly for WASI. It is run in a sandbox
+	// which cannot access files, environment variables, and network instead of running Command.
+	// A relative path is resolved from the directory of the config file. actionlint must be built
+	// with "wazero" build tag to run it.
+	WASM string `yaml:"wasm"`
+	// Timeout is the maximum number of seconds to run the plugin for each workflow file. The plugin
+	// is killed when it does not finish within the time. When this value is zero, 60 seconds is
+	// used.
+	Timeout int `yaml:"timeout"`
+
+	// dir is the directory of the config file which defines this plugin.
+	dir      string
+	wasmOnce sync.Once
+	wasmName string
+	wasmBin  []byte
+	wasmErr  error
+}
+
+func (c *PluginConfig) validate() error {
+	if c.Name == "" {
+		return errors.New("\"name\" is missing")
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("\"timeout\" must not be negative but got %d for plugin %q", c.Timeout, c.Name)
+	}
+	if c.WASM != "" {
+		if len(c.Command) > 0 {
+			return fmt.Errorf("both \"command\" and \"wasm\" are set for plugin %q. only one of them can be set", c.Name)
+		}
+		return nil
+	}
+	if len(c.Command) == 0 || c.Command[0] == "" {
+		return fmt.Errorf("\"command\" or \"wasm\" is missing for plugin %q", c.Name)
+	}
+	return nil
 }
 
 func parseConfig(b []byte, path string) (*Config, error) {
@@ -30,24 +520,111 @@ func parseConfig(b []byte, path string) (*Config, error) {
 		msg := strings.ReplaceAll(err.Error(), "\n", " ")
 		return nil, fmt.Errorf("could not parse config file %q: %s", path, msg)
 	}
+	if c.GitHubEnterprise != nil {
+		if err := c.GitHubEnterprise.validate(); err != nil {
+			return nil, fmt.Errorf("invalid \"github-enterprise\" section in config file %q: %w", path, err)
+		}
+	}
+	if c.Shellcheck != nil {
+		if err := c.Shellcheck.validate(); err != nil {
+			return nil, fmt.Errorf("invalid \"shellcheck\" section in config file %q: %w", path, err)
+		}
+	}
+	if c.PythonChecker != nil {
+		if err := c.PythonChecker.validate(); err != nil {
+			return nil, fmt.Errorf("invalid \"python-checker\" section in config file %q: %w", path, err)
+		}
+	}
+	if c.Locale != "" {
+		if err := validateLocale(c.Locale); err != nil {
+			return nil, fmt.Errorf("invalid \"locale\" in config file %q: %w", path, err)
+		}
+	}
+	seen := map[string]struct{}{}
+	for _, sh := range c.CustomShells {
+		if sh == nil {
+			return nil, fmt.Errorf("invalid \"custom-shells\" section in config file %q: item must not be empty", path)
+		}
+		if err := sh.validate(); err != nil {
+			return nil, fmt.Errorf("invalid \"custom-shells\" section in config file %q: %w", path, err)
+		}
+		n := normalizeShell(sh.Shell)
+		if _, ok := seen[n]; ok {
+			return nil, fmt.Errorf("invalid \"custom-shells\" section in config file %q: shell %q is defined twice", path, n)
+		}
+		seen[n] = struct{}{}
+	}
+	for _, p := range c.Presets {
+		if err := c.applyPreset(p); err != nil {
+			return nil, fmt.Errorf("invalid \"presets\" section in config file %q: %w", path, err)
+		}
+	}
+	names := map[string]struct{}{}
+	for _, p := range c.Plugins {
+		if p == nil {
+			return nil, fmt.Errorf("invalid \"plugins\" section in config file %q: item must not be empty", path)
+		}
+		if err := p.validate(); err != nil {
+			return nil, fmt.Errorf("invalid \"plugins\" section in config file %q: %w", path, err)
+		}
+		if _, ok := names[p.Name]; ok {
+			return nil, fmt.Errorf("invalid \"plugins\" section in config file %q: plugin %q is defined twice", path, p.Name)
+		}
+		names[p.Name] = struct{}{}
+	}
 	return &c, nil
 }
 
+// setDir sets the directory of the config file. Relative file paths of plugins are resolved from
+// the directory.
+func (c *Config) setDir(dir string) {
+	for _, p := range c.Plugins {
+		p.dir = dir
+	}
+}
+
+// applyPreset enables the optional rules grouped by the preset.
+func (c *Config) applyPreset(name string) error {
+	switch name {
+	case "performance":
+		c.CheckoutPerformance = true
+		c.CacheKey = true
+		return nil
+	default:
+		return fmt.Errorf("unknown preset %q. available preset is \"performance\"", name)
+	}
+}
+
+// ParseConfig parses the content of actionlint config file (actionlint.yaml). The 'path' parameter
+// is used in error messages.
+func ParseConfig(b []byte, path string) (*Config, error) {
+	return parseConfig(b, path)
+}
+
 // ReadConfigFile reads actionlint config file (actionlint.yaml) from the given file path.
 func ReadConfigFile(path string) (*Config, error) {
-	b, err := os.ReadFile(path)
+	return readConfigFile(path, osFileSystem{})
+}
+
+func readConfigFile(path string, fsys fileSystem) (*Config, error) {
+	b, err := fsys.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("could not read config file %q: %w", path, err)
 	}
-	return parseConfig(b, path)
+	cfg, err := parseConfig(b, path)
+	if err != nil {
+		return nil, err
+	}
+	cfg.setDir(filepath.Dir(path))
+	return cfg, nil
 }
 
 // loadRepoConfig reads config file from the repository's .github/actionlint.yml or
 // .github/actionlint.yaml.
-func loadRepoConfig(root string) (*Config, error) {
+func loadRepoConfig(root string, fsys fileSystem) (*Config, error) {
 	for _, f := range []string{"actionlint.yaml", "actionlint.yml"} {
 		path := filepath.Join(root, ".github", f)
-		b, err := os.ReadFile(path)
+		b, err := fsys.ReadFile(path)
 		if err != nil {
 			continue // file does not exist
 		}
@@ -55,6 +632,7 @@ func loadRepoConfig(root string) (*Config, error) {
 		if err != nil {
 			return nil, err
 		}
+		cfg.setDir(filepath.Dir(path))
 		return cfg, nil
 	}
 	return nil, nil
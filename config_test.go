@@ -56,6 +56,57 @@ func TestConfigParseOK(t *testing.T) {
 	}
 }
 
+func TestConfigParseRunnerGroups(t *testing.T) {
+	input := `self-hosted-runner:
+  labels: [foo]
+  groups:
+    gpu-runners: [self-hosted, gpu-*]
+    empty: []
+`
+	c, err := parseConfig([]byte(input), "/path/to/file.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]string{
+		"gpu-runners": {"self-hosted", "gpu-*"},
+		"empty":       {},
+	}
+	if !cmp.Equal(c.SelfHostedRunner.Groups, want) {
+		t.Fatal(cmp.Diff(c.SelfHostedRunner.Groups, want))
+	}
+}
+
+func TestConfigParseRepositoryDispatch(t *testing.T) {
+	input := `repository-dispatch:
+  types: [deploy, rollback]
+  client-payload-schema: schemas/payload.json
+`
+	c, err := parseConfig([]byte(input), "/path/to/file.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &RepositoryDispatchConfig{
+		Types:               []string{"deploy", "rollback"},
+		ClientPayloadSchema: "schemas/payload.json",
+	}
+	if !cmp.Equal(c.RepositoryDispatch, want) {
+		t.Fatal(cmp.Diff(c.RepositoryDispatch, want))
+	}
+}
+
+func TestConfigParsePresets(t *testing.T) {
+	c, err := parseConfig([]byte("presets: [performance]\n"), "/path/to/file.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.CheckoutPerformance {
+		t.Error("checkout-performance should be enabled by the preset")
+	}
+	if !c.CacheKey {
+		t.Error("cache-key should be enabled by the preset")
+	}
+}
+
 func TestConfigParseError(t *testing.T) {
 	input := "self-hosted-runner: 42\n"
 	_, err := parseConfig([]byte(input), "/path/to/file.yml")
@@ -68,6 +119,208 @@ func TestConfigParseError(t *testing.T) {
 	}
 }
 
+func TestConfigParseGitHubEnterpriseError(t *testing.T) {
+	testCases := []struct {
+		what  string
+		input string
+		want  string
+	}{
+		{
+			what:  "invalid API URL",
+			input: "github-enterprise:\n  api-url: ghe.example.com/api/v3",
+			want:  `"api-url" must be an HTTP or HTTPS URL but got "ghe.example.com/api/v3"`,
+		},
+		{
+			what:  "unknown feature",
+			input: "github-enterprise:\n  disabled-features: [foo]",
+			want:  `unknown feature "foo" in "disabled-features"`,
+		},
+		{
+			what:  "invalid version",
+			input: "github-enterprise:\n  version: v3.12",
+			want:  `"version" must be in MAJOR.MINOR format like "3.12" but got "v3.12"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := parseConfig([]byte(tc.input), "/path/to/file.yml")
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			msg := err.Error()
+			if !strings.Contains(msg, `invalid "github-enterprise" section in config file "/path/to/file.yml"`) || !strings.Contains(msg, tc.want) {
+				t.Fatalf("unexpected error message: %q", msg)
+			}
+		})
+	}
+}
+
+func TestConfigParseShellcheckError(t *testing.T) {
+	testCases := []struct {
+		what  string
+		input string
+		want  string
+	}{
+		{
+			what:  "invalid code",
+			input: "shellcheck:\n  exclude: [2086]",
+			want:  `code of shellcheck rule in "exclude" must be in "SC1234" format but got "2086"`,
+		},
+		{
+			what:  "unknown severity",
+			input: "shellcheck:\n  severity: fatal",
+			want:  `unknown severity "fatal" at "severity"`,
+		},
+		{
+			what:  "unknown dialect",
+			input: "shellcheck:\n  shells:\n    test: zsh",
+			want:  `unknown shell dialect "zsh" for job ID pattern "test" in "shells"`,
+		},
+		{
+			what:  "invalid pattern",
+			input: "shellcheck:\n  shells:\n    '[test': sh",
+			want:  `invalid job ID pattern "[test" in "shells"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := parseConfig([]byte(tc.input), "/path/to/file.yml")
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			msg := err.Error()
+			if !strings.Contains(msg, `invalid "shellcheck" section in config file "/path/to/file.yml"`) || !strings.Contains(msg, tc.want) {
+				t.Fatalf("unexpected error message: %q", msg)
+			}
+		})
+	}
+}
+
+func TestConfigParsePythonCheckerError(t *testing.T) {
+	_, err := parseConfig([]byte("python-checker:\n  name: pylint"), "/path/to/file.yml")
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	msg := err.Error()
+	want := `invalid "python-checker" section in config file "/path/to/file.yml": unknown Python checker "pylint" at "name". available checkers are "flake8", "pyflakes", "ruff"`
+	if msg != want {
+		t.Fatalf("unexpected error message: %q", msg)
+	}
+}
+
+func TestConfigParsePresetsError(t *testing.T) {
+	_, err := parseConfig([]byte("presets: [security]"), "/path/to/file.yml")
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	msg := err.Error()
+	want := `invalid "presets" section in config file "/path/to/file.yml": unknown preset "security". available preset is "performance"`
+	if msg != want {
+		t.Fatalf("unexpected error message: %q", msg)
+	}
+}
+
+func TestConfigParseCustomShellsError(t *testing.T) {
+	testCases := []struct {
+		what  string
+		input string
+		want  string
+	}{
+		{
+			what:  "no placeholder",
+			input: "custom-shells:\n  - shell: deno run\n    language: typescript\n    checker: [check-deno]",
+			want:  `"shell" must contain "{0}" for the script file but got "deno run"`,
+		},
+		{
+			what:  "no language",
+			input: "custom-shells:\n  - shell: deno run {0}\n    checker: [check-deno]",
+			want:  `"language" is missing for shell "deno run {0}"`,
+		},
+		{
+			what:  "no checker",
+			input: "custom-shells:\n  - shell: deno run {0}\n    language: typescript",
+			want:  `"checker" is missing for shell "deno run {0}"`,
+		},
+		{
+			what:  "duplicate shell",
+			input: "custom-shells:\n  - shell: deno run {0}\n    language: typescript\n    checker: [a]\n  - shell: deno  run  {0}\n    language: javascript\n    checker: [b]",
+			want:  `shell "deno run {0}" is defined twice`,
+		},
+		{
+			what:  "empty item",
+			input: "custom-shells:\n  -",
+			want:  `item must not be empty`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := parseConfig([]byte(tc.input), "/path/to/file.yml")
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			msg := err.Error()
+			if !strings.Contains(msg, `invalid "custom-shells" section in config file "/path/to/file.yml"`) || !strings.Contains(msg, tc.want) {
+				t.Fatalf("unexpected error message: %q", msg)
+			}
+		})
+	}
+}
+
+func TestConfigParsePluginsError(t *testing.T) {
+	testCases := []struct {
+		what  string
+		input string
+		want  string
+	}{
+		{
+			what:  "no name",
+			input: "plugins:\n  - command: [policy]",
+			want:  `"name" is missing`,
+		},
+		{
+			what:  "no command",
+			input: "plugins:\n  - name: policy",
+			want:  `"command" or "wasm" is missing for plugin "policy"`,
+		},
+		{
+			what:  "both command and wasm",
+			input: "plugins:\n  - name: policy\n    command: [policy]\n    wasm: policy.wasm",
+			want:  `both "command" and "wasm" are set for plugin "policy"`,
+		},
+		{
+			what:  "negative timeout",
+			input: "plugins:\n  - name: policy\n    command: [policy]\n    timeout: -1",
+			want:  `"timeout" must not be negative but got -1 for plugin "policy"`,
+		},
+		{
+			what:  "duplicate name",
+			input: "plugins:\n  - name: policy\n    command: [a]\n  - name: policy\n    command: [b]",
+			want:  `plugin "policy" is defined twice`,
+		},
+		{
+			what:  "empty item",
+			input: "plugins:\n  -",
+			want:  `item must not be empty`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := parseConfig([]byte(tc.input), "/path/to/file.yml")
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			msg := err.Error()
+			if !strings.Contains(msg, `invalid "plugins" section in config file "/path/to/file.yml"`) || !strings.Contains(msg, tc.want) {
+				t.Fatalf("unexpected error message: %q", msg)
+			}
+		})
+	}
+}
+
 func TestConfigReadFileOK(t *testing.T) {
 	p := filepath.Join("testdata", "config", "ok.yml")
 	c, err := ReadConfigFile(p)
@@ -0,0 +1,52 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// ContextAvailability is availability of contexts and special functions at a workflow key. Tools
+// like editor plugins can use this information to offer completions of contexts and functions in
+// `${{ }}` placeholders.
+// https://docs.github.com/en/actions/learn-github-actions/contexts#context-availability
+type ContextAvailability struct {
+	// Key is a workflow key like "jobs.<job_id>.steps.if". Placeholders such as "<job_id>" match to
+	// any keys of the mapping.
+	Key string `json:"key"`
+	// Contexts is a list of context names available at the key.
+	Contexts []string `json:"contexts"`
+	// SpecialFunctions is a list of function names which are only available at some keys (e.g.
+	// "always"). Functions not in SpecialFunctionNames are available anywhere.
+	SpecialFunctions []string `json:"special_functions"`
+}
+
+// ContextAvailabilityOf returns availability of contexts and special functions at the workflow key
+// like "jobs.<job_id>.steps.if". It returns nil when the key is unknown.
+func ContextAvailabilityOf(key string) *ContextAvailability {
+	ctx, sp := WorkflowKeyAvailability(key)
+	if ctx == nil {
+		return nil
+	}
+	return &ContextAvailability{key, ctx, sp}
+}
+
+// AllContextAvailabilities returns availability of contexts and special functions at all workflow
+// keys where expressions are available. The returned slice is sorted by the workflow keys.
+func AllContextAvailabilities() []*ContextAvailability {
+	ret := make([]*ContextAvailability, 0, len(allWorkflowKeys))
+	for _, k := range allWorkflowKeys {
+		if a := ContextAvailabilityOf(k); a != nil {
+			ret = append(ret, a)
+		}
+	}
+	return ret
+}
+
+// printContextAvailability prints availability of contexts and special functions at all workflow
+// keys as JSON array.
+func printContextAvailability(out io.Writer) error {
+	enc := json.NewEncoder(out)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(AllContextAvailabilities())
+}
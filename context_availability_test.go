@@ -0,0 +1,69 @@
+package actionlint
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestContextAvailabilityOf(t *testing.T) {
+	a := ContextAvailabilityOf("jobs.<job_id>.steps.if")
+	if a == nil {
+		t.Fatal("availability of jobs.<job_id>.steps.if was not found")
+	}
+	ctx, sp := WorkflowKeyAvailability("jobs.<job_id>.steps.if")
+	want := &ContextAvailability{
+		Key:              "jobs.<job_id>.steps.if",
+		Contexts:         ctx,
+		SpecialFunctions: sp,
+	}
+	if !cmp.Equal(a, want) {
+		t.Fatal(cmp.Diff(a, want))
+	}
+
+	if a := ContextAvailabilityOf("unknown.workflow.key"); a != nil {
+		t.Fatalf("availability of unknown key should be nil but got %#v", a)
+	}
+}
+
+func TestContextAvailabilityAll(t *testing.T) {
+	all := AllContextAvailabilities()
+	if len(all) != len(allWorkflowKeys) {
+		t.Fatalf("%d workflow keys are expected but got %d", len(allWorkflowKeys), len(all))
+	}
+	if !sort.SliceIsSorted(all, func(i, j int) bool { return all[i].Key < all[j].Key }) {
+		t.Error("availabilities are not sorted by keys")
+	}
+	for _, a := range all {
+		if len(a.Contexts) == 0 {
+			t.Errorf("no context is available at %q", a.Key)
+		}
+		if a.SpecialFunctions == nil {
+			t.Errorf("special functions at %q should not be nil", a.Key)
+		}
+	}
+}
+
+func TestContextAvailabilityPrintJSON(t *testing.T) {
+	var b bytes.Buffer
+	if err := printContextAvailability(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	// Placeholders in keys should not be escaped
+	if !bytes.Contains(b.Bytes(), []byte(`"jobs.<job_id>.steps.if"`)) {
+		t.Errorf("output does not contain jobs.<job_id>.steps.if key: %s", b.String())
+	}
+
+	decoded := []*ContextAvailability{}
+	if err := json.Unmarshal(b.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	want := AllContextAvailabilities()
+	if !cmp.Equal(decoded, want) {
+		t.Fatal(cmp.Diff(decoded, want))
+	}
+}
@@ -0,0 +1,300 @@
+package actionlint
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Default average duration of jobs in minutes used for the cost estimation.
+const defaultCostEstimateJobMinutes = 10
+
+// Minute multipliers of GitHub-hosted runners relative to Linux runners.
+// https://docs.github.com/en/billing/managing-billing-for-github-actions/about-billing-for-github-actions#minute-multipliers
+var runnerCostMultipliers = map[string]int{
+	"linux":   1,
+	"windows": 2,
+	"macos":   10,
+}
+
+// JobCostEstimate is an estimated cost of one job.
+type JobCostEstimate struct {
+	// ID is an ID of the job.
+	ID string
+	// Runners is a list of runner types the job runs on. Each element is one of "linux", "windows",
+	// and "macos". This value is nil when the job runs on self-hosted runners or the runners are
+	// unknown statically.
+	Runners []string
+	// SelfHosted is true when the job runs on self-hosted runners. Self-hosted runners are not billed.
+	SelfHosted bool
+	// Runs is a number of runs of the job. When the job has matrix, this value is a number of the
+	// matrix combinations.
+	Runs int
+	// Minutes is an average duration of one run in minutes.
+	Minutes int
+	// BillableMinutes is estimated billable minutes of all runs of the job. Minute multipliers of
+	// the runners are applied.
+	BillableMinutes int
+}
+
+// Known returns true when the cost of the job was estimated.
+func (e *JobCostEstimate) Known() bool {
+	return e.SelfHosted || len(e.Runners) > 0 && e.Runs > 0
+}
+
+// CostEstimate is an estimated cost of one workflow run. Only GitHub-hosted runners are billed.
+// Note that this is a rough estimation. For example, rates of larger runners are not considered.
+type CostEstimate struct {
+	// Path is a file path of the workflow.
+	Path string
+	// Name is a name of the workflow. This value is empty when the workflow has no name.
+	Name string
+	// Jobs is a list of estimated costs of the jobs in the workflow sorted by job IDs.
+	Jobs []*JobCostEstimate
+	// Minutes is total minutes of all runs of all jobs which were known.
+	Minutes int
+	// BillableMinutes is total billable minutes of all jobs which were known.
+	BillableMinutes int
+}
+
+// Multiplier returns a cost multiplier of the workflow relative to running all the jobs on Linux
+// runners. It returns 0 when no minute is billed.
+func (e *CostEstimate) Multiplier() float64 {
+	if e.Minutes == 0 {
+		return 0
+	}
+	return float64(e.BillableMinutes) / float64(e.Minutes)
+}
+
+// Print prints the estimation to the given writer in human readable format.
+func (e *CostEstimate) Print(out io.Writer) {
+	if e.Name != "" {
+		fmt.Fprintf(out, "Estimated cost of workflow %q at %s:\n", e.Name, e.Path)
+	} else {
+		fmt.Fprintf(out, "Estimated cost of workflow at %s:\n", e.Path)
+	}
+	for _, j := range e.Jobs {
+		switch {
+		case j.SelfHosted:
+			fmt.Fprintf(out, "  job %q: runs on self-hosted runner which is not billed\n", j.ID)
+		case !j.Known():
+			fmt.Fprintf(out, "  job %q: runner or number of runs is unknown statically\n", j.ID)
+		default:
+			rs := make([]string, 0, len(j.Runners))
+			for _, r := range j.Runners {
+				rs = append(rs, fmt.Sprintf("%s (%dx)", r, runnerCostMultipliers[r]))
+			}
+			fmt.Fprintf(out, "  job %q: %d run(s) x %d min on %s = %d billable minutes\n", j.ID, j.Runs, j.Minutes, strings.Join(rs, ", "), j.BillableMinutes)
+		}
+	}
+	fmt.Fprintf(out, "  total: %d minutes, %d billable minutes (%.1fx of Linux runners)\n", e.Minutes, e.BillableMinutes, e.Multiplier())
+}
+
+// EstimateCost estimates billable minutes of one run of the workflow. Average durations of jobs
+// are given via the cfg parameter. When it is nil, the default duration (10 minutes) is used for
+// all jobs.
+func EstimateCost(path string, w *Workflow, cfg *CostEstimateConfig) *CostEstimate {
+	e := &CostEstimate{Path: path}
+	if w.Name != nil {
+		e.Name = w.Name.Value
+	}
+
+	for _, id := range sortedJobIDs(w.Jobs) {
+		j := estimateJobCost(w.Jobs[id], jobAverageMinutes(id, cfg))
+		e.Jobs = append(e.Jobs, j)
+		if j.SelfHosted || !j.Known() {
+			continue
+		}
+		e.Minutes += j.Runs * j.Minutes
+		e.BillableMinutes += j.BillableMinutes
+	}
+
+	return e
+}
+
+func sortedJobIDs(jobs map[string]*Job) []string {
+	ids := make([]string, 0, len(jobs))
+	for id := range jobs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func jobAverageMinutes(id string, cfg *CostEstimateConfig) int {
+	if cfg == nil {
+		return defaultCostEstimateJobMinutes
+	}
+	for k, v := range cfg.Jobs {
+		if strings.EqualFold(k, id) && v > 0 {
+			return v
+		}
+	}
+	if cfg.DefaultMinutes > 0 {
+		return cfg.DefaultMinutes
+	}
+	return defaultCostEstimateJobMinutes
+}
+
+func estimateJobCost(j *Job, minutes int) *JobCostEstimate {
+	e := &JobCostEstimate{Minutes: minutes}
+	if j.ID != nil {
+		e.ID = j.ID.Value
+	}
+	if j.RunsOn == nil {
+		return e // Reusable workflow call. Its runners are unknown
+	}
+
+	var m *Matrix
+	if j.Strategy != nil {
+		m = j.Strategy.Matrix
+	}
+	if n, ok := matrixCombinations(m); ok {
+		e.Runs = n
+	}
+
+	labels := j.RunsOn.Labels
+	if j.RunsOn.LabelsExpr != nil {
+		labels = []*String{j.RunsOn.LabelsExpr}
+	}
+
+	// When the runner is given via matrix like `runs-on: ${{ matrix.os }}`, the runs are distributed
+	// to the runners in the matrix row evenly
+	if len(labels) == 1 && labels[0].ContainsExpression() {
+		ls := runnerLabelsInMatrix(labels[0], m)
+		if len(ls) == 0 {
+			return e
+		}
+		sum := 0
+		for _, l := range ls {
+			r := githubHostedRunnerType([]*String{l})
+			if r == "" {
+				e.Runners = nil
+				return e // Self-hosted runners are mixed. Give up estimation
+			}
+			if !contains(e.Runners, r) {
+				e.Runners = append(e.Runners, r)
+			}
+			sum += runnerCostMultipliers[r]
+		}
+		e.BillableMinutes = int(math.Round(float64(e.Runs*minutes*sum) / float64(len(ls))))
+		return e
+	}
+
+	for _, l := range labels {
+		if l.ContainsExpression() {
+			return e
+		}
+	}
+	r := githubHostedRunnerType(labels)
+	if r == "" {
+		e.SelfHosted = true
+		return e
+	}
+	e.Runners = []string{r}
+	e.BillableMinutes = e.Runs * minutes * runnerCostMultipliers[r]
+	return e
+}
+
+// githubHostedRunnerType returns one of "linux", "windows", "macos" for the labels of GitHub-hosted
+// runner. It returns an empty string when the labels are for self-hosted runner.
+func githubHostedRunnerType(labels []*String) string {
+	if len(labels) != 1 {
+		return "" // GitHub-hosted runner is specified with exactly one label
+	}
+	l := strings.ToLower(labels[0].Value)
+	if !contains(allGitHubHostedRunnerLabels, l) {
+		return ""
+	}
+	switch {
+	case strings.HasPrefix(l, "ubuntu-"):
+		return "linux"
+	case strings.HasPrefix(l, "windows-"):
+		return "windows"
+	case strings.HasPrefix(l, "macos-"):
+		return "macos"
+	default:
+		return ""
+	}
+}
+
+// matrixCombinations returns a number of combinations of the matrix. The second return value is
+// false when the number is unknown statically due to expressions.
+// https://docs.github.com/en/actions/using-jobs/using-a-matrix-for-your-jobs
+func matrixCombinations(m *Matrix) (int, bool) {
+	if m == nil {
+		return 1, true
+	}
+	if m.Expression != nil {
+		return 0, false
+	}
+
+	n := 1
+	for _, r := range m.Rows {
+		if r.Expression != nil {
+			return 0, false
+		}
+		n *= len(r.Values)
+	}
+
+	if m.Exclude != nil && len(m.Rows) > 0 {
+		if m.Exclude.ContainsExpression() {
+			return 0, false
+		}
+		for _, c := range m.Exclude.Combinations {
+			// Excluded combinations are all combinations of the rows which are not specified
+			x := 1
+			for k, r := range m.Rows {
+				if _, ok := c.Assigns[k]; !ok {
+					x *= len(r.Values)
+				}
+			}
+			n -= x
+		}
+		if n < 0 {
+			n = 0
+		}
+	}
+
+	if len(m.Rows) == 0 {
+		n = 0
+	}
+
+	if m.Include != nil {
+		if m.Include.ContainsExpression() {
+			return 0, false
+		}
+		for _, c := range m.Include.Combinations {
+			if len(m.Rows) == 0 || !extendsMatrixCombinations(m, c) {
+				n++ // Added as a new combination
+			}
+		}
+	}
+
+	return n, true
+}
+
+// extendsMatrixCombinations returns true when the combination in "include:" extends existing
+// combinations instead of adding a new combination. It adds a new combination when it overwrites
+// some original value of the matrix.
+func extendsMatrixCombinations(m *Matrix, c *MatrixCombination) bool {
+	for k, a := range c.Assigns {
+		r, ok := m.Rows[k]
+		if !ok {
+			continue
+		}
+		found := false
+		for _, v := range r.Values {
+			if v.Equals(a.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package actionlint
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestEstimateCost(t *testing.T) {
+	src := `
+name: CI
+on: push
+jobs:
+  test:
+    strategy:
+      matrix:
+        os: [ubuntu-latest, windows-latest, macos-latest]
+        node: [18, 20]
+    runs-on: ${{ matrix.os }}
+    steps:
+      - run: npm test
+  lint:
+    runs-on: ubuntu-latest
+    steps:
+      - run: npm run lint
+  deploy:
+    runs-on: [self-hosted, linux]
+    steps:
+      - run: ./deploy.sh
+  call:
+    uses: ./.github/workflows/reusable.yaml
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	cfg := &CostEstimateConfig{
+		DefaultMinutes: 5,
+		Jobs:           map[string]int{"test": 20},
+	}
+	e := EstimateCost("ci.yaml", w, cfg)
+
+	want := []struct {
+		id         string
+		runs       int
+		minutes    int
+		billable   int
+		selfHosted bool
+		known      bool
+	}{
+		{"call", 0, 5, 0, false, false},
+		{"deploy", 1, 5, 0, true, true},
+		{"lint", 1, 5, 5, false, true},
+		{"test", 6, 20, 520, false, true},
+	}
+	if len(e.Jobs) != len(want) {
+		t.Fatalf("wanted %d jobs but got %d: %v", len(want), len(e.Jobs), e.Jobs)
+	}
+	for i, w := range want {
+		j := e.Jobs[i]
+		if j.ID != w.id || j.Runs != w.runs || j.Minutes != w.minutes || j.BillableMinutes != w.billable || j.SelfHosted != w.selfHosted || j.Known() != w.known {
+			t.Errorf("wanted %+v but got %+v", w, j)
+		}
+	}
+	if e.Minutes != 125 || e.BillableMinutes != 525 {
+		t.Errorf("wanted total 125 minutes and 525 billable minutes but got %d and %d", e.Minutes, e.BillableMinutes)
+	}
+
+	var b bytes.Buffer
+	e.Print(&b)
+	out := b.String()
+	for _, s := range []string{
+		`Estimated cost of workflow "CI" at ci.yaml:`,
+		`job "test": 6 run(s) x 20 min on linux (1x), windows (2x), macos (10x) = 520 billable minutes`,
+		`job "deploy": runs on self-hosted runner which is not billed`,
+		`job "call": runner or number of runs is unknown statically`,
+		`total: 125 minutes, 525 billable minutes (4.2x of Linux runners)`,
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output does not contain %q:\n%s", s, out)
+		}
+	}
+}
+
+func TestMatrixCombinations(t *testing.T) {
+	tests := []struct {
+		what   string
+		matrix string
+		want   int
+	}{
+		{"rows", "os: [a, b]\n        node: [1, 2, 3]", 6},
+		{"exclude", "os: [a, b]\n        node: [1, 2]\n        exclude:\n          - os: a\n            node: 1", 3},
+		{"exclude row", "os: [a, b]\n        node: [1, 2]\n        exclude:\n          - os: a", 2},
+		{"include extends", "os: [a, b]\n        include:\n          - os: a\n            experimental: true", 2},
+		{"include adds", "os: [a, b]\n        include:\n          - os: c", 3},
+		{"include only", "include:\n          - os: a\n          - os: b", 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			src := "on: push\njobs:\n  test:\n    strategy:\n      matrix:\n        " + tc.matrix + "\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n"
+			w, errs := Parse([]byte(src))
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			n, ok := matrixCombinations(w.Jobs["test"].Strategy.Matrix)
+			if !ok {
+				t.Fatal("number of combinations is unknown")
+			}
+			if n != tc.want {
+				t.Fatalf("wanted %d combinations but got %d", tc.want, n)
+			}
+		})
+	}
+}
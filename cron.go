@@ -0,0 +1,248 @@
+package actionlint
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Set when the field is "*" in the CRON spec. This is the same as the top bit used by robfig/cron.
+const cronStarBit = 1 << 63
+
+var (
+	cronMonthNames = []string{"", "January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+	cronDayNames   = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+)
+
+// parseCronSchedule parses the CRON spec in "schedule" event. Scheduled workflows run in UTC.
+// https://docs.github.com/en/actions/learn-github-actions/workflow-syntax-for-github-actions#onschedule
+func parseCronSchedule(spec string) (*cron.SpecSchedule, error) {
+	p := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	s, err := p.Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+	sched, ok := s.(*cron.SpecSchedule)
+	if !ok {
+		return nil, fmt.Errorf("unexpected schedule %T", s)
+	}
+	sched.Location = time.UTC
+	return sched, nil
+}
+
+// cronValues returns the values set in the bits of the field.
+func cronValues(bits uint64, min, max uint) []uint {
+	vs := []uint{}
+	for v := min; v <= max; v++ {
+		if bits&(1<<v) != 0 {
+			vs = append(vs, v)
+		}
+	}
+	return vs
+}
+
+// cronStep returns the step when the values are like "*/15". It returns 0 otherwise. Two values
+// like "0,30" are not regarded as a step since listing them is easier to read.
+func cronStep(vs []uint, min, max uint) uint {
+	if len(vs) < 3 || vs[0] != min {
+		return 0
+	}
+	step := vs[1] - vs[0]
+	if step < 2 {
+		return 0
+	}
+	for i := 2; i < len(vs); i++ {
+		if vs[i]-vs[i-1] != step {
+			return 0
+		}
+	}
+	if vs[len(vs)-1]+step <= max {
+		return 0
+	}
+	return step
+}
+
+// joinWords joins the words with commas and "and" like "a, b, and c".
+func joinWords(ws []string) string {
+	switch len(ws) {
+	case 1:
+		return ws[0]
+	case 2:
+		return ws[0] + " and " + ws[1]
+	default:
+		return strings.Join(ws[:len(ws)-1], ", ") + ", and " + ws[len(ws)-1]
+	}
+}
+
+// describeCronValues describes the values like "1 through 5 and 10". Consecutive three or more
+// values are described as a range.
+func describeCronValues(vs []uint, name func(uint) string) string {
+	ws := []string{}
+	for i := 0; i < len(vs); {
+		j := i
+		for j+1 < len(vs) && vs[j+1] == vs[j]+1 {
+			j++
+		}
+		if j-i >= 2 {
+			ws = append(ws, name(vs[i])+" through "+name(vs[j]))
+		} else {
+			for k := i; k <= j; k++ {
+				ws = append(ws, name(vs[k]))
+			}
+		}
+		i = j + 1
+	}
+	return joinWords(ws)
+}
+
+func cronNumber(v uint) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+// describeCronField describes the field like "minute 0 and 30", "every 15th minute", or "every
+// minute".
+func describeCronField(bits uint64, min, max uint, unit string, name func(uint) string) string {
+	vs := cronValues(bits, min, max)
+	if bits&cronStarBit != 0 || len(vs) == int(max-min+1) {
+		return "every " + unit
+	}
+	if s := cronStep(vs, min, max); s > 0 {
+		return fmt.Sprintf("every %s %s", ordinal(int(s)), unit) // Defined at expr_sema.go
+	}
+	return unit + " " + describeCronValues(vs, name)
+}
+
+// describeCronSchedule returns a human-readable description of the schedule like "At 09:30 on
+// Monday through Friday".
+func describeCronSchedule(s *cron.SpecSchedule) string {
+	var b strings.Builder
+
+	mins := cronValues(s.Minute, 0, 59)
+	hours := cronValues(s.Hour, 0, 23)
+	everyMin := s.Minute&cronStarBit != 0 || len(mins) == 60
+	everyHour := s.Hour&cronStarBit != 0 || len(hours) == 24
+	switch {
+	case len(mins) == 1 && !everyHour && cronStep(hours, 0, 23) == 0 && len(hours) <= 4:
+		ts := make([]string, 0, len(hours))
+		for _, h := range hours {
+			ts = append(ts, fmt.Sprintf("%02d:%02d", h, mins[0]))
+		}
+		b.WriteString("at ")
+		b.WriteString(joinWords(ts))
+	case everyMin && everyHour:
+		b.WriteString("every minute")
+	case everyHour:
+		m := describeCronField(s.Minute, 0, 59, "minute", cronNumber)
+		if strings.HasPrefix(m, "every ") {
+			b.WriteString(m)
+		} else {
+			b.WriteString("at ")
+			b.WriteString(m)
+			b.WriteString(" past every hour")
+		}
+	default:
+		m := describeCronField(s.Minute, 0, 59, "minute", cronNumber)
+		if !strings.HasPrefix(m, "every ") {
+			b.WriteString("at ")
+		}
+		b.WriteString(m)
+		b.WriteString(" past ")
+		b.WriteString(describeCronField(s.Hour, 0, 23, "hour", cronNumber))
+	}
+
+	everyDom := s.Dom&cronStarBit != 0
+	everyDow := s.Dow&cronStarBit != 0
+	dow := func() string {
+		d := describeCronField(s.Dow, 0, 6, "day-of-week", func(v uint) string { return cronDayNames[v] })
+		return strings.TrimPrefix(d, "day-of-week ")
+	}
+	switch {
+	case !everyDom && !everyDow:
+		// When both are restricted, the schedule runs when either of them matches
+		fmt.Fprintf(&b, " on %s or on %s", describeCronField(s.Dom, 1, 31, "day-of-month", cronNumber), dow())
+	case !everyDom:
+		fmt.Fprintf(&b, " on %s", describeCronField(s.Dom, 1, 31, "day-of-month", cronNumber))
+	case !everyDow:
+		fmt.Fprintf(&b, " on %s", dow())
+	}
+
+	if s.Month&cronStarBit == 0 {
+		m := describeCronField(s.Month, 1, 12, "month", func(v uint) string { return cronMonthNames[v] })
+		if strings.HasPrefix(m, "every ") {
+			fmt.Fprintf(&b, " in %s", m)
+		} else {
+			fmt.Fprintf(&b, " in %s", strings.TrimPrefix(m, "month "))
+		}
+	}
+
+	d := b.String()
+	return strings.ToUpper(d[:1]) + d[1:]
+}
+
+// cronDayMatches returns true when the schedule runs on the day of month and the day of week. Like
+// POSIX cron, the schedule runs when either of them matches when both are restricted.
+func cronDayMatches(s *cron.SpecSchedule, dom, dow uint) bool {
+	d := s.Dom&(1<<dom) != 0
+	w := s.Dow&(1<<dow) != 0
+	if s.Dom&cronStarBit != 0 || s.Dow&cronStarBit != 0 {
+		return d && w
+	}
+	return d || w
+}
+
+// cronScheduleCovers returns true when the schedule a runs at all times when the schedule b runs.
+func cronScheduleCovers(a, b *cron.SpecSchedule) bool {
+	const mask = ^uint64(cronStarBit)
+	for _, f := range [][2]uint64{{a.Minute, b.Minute}, {a.Hour, b.Hour}, {a.Month, b.Month}} {
+		if f[1]&mask&^f[0] != 0 {
+			return false
+		}
+	}
+	for dom := uint(1); dom <= 31; dom++ {
+		for dow := uint(0); dow <= 6; dow++ {
+			if cronDayMatches(b, dom, dow) && !cronDayMatches(a, dom, dow) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// nextCronRuns returns the next n times when the schedule runs after the given time.
+func nextCronRuns(s *cron.SpecSchedule, from time.Time, n int) []time.Time {
+	ts := make([]time.Time, 0, n)
+	t := from.UTC()
+	for i := 0; i < n; i++ {
+		t = s.Next(t)
+		if t.IsZero() {
+			break // The schedule never runs like "0 0 31 2 *"
+		}
+		ts = append(ts, t)
+	}
+	return ts
+}
+
+// printCronSchedule prints the description and the next run times of the CRON spec. This is used
+// by -check-cron option.
+func printCronSchedule(out io.Writer, spec string, now time.Time) error {
+	s, err := parseCronSchedule(spec)
+	if err != nil {
+		return fmt.Errorf("invalid CRON format %q: %w", spec, err)
+	}
+	fmt.Fprintf(out, "Schedule:    %s\n", spec)
+	fmt.Fprintf(out, "Description: %s (UTC)\n", describeCronSchedule(s))
+	ts := nextCronRuns(s, now, 5)
+	if len(ts) == 0 {
+		fmt.Fprintln(out, "Next runs:   The schedule never runs")
+		return nil
+	}
+	fmt.Fprintln(out, "Next runs:")
+	for _, t := range ts {
+		fmt.Fprintf(out, "  %s\n", t.Format("2006-01-02 15:04 Mon"))
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package actionlint
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCronDescribeSchedule(t *testing.T) {
+	testCases := []struct {
+		spec string
+		want string
+	}{
+		{"* * * * *", "Every minute"},
+		{"*/15 * * * *", "Every 15th minute"},
+		{"5 * * * *", "At minute 5 past every hour"},
+		{"0,30 * * * *", "At minute 0 and 30 past every hour"},
+		{"* 3 * * *", "Every minute past hour 3"},
+		{"30 9 * * *", "At 09:30"},
+		{"0 9,17 * * *", "At 09:00 and 17:00"},
+		{"0 */6 * * *", "At minute 0 past every 6th hour"},
+		{"15 1-5 * * *", "At minute 15 past hour 1 through 5"},
+		{"0,30 8-18 * * 1-5", "At minute 0 and 30 past hour 8 through 18 on Monday through Friday"},
+		{"30 9 * * 1", "At 09:30 on Monday"},
+		{"0 0 * * sat,sun", "At 00:00 on Sunday and Saturday"},
+		{"0 0 1,15 * *", "At 00:00 on day-of-month 1 and 15"},
+		{"0 0 1 * 1", "At 00:00 on day-of-month 1 or on Monday"},
+		{"0 12 * 1,7 *", "At 12:00 in January and July"},
+		{"0 12 1 */3 *", "At 12:00 on day-of-month 1 in every 3rd month"},
+		{"0 0 31 2 *", "At 00:00 on day-of-month 31 in February"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			s, err := parseCronSchedule(tc.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have := describeCronSchedule(s); have != tc.want {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestCronNextRuns(t *testing.T) {
+	s, err := parseCronSchedule("30 9 * * 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	ts := nextCronRuns(s, now, 3)
+	want := []string{"2024-05-06T09:30:00Z", "2024-05-13T09:30:00Z", "2024-05-20T09:30:00Z"}
+	if len(ts) != len(want) {
+		t.Fatalf("wanted %d times but got %v", len(want), ts)
+	}
+	for i, t2 := range ts {
+		if have := t2.Format(time.RFC3339); have != want[i] {
+			t.Errorf("wanted %q at %d but got %q", want[i], i, have)
+		}
+	}
+
+	s, err = parseCronSchedule("0 0 31 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts := nextCronRuns(s, now, 3); len(ts) != 0 {
+		t.Fatalf("schedule which never runs returned %v", ts)
+	}
+}
+
+func TestCronPrintSchedule(t *testing.T) {
+	var b bytes.Buffer
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	if err := printCronSchedule(&b, "0 */12 * * *", now); err != nil {
+		t.Fatal(err)
+	}
+	want := `Schedule:    0 */12 * * *
+Description: At 00:00 and 12:00 (UTC)
+Next runs:
+  2024-05-02 00:00 Thu
+  2024-05-02 12:00 Thu
+  2024-05-03 00:00 Fri
+  2024-05-03 12:00 Fri
+  2024-05-04 00:00 Sat
+`
+	if have := b.String(); have != want {
+		t.Fatalf("wanted:\n%s\nbut got:\n%s", want, have)
+	}
+
+	err := printCronSchedule(&b, "0 0 * *", now)
+	if err == nil || !strings.Contains(err.Error(), `invalid CRON format "0 0 * *"`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCronScheduleCovers(t *testing.T) {
+	testCases := []struct {
+		a    string
+		b    string
+		want bool
+	}{
+		{"0 0 * * *", "0 0 * * *", true},
+		{"0 0 * * *", "0 0 * * 0-6", true},
+		{"0 0 * * 0-6", "0 0 * * *", true},
+		{"0 */6 * * *", "0 12 * * 1", true},
+		{"0 12 * * 1", "0 */6 * * *", false},
+		{"*/5 * * * *", "30 9 1 1 *", true},
+		{"0 0 * * *", "0 0 1 * 1", true},
+		{"0 0 1 * 1", "0 0 1 * *", true},
+		{"0 0 1 * 1", "0 0 * * 1", true},
+		{"0 0 1 * *", "0 0 1 * 1", false},
+		{"0 0 * 1 *", "0 0 * * *", false},
+		{"15 3 * * *", "15 */2 * * *", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a+" covers "+tc.b, func(t *testing.T) {
+			a, err := parseCronSchedule(tc.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := parseCronSchedule(tc.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have := cronScheduleCovers(a, b); have != tc.want {
+				t.Fatalf("wanted %v but got %v", tc.want, have)
+			}
+		})
+	}
+}
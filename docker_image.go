@@ -0,0 +1,298 @@
+package actionlint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// https://github.com/distribution/reference/blob/main/reference.go
+var (
+	reDockerDomain        = regexp.MustCompile(`^(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?)*|\[[0-9a-fA-F:]+\])(?::[0-9]+)?$`)
+	reDockerPathComponent = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*$`)
+	reDockerTag           = regexp.MustCompile(`^\w[\w.-]{0,127}$`)
+	reDockerDigest        = regexp.MustCompile(`^[a-z0-9]+(?:[.+_-][a-z0-9]+)*:[a-zA-Z0-9=_-]{32,}$`)
+	reDockerSHA256        = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+)
+
+// dockerImageRef is a reference of Docker image like "ghcr.io/owner/image:tag@sha256:...".
+type dockerImageRef struct {
+	// domain is a domain of the registry like "ghcr.io". It is empty for Docker Hub.
+	domain string
+	// path is a path of the repository in the registry like "owner/image".
+	path string
+	// tag is a tag of the image. It is empty when no tag is specified.
+	tag string
+	// hasTag is true when ":" for tag exists even if the tag is empty.
+	hasTag bool
+	// digest is a digest of the image like "sha256:...". It is empty when no digest is specified.
+	digest string
+}
+
+// parseDockerImageRef parses the reference of Docker image like "alpine:3.19" following the grammar
+// defined in distribution/reference. An empty tag like "alpine:" is not an error here so that
+// callers can report it.
+func parseDockerImageRef(s string) (*dockerImageRef, error) {
+	ref := &dockerImageRef{}
+
+	if i := strings.IndexByte(s, '@'); i >= 0 {
+		ref.digest = s[i+1:]
+		s = s[:i]
+		if !reDockerDigest.MatchString(ref.digest) || strings.HasPrefix(ref.digest, "sha256:") && !reDockerSHA256.MatchString(ref.digest) {
+			return nil, fmt.Errorf("digest %q is invalid. digest must be in format \"{algorithm}:{hex}\" like \"sha256:{64 hex characters}\"", ref.digest)
+		}
+	}
+
+	if i := strings.LastIndexByte(s, ':'); i > strings.LastIndexByte(s, '/') {
+		ref.tag = s[i+1:]
+		ref.hasTag = true
+		s = s[:i]
+		if ref.tag != "" && !reDockerTag.MatchString(ref.tag) {
+			return nil, fmt.Errorf("tag %q is invalid. tag must consist of up to 128 word characters, '.', and '-' and must not start with '.' nor '-'", ref.tag)
+		}
+	}
+
+	if s == "" {
+		return nil, fmt.Errorf("image name is empty")
+	}
+	if len(s) > 255 {
+		return nil, fmt.Errorf("image name must not be longer than 255 characters")
+	}
+
+	cs := strings.Split(s, "/")
+	if len(cs) > 1 && (strings.ContainsAny(cs[0], ".:") || cs[0] == "localhost") {
+		if !reDockerDomain.MatchString(cs[0]) {
+			return nil, fmt.Errorf("domain of registry %q is invalid", cs[0])
+		}
+		ref.domain = cs[0]
+		cs = cs[1:]
+	}
+	for _, c := range cs {
+		if c == "" {
+			return nil, fmt.Errorf("image name %q contains empty path component", s)
+		}
+		if !reDockerPathComponent.MatchString(c) {
+			if reDockerPathComponent.MatchString(strings.ToLower(c)) {
+				return nil, fmt.Errorf("image name %q must be lowercase", s)
+			}
+			return nil, fmt.Errorf("path component %q of image name is invalid. it must consist of lowercase alphanumeric characters separated by '.', '_', '__', or '-'", c)
+		}
+	}
+	ref.path = strings.Join(cs, "/")
+
+	return ref, nil
+}
+
+// isValidDockerImageRef returns true when the string is a well-formed reference of Docker image.
+// Unlike parseDockerImageRef, an empty tag like "alpine:" is not allowed.
+func isValidDockerImageRef(s string) bool {
+	r, err := parseDockerImageRef(s)
+	return err == nil && (!r.hasTag || r.tag != "")
+}
+
+// name returns the name of the image without the tag and the digest like "ghcr.io/owner/image".
+func (r *dockerImageRef) name() string {
+	if r.domain == "" {
+		return r.path
+	}
+	return r.domain + "/" + r.path
+}
+
+func (r *dockerImageRef) String() string {
+	var b strings.Builder
+	b.WriteString(r.name())
+	if r.hasTag {
+		b.WriteByte(':')
+		b.WriteString(r.tag)
+	}
+	if r.digest != "" {
+		b.WriteByte('@')
+		b.WriteString(r.digest)
+	}
+	return b.String()
+}
+
+// dockerRegistry checks existence of Docker images in registries with Docker Registry HTTP API V2.
+// Results are cached in memory since the same image is often used in many workflows.
+// https://distribution.github.io/distribution/spec/api/
+type dockerRegistry struct {
+	client *http.Client
+	// baseURL returns the base URL of the API for the domain like "https://ghcr.io".
+	baseURL func(domain string) string
+	dbg     io.Writer
+	mu      sync.Mutex
+	cache   map[string]dockerImageExistence
+}
+
+type dockerImageExistence int
+
+const (
+	// dockerImageUnknown means the existence could not be confirmed. For example, the image is
+	// private or the registry is not reachable.
+	dockerImageUnknown dockerImageExistence = iota
+	dockerImageExists
+	dockerImageNotFound
+)
+
+func newDockerRegistry(client *http.Client, dbg io.Writer) *dockerRegistry {
+	if client == nil {
+		client = &http.Client{Timeout: defaultHTTPTimeout}
+	}
+	return &dockerRegistry{
+		client:  client,
+		baseURL: func(d string) string { return "https://" + d },
+		dbg:     dbg,
+		cache:   map[string]dockerImageExistence{},
+	}
+}
+
+func (r *dockerRegistry) debug(format string, args ...interface{}) {
+	if r.dbg == nil {
+		return
+	}
+	fmt.Fprintf(r.dbg, "[dockerRegistry] "+format+"\n", args...)
+}
+
+// exists checks the image exists in the registry. Errors are not returned because failing to
+// access the registry should not be reported as a lint error. dockerImageUnknown is returned
+// instead. When the context is canceled, requests in flight are aborted and dockerImageUnknown is
+// returned.
+func (r *dockerRegistry) exists(ctx context.Context, ref *dockerImageRef) dockerImageExistence {
+	domain, path := ref.domain, ref.path
+	if domain == "" || domain == "docker.io" || domain == "index.docker.io" {
+		domain = "registry-1.docker.io"
+		if !strings.Contains(path, "/") {
+			path = "library/" + path // Official images
+		}
+	}
+	reference := "latest"
+	if ref.digest != "" {
+		reference = ref.digest
+	} else if ref.tag != "" {
+		reference = ref.tag
+	}
+
+	key := domain + "/" + path + "@" + reference
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.cache[key]; ok {
+		return e
+	}
+	e := r.fetch(ctx, domain, path, reference)
+	if ctx.Err() == nil {
+		r.cache[key] = e // Do not remember the result of the aborted request
+	}
+	return e
+}
+
+func (r *dockerRegistry) fetch(ctx context.Context, domain, path, reference string) dockerImageExistence {
+	u := fmt.Sprintf("%s/v2/%s/manifests/%s", r.baseURL(domain), path, reference)
+	res, err := r.head(ctx, u, "")
+	if err != nil {
+		r.debug("Could not send request to %s: %s", u, err)
+		return dockerImageUnknown
+	}
+	if res.StatusCode == http.StatusUnauthorized {
+		// Anonymous token is necessary even for public images in most registries
+		tok, err := r.token(ctx, res.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			r.debug("Could not get token to access %s: %s", u, err)
+			return dockerImageUnknown
+		}
+		if res, err = r.head(ctx, u, tok); err != nil {
+			r.debug("Could not send request to %s: %s", u, err)
+			return dockerImageUnknown
+		}
+	}
+
+	r.debug("Status of %s: %s", u, res.Status)
+	switch res.StatusCode {
+	case http.StatusOK:
+		return dockerImageExists
+	case http.StatusNotFound:
+		return dockerImageNotFound
+	default:
+		// 401 or 403 for private images, 429 for rate limits, and so on
+		return dockerImageUnknown
+	}
+}
+
+func (r *dockerRegistry) head(ctx context.Context, u, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", strings.Join([]string{
+		"application/vnd.oci.image.index.v1+json",
+		"application/vnd.oci.image.manifest.v1+json",
+		"application/vnd.docker.distribution.manifest.list.v2+json",
+		"application/vnd.docker.distribution.manifest.v2+json",
+	}, ", "))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	res, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	res.Body.Close()
+	return res, nil
+}
+
+// token fetches an anonymous token from the authorization server described in the header like
+// `Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/alpine:pull"`.
+func (r *dockerRegistry) token(ctx context.Context, header string) (string, error) {
+	if !strings.HasPrefix(header, "Bearer ") {
+		return "", fmt.Errorf("unsupported authentication %q", header)
+	}
+	params := map[string]string{}
+	for _, p := range strings.Split(header[len("Bearer "):], ",") {
+		if k, v, ok := strings.Cut(strings.TrimSpace(p), "="); ok {
+			params[k] = strings.Trim(v, `"`)
+		}
+	}
+	realm, ok := params["realm"]
+	if !ok {
+		return "", fmt.Errorf("realm is missing in %q", header)
+	}
+	q := url.Values{}
+	for _, k := range []string{"service", "scope"} {
+		if v, ok := params[k]; ok {
+			q.Set(k, v)
+		}
+	}
+	u := realm
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", err
+	}
+	res, err := r.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("authorization server %s returned %s", u, res.Status)
+	}
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("could not parse response from %s: %w", u, err)
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	return body.AccessToken, nil
+}
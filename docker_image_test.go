@@ -0,0 +1,251 @@
+package actionlint
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDockerImageRefOK(t *testing.T) {
+	testCases := []struct {
+		input  string
+		domain string
+		path   string
+		tag    string
+		digest string
+	}{
+		{"alpine", "", "alpine", "", ""},
+		{"alpine:3.20", "", "alpine", "3.20", ""},
+		{"alpine:", "", "alpine", "", ""},
+		{"owner/image:latest", "", "owner/image", "latest", ""},
+		{"ghcr.io/owner/image:v1.2.3", "ghcr.io", "owner/image", "v1.2.3", ""},
+		{"localhost/image", "localhost", "image", "", ""},
+		{"localhost:5000/my-org/my_image:1.0.0-rc.1", "localhost:5000", "my-org/my_image", "1.0.0-rc.1", ""},
+		{"a.b__c---d:_tag", "", "a.b__c---d", "_tag", ""},
+		{
+			"alpine@sha256:c5b1261d6d3e43071626931fc004f70149baeba2c8ec672bd4f27761f8e1ad6b",
+			"", "alpine", "", "sha256:c5b1261d6d3e43071626931fc004f70149baeba2c8ec672bd4f27761f8e1ad6b",
+		},
+		{
+			"ghcr.io/owner/image:v1@sha512:0123456789abcdef0123456789abcdef",
+			"ghcr.io", "owner/image", "v1", "sha512:0123456789abcdef0123456789abcdef",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			r, err := parseDockerImageRef(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.domain != tc.domain || r.path != tc.path || r.tag != tc.tag || r.digest != tc.digest {
+				t.Fatalf("wanted domain=%q path=%q tag=%q digest=%q but got %#v", tc.domain, tc.path, tc.tag, tc.digest, r)
+			}
+			if s := r.String(); s != tc.input {
+				t.Fatalf("string representation %q is different from input %q", s, tc.input)
+			}
+		})
+	}
+}
+
+func TestParseDockerImageRefError(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  string
+	}{
+		{"", "image name is empty"},
+		{":v1", "image name is empty"},
+		{"Alpine:3.20", `image name "Alpine" must be lowercase`},
+		{"ghcr.io/Owner/image", `image name "ghcr.io/Owner/image" must be lowercase`},
+		{"owner//image", "contains empty path component"},
+		{"owner/image/", "contains empty path component"},
+		{"-image", `path component "-image" of image name is invalid`},
+		{"ima..ge", `path component "ima..ge" of image name is invalid`},
+		{"exa_mple.com:5000/image", `domain of registry "exa_mple.com:5000" is invalid`},
+		{"alpine:-tag", `tag "-tag" is invalid`},
+		{"alpine:" + strings.Repeat("a", 129), "is invalid. tag must consist of up to 128"},
+		{"alpine@sha256:c5b1261d6d3e", `digest "sha256:c5b1261d6d3e" is invalid`},
+		{"alpine@sha256:" + strings.Repeat("A", 64), "is invalid"},
+		{"alpine@c5b1261d6d3e43071626931fc004f70149baeba2c8ec672bd4f27761f8e1ad6b", "is invalid"},
+		{strings.Repeat("a", 256), "must not be longer than 255 characters"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := parseDockerImageRef(tc.input)
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Fatalf("error message %q does not contain %q", msg, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsValidDockerImageRef(t *testing.T) {
+	for input, want := range map[string]bool{
+		"alpine":      true,
+		"alpine:3.20": true,
+		"alpine:":     false,
+		"Alpine":      false,
+	} {
+		if have := isValidDockerImageRef(input); have != want {
+			t.Errorf("wanted %v for %q but got %v", want, input, have)
+		}
+	}
+}
+
+func testDockerRegistryServer(t *testing.T) (*httptest.Server, *[]string) {
+	reqs := []string{}
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs = append(reqs, r.Method+" "+r.URL.String())
+		if r.URL.Path == "/token" {
+			if r.URL.Query().Get("scope") != "repository:library/alpine:pull" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Write([]byte(`{"token":"dummy-token"}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/library/alpine/manifests/3.20", "/v2/library/alpine/manifests/latest":
+			if r.Header.Get("Authorization") != "Bearer dummy-token" {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="`+srv.URL+`/token",service="registry.docker.io",scope="repository:library/alpine:pull"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/v2/owner/private/manifests/v1":
+			w.WriteHeader(http.StatusUnauthorized)
+		case "/v2/owner/limited/manifests/v1":
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &reqs
+}
+
+func TestDockerRegistryExists(t *testing.T) {
+	srv, reqs := testDockerRegistryServer(t)
+	r := newDockerRegistry(nil, nil)
+	domains := []string{}
+	r.baseURL = func(d string) string {
+		domains = append(domains, d)
+		return srv.URL
+	}
+
+	testCases := []struct {
+		input string
+		want  dockerImageExistence
+	}{
+		{"alpine:3.20", dockerImageExists},
+		{"docker.io/alpine", dockerImageExists},
+		{"alpine:3.0.0-does-not-exist", dockerImageNotFound},
+		{"ghcr.io/owner/private:v1", dockerImageUnknown},
+		{"ghcr.io/owner/limited:v1", dockerImageUnknown},
+		{"ghcr.io/owner/image@sha256:c5b1261d6d3e43071626931fc004f70149baeba2c8ec672bd4f27761f8e1ad6b", dockerImageNotFound},
+	}
+
+	for _, tc := range testCases {
+		ref, err := parseDockerImageRef(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have := r.exists(context.Background(), ref); have != tc.want {
+			t.Errorf("wanted %v for %q but got %v", tc.want, tc.input, have)
+		}
+	}
+
+	wantDomains := []string{"registry-1.docker.io", "registry-1.docker.io", "registry-1.docker.io", "ghcr.io", "ghcr.io", "ghcr.io"}
+	if strings.Join(domains, ",") != strings.Join(wantDomains, ",") {
+		t.Errorf("wanted domains %v but got %v", wantDomains, domains)
+	}
+
+	want := []string{
+		"HEAD /v2/library/alpine/manifests/3.20",
+		"GET /token?scope=repository%3Alibrary%2Falpine%3Apull&service=registry.docker.io",
+		"HEAD /v2/library/alpine/manifests/3.20",
+		"HEAD /v2/library/alpine/manifests/latest",
+		"GET /token?scope=repository%3Alibrary%2Falpine%3Apull&service=registry.docker.io",
+		"HEAD /v2/library/alpine/manifests/latest",
+		"HEAD /v2/library/alpine/manifests/3.0.0-does-not-exist",
+		"HEAD /v2/owner/private/manifests/v1",
+		"HEAD /v2/owner/limited/manifests/v1",
+		"HEAD /v2/owner/image/manifests/sha256:c5b1261d6d3e43071626931fc004f70149baeba2c8ec672bd4f27761f8e1ad6b",
+	}
+	if strings.Join(*reqs, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected requests:\n%s", strings.Join(*reqs, "\n"))
+	}
+
+	// Results are cached
+	ref, err := parseDockerImageRef("alpine:3.20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have := r.exists(context.Background(), ref); have != dockerImageExists {
+		t.Fatalf("cached result is unexpected: %v", have)
+	}
+	if len(*reqs) != len(want) {
+		t.Fatalf("request was sent though the result was cached: %v", (*reqs)[len(want):])
+	}
+}
+
+func TestDockerRegistryExistsAbortByCanceledContext(t *testing.T) {
+	srv, aborted := testNewBlockingServer(t)
+	r := newDockerRegistry(&http.Client{}, nil)
+	r.baseURL = func(string) string { return srv.URL }
+
+	ref, err := parseDockerImageRef("alpine:3.20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if have := r.exists(ctx, ref); have != dockerImageUnknown {
+		t.Fatalf("wanted %v but got %v", dockerImageUnknown, have)
+	}
+	testWaitAborted(t, aborted)
+	if len(r.cache) > 0 {
+		t.Fatalf("result of aborted request was cached: %v", r.cache)
+	}
+}
+
+func TestRuleActionDockerImageNotFoundInRegistry(t *testing.T) {
+	srv, _ := testDockerRegistryServer(t)
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: docker://alpine:3.20
+      - uses: docker://alpine:3.0.0-does-not-exist
+      - uses: docker://ghcr.io/owner/private:v1
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	r := NewRuleAction(nil)
+	r.registry = newDockerRegistry(nil, nil)
+	r.registry.baseURL = func(string) string { return srv.URL }
+	v := NewVisitor()
+	v.AddPass(r)
+	if err := v.Visit(w); err != nil {
+		t.Fatal(err)
+	}
+	errs = r.Errs()
+	if len(errs) != 1 {
+		t.Fatalf("wanted one error but got %v", errs)
+	}
+	want := `:7:15: Docker image "alpine:3.0.0-does-not-exist" of Docker action does not exist in the registry`
+	if msg := errs[0].Error(); !strings.Contains(msg, want) {
+		t.Fatalf("error %q does not contain %q", msg, want)
+	}
+}
@@ -0,0 +1,119 @@
+package actionlint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const defaultDuplicateStepsMinLength = 3
+
+// duplicateStepsTarget is a workflow file to detect duplicate step sequences.
+type duplicateStepsTarget struct {
+	path     string
+	workflow *Workflow
+}
+
+type duplicateStepsJob struct {
+	file  int
+	job   *Job
+	steps []string
+}
+
+// stepFingerprint returns a string which is the same for steps doing the same thing.
+func stepFingerprint(s *Step) string {
+	fs := appendStepShape(nil, "", s)
+	var b strings.Builder
+	for _, f := range fs {
+		b.WriteString(f.key)
+		b.WriteByte(0)
+		b.WriteString(f.value)
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+// findDuplicateStepSequences finds sequences of steps repeated across jobs in the given workflows.
+// The sequences must have minLen steps or more. When minLen is zero or negative, the default
+// length is used. A duplicate sequence is reported at its first step except for the first
+// occurrence. The returned slice contains errors for each target at the same index.
+func findDuplicateStepSequences(targets []duplicateStepsTarget, minLen int) [][]*Error {
+	if minLen <= 0 {
+		minLen = defaultDuplicateStepsMinLength
+	}
+
+	jobs := []*duplicateStepsJob{}
+	for i, t := range targets {
+		if t.workflow == nil {
+			continue
+		}
+		js := make([]*Job, 0, len(t.workflow.Jobs))
+		for _, j := range t.workflow.Jobs {
+			if len(j.Steps) >= minLen {
+				js = append(js, j)
+			}
+		}
+		sort.Slice(js, func(a, b int) bool {
+			return js[a].Pos.IsBefore(js[b].Pos)
+		})
+		for _, j := range js {
+			ss := make([]string, 0, len(j.Steps))
+			for _, s := range j.Steps {
+				ss = append(ss, stepFingerprint(s))
+			}
+			jobs = append(jobs, &duplicateStepsJob{i, j, ss})
+		}
+	}
+
+	type occurrence struct {
+		job   int
+		start int
+	}
+	// Map from fingerprints of minLen steps to their first occurrence
+	firsts := map[string]occurrence{}
+	errs := make([][]*Error, len(targets))
+
+	for ji, j := range jobs {
+		for start := 0; start+minLen <= len(j.steps); {
+			k := strings.Join(j.steps[start:start+minLen], "\n")
+			o, ok := firsts[k]
+			if !ok {
+				firsts[k] = occurrence{ji, start}
+				start++
+				continue
+			}
+			if o.job == ji {
+				start++ // Repetition in the same job is not a target
+				continue
+			}
+
+			// Extend the sequence as long as possible
+			f := jobs[o.job]
+			l := minLen
+			for start+l < len(j.steps) && o.start+l < len(f.steps) && j.steps[start+l] == f.steps[o.start+l] {
+				l++
+			}
+
+			where := fmt.Sprintf("job %q", f.job.ID.Value)
+			if f.file != j.file {
+				where = fmt.Sprintf("job %q in workflow %q", f.job.ID.Value, targets[f.file].path)
+			}
+			pos := j.job.Steps[start].Pos
+			errs[j.file] = append(errs[j.file], &Error{
+				Message: fmt.Sprintf(
+					"%d steps from this step are identical to steps from %s at %s. consider extracting them into a composite action or a reusable workflow",
+					l,
+					where,
+					f.job.Steps[o.start].Pos.String(),
+				),
+				Filepath: targets[j.file].path,
+				Line:     pos.Line,
+				Column:   pos.Col,
+				Kind:     "duplicate-steps",
+			})
+			start += l
+		}
+	}
+
+	return errs
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"text/template"
@@ -30,10 +31,22 @@ type Error struct {
 	Filepath string
 	// Line is a line number where the error occurred. This value is 1-based.
 	Line int
-	// Column is a column number where the error occurred. This value is 1-based.
+	// Column is a column number where the error occurred. This value is 1-based and counted in
+	// characters (not in bytes).
 	Column int
+	// Offset is a byte offset in the source where the error occurred. This value is 0-based. Linter
+	// calculates this value from Line and Column so that multi-byte characters are handled
+	// correctly. This value is -1 when the position is not in the source.
+	Offset int
 	// Kind is a string to represent kind of the error. Usually rule name which found the error.
 	Kind string
+	// Suggestions is a list of names similar to the wrong name which caused the error. Editors can
+	// use this field to suggest fixes. This field is nil when no suggestion is available.
+	Suggestions []string
+	// Severity is a severity of the error reported by external linters. shellcheck reports one of
+	// "error", "warning", "info", and "style". This field is empty for errors reported by
+	// actionlint itself.
+	Severity string
 }
 
 // Error returns summary of the error as string.
@@ -66,27 +79,42 @@ func errorfAt(pos *Pos, kind string, format string, args ...interface{}) *Error
 // GetTemplateFields fields for formatting this error with Go template.
 func (e *Error) GetTemplateFields(source []byte) *ErrorTemplateFields {
 	snippet := ""
+	line := ""
+	indicator := ""
 	end := e.Column
+	endOffset := e.Offset
 	if len(source) > 0 && e.Line > 0 {
 		if l, ok := e.getLine(source); ok {
 			snippet = l
-			if len(l) >= e.Column-1 {
+			line = l
+			if start, ok := byteIndexOfColumn(l, e.Column); ok {
 				if i := e.getIndicator(l); i != "" {
 					snippet += "\n" + i
+					indicator = i
 					end = len(i) // Byte length can be used here because this line only contains ASCII
+					if e.Offset >= 0 {
+						endOffset = e.Offset + len(indicatedToken(l[start:]))
+					}
 				}
 			}
 		}
 	}
 
 	return &ErrorTemplateFields{
-		Message:   e.Message,
-		Filepath:  e.Filepath,
-		Line:      e.Line,
-		Column:    e.Column,
-		Kind:      e.Kind,
-		Snippet:   snippet,
-		EndColumn: end,
+		Message:     e.Message,
+		Filepath:    e.Filepath,
+		Line:        e.Line,
+		Column:      e.Column,
+		Kind:        e.Kind,
+		Snippet:     snippet,
+		EndColumn:   end,
+		Offset:      e.Offset,
+		EndOffset:   endOffset,
+		Suggestions: e.Suggestions,
+		Severity:    e.Severity,
+		SourceLine:  line,
+		Indicator:   indicator,
+		DocURL:      ruleDocURL(e.Kind),
 	}
 }
 
@@ -94,8 +122,26 @@ func (e *Error) GetTemplateFields(source []byte) *ErrorTemplateFields {
 // message with colorful output and source snippet with indicator. When nil is set to source, no
 // source snippet is not printed. To disable colorful output, set true to fatih/color.NoColor.
 func (e *Error) PrettyPrint(w io.Writer, source []byte) {
+	e.PrettyPrintWithContext(w, source, 0)
+}
+
+// PrettyPrintWithContext prints the error in the same way as PrettyPrint. In addition, the source
+// snippet contains the given number of lines before and after the line of the error with their line
+// numbers.
+func (e *Error) PrettyPrintWithContext(w io.Writer, source []byte, context int) {
 	yellow.Fprint(w, e.Filepath)
 	gray.Fprint(w, ":")
+	e.prettyPrintBody(w, source, "", context)
+}
+
+// prettyPrintGrouped prints the error in the same way as PrettyPrintWithContext but the file name
+// is omitted and the output is indented. The file name is printed once as the header of the group.
+func (e *Error) prettyPrintGrouped(w io.Writer, source []byte, context int) {
+	fmt.Fprint(w, "  ")
+	e.prettyPrintBody(w, source, "  ", context)
+}
+
+func (e *Error) prettyPrintBody(w io.Writer, source []byte, indent string, context int) {
 	fmt.Fprint(w, e.Line)
 	gray.Fprint(w, ":")
 	fmt.Fprint(w, e.Column)
@@ -106,18 +152,50 @@ func (e *Error) PrettyPrint(w io.Writer, source []byte) {
 	if len(source) == 0 || e.Line <= 0 {
 		return
 	}
-	line, ok := e.getLine(source)
-	if !ok || len(line) < e.Column-1 {
+	first := e.Line - context
+	if first < 1 {
+		first = 1
+	}
+	lines := sourceLinesBetween(source, first, e.Line+context)
+	idx := e.Line - first
+	if idx >= len(lines) {
+		return
+	}
+	line := lines[idx]
+	if _, ok := byteIndexOfColumn(line, e.Column); !ok {
 		return
 	}
 
-	lnum := fmt.Sprintf("%d | ", e.Line)
-	indent := strings.Repeat(" ", len(lnum)-2)
-	gray.Fprintf(w, "%s|\n", indent)
-	gray.Fprint(w, lnum)
-	fmt.Fprintln(w, line)
-	gray.Fprintf(w, "%s| ", indent)
-	green.Fprintln(w, e.getIndicator(line))
+	// Align line numbers of all lines in the snippet
+	width := len(strconv.Itoa(first + len(lines) - 1))
+	pad := indent + strings.Repeat(" ", width+1)
+	gray.Fprintf(w, "%s|\n", pad)
+	for i, l := range lines {
+		gray.Fprintf(w, "%s%*d | ", indent, width, first+i)
+		fmt.Fprintln(w, l)
+		if i == idx {
+			gray.Fprintf(w, "%s| ", pad)
+			green.Fprintln(w, e.getIndicator(line))
+		}
+	}
+}
+
+// sourceLinesBetween returns the lines from the first line to the last line (1-based, inclusive).
+// The lines after the end of the source are not included.
+func sourceLinesBetween(source []byte, first, last int) []string {
+	ret := make([]string, 0, last-first+1)
+	s := bufio.NewScanner(bytes.NewReader(source))
+	l := 0
+	for s.Scan() {
+		l++
+		if l > last {
+			break
+		}
+		if l >= first {
+			ret = append(ret, s.Text())
+		}
+	}
+	return ret
 }
 
 func (e *Error) getLine(source []byte) (string, bool) {
@@ -132,23 +210,46 @@ func (e *Error) getLine(source []byte) (string, bool) {
 	return "", false
 }
 
+// byteIndexOfColumn returns the byte index of the 1-based column in the line. The column is counted
+// in characters. The second return value is false when the column is out of the line. The column
+// just after the end of line is in the line.
+func byteIndexOfColumn(line string, col int) (int, bool) {
+	if col <= 1 {
+		return 0, true
+	}
+	c := 1
+	for i := range line {
+		if c == col {
+			return i, true
+		}
+		c++
+	}
+	return len(line), c == col
+}
+
+// indicatedToken returns the leading non-space characters of the string. They are underlined by the
+// error indicator.
+func indicatedToken(s string) string {
+	for i, c := range s {
+		if c == ' ' || c == '\t' || c == '\n' || c == '\r' {
+			return s[:i]
+		}
+	}
+	return s
+}
+
 func (e *Error) getIndicator(line string) string {
 	if e.Column <= 0 {
 		return ""
 	}
 
-	start := e.Column - 1 // Column is 1-based
+	start, ok := byteIndexOfColumn(line, e.Column)
+	if !ok {
+		return ""
+	}
 
 	// Count width of non-space characters after '^' for underline
-	uw := 0
-	r := strings.NewReader(line[start:])
-	for {
-		c, s, err := r.ReadRune()
-		if err != nil || s == 0 || c == ' ' || c == '\t' || c == '\n' || c == '\r' {
-			break
-		}
-		uw += runewidth.RuneWidth(c)
-	}
+	uw := runewidth.StringWidth(indicatedToken(line[start:]))
 	if uw > 0 {
 		uw-- // Decrement for place for '^'
 	}
@@ -158,8 +259,53 @@ func (e *Error) getIndicator(line string) string {
 	return fmt.Sprintf("%s^%s", strings.Repeat(" ", sw), strings.Repeat("~", uw))
 }
 
+// sourceLines is an index of lines in source to convert line and column numbers into byte offsets
+// without scanning the entire source for each position.
+type sourceLines struct {
+	src    []byte
+	starts []int
+}
+
+func newSourceLines(src []byte) *sourceLines {
+	starts := []int{0}
+	for i, b := range src {
+		if b == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return &sourceLines{src, starts}
+}
+
+// offset returns the 0-based byte offset of the 1-based line and column. The column is counted in
+// characters. When the column is 0, it returns the offset of the start of the line. It returns -1
+// when the position is not in the source.
+func (s *sourceLines) offset(line, col int) int {
+	if line <= 0 || line > len(s.starts) {
+		return -1
+	}
+	start := s.starts[line-1]
+	end := len(s.src)
+	if line < len(s.starts) {
+		end = s.starts[line] - 1 // Exclude '\n'
+	}
+	i, ok := byteIndexOfColumn(string(s.src[start:end]), col)
+	if !ok {
+		return -1
+	}
+	return start + i
+}
+
+// setOffsets sets byte offsets of the errors in the source.
+func (s *sourceLines) setOffsets(errs []*Error) {
+	for _, err := range errs {
+		err.Offset = s.offset(err.Line, err.Column)
+	}
+}
+
 // ByErrorPosition is predicate for sort.Interface. It sorts errors slice by file path, line, and
-// column.
+// column. Errors at the same position are sorted by rule name (syntax errors first) so that the
+// order does not depend on the order of rules reporting them. Use sort.Stable to keep the order of
+// errors reported by the same rule at the same position.
 type ByErrorPosition []*Error
 
 func (by ByErrorPosition) Len() int {
@@ -167,13 +313,7 @@ func (by ByErrorPosition) Len() int {
 }
 
 func (by ByErrorPosition) Less(i, j int) bool {
-	if c := strings.Compare(by[i].Filepath, by[j].Filepath); c != 0 {
-		return c < 0
-	}
-	if by[i].Line == by[j].Line {
-		return by[i].Column < by[j].Column
-	}
-	return by[i].Line < by[j].Line
+	return errorPositionLess(by[i], by[j])
 }
 
 func (by ByErrorPosition) Swap(i, j int) {
@@ -199,6 +339,28 @@ type ErrorTemplateFields struct {
 	// EndColumn is a column number where the error indicator (^~~~~~~) ends. When no indicator
 	// can be shown, EndColumn is equal to Column.
 	EndColumn int `json:"end_column"`
+	// Offset is a 0-based byte offset of error position in the source. This is -1 when the position
+	// is not in the source.
+	Offset int `json:"offset"`
+	// EndOffset is a 0-based byte offset where the error indicator (^~~~~~~) ends. The offset is
+	// exclusive. When no indicator can be shown, EndOffset is equal to Offset.
+	EndOffset int `json:"end_offset"`
+	// Suggestions is a list of names similar to the wrong name which caused the error.
+	// When encoding into JSON, this field may be omitted when no suggestion is available.
+	Suggestions []string `json:"suggestions,omitempty"`
+	// Severity is a severity of the error reported by external linters such as shellcheck.
+	// When encoding into JSON, this field may be omitted when the severity is empty.
+	Severity string `json:"severity,omitempty"`
+	// SourceLine is the line of the source where the error occurred. Snippet is this line followed
+	// by Indicator. When encoding into JSON, this field may be omitted when the line is not
+	// available.
+	SourceLine string `json:"source_line,omitempty"`
+	// Indicator is the indicator (^~~~~~~) which underlines the position of the error in
+	// SourceLine. When encoding into JSON, this field may be omitted when no indicator can be shown.
+	Indicator string `json:"indicator,omitempty"`
+	// DocURL is the URL of the document of the rule which reported the error. When encoding into
+	// JSON, this field may be omitted when the rule is not built-in such as custom rules.
+	DocURL string `json:"doc_url,omitempty"`
 }
 
 func unescapeBackslash(s string) string {
@@ -329,3 +491,65 @@ func (f *ErrorFormatter) RegisterRule(r Rule) {
 		f.rules[n] = &ruleTemplateFields{n, r.Description()}
 	}
 }
+
+func errorPositionLess(a, b *Error) bool {
+	if c := strings.Compare(a.Filepath, b.Filepath); c != 0 {
+		return c < 0
+	}
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+	if a.Column != b.Column {
+		return a.Column < b.Column
+	}
+	return errorKindLess(a.Kind, b.Kind)
+}
+
+// errorKindLess compares rule names of errors. Syntax errors come first since they are found by
+// the parser before applying rules.
+func errorKindLess(a, b string) bool {
+	if x, y := a == "syntax-check", b == "syntax-check"; x != y {
+		return x
+	}
+	return a < b
+}
+
+// severityRank returns the rank of the severity. Smaller rank is more severe. Errors reported by
+// actionlint itself have no severity and they are treated as "error".
+func severityRank(s string) int {
+	switch s {
+	case "", "error":
+		return 0
+	case "warning":
+		return 1
+	case "info":
+		return 2
+	case "style":
+		return 3
+	default:
+		return 4
+	}
+}
+
+// errorsLessBy returns the predicate to sort errors in the order. Available orders are "file",
+// "rule", and "severity". Errors are sorted by their positions after the key of the order.
+func errorsLessBy(order string) func(a, b *Error) bool {
+	switch order {
+	case "rule":
+		return func(a, b *Error) bool {
+			if a.Kind != b.Kind {
+				return errorKindLess(a.Kind, b.Kind)
+			}
+			return errorPositionLess(a, b)
+		}
+	case "severity":
+		return func(a, b *Error) bool {
+			if x, y := severityRank(a.Severity), severityRank(b.Severity); x != y {
+				return x < y
+			}
+			return errorPositionLess(a, b)
+		}
+	default:
+		return errorPositionLess
+	}
+}
@@ -199,6 +199,26 @@ func TestErrorPrettyPrint(t *testing.T) {
   |
 1 | this is source
   | `,
+		},
+		{
+			message: "error after multi-byte characters",
+			line:    1,
+			column:  4,
+			source:  "あい foo",
+			expected: `filename.txt:1:4: error after multi-byte characters [kind]
+  |
+1 | あい foo
+  |      ^~~`,
+		},
+		{
+			message: "error at multi-byte characters",
+			line:    1,
+			column:  3,
+			source:  "a あい",
+			expected: `filename.txt:1:3: error at multi-byte characters [kind]
+  |
+1 | a あい
+  |   ^~~~`,
 		},
 		{
 			message:  "error at zero line and zero column",
@@ -226,6 +246,86 @@ func TestErrorPrettyPrint(t *testing.T) {
 	}
 }
 
+func TestErrorPrettyPrintWithContext(t *testing.T) {
+	src := "line 1\nline 2\nline 3\nline 4\nline 5\nline 6\nline 7\nline 8\nline 9\nline 10\nline 11\n"
+	testCases := []struct {
+		what     string
+		line     int
+		context  int
+		expected string
+	}{
+		{
+			what:    "no context",
+			line:    3,
+			context: 0,
+			expected: `filename.txt:3:6: message [kind]
+  |
+3 | line 3
+  |      ^`,
+		},
+		{
+			what:    "context lines",
+			line:    3,
+			context: 1,
+			expected: `filename.txt:3:6: message [kind]
+  |
+2 | line 2
+3 | line 3
+  |      ^
+4 | line 4`,
+		},
+		{
+			what:    "context at start of source",
+			line:    1,
+			context: 2,
+			expected: `filename.txt:1:6: message [kind]
+  |
+1 | line 1
+  |      ^
+2 | line 2
+3 | line 3`,
+		},
+		{
+			what:    "context at end of source",
+			line:    11,
+			context: 2,
+			expected: `filename.txt:11:6: message [kind]
+   |
+ 9 | line 9
+10 | line 10
+11 | line 11
+   |      ^~`,
+		},
+		{
+			what:    "line numbers are aligned",
+			line:    9,
+			context: 1,
+			expected: `filename.txt:9:6: message [kind]
+   |
+ 8 | line 8
+ 9 | line 9
+   |      ^
+10 | line 10`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			err := errorAt(&Pos{tc.line, 6}, "kind", "message")
+			err.Filepath = "filename.txt"
+
+			var buf bytes.Buffer
+			err.PrettyPrintWithContext(&buf, []byte(src), tc.context)
+
+			out := buf.String()
+			want := tc.expected + "\n"
+			if out != want {
+				t.Fatalf("wanted:\n%s\n\nhave:\n%s", want, out)
+			}
+		})
+	}
+}
+
 func TestErrorSortErrorsByPosition(t *testing.T) {
 	testCases := [][]struct {
 		line int
@@ -310,6 +410,8 @@ func TestErrorGetTemplateFieldsOK(t *testing.T) {
 		endCol  int
 		source  string
 		snippet string
+		line    string
+		indic   string
 	}{
 		{
 			message: "simple message with source",
@@ -317,6 +419,8 @@ func TestErrorGetTemplateFieldsOK(t *testing.T) {
 			endCol:  4,
 			source:  "this is source",
 			snippet: "this is source\n^~~~",
+			line:    "this is source",
+			indic:   "^~~~",
 		},
 		{
 			message: "simple message",
@@ -330,6 +434,7 @@ func TestErrorGetTemplateFieldsOK(t *testing.T) {
 			endCol:  0,
 			source:  "this is source",
 			snippet: "this is source",
+			line:    "this is source",
 		},
 	}
 
@@ -359,10 +464,58 @@ func TestErrorGetTemplateFieldsOK(t *testing.T) {
 			if f.EndColumn != tc.endCol {
 				t.Fatalf("wanted %d but have %d", tc.endCol, f.EndColumn)
 			}
+			if f.SourceLine != tc.line {
+				t.Fatalf("wanted %q but have %q", tc.line, f.SourceLine)
+			}
+			if f.Indicator != tc.indic {
+				t.Fatalf("wanted %q but have %q", tc.indic, f.Indicator)
+			}
+			if f.DocURL != "" {
+				t.Fatalf("doc URL of unknown rule should be empty but have %q", f.DocURL)
+			}
 		})
 	}
 }
 
+func TestErrorSourceLinesOffset(t *testing.T) {
+	src := []byte("foo\nあい bar\n\nbaz")
+	testCases := []struct {
+		line   int
+		col    int
+		offset int
+	}{
+		{1, 1, 0},
+		{1, 3, 2},
+		{1, 4, 3},
+		{1, 0, 0},
+		{2, 1, 4},
+		{2, 2, 7},
+		{2, 4, 11},
+		{2, 7, 14},
+		{3, 1, 15},
+		{4, 3, 18},
+		{4, 4, 19},
+		{1, 5, -1},
+		{2, 8, -1},
+		{5, 1, -1},
+		{0, 0, -1},
+	}
+
+	l := newSourceLines(src)
+	for _, tc := range testCases {
+		if o := l.offset(tc.line, tc.col); o != tc.offset {
+			t.Errorf("wanted offset %d at line:%d,col:%d but got %d", tc.offset, tc.line, tc.col, o)
+		}
+	}
+
+	err := errorAt(&Pos{2, 4}, "kind", "msg")
+	l.setOffsets([]*Error{err})
+	f := err.GetTemplateFields(src)
+	if f.Offset != 11 || f.EndOffset != 14 || string(src[f.Offset:f.EndOffset]) != "bar" {
+		t.Fatalf("wanted offset range [11, 14) but got [%d, %d)", f.Offset, f.EndOffset)
+	}
+}
+
 // Regression test for #128
 func TestErrorGetTemplateFieldsColumnIsOutOfBounds(t *testing.T) {
 	err := errorAt(&Pos{1, 9999}, "kind", "this is message")
@@ -686,3 +839,48 @@ func TestErrorFormatterRegisterRuleInParallel(t *testing.T) {
 		t.Fatalf("not all rules were registered. %d rules were registered", len(f.rules))
 	}
 }
+
+func TestErrorPrintSuggestionsInJSON(t *testing.T) {
+	f, err := NewErrorFormatter("{{json .}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := []*Error{
+		{
+			Message:     `property "nod" is not defined in object type {node: number}. did you mean "node"?`,
+			Filepath:    "test.yaml",
+			Line:        1,
+			Column:      1,
+			Kind:        "expression",
+			Suggestions: []string{"node"},
+		},
+		{
+			Message:  "this is error",
+			Filepath: "test.yaml",
+			Line:     2,
+			Column:   1,
+			Kind:     "some-rule",
+		},
+	}
+
+	var b bytes.Buffer
+	if err := f.PrintErrors(&b, errs, []byte("dummy source\nsecond line")); err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := []map[string]interface{}{}
+	if err := json.Unmarshal(b.Bytes(), &decoded); err != nil {
+		t.Fatal(err, b.String())
+	}
+	if len(decoded) != 2 {
+		t.Fatalf("wanted 2 errors but got %d: %s", len(decoded), b.String())
+	}
+	want := []interface{}{"node"}
+	if !cmp.Equal(decoded[0]["suggestions"], want) {
+		t.Fatal(cmp.Diff(decoded[0]["suggestions"], want))
+	}
+	if s, ok := decoded[1]["suggestions"]; ok {
+		t.Fatalf("\"suggestions\" field should be omitted when no suggestion is available but got %v", s)
+	}
+}
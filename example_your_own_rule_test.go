@@ -63,3 +63,30 @@ func ExampleLinter_yourOwnRule() {
 	fmt.Println(len(errs), "lint errors found by actionlint")
 	// Output: 1 lint errors found by actionlint
 }
+
+func ExampleLinterOptions_AddRule() {
+	o := &actionlint.LinterOptions{}
+
+	// The function passed to AddRule is called on linting each workflow file since rule instances
+	// have their own states while visiting the syntax tree. The created rule is applied in addition
+	// to the built-in rules.
+	o.AddRule(func() actionlint.Rule {
+		return NewRuleStepName()
+	})
+
+	l, err := actionlint.NewLinter(io.Discard, o)
+	if err != nil {
+		panic(err)
+	}
+
+	f := filepath.Join("testdata", "ok", "minimal.yaml")
+	errs, err := l.LintFile(f, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, err := range errs {
+		fmt.Println(err.Kind)
+	}
+	// Output: step-name
+}
@@ -0,0 +1,284 @@
+package actionlint
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// exprExplanation is an explanation of the type of an expression at some position in a workflow.
+type exprExplanation struct {
+	expr    string
+	node    string
+	ty      ExprType
+	sources []string
+}
+
+// Print prints the explanation to the writer.
+func (e *exprExplanation) Print(out io.Writer) {
+	fmt.Fprintf(out, "expression: %s\n", e.expr)
+	if e.node != e.expr {
+		fmt.Fprintf(out, "node: %s\n", e.node)
+	}
+	fmt.Fprintf(out, "type: %s\n", e.ty.String())
+	for _, s := range e.sources {
+		fmt.Fprintf(out, "source: %s\n", s)
+	}
+}
+
+// exprExplainer finds the expression at the position in a workflow while RuleExpression checks
+// expressions and explains how the type of the expression was inferred.
+type exprExplainer struct {
+	line   int
+	col    int
+	job    *Job
+	steps  map[string]*Step
+	result *exprExplanation
+}
+
+func newExprExplainer(line, col int) *exprExplainer {
+	return &exprExplainer{line: line, col: col}
+}
+
+// nodeAt returns the innermost node at the position in the expression. The line and col are the
+// position where the expression starts. It returns nil when the position is not in the expression.
+func (e *exprExplainer) nodeAt(expr ExprNode, tokens []*Token, line, col int) ExprNode {
+	if e.result != nil {
+		return nil // Already found
+	}
+
+	// Convert the position into the position relative to the expression (see convertExprLineColToPos)
+	l, c := e.line-line+1, e.col-col+1
+	target := -1
+	for _, t := range tokens {
+		if t.Kind != TokenKindEnd && t.Line == l && t.Column <= c && c < t.Column+len(t.Value) {
+			target = t.Offset
+			break
+		}
+	}
+	if target < 0 {
+		return nil
+	}
+
+	spans := newExprNodeSpans(tokens)
+	var found ExprNode
+	size := 0
+	VisitExprNode(expr, func(n, _ ExprNode, entering bool) {
+		if !entering {
+			return
+		}
+		// Children are visited after their parent. Prefer the child when the ranges are the same
+		s, end := spans.span(n)
+		if s <= target && target < end && (found == nil || end-s <= size) {
+			found, size = n, end-s
+		}
+	})
+	return found
+}
+
+// exprNodeSpans calculates ranges of offsets of expression nodes from tokens of the expression.
+type exprNodeSpans struct {
+	tokens []*Token
+	index  map[int]int
+	closes map[int]int
+}
+
+func newExprNodeSpans(tokens []*Token) *exprNodeSpans {
+	c := newExprPrecedenceChecker(tokens)
+	return &exprNodeSpans{tokens, c.index, c.closes}
+}
+
+// next returns the index of the token next to i skipping ")" of parenthesized sub-expressions.
+func (s *exprNodeSpans) next(i int, kind TokenKind) int {
+	for i++; i < len(s.tokens) && s.tokens[i].Kind == TokenKindRightParen && kind != TokenKindRightParen; i++ {
+	}
+	if i >= len(s.tokens) {
+		return len(s.tokens) - 1
+	}
+	return i
+}
+
+// last returns the index of the last token of the node.
+func (s *exprNodeSpans) last(n ExprNode) int {
+	switch n := n.(type) {
+	case *ObjectDerefNode:
+		return s.next(s.next(s.last(n.Receiver), TokenKindDot), TokenKindIdent) // Receiver "." Property
+	case *ArrayDerefNode:
+		return s.next(s.next(s.last(n.Receiver), TokenKindDot), TokenKindStar) // Receiver "." "*"
+	case *IndexAccessNode:
+		return s.next(s.last(n.Index), TokenKindRightBracket) // Operand "[" Index "]"
+	case *NotOpNode:
+		return s.last(n.Operand)
+	case *CompareOpNode:
+		return s.last(n.Right)
+	case *LogicalOpNode:
+		return s.last(n.Right)
+	case *FuncCallNode:
+		i := s.index[n.Token().Offset] + 1 // Index of "("
+		if j, ok := s.closes[i]; ok {
+			return j
+		}
+		return i
+	default:
+		return s.index[n.Token().Offset]
+	}
+}
+
+// span returns the start offset and the end offset of the node.
+func (s *exprNodeSpans) span(n ExprNode) (int, int) {
+	t := s.tokens[s.last(n)]
+	return n.Token().Offset, t.Offset + len(t.Value)
+}
+
+// exprNodeSource returns the source string of the node from the source of the expression.
+func exprNodeSource(n ExprNode, tokens []*Token, src string) string {
+	start, end := newExprNodeSpans(tokens).span(n)
+	if end > len(src) {
+		end = len(src)
+	}
+	return src[start:end]
+}
+
+// exprAccessPath returns the root node and lower-cased property names of the property access
+// chain like `steps.foo.outputs`. Index accesses with string literals are also included.
+func exprAccessPath(n ExprNode) (ExprNode, []string) {
+	switch n := n.(type) {
+	case *ObjectDerefNode:
+		r, p := exprAccessPath(n.Receiver)
+		return r, append(p, n.Property)
+	case *IndexAccessNode:
+		r, p := exprAccessPath(n.Operand)
+		if s, ok := n.Index.(*StringNode); ok {
+			return r, append(p, strings.ToLower(s.Value))
+		}
+		return r, append(p, "*")
+	case *ArrayDerefNode:
+		r, p := exprAccessPath(n.Receiver)
+		return r, append(p, "*")
+	default:
+		return n, nil
+	}
+}
+
+// explainSources describes which contexts, action metadata, and configurations contributed to
+// the type of the node.
+func (rule *RuleExpression) explainSources(n ExprNode) []string {
+	root, path := exprAccessPath(n)
+	prop := func(i int) (string, bool) {
+		if i < len(path) && path[i] != "*" {
+			return path[i], true
+		}
+		return "", false
+	}
+
+	switch root := root.(type) {
+	case *VariableNode:
+		switch root.Name {
+		case "steps":
+			id, ok := prop(0)
+			if !ok {
+				return []string{"\"steps\" context is typed from steps which have \"id\" and were run before the current step in the job"}
+			}
+			return []string{rule.explainStep(id)}
+		case "needs":
+			id, ok := prop(0)
+			if !ok || rule.workflow == nil {
+				return []string{"\"needs\" context is typed from outputs of jobs at \"needs:\" of the job and their dependencies"}
+			}
+			if j, ok := rule.workflow.Jobs[id]; ok {
+				return []string{fmt.Sprintf("\"needs.%s\" is typed from outputs of job %q defined at line:%d,col:%d", id, j.ID.Value, j.Pos.Line, j.Pos.Col)}
+			}
+			return []string{fmt.Sprintf("job %q is not found in the workflow", id)}
+		case "matrix":
+			j := rule.explainer.job
+			if j == nil || j.Strategy == nil || j.Strategy.Matrix == nil {
+				return []string{"\"matrix\" context is empty since the job has no \"strategy.matrix\""}
+			}
+			m := j.Strategy.Matrix
+			return []string{fmt.Sprintf("\"matrix\" context is typed from \"strategy.matrix\" of job %q defined at line:%d,col:%d", j.ID.Value, m.Pos.Line, m.Pos.Col)}
+		case "inputs":
+			ss := []string{}
+			if rule.workflow != nil {
+				for _, e := range rule.workflow.On {
+					switch e.(type) {
+					case *WorkflowCallEvent, *WorkflowDispatchEvent:
+						ss = append(ss, fmt.Sprintf("\"inputs\" context is typed from inputs of %q event", e.EventName()))
+					}
+				}
+			}
+			if len(ss) == 0 {
+				ss = append(ss, "\"inputs\" context is empty since the workflow is triggered by neither \"workflow_call\" nor \"workflow_dispatch\" event")
+			}
+			return ss
+		case "secrets":
+			if rule.secretsTy != nil {
+				return []string{"\"secrets\" context is typed from secrets of \"workflow_call\" event and automatically supplied secrets"}
+			}
+		case "vars":
+			if rule.config != nil && rule.config.ConfigVariables != nil {
+				return []string{"\"vars\" context is typed from \"config-variables\" in the configuration file"}
+			}
+		case "github":
+			if p, ok := prop(0); !ok || p != "event" {
+				break
+			}
+			if p, ok := prop(1); ok && p == "inputs" && rule.dispatchInputsTy != nil {
+				return []string{"\"github.event.inputs\" is typed from inputs of \"workflow_dispatch\" event. all of them are strings"}
+			}
+			if rule.eventTy == nil || rule.workflow == nil {
+				return []string{"\"github.event\" is typed loosely since webhook payloads of the events which trigger the workflow are unknown"}
+			}
+			ns := make([]string, 0, len(rule.workflow.On))
+			for _, e := range rule.workflow.On {
+				ns = append(ns, e.EventName())
+			}
+			sort.Strings(ns)
+			return []string{fmt.Sprintf("\"github.event\" is typed from webhook payloads of %s events", quotes(ns))}
+		}
+		return []string{fmt.Sprintf("%q is a built-in context", root.Name)}
+	case *FuncCallNode:
+		if strings.ToLower(root.Callee) == "fromjson" && len(root.Args) == 1 {
+			if k, ok := fromJSONSchemaKey(root.Args[0]); ok {
+				if _, ok := rule.fromJSONTypes[k]; ok {
+					return []string{fmt.Sprintf("return value of fromJSON() is typed from the schema configured for %q at \"from-json-schemas\" or \"matrix-schemas\" in the configuration file", k)}
+				}
+			}
+		}
+		return []string{fmt.Sprintf("return value of built-in function %q", root.Callee)}
+	}
+	return nil
+}
+
+func (rule *RuleExpression) explainStep(id string) string {
+	s, ok := rule.explainer.steps[id]
+	if !ok {
+		return fmt.Sprintf("step %q is not found in the steps run before the current step", id)
+	}
+	a, ok := s.Exec.(*ExecAction)
+	if !ok || a.Uses == nil {
+		return fmt.Sprintf("outputs of step %q are typed as {string => string} since the step runs a script", id)
+	}
+	spec := a.Uses.Value
+	if strings.HasPrefix(spec, "./") {
+		if m, _, err := rule.localActions.FindMetadata(spec); err == nil && m != nil {
+			return fmt.Sprintf("outputs of step %q are typed from metadata of local action %q", id, spec)
+		}
+		return fmt.Sprintf("outputs of step %q are typed as {string => string} since metadata of local action %q is not found", id, spec)
+	}
+	if strings.HasPrefix(spec, "actions/github-script@") {
+		return fmt.Sprintf("outputs of step %q are typed loosely since %q can set any outputs", id, spec)
+	}
+	if m := rule.privateActions.find(spec); m != nil {
+		return fmt.Sprintf("outputs of step %q are typed from schema of action %q declared at \"actions\" in the configuration file", id, spec)
+	}
+	if _, ok := FindPopularAction(spec); ok {
+		return fmt.Sprintf("outputs of step %q are typed from metadata of popular action %q", id, spec)
+	}
+	if rule.strictActionOutputs {
+		if s, meta := popularActionOfSameMajorVersion(spec); meta != nil {
+			return fmt.Sprintf("outputs of step %q are typed from metadata of popular action %q which has the same major version since \"strict-action-outputs\" is enabled", id, s)
+		}
+	}
+	return fmt.Sprintf("outputs of step %q are typed as {string => string} since metadata of action %q is unknown", id, spec)
+}
@@ -13,6 +13,8 @@ type ExprError struct {
 	Line int
 	// Column is column number position which caused the error. Note that this value is 1-based.
 	Column int
+	// Suggestions is a list of names similar to the wrong name which caused the error.
+	Suggestions []string
 }
 
 func (e *ExprError) Error() string {
@@ -148,6 +148,8 @@ type UntrustedInputChecker struct {
 	cur             []*UntrustedInputMap
 	start           ExprNode
 	errs            []*ExprError
+	// usage describes where the expression is used in error messages like "inline scripts".
+	usage string
 }
 
 // NewUntrustedInputChecker creates a new UntrustedInputChecker instance. The roots argument is a
@@ -159,6 +161,7 @@ func NewUntrustedInputChecker(roots UntrustedInputSearchRoots) *UntrustedInputCh
 		cur:             nil,
 		start:           nil,
 		errs:            []*ExprError{},
+		usage:           "inline scripts",
 	}
 }
 
@@ -274,8 +277,9 @@ func (u *UntrustedInputChecker) end() {
 	if len(inputs) == 1 {
 		err := errorfAtExpr(
 			u.start,
-			"%q is potentially untrusted. avoid using it directly in inline scripts. instead, pass it through an environment variable. see https://docs.github.com/en/actions/security-guides/security-hardening-for-github-actions for more details",
+			"%q is potentially untrusted. avoid using it directly in %s. instead, pass it through an environment variable. see https://docs.github.com/en/actions/security-guides/security-hardening-for-github-actions for more details",
 			inputs[0],
+			u.usage,
 		)
 		u.errs = append(u.errs, err)
 	} else if len(inputs) > 1 {
@@ -283,8 +287,9 @@ func (u *UntrustedInputChecker) end() {
 		// filter syntax. Show all properties in error message.
 		err := errorfAtExpr(
 			u.start,
-			"object filter extracts potentially untrusted properties %s. avoid using the value directly in inline scripts. instead, pass the value through an environment variable. see https://docs.github.com/en/actions/security-guides/security-hardening-for-github-actions for more details",
+			"object filter extracts potentially untrusted properties %s. avoid using the value directly in %s. instead, pass the value through an environment variable. see https://docs.github.com/en/actions/security-guides/security-hardening-for-github-actions for more details",
 			sortedQuotes(inputs),
+			u.usage,
 		)
 		u.errs = append(u.errs, err)
 	}
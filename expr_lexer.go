@@ -3,8 +3,7 @@ package actionlint
 import (
 	"fmt"
 	"strconv"
-	"strings"
-	"text/scanner"
+	"unicode/utf8"
 )
 
 // TokenKind is kind of token.
@@ -153,86 +152,140 @@ const expectedDigitChars = "'0'..'9'"
 const expectedAlphaChars = "'a'..'z', 'A'..'Z', '_'"
 const expectedAllChars = expectedAlphaChars + ", " + expectedDigitChars + ", " + expectedPunctChars
 
+// exprEOF is a character returned from ExprLexer at the end of input.
+const exprEOF rune = -1
+
+// exprTokenChunkSize is the maximum number of tokens allocated at once by ExprLexer.
+const exprTokenChunkSize = 16
+
+// exprLexerPos is a position in the source of expression.
+type exprLexerPos struct {
+	offset int
+	line   int
+	col    int
+}
+
 // ExprLexer is a struct to lex expression syntax. To know the syntax, see
 // https://docs.github.com/en/actions/learn-github-actions/expressions
 type ExprLexer struct {
 	src    string
-	scan   scanner.Scanner
 	lexErr *ExprError
-	start  scanner.Position
+	start  exprLexerPos
+	pos    exprLexerPos
+	// tokens is a chunk of tokens to allocate many tokens at once. Lexing expressions is a hot path
+	// and allocating each token separately is costly.
+	tokens []Token
 }
 
 // NewExprLexer makes new ExprLexer instance.
 func NewExprLexer(src string) *ExprLexer {
-	l := &ExprLexer{
-		src: src,
-		start: scanner.Position{
-			Offset: 0,
-			Line:   1,
-			Column: 1,
-		},
+	p := exprLexerPos{0, 1, 1}
+	return &ExprLexer{
+		src:   src,
+		start: p,
+		pos:   p,
 	}
-	l.scan.Init(strings.NewReader(src))
-	l.scan.Error = func(_ *scanner.Scanner, m string) {
-		l.error(fmt.Sprintf("scan error while lexing expression: %s", m))
-	}
-	return l
 }
 
 func (lex *ExprLexer) error(msg string) {
 	if lex.lexErr == nil {
-		p := lex.scan.Pos()
 		lex.lexErr = &ExprError{
 			Message: msg,
-			Offset:  p.Offset,
-			Line:    p.Line,
-			Column:  p.Column,
+			Offset:  lex.pos.offset,
+			Line:    lex.pos.line,
+			Column:  lex.pos.col,
+		}
+	}
+}
+
+// peek returns the character at the current position without consuming it.
+func (lex *ExprLexer) peek() rune {
+	if lex.pos.offset >= len(lex.src) {
+		return exprEOF
+	}
+	if c := lex.src[lex.pos.offset]; c < utf8.RuneSelf {
+		if c == 0 {
+			lex.error("scan error while lexing expression: invalid character NUL")
+		}
+		return rune(c)
+	}
+	r, w := utf8.DecodeRuneInString(lex.src[lex.pos.offset:])
+	if r == utf8.RuneError && w == 1 {
+		lex.error("scan error while lexing expression: invalid UTF-8 encoding")
+	}
+	return r
+}
+
+// next consumes the character at the current position and returns it.
+func (lex *ExprLexer) next() rune {
+	r := lex.peek()
+	if r == exprEOF {
+		return r
+	}
+	if r < utf8.RuneSelf {
+		lex.pos.offset++
+	} else {
+		_, w := utf8.DecodeRuneInString(lex.src[lex.pos.offset:])
+		lex.pos.offset += w
+	}
+	if r == '\n' {
+		lex.pos.line++
+		lex.pos.col = 1
+	} else {
+		lex.pos.col++
+	}
+	lex.peek() // Check the next character to report an invalid character as early as possible
+	return r
+}
+
+func (lex *ExprLexer) newToken(kind TokenKind, value string, p exprLexerPos) *Token {
+	if len(lex.tokens) == cap(lex.tokens) {
+		// Estimate the number of remaining tokens from the length of the rest of source
+		n := (len(lex.src)-p.offset)/4 + 1
+		if n > exprTokenChunkSize {
+			n = exprTokenChunkSize
 		}
+		lex.tokens = make([]Token, 0, n)
 	}
+	lex.tokens = append(lex.tokens, Token{
+		Kind:   kind,
+		Value:  value,
+		Offset: p.offset,
+		Line:   p.line,
+		Column: p.col,
+	})
+	return &lex.tokens[len(lex.tokens)-1]
 }
 
 func (lex *ExprLexer) token(kind TokenKind) *Token {
-	p := lex.scan.Pos()
 	s := lex.start
-	t := &Token{
-		Kind:   kind,
-		Value:  lex.src[s.Offset:p.Offset],
-		Offset: s.Offset,
-		Line:   s.Line,
-		Column: s.Column,
-	}
-	lex.start = p
+	t := lex.newToken(kind, lex.src[s.offset:lex.pos.offset], s)
+	lex.start = lex.pos
 	return t
 }
 
 func (lex *ExprLexer) eof() *Token {
-	return &Token{
-		Kind:   TokenKindEnd,
-		Value:  "",
-		Offset: lex.start.Offset,
-		Line:   lex.start.Line,
-		Column: lex.start.Column,
-	}
+	return lex.newToken(TokenKindEnd, "", lex.start)
 }
 
 func (lex *ExprLexer) eat() rune {
-	lex.scan.Next()
-	return lex.scan.Peek() // unlike lex.scan.Next(), return top char *after* eating
+	lex.next()
+	return lex.peek() // unlike lex.next(), return top char *after* eating
 }
 
 func (lex *ExprLexer) skipWhite() {
 	for {
-		if r := lex.scan.Peek(); !isWhitespace(r) {
+		if r := lex.peek(); !isWhitespace(r) {
 			return
 		}
-		lex.scan.Next()
-		lex.start = lex.scan.Pos()
+		lex.next()
+		lex.start = lex.pos
 	}
 }
 
 func (lex *ExprLexer) unexpected(r rune, where string, expected string) *Token {
 	var what string
-	if r == scanner.EOF {
+	if r == exprEOF {
 		what = "EOF"
 	} else {
 		what = "character " + strconv.QuoteRune(r)
@@ -274,7 +327,7 @@ func (lex *ExprLexer) lexNum() *Token {
 	// The official document says number literals are 'Any number format supported by JSON' but actually
 	// hex numbers starting with 0x are supported.
 
-	r := lex.scan.Peek() // precond: r is digit or '-'
+	r := lex.peek() // precond: r is digit or '-'
 
 	if r == '-' {
 		r = lex.eat()
@@ -283,7 +336,7 @@ func (lex *ExprLexer) lexNum() *Token {
 	if r == '0' {
 		r = lex.eat()
 		if r == 'x' {
-			lex.scan.Next()
+			lex.next()
 			return lex.lexHexInt()
 		}
 	} else {
@@ -342,7 +395,7 @@ func (lex *ExprLexer) lexNum() *Token {
 	}
 
 	if isAlnum(r) {
-		s := lex.src[lex.start.Offset:lex.scan.Pos().Offset]
+		s := lex.src[lex.start.offset:lex.pos.offset]
 		return lex.unexpected(r, "character following number "+s, expectedPunctChars)
 	}
 
@@ -350,7 +403,7 @@ func (lex *ExprLexer) lexNum() *Token {
 }
 
 func (lex *ExprLexer) lexHexInt() *Token {
-	r := lex.scan.Peek()
+	r := lex.peek()
 
 	if r == '0' {
 		r = lex.eat()
@@ -370,7 +423,7 @@ func (lex *ExprLexer) lexHexInt() *Token {
 	// Note: GitHub Actions does not support exponent part like 0x1f2p-a8
 
 	if isAlnum(r) {
-		s := lex.src[lex.start.Offset:lex.scan.Pos().Offset]
+		s := lex.src[lex.start.offset:lex.pos.offset]
 		return lex.unexpected(r, "character following hex integer "+s, expectedPunctChars)
 	}
 
@@ -385,8 +438,8 @@ func (lex *ExprLexer) lexString() *Token {
 			if lex.eat() != '\'' { // when not escaped single quote ''
 				return lex.token(TokenKindString)
 			}
-		case scanner.EOF:
-			return lex.unexpected(scanner.EOF, "end of string literal", "'''")
+		case exprEOF:
+			return lex.unexpected(exprEOF, "end of string literal", "'''")
 		}
 	}
 }
@@ -396,7 +449,7 @@ func (lex *ExprLexer) lexEnd() *Token {
 	if r != '}' {
 		return lex.unexpected(r, "end marker }}", "'}'")
 	}
-	lex.scan.Next()
+	lex.next()
 	// }} is an end marker of interpolation
 	return lex.token(TokenKindEnd)
 }
@@ -405,7 +458,7 @@ func (lex *ExprLexer) lexLess() *Token {
 	k := TokenKindLess
 	if lex.eat() == '=' { // eat '<'
 		k = TokenKindLessEq
-		lex.scan.Next()
+		lex.next()
 	}
 	return lex.token(k)
 }
@@ -414,7 +467,7 @@ func (lex *ExprLexer) lexGreater() *Token {
 	k := TokenKindGreater
 	if lex.eat() == '=' { // eat '>'
 		k = TokenKindGreaterEq
-		lex.scan.Next()
+		lex.next()
 	}
 	return lex.token(k)
 }
@@ -423,14 +476,14 @@ func (lex *ExprLexer) lexEq() *Token {
 	if r := lex.eat(); r != '=' { // eat '='
 		return lex.unexpected(r, "== operator", "'='")
 	}
-	lex.scan.Next()
+	lex.next()
 	return lex.token(TokenKindEq)
 }
 
 func (lex *ExprLexer) lexBang() *Token {
 	k := TokenKindNot
 	if lex.eat() == '=' { // eat '!'
-		lex.scan.Next() // eat '='
+		lex.next() // eat '='
 		k = TokenKindNotEq
 	}
 	return lex.token(k)
@@ -440,7 +493,7 @@ func (lex *ExprLexer) lexAnd() *Token {
 	if r := lex.eat(); r != '&' { // eat the first '&'
 		return lex.unexpected(r, "&& operator", "'&'")
 	}
-	lex.scan.Next() // eat the second '&'
+	lex.next() // eat the second '&'
 	return lex.token(TokenKindAnd)
 }
 
@@ -448,12 +501,12 @@ func (lex *ExprLexer) lexOr() *Token {
 	if r := lex.eat(); r != '|' { // eat the first '|'
 		return lex.unexpected(r, "|| operator", "'|'")
 	}
-	lex.scan.Next() // eat the second '|'
+	lex.next() // eat the second '|'
 	return lex.token(TokenKindOr)
 }
 
 func (lex *ExprLexer) lexChar(k TokenKind) *Token {
-	lex.scan.Next()
+	lex.next()
 	return lex.token(k)
 }
 
@@ -463,8 +516,8 @@ func (lex *ExprLexer) lexChar(k TokenKind) *Token {
 func (lex *ExprLexer) Next() *Token {
 	lex.skipWhite()
 
-	r := lex.scan.Peek()
-	if r == scanner.EOF {
+	r := lex.peek()
+	if r == exprEOF {
 		return lex.unexpectedEOF()
 	}
 
@@ -516,7 +569,7 @@ func (lex *ExprLexer) Next() *Token {
 
 // Offset returns the current offset (scanning position).
 func (lex *ExprLexer) Offset() int {
-	return lex.scan.Pos().Offset
+	return lex.pos.offset
 }
 
 // Err returns an error while lexing. When multiple errors occur, the first one is returned.
@@ -533,11 +586,11 @@ func LexExpression(src string) ([]*Token, int, *ExprError) {
 	for {
 		t := l.Next()
 		if l.lexErr != nil {
-			return nil, l.scan.Pos().Offset, l.lexErr
+			return nil, l.pos.offset, l.lexErr
 		}
 		ts = append(ts, t)
 		if t.Kind == TokenKindEnd {
-			return ts, l.scan.Pos().Offset, nil
+			return ts, l.pos.offset, nil
 		}
 	}
 }
@@ -807,6 +807,24 @@ func TestLexExprError(t *testing.T) {
 			want:  "unexpected character 'z' while lexing character following hex integer 0x1",
 			col:   5,
 		},
+		{
+			what:  "NUL character",
+			input: "foo.\x00bar}}",
+			want:  "scan error while lexing expression: invalid character NUL",
+			col:   5,
+		},
+		{
+			what:  "invalid UTF-8 sequence",
+			input: "'\xff'}}",
+			want:  "scan error while lexing expression: invalid UTF-8 encoding",
+			col:   2,
+		},
+		{
+			what:  "invalid UTF-8 sequence after multi-byte characters",
+			input: "'日本\xff'}}",
+			want:  "scan error while lexing expression: invalid UTF-8 encoding",
+			col:   4,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,134 @@
+package actionlint
+
+import "fmt"
+
+// exprPrecedenceChecker detects operator precedence pitfalls in expression syntax. Since the parser
+// does not keep parentheses in the syntax tree, tokens of the expression are used to know whether
+// operands are explicitly surrounded by parentheses.
+// https://docs.github.com/en/actions/learn-github-actions/expressions#operators
+type exprPrecedenceChecker struct {
+	tokens []*Token
+	index  map[int]int // Offset of token -> Index of token
+	closes map[int]int // Index of "(" -> Index of matching ")"
+	errs   []*exprPrecedenceError
+}
+
+// exprPrecedenceError is an error of the precedence pitfall. Severity is empty when the pitfall is
+// reported as an error.
+type exprPrecedenceError struct {
+	*ExprError
+	severity string
+}
+
+func newExprPrecedenceChecker(tokens []*Token) *exprPrecedenceChecker {
+	index := make(map[int]int, len(tokens))
+	closes := map[int]int{}
+	opens := []int{}
+	for i, t := range tokens {
+		index[t.Offset] = i
+		switch t.Kind {
+		case TokenKindLeftParen:
+			opens = append(opens, i)
+		case TokenKindRightParen:
+			if len(opens) > 0 {
+				closes[opens[len(opens)-1]] = i
+				opens = opens[:len(opens)-1]
+			}
+		}
+	}
+	return &exprPrecedenceChecker{tokens: tokens, index: index, closes: closes}
+}
+
+// operatorBefore returns the index of the binary operator token whose RHS is the node.
+func (c *exprPrecedenceChecker) operatorBefore(rhs ExprNode) int {
+	i, ok := c.index[rhs.Token().Offset]
+	if !ok {
+		return -1
+	}
+	for i--; i >= 0 && c.tokens[i].Kind == TokenKindLeftParen; i-- {
+	}
+	return i
+}
+
+// parenthesized returns true when the node is surrounded by parentheses which are closed before or
+// after the operator token at index op. When the node is unknown, it returns true to avoid false
+// positives.
+func (c *exprPrecedenceChecker) parenthesized(n ExprNode, op int, before bool) bool {
+	i, ok := c.index[n.Token().Offset]
+	if !ok {
+		return true
+	}
+	for i--; i >= 0 && c.tokens[i].Kind == TokenKindLeftParen; i-- {
+		if j, ok := c.closes[i]; ok && (j < op) == before {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *exprPrecedenceChecker) errorfWithSeverity(t *Token, severity string, format string, args ...interface{}) {
+	c.errs = append(c.errs, &exprPrecedenceError{errorAtToken(t, fmt.Sprintf(format, args...)), severity})
+}
+
+func (c *exprPrecedenceChecker) errorf(t *Token, format string, args ...interface{}) {
+	c.errorfWithSeverity(t, "", format, args...)
+}
+
+func (c *exprPrecedenceChecker) checkCompareOp(n *CompareOpNode) {
+	not, ok := n.Left.(*NotOpNode)
+	if !ok {
+		return
+	}
+	op := c.operatorBefore(n.Right)
+	if op < 0 || c.parenthesized(not, op, true) {
+		return
+	}
+	c.errorf(
+		not.Token(),
+		"\"!\" operator has higher precedence than %q operator. \"!x %[1]s y\" is parsed as \"(!x) %[1]s y\". use parentheses to make the precedence explicit like \"!(x %[1]s y)\" or \"(!x) %[1]s y\"",
+		n.Kind.String(),
+	)
+}
+
+// checkLogicalOp reports "&&" operator at RHS of "||" operator without parentheses like
+// "x || y && z". It is parsed as "x || (y && z)" though "(x || y) && z" is often intended. "&&" at
+// LHS of "||" like "x && 'a' || 'b'" is not reported since it is a common idiom of ternary operator.
+// Chains of the idiom like "x && 'a' || y && 'b' || 'c'" are not reported for the same reason.
+// This is reported as a warning since "x || (y && z)" is sometimes intended.
+func (c *exprPrecedenceChecker) checkLogicalOp(n *LogicalOpNode) {
+	if n.Kind != LogicalOpNodeKindOr {
+		return
+	}
+	and, ok := n.Right.(*LogicalOpNode)
+	if !ok || and.Kind != LogicalOpNodeKindAnd {
+		return
+	}
+	if l, ok := n.Left.(*LogicalOpNode); ok && l.Kind == LogicalOpNodeKindAnd {
+		return
+	}
+	t := c.operatorBefore(and.Right)
+	if t < 0 || c.parenthesized(and, t, false) {
+		return
+	}
+	c.errorfWithSeverity(
+		c.tokens[t],
+		"warning",
+		"\"&&\" operator has higher precedence than \"||\" operator. \"x || y && z\" is parsed as \"x || (y && z)\". use parentheses to make the precedence explicit like \"(x || y) && z\" or \"x || (y && z)\"",
+	)
+}
+
+// check checks the expression and returns errors for the precedence pitfalls.
+func (c *exprPrecedenceChecker) check(expr ExprNode) []*exprPrecedenceError {
+	VisitExprNode(expr, func(n, _ ExprNode, entering bool) {
+		if !entering {
+			return
+		}
+		switch n := n.(type) {
+		case *CompareOpNode:
+			c.checkCompareOp(n)
+		case *LogicalOpNode:
+			c.checkLogicalOp(n)
+		}
+	})
+	return c.errs
+}
@@ -0,0 +1,101 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExprPrecedenceCheckOK(t *testing.T) {
+	testCases := []string{
+		"!(a == b)",
+		"(!a) == b",
+		"((!a)) != b",
+		"a == !b",
+		"!a",
+		"!a && b == c",
+		"a == b || c == d",
+		"a || ('v' == b)",
+		"(a || 'v') == b",
+		"a || b == 'v'",
+		"a || 'v' == b",
+		"a && 1 != b",
+		"a == 'push' || 'refs/heads/main' == b",
+		"a && b || c",
+		"a && 'x' || b && 'y' || 'z'",
+		"a || (b && c)",
+		"(a || b) && c",
+		"a && b && c || d",
+		"f((!a) == b)",
+		"a[(!b) == c]",
+	}
+
+	for _, input := range testCases {
+		t.Run(input, func(t *testing.T) {
+			ts, _, err := LexExpression(input + "}}")
+			if err != nil {
+				t.Fatal(err)
+			}
+			n, err := NewExprParser().Parse(NewExprLexer(input + "}}"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if errs := newExprPrecedenceChecker(ts).check(n); len(errs) > 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+		})
+	}
+}
+
+func TestExprPrecedenceCheckError(t *testing.T) {
+	testCases := []struct {
+		input    string
+		want     []string
+		col      int
+		severity string
+	}{
+		{"!a == b", []string{`"!x == y" is parsed as "(!x) == y"`}, 1, ""},
+		{"!!a != b", []string{`"!x != y" is parsed as "(!x) != y"`}, 1, ""},
+		{"(!a == b)", []string{`"!x == y" is parsed as "(!x) == y"`}, 2, ""},
+		{"!a.b < 1", []string{`"!x < y" is parsed as "(!x) < y"`}, 1, ""},
+		{"!(a) == b", []string{`"!x == y" is parsed as "(!x) == y"`}, 1, ""},
+		{"f(!a == b)", []string{`"!x == y" is parsed as "(!x) == y"`}, 3, ""},
+		{"a || b && c", []string{`"x || y && z" is parsed as "x || (y && z)"`}, 8, "warning"},
+		{"a == 'x' || b == 'y' && c", []string{`"x || y && z" is parsed as "x || (y && z)"`}, 22, "warning"},
+		{"a || b && c && d", []string{`"x || y && z" is parsed as "x || (y && z)"`}, 8, "warning"},
+		{"a || (b) && c", []string{`"x || y && z" is parsed as "x || (y && z)"`}, 10, "warning"},
+		{
+			"a || !b == c && d",
+			[]string{`"x || y && z" is parsed as "x || (y && z)"`, `"!x == y" is parsed as "(!x) == y"`},
+			14,
+			"warning",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			ts, _, err := LexExpression(tc.input + "}}")
+			if err != nil {
+				t.Fatal(err)
+			}
+			n, err := NewExprParser().Parse(NewExprLexer(tc.input + "}}"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			errs := newExprPrecedenceChecker(ts).check(n)
+			if len(errs) != len(tc.want) {
+				t.Fatalf("wanted %d errors but got %d errors: %v", len(tc.want), len(errs), errs)
+			}
+			for i, want := range tc.want {
+				if !strings.Contains(errs[i].Message, want) {
+					t.Errorf("error %q does not contain %q", errs[i].Message, want)
+				}
+			}
+			if errs[0].Column != tc.col {
+				t.Errorf("wanted column %d but got %d: %v", tc.col, errs[0].Column, errs[0])
+			}
+			if errs[0].severity != tc.severity {
+				t.Errorf("wanted severity %q but got %q: %v", tc.severity, errs[0].severity, errs[0])
+			}
+		})
+	}
+}
@@ -2,13 +2,13 @@ package actionlint
 
 import (
 	"fmt"
-	"regexp"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
-var reFormatPlaceholder = regexp.MustCompile(`{\d+}`)
-
 func ordinal(i int) string {
 	suffix := "th"
 	switch i % 10 {
@@ -302,6 +302,11 @@ type ExprSemanticsChecker struct {
 	availableContexts     []string
 	availableSpecialFuncs []string
 	configVars            []string
+	workspace             *hashFilesWorkspace
+	fromJSONTypes         map[string]ExprType
+	dispatchInputs        *ObjectType
+	dispatchTypes         []string
+	nodeTypes             map[ExprNode]ExprType // Types of all nodes are recorded when this is not nil
 }
 
 // NewExprSemanticsChecker creates new ExprSemanticsChecker instance. When checkUntrustedInput is
@@ -338,6 +343,27 @@ func (sema *ExprSemanticsChecker) errorf(e ExprNode, format string, args ...inte
 	sema.errs = append(sema.errs, errorfAtExpr(e, format, args...))
 }
 
+// errorfWithSuggestions reports a new error like errorf. When some names in candidates are similar
+// to the wrong name, they are suggested in the error message and stored in the error.
+func (sema *ExprSemanticsChecker) errorfWithSuggestions(e ExprNode, name string, candidates []string, format string, args ...interface{}) {
+	err := errorfAtExpr(e, format, args...)
+	if s := similarNames(name, candidates); len(s) > 0 {
+		err.Message += ". " + didYouMean(s)
+		err.Suggestions = s
+	}
+	sema.errs = append(sema.errs, err)
+}
+
+// propNamesOf returns the names of the properties of the object type. They are used as candidates
+// of suggestions for undefined properties.
+func propNamesOf(ty *ObjectType) []string {
+	ps := make([]string, 0, len(ty.Props))
+	for n := range ty.Props {
+		ps = append(ps, n)
+	}
+	return ps
+}
+
 func (sema *ExprSemanticsChecker) ensureVarsCopied() {
 	if sema.varsCopied {
 		return
@@ -414,6 +440,7 @@ func (sema *ExprSemanticsChecker) UpdateInputs(ty *ObjectType) {
 // https://docs.github.com/en/actions/using-workflows/events-that-trigger-workflows#workflow_dispatch
 func (sema *ExprSemanticsChecker) UpdateDispatchInputs(ty *ObjectType) {
 	sema.UpdateInputs(ty)
+	sema.dispatchInputs = ty
 
 	// Update `github.event.inputs`.
 	// Unlike `inputs.*`, type of `github.event.inputs.*` is always string unlike `inputs.*`. We need
@@ -426,7 +453,23 @@ func (sema *ExprSemanticsChecker) UpdateDispatchInputs(ty *ObjectType) {
 	ty = NewStrictObjectType(p)
 
 	sema.ensureGithubVarCopied()
-	sema.vars["github"].(*ObjectType).Props["event"].(*ObjectType).Props["inputs"] = ty
+	gh := sema.vars["github"].(*ObjectType)
+	ev := gh.Props["event"].(*ObjectType)
+	// Copy the event object not to modify the object given by UpdateEvent
+	props := make(map[string]ExprType, len(ev.Props)+1)
+	for n, p := range ev.Props {
+		props[n] = p
+	}
+	props["inputs"] = ty
+	gh.Props["event"] = &ObjectType{props, ev.Mapped}
+}
+
+// UpdateEvent updates 'github.event' object to given object type. The type is a type of webhook
+// payload of the events which trigger the workflow.
+// https://docs.github.com/en/webhooks/webhook-events-and-payloads
+func (sema *ExprSemanticsChecker) UpdateEvent(ty *ObjectType) {
+	sema.ensureGithubVarCopied()
+	sema.vars["github"].(*ObjectType).Props["event"] = ty
 }
 
 // UpdateJobs updates 'jobs' context object to given object type.
@@ -435,6 +478,13 @@ func (sema *ExprSemanticsChecker) UpdateJobs(ty *ObjectType) {
 	sema.vars["jobs"] = ty
 }
 
+// SetRepositoryDispatchTypes sets event types of "repository_dispatch" event which triggers the
+// workflow. Comparisons between "github.event.action" and string literals which are not in the
+// types are reported since they are always false.
+func (sema *ExprSemanticsChecker) SetRepositoryDispatchTypes(types []string) {
+	sema.dispatchTypes = types
+}
+
 // SetContextAvailability sets available context names while semantics checks. Some contexts limit
 // where they can be used.
 // https://docs.github.com/en/actions/learn-github-actions/contexts#context-availability
@@ -524,7 +574,7 @@ func (sema *ExprSemanticsChecker) checkVariable(n *VariableNode) ExprType {
 		for n := range sema.vars {
 			ss = append(ss, n)
 		}
-		sema.errorf(n, "undefined variable %q. available variables are %s", n.Token().Value, sortedQuotes(ss))
+		sema.errorfWithSuggestions(n, n.Name, ss, "undefined variable %q. available variables are %s", n.Token().Value, sortedQuotes(ss))
 		return AnyType{}
 	}
 
@@ -547,7 +597,7 @@ func (sema *ExprSemanticsChecker) checkObjectDeref(n *ObjectDerefNode) ExprType
 			return ty.Mapped
 		}
 		if ty.IsStrict() {
-			sema.errorf(n, "property %q is not defined in object type %s", n.Property, ty.String())
+			sema.errorfWithSuggestions(n, n.Property, propNamesOf(ty), "property %q is not defined in object type %s", n.Property, ty.String())
 		}
 		return AnyType{}
 	case *ArrayType:
@@ -567,7 +617,7 @@ func (sema *ExprSemanticsChecker) checkObjectDeref(n *ObjectDerefNode) ExprType
 			} else if et.Mapped != nil {
 				elem = et.Mapped
 			} else if et.IsStrict() {
-				sema.errorf(n, "property %q is not defined in object type %s as element of filtered array", n.Property, et.String())
+				sema.errorfWithSuggestions(n, n.Property, propNamesOf(et), "property %q is not defined in object type %s as element of filtered array", n.Property, et.String())
 			}
 			return &ArrayType{elem, true}
 		default:
@@ -640,8 +690,8 @@ func (sema *ExprSemanticsChecker) checkArrayDeref(n *ArrayDerefNode) ExprType {
 	case AnyType:
 		return &ArrayType{AnyType{}, true}
 	case *ArrayType:
-		ty.Deref = true
-		return ty
+		// Do not modify the receiver type since it may be shared with other expressions
+		return &ArrayType{ty.Elem, true}
 	case *ObjectType:
 		// Object filtering is available for objects, not only arrays (#66)
 
@@ -660,20 +710,32 @@ func (sema *ExprSemanticsChecker) checkArrayDeref(n *ArrayDerefNode) ExprType {
 
 		// For strict object at receiver of .*
 		found := false
-		for _, t := range ty.Props {
+		keys := make([]string, 0, len(ty.Props))
+		for k, t := range ty.Props {
 			if _, ok := t.(*ObjectType); ok {
 				found = true
-				break
 			}
+			keys = append(keys, k)
 		}
 		if !found {
 			sema.errorf(n, "object type %q cannot be filtered by object filtering `.*` since it has no object element", ty.String())
 			return AnyType{}
 		}
 
-		return &ArrayType{AnyType{}, true}
+		// Element type is the union of types of all properties. Merge them in stable order since
+		// merging types is not commutative.
+		sort.Strings(keys)
+		var elem ExprType
+		for _, k := range keys {
+			if elem == nil {
+				elem = ty.Props[k]
+			} else {
+				elem = elem.Merge(ty.Props[k])
+			}
+		}
+		return &ArrayType{elem, true}
 	default:
-		sema.errorf(n, "receiver of object filtering `.*` must be type of array or object but got %q", ty.String())
+		sema.errorf(n, "receiver of object filtering `.*` must be type of array or object but got %q. object filtering is only available for arrays and objects", ty.String())
 		return AnyType{}
 	}
 }
@@ -710,7 +772,7 @@ func (sema *ExprSemanticsChecker) checkIndexAccess(n *IndexAccessNode) ExprType
 					return ty.Mapped
 				}
 				if ty.IsStrict() {
-					sema.errorf(n, "property %q is not defined in object type %s", lit.Value, ty.String())
+					sema.errorfWithSuggestions(n, lit.Value, propNamesOf(ty), "property %q is not defined in object type %s", lit.Value, ty.String())
 				}
 			}
 			if ty.Mapped != nil {
@@ -784,7 +846,7 @@ func (sema *ExprSemanticsChecker) checkBuiltinFunctionCall(n *FuncCallNode, sig
 	sema.checkSpecialFunctionAvailability(n)
 
 	// Special checks for specific built-in functions
-	switch n.Callee {
+	switch strings.ToLower(n.Callee) {
 	case "format":
 		lit, ok := n.Args[0].(*StringNode)
 		if !ok {
@@ -793,25 +855,154 @@ func (sema *ExprSemanticsChecker) checkBuiltinFunctionCall(n *FuncCallNode, sig
 		l := len(n.Args) - 1 // -1 means removing first format string argument
 
 		// Find all placeholders in format string
-		holders := make(map[int]struct{}, l)
-		for _, m := range reFormatPlaceholder.FindAllString(lit.Value, -1) {
-			i, _ := strconv.Atoi(m[1 : len(m)-1])
-			holders[i] = struct{}{}
+		holders, offset, invalid := parseFormatPlaceholders(lit.Value)
+		if invalid != "" {
+			sema.errs = append(sema.errs, errorfInStringLiteral(lit, offset, "format string %q is invalid: %s", lit.Value, invalid))
+			return
 		}
 
 		for i := 0; i < l; i++ {
 			_, ok := holders[i]
 			if !ok {
-				sema.errorf(n, "format string %q does not contain placeholder {%d}. remove argument which is unused in the format string", lit.Value, i)
+				sema.errorf(n.Args[i+1], "format string %q does not contain placeholder {%d}. remove argument which is unused in the format string", lit.Value, i)
 				continue
 			}
 			delete(holders, i) // forget it to check unused placeholders
 		}
 
+		rest := make([]int, 0, len(holders))
 		for i := range holders {
-			sema.errorf(n, "format string %q contains placeholder {%d} but only %d arguments are given to format", lit.Value, i, l)
+			rest = append(rest, i)
+		}
+		sort.Ints(rest)
+		for _, i := range rest {
+			sema.errorf(lit, "format string %q contains placeholder {%d} but only %d arguments are given to format", lit.Value, i, l)
+		}
+	case "hashfiles":
+		sema.checkHashFilesCall(n)
+	}
+}
+
+// fromJSONType returns the type of fromJSON() call result when JSON schema of the argument is
+// configured.
+func (sema *ExprSemanticsChecker) fromJSONType(n *FuncCallNode) (ExprType, bool) {
+	if len(sema.fromJSONTypes) == 0 || strings.ToLower(n.Callee) != "fromjson" || len(n.Args) != 1 {
+		return nil, false
+	}
+	k, ok := fromJSONSchemaKey(n.Args[0])
+	if !ok {
+		return nil, false
+	}
+	ty, ok := sema.fromJSONTypes[k]
+	if !ok {
+		return nil, false
+	}
+	return ty.DeepCopy(), true
+}
+
+func (sema *ExprSemanticsChecker) checkHashFilesCall(n *FuncCallNode) {
+	pats := make([]string, 0, len(n.Args))
+	for _, a := range n.Args {
+		lit, ok := a.(*StringNode)
+		if !ok {
+			return // Patterns are not known statically
+		}
+		if err := hashFilesPatternError(lit.Value); err != "" {
+			sema.errorf(lit, "pattern %q of hashFiles() is invalid: %s", lit.Value, err)
+			return
+		}
+		pats = append(pats, lit.Value)
+	}
+
+	negated := true
+	for _, p := range pats {
+		if !strings.HasPrefix(p, "!") {
+			negated = false
+			break
 		}
 	}
+	if negated {
+		sema.errorf(n, "all patterns of hashFiles() are negated with \"!\". no file is matched and the result is always an empty string")
+		return
+	}
+
+	if sema.workspace != nil && !matchHashFiles(pats, sema.workspace.list()) {
+		sema.errorf(n, "no file in the repository matches patterns %s of hashFiles(). the result is an empty string unless the files are created in the workflow", quotes(pats))
+	}
+}
+
+// errorfInStringLiteral creates an error at the byte offset in the value of the string literal. The
+// offset is mapped to the position in source since single quotes are escaped by doubling them in
+// source.
+func errorfInStringLiteral(lit *StringNode, offset int, format string, args ...interface{}) *ExprError {
+	err := errorfAtExpr(lit, format, args...)
+	src := lit.Token().Value
+	i := 1 // Skip the opening quote
+	for o := 0; o < offset && i < len(src); o++ {
+		if src[i] == '\'' {
+			i++ // '' is unescaped to '
+		}
+		i++
+	}
+	if i >= len(src) || strings.ContainsRune(src[:i], '\n') {
+		return err // Fall back to the start of the literal
+	}
+	err.Offset += i
+	err.Column += utf8.RuneCountInString(src[:i])
+	return err
+}
+
+// parseFormatPlaceholders parses the format string of format() function and returns the indices of
+// placeholders in it. "{{" and "}}" are escaped braces. Placeholders can have format specifiers like
+// "{0:yyyyMMdd}". When the format string is invalid, the byte offset of the invalid brace in the
+// format string and the reason are returned as the second and third return values.
+// https://github.com/actions/runner/blob/main/src/Sdk/Expressions/Sdk/Functions/Format.cs
+func parseFormatPlaceholders(s string) (map[int]struct{}, int, string) {
+	holders := map[int]struct{}{}
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			if i+1 < len(s) && s[i+1] == '{' {
+				i++ // Skip escaped "{{"
+				continue
+			}
+			j := i + 1
+			for j < len(s) && '0' <= s[j] && s[j] <= '9' {
+				j++
+			}
+			if j == i+1 {
+				return nil, i, "\"{\" does not start placeholder like {0}. escape it as \"{{\""
+			}
+			idx, err := strconv.Atoi(s[i+1 : j])
+			if err != nil {
+				return nil, i, fmt.Sprintf("index of placeholder %q is too large", s[i:j])
+			}
+			if j < len(s) && s[j] == ':' {
+				// Format specifiers continue until "}" which is not escaped
+				for j++; j < len(s); j++ {
+					if s[j] == '}' {
+						if j+1 < len(s) && s[j+1] == '}' {
+							j++
+							continue
+						}
+						break
+					}
+				}
+			}
+			if j >= len(s) || s[j] != '}' {
+				return nil, i, "placeholder is not closed with \"}\""
+			}
+			holders[idx] = struct{}{}
+			i = j
+		case '}':
+			if i+1 < len(s) && s[i+1] == '}' {
+				i++ // Skip escaped "}}"
+				continue
+			}
+			return nil, i, "\"}\" is not escaped. escape it as \"}}\""
+		}
+	}
+	return holders, 0, ""
 }
 
 func (sema *ExprSemanticsChecker) checkFuncCall(n *FuncCallNode) ExprType {
@@ -820,10 +1011,14 @@ func (sema *ExprSemanticsChecker) checkFuncCall(n *FuncCallNode) ExprType {
 	sigs, ok := sema.funcs[callee]
 	if !ok {
 		ss := make([]string, 0, len(sema.funcs))
-		for n := range sema.funcs {
+		names := make([]string, 0, len(sema.funcs))
+		for n, sigs := range sema.funcs {
 			ss = append(ss, n)
+			if len(sigs) > 0 {
+				names = append(names, sigs[0].Name)
+			}
 		}
-		sema.errorf(n, "undefined function %q. available functions are %s", n.Callee, sortedQuotes(ss))
+		sema.errorfWithSuggestions(n, n.Callee, names, "undefined function %q. available functions are %s", n.Callee, sortedQuotes(ss))
 		return AnyType{}
 	}
 
@@ -839,17 +1034,47 @@ func (sema *ExprSemanticsChecker) checkFuncCall(n *FuncCallNode) ExprType {
 		if err == nil {
 			// When one of overload pass type check, overload was resolved correctly
 			sema.checkBuiltinFunctionCall(n, sig)
+			if ty, ok := sema.fromJSONType(n); ok {
+				return ty
+			}
 			return sig.Ret
 		}
 		errs = append(errs, err)
 	}
 
 	// All candidates failed
+	if callee == "join" && len(n.Args) > 0 {
+		if msg := joinArgError(tys[0]); msg != "" {
+			sema.errorf(n.Args[0], "%s", msg)
+			return AnyType{}
+		}
+	}
 	sema.errs = append(sema.errs, errs...)
 
 	return AnyType{}
 }
 
+// joinArgError returns the precise reason why the type cannot be the first argument of join().
+// join() only joins string representations of array elements hence objects and arrays in the array
+// are not joined as expected. It returns an empty string when the reason is not specific.
+func joinArgError(ty ExprType) string {
+	switch ty := ty.(type) {
+	case *ObjectType:
+		return fmt.Sprintf("object value of type %q cannot be joined by join(). use object filtering `.*` to make an array of the values like `join(obj.*)`", ty.String())
+	case *ArrayType:
+		switch ty.Elem.(type) {
+		case *ObjectType:
+			if ty.Deref {
+				return fmt.Sprintf("elements of filtered array %q are objects and cannot be joined by join(). filter a property of the elements like `join(arr.*.name)`", ty.String())
+			}
+			return fmt.Sprintf("elements of array %q are objects and cannot be joined by join(). use object filtering to select a property of the elements like `join(arr.*.name)`", ty.String())
+		case *ArrayType:
+			return fmt.Sprintf("elements of array %q are arrays and cannot be joined by join(). only arrays of strings, numbers, or booleans can be joined", ty.String())
+		}
+	}
+	return ""
+}
+
 func (sema *ExprSemanticsChecker) checkNotOp(n *NotOpNode) ExprType {
 	ty := sema.check(n.Operand)
 	if !(BoolType{}).Assignable(ty) {
@@ -918,11 +1143,205 @@ func (sema *ExprSemanticsChecker) checkCompareOp(n *CompareOpNode) ExprType {
 
 	if !validateCompareOpOperands(n.Kind, l, r) {
 		sema.errorf(n, "%q value cannot be compared to %q value with %q operator", l.String(), r.String(), n.Kind.String())
+	} else if n.Kind.IsEqualityOp() {
+		sema.checkNeverEqualOperands(n, n.Left, l, n.Right)
+		sema.checkNeverEqualOperands(n, n.Right, r, n.Left)
+		sema.checkDispatchInputComparison(n, n.Left, n.Right)
+		sema.checkDispatchInputComparison(n, n.Right, n.Left)
+		sema.checkDispatchTypeComparison(n, n.Left, n.Right)
+		sema.checkDispatchTypeComparison(n, n.Right, n.Left)
 	}
 
 	return BoolType{}
 }
 
+// coerceLiteralToNumber converts the literal to number as GitHub Actions runtime does when types of
+// operands of comparison don't match.
+// https://docs.github.com/en/actions/learn-github-actions/expressions#operators
+func coerceLiteralToNumber(n ExprNode) (float64, bool) {
+	switch n := n.(type) {
+	case *StringNode:
+		s := strings.TrimSpace(n.Value)
+		if s == "" {
+			return 0, true
+		}
+		if len(s) > 2 && s[0] == '0' && strings.ContainsRune("xXoObB", rune(s[1])) {
+			if i, err := strconv.ParseInt(s, 0, 64); err == nil {
+				return float64(i), true
+			}
+			return math.NaN(), true
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return math.NaN(), true
+		}
+		return f, true
+	case *IntNode:
+		return float64(n.Value), true
+	case *FloatNode:
+		return n.Value, true
+	case *BoolNode:
+		if n.Value {
+			return 1, true
+		}
+		return 0, true
+	case *NullNode:
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+// checkNeverEqualOperands checks the operand e of type ty is never equal to the literal operand
+// lit. Operands are coerced to numbers when their types don't match. For example, `x == 'true'` is
+// always false when x is bool since the bool value is coerced to 0 or 1 and the string 'true' is
+// coerced to NaN.
+func (sema *ExprSemanticsChecker) checkNeverEqualOperands(n *CompareOpNode, e ExprNode, ty ExprType, lit ExprNode) {
+	switch e.(type) {
+	case *StringNode, *IntNode, *FloatNode, *BoolNode, *NullNode:
+		return // Comparing two literals is intentional
+	case *NotOpNode:
+		return // `!x == y` is reported as operator precedence pitfall
+	}
+
+	f, ok := coerceLiteralToNumber(lit)
+	if !ok {
+		return
+	}
+	switch ty.(type) {
+	case BoolType:
+		switch lit.(type) {
+		case *StringNode, *IntNode, *FloatNode:
+			if f == 0 || f == 1 {
+				return // Bool value is coerced to 0 or 1
+			}
+		default:
+			return
+		}
+	case NumberType:
+		if _, ok := lit.(*StringNode); !ok || !math.IsNaN(f) {
+			return
+		}
+	default:
+		return
+	}
+
+	always := "false"
+	if n.Kind == CompareOpNodeKindNotEq {
+		always = "true"
+	}
+	hint := ""
+	if s, ok := lit.(*StringNode); ok {
+		if _, ok := ty.(BoolType); ok {
+			switch strings.ToLower(s.Value) {
+			case "true", "false":
+				hint = fmt.Sprintf(". compare the value with bool literal %s instead", strings.ToLower(s.Value))
+			}
+		}
+	}
+	coerced := strconv.FormatFloat(f, 'g', -1, 64)
+	sema.errorf(
+		n,
+		"%q value is never equal to %s since operands of different types are coerced to numbers and %[2]s is coerced to %s. the result of %q operator is always %s%s",
+		ty.String(),
+		literalForMessage(lit),
+		coerced,
+		n.Kind.String(),
+		always,
+		hint,
+	)
+}
+
+// dispatchInputName returns the name of input when the expression is a property access to
+// `github.event.inputs` like `github.event.inputs.foo` or `github.event.inputs['foo']`.
+func dispatchInputName(n ExprNode) (string, bool) {
+	var name string
+	var recv ExprNode
+	switch n := n.(type) {
+	case *ObjectDerefNode:
+		name, recv = n.Property, n.Receiver
+	case *IndexAccessNode:
+		s, ok := n.Index.(*StringNode)
+		if !ok {
+			return "", false
+		}
+		name, recv = strings.ToLower(s.Value), n.Operand
+	default:
+		return "", false
+	}
+	if k, ok := fromJSONSchemaKey(recv); !ok || k != "github.event.inputs" {
+		return "", false
+	}
+	return name, true
+}
+
+// checkDispatchInputComparison checks the comparison between `github.event.inputs.<name>` of
+// boolean input and bool literal. Values of `github.event.inputs` are always strings such as
+// 'true' hence they are never equal to bool values.
+func (sema *ExprSemanticsChecker) checkDispatchInputComparison(n *CompareOpNode, e ExprNode, lit ExprNode) {
+	if sema.dispatchInputs == nil {
+		return
+	}
+	b, ok := lit.(*BoolNode)
+	if !ok {
+		return
+	}
+	name, ok := dispatchInputName(e)
+	if !ok {
+		return
+	}
+	if _, ok := sema.dispatchInputs.Props[name].(BoolType); !ok {
+		return
+	}
+	sema.errorf(
+		n,
+		"\"github.event.inputs.%s\" is always a string even if the input is boolean type. the string 'true' or 'false' is never equal to bool literal %v. use \"inputs.%[1]s\" which is typed as bool instead",
+		name,
+		b.Value,
+	)
+}
+
+// checkDispatchTypeComparison checks the comparison between `github.event.action` and string
+// literal. The value is one of the event types of "repository_dispatch" which triggers the workflow
+// hence comparing it with other strings is always false.
+func (sema *ExprSemanticsChecker) checkDispatchTypeComparison(n *CompareOpNode, e ExprNode, lit ExprNode) {
+	if len(sema.dispatchTypes) == 0 {
+		return
+	}
+	s, ok := lit.(*StringNode)
+	if !ok {
+		return
+	}
+	if k, ok := fromJSONSchemaKey(e); !ok || k != "github.event.action" {
+		return
+	}
+	// String comparison in expressions is case-insensitive
+	if containsFold(sema.dispatchTypes, s.Value) {
+		return
+	}
+	sema.errorfWithSuggestions(
+		n,
+		s.Value,
+		sema.dispatchTypes,
+		"\"github.event.action\" is compared with string '%s' but it is not a type of \"repository_dispatch\" event which triggers the workflow. the comparison is always false. available types are %s",
+		s.Value,
+		sortedQuotes(sema.dispatchTypes),
+	)
+}
+
+func literalForMessage(n ExprNode) string {
+	switch n := n.(type) {
+	case *StringNode:
+		return fmt.Sprintf("string '%s'", n.Value)
+	case *IntNode:
+		return fmt.Sprintf("number %d", n.Value)
+	case *FloatNode:
+		return fmt.Sprintf("number %s", strconv.FormatFloat(n.Value, 'g', -1, 64))
+	default:
+		return n.Token().Value
+	}
+}
+
 // checkWithNarrowing checks type of given expression with type narrowing. Type narrowing narrows
 // down the type of the expression by assuming its value. For example, `l && r` is typed as
 // `typeof(l) | typeof(r)` usually. However when the expression is assumed to be true, its type can
@@ -937,20 +1356,34 @@ func (sema *ExprSemanticsChecker) checkWithNarrowing(n ExprNode, isTruthy bool)
 			// When `l && r` is true, narrow its type to `typeof(r)`
 			if isTruthy {
 				sema.check(n.Left)
-				return sema.check(n.Right)
+				return sema.checkWithNarrowing(n.Right, true)
 			}
+			// When `l && r` is false, either `l` or `r` is false
+			return mergeLogicalOpOperands(sema.checkWithNarrowing(n.Left, false), sema.checkWithNarrowing(n.Right, false))
 		case LogicalOpNodeKindOr:
 			// When `l || r` is false, narrow its type to `typeof(r)`
 			if !isTruthy {
 				sema.check(n.Left)
-				return sema.check(n.Right)
+				return sema.checkWithNarrowing(n.Right, false)
 			}
+			// When `l || r` is true, either `l` or `r` is true. This narrows chained ternary
+			// emulations like `c1 && x || c2 && y || z` to `typeof(x) | typeof(y) | typeof(z)`
+			return mergeLogicalOpOperands(sema.checkWithNarrowing(n.Left, true), sema.checkWithNarrowing(n.Right, true))
 		}
 		return sema.checkLogicalOp(n)
 	case *NotOpNode:
 		return sema.checkWithNarrowing(n.Operand, !isTruthy)
 	default:
-		return sema.check(n)
+		ty := sema.check(n)
+		if !isTruthy {
+			switch ty.(type) {
+			case *ObjectType, *ArrayType:
+				// Objects and arrays are always truthy. When the value is assumed to be falsy, it
+				// must be null like a missing property in guards such as `obj && obj.prop`.
+				return NullType{}
+			}
+		}
+		return ty
 	}
 }
 
@@ -959,11 +1392,11 @@ func (sema *ExprSemanticsChecker) checkLogicalOp(n *LogicalOpNode) ExprType {
 	case LogicalOpNodeKindAnd:
 		// When `l` is false in `l && r`, its type is `typeof(l)`. Otherwise `typeof(r)`.
 		// Narrow the type of LHS expression by assuming its value is falsy.
-		return sema.checkWithNarrowing(n.Left, false).Merge(sema.check(n.Right))
+		return mergeLogicalOpOperands(sema.checkWithNarrowing(n.Left, false), sema.check(n.Right))
 	case LogicalOpNodeKindOr:
 		// When `l` is true in `l || r`, its type is `typeof(l)`. Otherwise `typeof(r).
 		// Narrow the type of LHS expression by assuming its value is truthy.
-		return sema.checkWithNarrowing(n.Left, true).Merge(sema.check(n.Right))
+		return mergeLogicalOpOperands(sema.checkWithNarrowing(n.Left, true), sema.check(n.Right))
 	default:
 		sema.check(n.Left)
 		sema.check(n.Right)
@@ -971,7 +1404,46 @@ func (sema *ExprSemanticsChecker) checkLogicalOp(n *LogicalOpNode) ExprType {
 	}
 }
 
+// mergeLogicalOpOperands merges types of operands of && and || operators. Unlike ExprType.Merge,
+// null and bool types are absorbed by object and array types since they are usually the values of
+// conditions which did not match in the "ternary emulation" idiom such as `cond && obj || null`.
+// Accessing properties of null or false is not an error at runtime so the result can be typed as
+// the object or array to check the property accesses.
+func mergeLogicalOpOperands(l, r ExprType) ExprType {
+	switch l.(type) {
+	case NullType:
+		if _, ok := r.(AnyType); !ok {
+			return r
+		}
+	case BoolType:
+		switch r.(type) {
+		case *ObjectType, *ArrayType:
+			return r
+		}
+	}
+	switch r.(type) {
+	case NullType:
+		if _, ok := l.(AnyType); !ok {
+			return l
+		}
+	case BoolType:
+		switch l.(type) {
+		case *ObjectType, *ArrayType:
+			return l
+		}
+	}
+	return l.Merge(r)
+}
+
 func (sema *ExprSemanticsChecker) check(expr ExprNode) ExprType {
+	ty := sema.checkNode(expr)
+	if sema.nodeTypes != nil {
+		sema.nodeTypes[expr] = ty
+	}
+	return ty
+}
+
+func (sema *ExprSemanticsChecker) checkNode(expr ExprNode) ExprType {
 	defer sema.visitUntrustedCheckerOnLeaveNode(expr) // Call this method in bottom-up order
 
 	switch e := expr.(type) {
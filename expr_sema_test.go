@@ -155,6 +155,47 @@ func TestExprSemanticsCheckOK(t *testing.T) {
 			input:    "github.*.name",
 			expected: &ArrayType{AnyType{}, true},
 		},
+		{
+			what:     "join filtered property of array of objects",
+			input:    "join(test().commits.*.id, ', ')",
+			expected: StringType{},
+			funcs: map[string][]*FuncSignature{
+				"join": BuiltinFuncSignatures["join"],
+				"test": {
+					{
+						Name: "test",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"commits": &ArrayType{
+								Elem: NewStrictObjectType(map[string]ExprType{
+									"id": StringType{},
+								}),
+							},
+						}),
+					},
+				},
+			},
+		},
+		{
+			what:     "object filter on strict object merges types of elements",
+			input:    "test().*.result",
+			expected: &ArrayType{StringType{}, true},
+			funcs: map[string][]*FuncSignature{
+				"test": {
+					{
+						Name: "test",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"build": NewStrictObjectType(map[string]ExprType{
+								"result": StringType{},
+							}),
+							"test": NewStrictObjectType(map[string]ExprType{
+								"result":  StringType{},
+								"outputs": NewMapObjectType(StringType{}),
+							}),
+						}),
+					},
+				},
+			},
+		},
 		{
 			what:     "function call",
 			input:    "contains('hello', 'll')",
@@ -320,8 +361,8 @@ func TestExprSemanticsCheckOK(t *testing.T) {
 		{
 			what:  "coercing two objects on && operator",
 			input: "foo() && bar()",
+			// Object at LHS is falsy only when it is null
 			expected: NewStrictObjectType(map[string]ExprType{
-				"foo": NumberType{},
 				"bar": BoolType{},
 			}),
 			funcs: map[string][]*FuncSignature{
@@ -343,6 +384,49 @@ func TestExprSemanticsCheckOK(t *testing.T) {
 				},
 			},
 		},
+		{
+			what:     "guard object before accessing its property",
+			input:    "foo() && foo().bar == false",
+			expected: BoolType{},
+			funcs: map[string][]*FuncSignature{
+				"foo": {
+					{
+						Name: "foo",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"bar": BoolType{},
+						}),
+					},
+				},
+			},
+		},
+		{
+			what:     "guard array before accessing its element",
+			input:    "foo() && foo()[0]",
+			expected: StringType{},
+			funcs: map[string][]*FuncSignature{
+				"foo": {
+					{
+						Name: "foo",
+						Ret:  &ArrayType{Elem: StringType{}},
+					},
+				},
+			},
+		},
+		{
+			what:     "negated guard object before accessing its property",
+			input:    "!foo() || foo().bar",
+			expected: BoolType{},
+			funcs: map[string][]*FuncSignature{
+				"foo": {
+					{
+						Name: "foo",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"bar": BoolType{},
+						}),
+					},
+				},
+			},
+		},
 		{
 			what:  "coercing two objects on || operator",
 			input: "foo() || bar()",
@@ -500,8 +584,18 @@ func TestExprSemanticsCheckOK(t *testing.T) {
 			expected: StringType{},
 		},
 		{
-			what:     "braces not for placeholders in format string of format() call",
-			input:    "format('{0} {} {x} {', 1)",
+			what:     "hashFiles() with negated pattern",
+			input:    "hashFiles('**/package-lock.json', '!**/node_modules/**', './[!.]*.lock')",
+			expected: StringType{},
+		},
+		{
+			what:     "escaped braces in format string of format() call",
+			input:    "format('{{0}} {0} {{ }} {{{0}}}', 1)",
+			expected: StringType{},
+		},
+		{
+			what:     "format specifiers of placeholders in format string of format() call",
+			input:    "format('{0:yyyyMMdd} {1:}}}', 1, 2)",
 			expected: StringType{},
 		},
 		{
@@ -714,11 +808,112 @@ func TestExprSemanticsCheckOK(t *testing.T) {
 			input:    "!('foo' || 10) || 20",
 			expected: NumberType{},
 		},
+		{
+			what:     "bool value compared with numeric string",
+			input:    "startsWith('foo', 'f') == '1' && contains('foo', 'f') != 0",
+			expected: BoolType{},
+		},
+		{
+			what:     "number value compared with numeric strings",
+			input:    "strategy.job-index == '0x1' || strategy.job-index == ' 2 ' || strategy.job-index == ''",
+			expected: BoolType{},
+		},
+		{
+			what:     "string value compared with number",
+			input:    "github.run_attempt == 1",
+			expected: BoolType{},
+		},
 		{
 			what:     "double not operators does nothing on type narrowing",
 			input:    "!!('foo' || 10) && 20",
 			expected: NumberType{},
 		},
+		{
+			what:  "object type absorbs null on || operator",
+			input: "('a' == 'b' && foo()) || null",
+			expected: NewStrictObjectType(map[string]ExprType{
+				"foo": NumberType{},
+			}),
+			funcs: map[string][]*FuncSignature{
+				"foo": {
+					{
+						Name: "foo",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"foo": NumberType{},
+						}),
+					},
+				},
+				"bar": {
+					{
+						Name: "bar",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"bar": BoolType{},
+						}),
+					},
+				},
+			},
+		},
+		{
+			what:  "object type absorbs bool on && operator",
+			input: "'a' == 'b' && foo()",
+			expected: NewStrictObjectType(map[string]ExprType{
+				"foo": NumberType{},
+			}),
+			funcs: map[string][]*FuncSignature{
+				"foo": {
+					{
+						Name: "foo",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"foo": NumberType{},
+						}),
+					},
+				},
+				"bar": {
+					{
+						Name: "bar",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"bar": BoolType{},
+						}),
+					},
+				},
+			},
+		},
+		{
+			what:  "narrow type of chained ternary emulation",
+			input: "'a' == 'b' && foo() || 'a' == 'c' && bar() || null",
+			expected: NewStrictObjectType(map[string]ExprType{
+				"foo": NumberType{},
+				"bar": BoolType{},
+			}),
+			funcs: map[string][]*FuncSignature{
+				"foo": {
+					{
+						Name: "foo",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"foo": NumberType{},
+						}),
+					},
+				},
+				"bar": {
+					{
+						Name: "bar",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"bar": BoolType{},
+						}),
+					},
+				},
+			},
+		},
+		{
+			what:     "narrow type of chained ternary emulation with primitive types",
+			input:    "'a' == 'b' && 1 || 'a' == 'c' && 2 || 3",
+			expected: NumberType{},
+		},
+		{
+			what:     "null type is merged into primitive type on || operator",
+			input:    "'a' == 'b' && 'foo' || null",
+			expected: StringType{},
+		},
 	}
 
 	allSPFuncs := []string{}
@@ -796,6 +991,44 @@ func TestExprSemanticsCheckError(t *testing.T) {
 				"undefined variable \"fooooo\"",
 			},
 		},
+		{
+			what:  "property access to ternary emulation",
+			input: "('a' == 'b' && foo() || null).bar",
+			expected: []string{
+				"property \"bar\" is not defined in object type {foo: number}",
+			},
+			funcs: map[string][]*FuncSignature{
+				"foo": {
+					{
+						Name: "foo",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"foo": NumberType{},
+						}),
+					},
+				},
+			},
+		},
+		{
+			what:  "bool value compared with string",
+			input: "startsWith('foo', 'f') == 'true'",
+			expected: []string{
+				"\"bool\" value is never equal to string 'true' since operands of different types are coerced to numbers and string 'true' is coerced to NaN. the result of \"==\" operator is always false. compare the value with bool literal true instead",
+			},
+		},
+		{
+			what:  "bool value compared with number",
+			input: "2 != contains('foo', 'f')",
+			expected: []string{
+				"\"bool\" value is never equal to number 2 since operands of different types are coerced to numbers and number 2 is coerced to 2. the result of \"!=\" operator is always true",
+			},
+		},
+		{
+			what:  "number value compared with non-numeric string",
+			input: "strategy.job-index == 'first'",
+			expected: []string{
+				"\"number\" value is never equal to string 'first' since operands of different types are coerced to numbers and string 'first' is coerced to NaN",
+			},
+		},
 		{
 			what:  "receiver of object dereference is not an object",
 			input: "true.foo",
@@ -853,6 +1086,79 @@ func TestExprSemanticsCheckError(t *testing.T) {
 				"receiver of object filtering `.*` must be type of array or object but got \"bool\"",
 			},
 		},
+		{
+			what:  "join object value",
+			input: "join(test())",
+			expected: []string{
+				"object value of type \"{foo: string}\" cannot be joined by join(). use object filtering `.*`",
+			},
+			funcs: map[string][]*FuncSignature{
+				"join": BuiltinFuncSignatures["join"],
+				"test": {
+					{
+						Name: "test",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"foo": StringType{},
+						}),
+					},
+				},
+			},
+		},
+		{
+			what:  "join filtered array of objects",
+			input: "join(test().*, ', ')",
+			expected: []string{
+				"elements of filtered array \"array<{foo: string}>\" are objects and cannot be joined by join(). filter a property of the elements like `join(arr.*.name)`",
+			},
+			funcs: map[string][]*FuncSignature{
+				"join": BuiltinFuncSignatures["join"],
+				"test": {
+					{
+						Name: "test",
+						Ret: &ArrayType{
+							Elem: NewStrictObjectType(map[string]ExprType{
+								"foo": StringType{},
+							}),
+						},
+					},
+				},
+			},
+		},
+		{
+			what:  "join array of arrays",
+			input: "join(test())",
+			expected: []string{
+				"elements of array \"array<array<string>>\" are arrays and cannot be joined by join()",
+			},
+			funcs: map[string][]*FuncSignature{
+				"join": BuiltinFuncSignatures["join"],
+				"test": {
+					{
+						Name: "test",
+						Ret:  &ArrayType{Elem: &ArrayType{Elem: StringType{}}},
+					},
+				},
+			},
+		},
+		{
+			what:  "strict prop check at object filter on strict object",
+			input: "test().*.reslt",
+			expected: []string{
+				"property \"reslt\" is not defined in object type {result: string} as element of filtered array",
+			},
+			funcs: map[string][]*FuncSignature{
+				"test": {
+					{
+						Name: "test",
+						Ret: NewStrictObjectType(map[string]ExprType{
+							"build": NewStrictObjectType(map[string]ExprType{
+								"result": StringType{},
+							}),
+						}),
+					},
+				},
+			},
+		},
 		{
 			what:  "receiver of object filter is not an object which has object element",
 			input: "env.*",
@@ -1009,6 +1315,76 @@ func TestExprSemanticsCheckError(t *testing.T) {
 				"format string \"format {0} {2}\" contains placeholder {2} but only 2 arguments are given to format",
 			},
 		},
+		{
+			what:  "braces not for placeholders in format string of format() call",
+			input: "format('{0} {} {x} {', 1)",
+			expected: []string{
+				"format string \"{0} {} {x} {\" is invalid: \"{\" does not start placeholder like {0}. escape it as \"{{\"",
+			},
+		},
+		{
+			what:  "unescaped closing brace in format string of format() call",
+			input: "format('{0} }', 1)",
+			expected: []string{
+				"format string \"{0} }\" is invalid: \"}\" is not escaped. escape it as \"}}\"",
+			},
+		},
+		{
+			what:  "unclosed placeholder in format string of format() call",
+			input: "format('{0} {1', 1, 2)",
+			expected: []string{
+				"format string \"{0} {1\" is invalid: placeholder is not closed with \"}\"",
+			},
+		},
+		{
+			what:  "escaped placeholder is not counted in format string of format() call",
+			input: "format('{{0}} {1}', 1, 2)",
+			expected: []string{
+				"format string \"{{0}} {1}\" does not contain placeholder {0}. remove argument which is unused in the format string",
+			},
+		},
+		{
+			what:  "empty pattern of hashFiles()",
+			input: "hashFiles('**/go.sum', '')",
+			expected: []string{
+				"pattern \"\" of hashFiles() is invalid: pattern is empty",
+			},
+		},
+		{
+			what:  "absolute path in hashFiles()",
+			input: "hashFiles('/home/runner/work/go.sum')",
+			expected: []string{
+				"pattern \"/home/runner/work/go.sum\" of hashFiles() is invalid: absolute path is not available",
+			},
+		},
+		{
+			what:  "Windows absolute path in hashFiles()",
+			input: "hashFiles('C:\\work\\go.sum')",
+			expected: []string{
+				"of hashFiles() is invalid: absolute path is not available",
+			},
+		},
+		{
+			what:  "parent directory in hashFiles()",
+			input: "hashFiles('../other/go.sum')",
+			expected: []string{
+				"pattern \"../other/go.sum\" of hashFiles() is invalid: \"..\" is not available",
+			},
+		},
+		{
+			what:  "invalid glob in hashFiles()",
+			input: "hashFiles('**/[abc.json')",
+			expected: []string{
+				"pattern \"**/[abc.json\" of hashFiles() is invalid: syntax error in glob pattern",
+			},
+		},
+		{
+			what:  "all patterns are negated in hashFiles()",
+			input: "hashFiles('!**/node_modules/**', '!dist')",
+			expected: []string{
+				"all patterns of hashFiles() are negated with \"!\"",
+			},
+		},
 		{
 			what:  "zero format arguments for format() call",
 			input: "format('hi')",
@@ -1568,6 +1944,84 @@ func TestExprSematincsCheckerUpdateDispatchInputsVarType(t *testing.T) {
 	}
 }
 
+func TestExprSemanticsCheckerDispatchInputComparedWithBool(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"github.event.inputs.foo == true", "\"github.event.inputs.foo\" is always a string even if the input is boolean type"},
+		{"false != github.event.inputs['foo']", "\"github.event.inputs.foo\" is always a string even if the input is boolean type"},
+		{"github.event.inputs.foo == 'true'", ""},
+		{"github.event.inputs.bar == true", ""},
+		{"inputs.foo == true", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			e, err := NewExprParser().Parse(NewExprLexer(tc.input + "}}"))
+			if err != nil {
+				t.Fatal("parse error:", tc.input)
+			}
+			c := NewExprSemanticsChecker(false, nil)
+			c.UpdateDispatchInputs(NewStrictObjectType(map[string]ExprType{
+				"foo": BoolType{},
+				"bar": NumberType{},
+			}))
+			_, errs := c.Check(e)
+			if tc.want == "" {
+				if len(errs) > 0 {
+					t.Fatal("unexpected errors:", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatal("one error was expected but got", errs)
+			}
+			if !strings.Contains(errs[0].Message, tc.want) {
+				t.Fatalf("error %q does not contain %q", errs[0].Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestExprSemanticsCheckerRepositoryDispatchTypeComparison(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"github.event.action == 'deploy'", ""},
+		{"'Rollback' != github.event.action", ""},
+		{"github.event.action == 'deplyo'", "\"github.event.action\" is compared with string 'deplyo' but it is not a type of \"repository_dispatch\" event which triggers the workflow. the comparison is always false. available types are \"deploy\", \"rollback\". did you mean \"deploy\"?"},
+		{"'release' == github.event['action']", "\"github.event.action\" is compared with string 'release'"},
+		{"github.event.action == github.event.client_payload.type", ""},
+		{"github.event.ref == 'release'", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			e, err := NewExprParser().Parse(NewExprLexer(tc.input + "}}"))
+			if err != nil {
+				t.Fatal("parse error:", tc.input)
+			}
+			c := NewExprSemanticsChecker(false, nil)
+			c.SetRepositoryDispatchTypes([]string{"rollback", "deploy"})
+			_, errs := c.Check(e)
+			if tc.want == "" {
+				if len(errs) > 0 {
+					t.Fatal("unexpected errors:", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatal("one error was expected but got", errs)
+			}
+			if !strings.Contains(errs[0].Message, tc.want) {
+				t.Fatalf("error %q does not contain %q", errs[0].Message, tc.want)
+			}
+		})
+	}
+}
+
 func TestExprSemanticsCheckerUpdateInputsMultipleTimes(t *testing.T) {
 	tests := []struct {
 		first  *ObjectType
@@ -1653,3 +2107,33 @@ func TestBuiltinGlobalVariableTypesValidation(t *testing.T) {
 		testObjectPropertiesAreInLowerCase(t, ty)
 	}
 }
+
+func TestExprSemanticsCheckerFormatStringErrorPosition(t *testing.T) {
+	tests := []struct {
+		input  string
+		col    int
+		offset int
+	}{
+		{"format('{0} }', 1)", 13, 12},
+		{"format('{0} {1', 1, 2)", 13, 12},
+		{"format('it''s {x}', 1)", 15, 14},
+		{"format('\u3042 {', 1)", 11, 12},
+		{"  format('{', 1)", 11, 10},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			e, err := NewExprParser().Parse(NewExprLexer(tc.input + "}}"))
+			if err != nil {
+				t.Fatal("parse error:", tc.input)
+			}
+			_, errs := NewExprSemanticsChecker(false, nil).Check(e)
+			if len(errs) != 1 {
+				t.Fatal("one error was expected but got", errs)
+			}
+			if errs[0].Column != tc.col || errs[0].Offset != tc.offset {
+				t.Fatalf("wanted column %d and offset %d but got column %d and offset %d: %v", tc.col, tc.offset, errs[0].Column, errs[0].Offset, errs[0])
+			}
+		})
+	}
+}
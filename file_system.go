@@ -0,0 +1,120 @@
+package actionlint
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// fileSystem is an abstraction of file system accessed by linter. Paths are OS-specific paths as
+// the same as os package.
+type fileSystem interface {
+	ReadFile(path string) ([]byte, error)
+	Stat(path string) (fs.FileInfo, error)
+	ReadDir(path string) ([]fs.DirEntry, error)
+	WalkDir(root string, fn fs.WalkDirFunc) error
+	// Abs returns the absolute path of the path. When the path cannot be resolved, it returns the
+	// path as-is.
+	Abs(path string) string
+}
+
+// osFileSystem is a file system on the OS.
+type osFileSystem struct{}
+
+func (osFileSystem) ReadFile(p string) ([]byte, error) {
+	return os.ReadFile(p)
+}
+
+func (osFileSystem) Stat(p string) (fs.FileInfo, error) {
+	return os.Stat(p)
+}
+
+func (osFileSystem) ReadDir(p string) ([]fs.DirEntry, error) {
+	return os.ReadDir(p)
+}
+
+func (osFileSystem) WalkDir(root string, fn fs.WalkDirFunc) error {
+	return filepath.WalkDir(root, fn)
+}
+
+func (osFileSystem) Abs(p string) string {
+	if a, err := filepath.Abs(p); err == nil {
+		return a
+	}
+	return p
+}
+
+// ioFileSystem is a file system backed by fs.FS such as fstest.MapFS, zip.Reader, or embed.FS.
+// The root of the fs.FS is mapped to the root directory of OS-specific paths like "/" so that
+// paths can be handled with path/filepath package as the same as the OS file system. Relative
+// paths are resolved from the root.
+type ioFileSystem struct {
+	fsys fs.FS
+}
+
+// ioFileSystemRoot is the root directory where the fs.FS is mapped.
+var ioFileSystemRoot = string(filepath.Separator)
+
+// name converts the OS-specific path into the name in fs.FS.
+func (s *ioFileSystem) name(p string) (string, error) {
+	n := strings.TrimLeft(path.Clean(filepath.ToSlash(p)), "/")
+	if n == "" {
+		n = "."
+	}
+	if !fs.ValidPath(n) {
+		return "", &fs.PathError{Op: "open", Path: p, Err: fs.ErrInvalid}
+	}
+	return n, nil
+}
+
+func (s *ioFileSystem) ReadFile(p string) ([]byte, error) {
+	n, err := s.name(p)
+	if err != nil {
+		return nil, err
+	}
+	return fs.ReadFile(s.fsys, n)
+}
+
+func (s *ioFileSystem) Stat(p string) (fs.FileInfo, error) {
+	n, err := s.name(p)
+	if err != nil {
+		return nil, err
+	}
+	return fs.Stat(s.fsys, n)
+}
+
+func (s *ioFileSystem) ReadDir(p string) ([]fs.DirEntry, error) {
+	n, err := s.name(p)
+	if err != nil {
+		return nil, err
+	}
+	return fs.ReadDir(s.fsys, n)
+}
+
+func (s *ioFileSystem) WalkDir(root string, fn fs.WalkDirFunc) error {
+	n, err := s.name(root)
+	if err != nil {
+		return fn(root, nil, err)
+	}
+	return fs.WalkDir(s.fsys, n, func(p string, d fs.DirEntry, err error) error {
+		return fn(s.Abs(p), d, err)
+	})
+}
+
+func (s *ioFileSystem) Abs(p string) string {
+	if n, err := s.name(p); err == nil && n != "." {
+		return filepath.Join(ioFileSystemRoot, filepath.FromSlash(n))
+	}
+	return ioFileSystemRoot
+}
+
+// newFileSystem creates a file system for linter. When the fs.FS is nil, the OS file system is
+// returned.
+func newFileSystem(fsys fs.FS) fileSystem {
+	if fsys == nil {
+		return osFileSystem{}
+	}
+	return &ioFileSystem{fsys}
+}
@@ -0,0 +1,200 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// fromJSONSchemaKey returns the key of the argument of fromJSON() to look up its JSON schema. The
+// key is a normalized form of the expression like "needs.setup.outputs.matrix". Only variables,
+// property accesses, and index accesses with string literals are supported since contexts and
+// property names are case insensitive.
+func fromJSONSchemaKey(n ExprNode) (string, bool) {
+	switch n := n.(type) {
+	case *VariableNode:
+		return strings.ToLower(n.Name), true
+	case *ObjectDerefNode:
+		r, ok := fromJSONSchemaKey(n.Receiver)
+		if !ok {
+			return "", false
+		}
+		return r + "." + strings.ToLower(n.Property), true
+	case *IndexAccessNode:
+		s, ok := n.Index.(*StringNode)
+		if !ok {
+			return "", false
+		}
+		r, ok := fromJSONSchemaKey(n.Operand)
+		if !ok {
+			return "", false
+		}
+		return r + "." + strings.ToLower(s.Value), true
+	default:
+		return "", false
+	}
+}
+
+// jsonSchemaToExprType converts the JSON schema to the type of expression. Parts of the schema
+// which cannot be represented by the type system such as "oneOf" are converted to any type.
+// https://json-schema.org/understanding-json-schema/reference
+func jsonSchemaToExprType(schema any) ExprType {
+	s, ok := schema.(map[string]any)
+	if !ok {
+		return AnyType{}
+	}
+
+	t, _ := s["type"].(string)
+	if ts, ok := s["type"].([]any); ok {
+		// Nullable type like ["string", "null"] is treated as the non-null type
+		nonNull := []string{}
+		for _, t := range ts {
+			if t, ok := t.(string); ok && t != "null" {
+				nonNull = append(nonNull, t)
+			}
+		}
+		if len(nonNull) == 1 {
+			t = nonNull[0]
+		}
+	}
+
+	switch t {
+	case "string":
+		return StringType{}
+	case "number", "integer":
+		return NumberType{}
+	case "boolean":
+		return BoolType{}
+	case "null":
+		return NullType{}
+	case "array":
+		return &ArrayType{Elem: jsonSchemaToExprType(s["items"])}
+	case "object":
+		props := map[string]ExprType{}
+		if ps, ok := s["properties"].(map[string]any); ok {
+			for k, p := range ps {
+				props[strings.ToLower(k)] = jsonSchemaToExprType(p)
+			}
+		}
+		switch a := s["additionalProperties"].(type) {
+		case bool:
+			if !a {
+				return NewStrictObjectType(props)
+			}
+		case map[string]any:
+			if len(props) == 0 {
+				return NewMapObjectType(jsonSchemaToExprType(a))
+			}
+		}
+		return NewObjectType(props)
+	default:
+		return AnyType{}
+	}
+}
+
+// parseFromJSONSchemaKey parses the expression in the section of config file like "vars.CONFIG"
+// and returns its key to look up the types of fromJSON() arguments.
+func parseFromJSONSchemaKey(expr, section string) (string, error) {
+	n, err := NewExprParser().Parse(NewExprLexer(expr + "}}"))
+	if err != nil {
+		return "", fmt.Errorf("could not parse expression %q at %q in config: %s", expr, section, err.Message)
+	}
+	key, ok := fromJSONSchemaKey(n)
+	if !ok {
+		return "", fmt.Errorf("expression %q at %q in config must be a property access like \"vars.CONFIG\"", expr, section)
+	}
+	return key, nil
+}
+
+// loadFromJSONSchemas reads JSON schema files configured at "from-json-schemas" in config file and
+// returns a table from keys of fromJSON() arguments to their types. Relative file paths are
+// resolved from the root directory.
+func loadFromJSONSchemas(root string, schemas map[string]string, fsys fileSystem) (map[string]ExprType, error) {
+	ret := make(map[string]ExprType, len(schemas))
+	for expr, file := range schemas {
+		key, err := parseFromJSONSchemaKey(expr, "from-json-schemas")
+		if err != nil {
+			return nil, err
+		}
+
+		p := file
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(root, p)
+		}
+		b, err := fsys.ReadFile(p)
+		if err != nil {
+			return nil, fmt.Errorf("could not read JSON schema for %q at \"from-json-schemas\" in config: %w", expr, err)
+		}
+		var schema any
+		if err := json.Unmarshal(b, &schema); err != nil {
+			return nil, fmt.Errorf("could not parse JSON schema %q for %q at \"from-json-schemas\" in config: %w", file, expr, err)
+		}
+
+		ret[key] = jsonSchemaToExprType(schema)
+	}
+	return ret, nil
+}
+
+// loadClientPayloadSchema reads the JSON schema file at "repository-dispatch.client-payload-schema"
+// in config file and returns the type of "github.event.client_payload". Relative file path is
+// resolved from the root directory.
+func loadClientPayloadSchema(root string, file string, fsys fileSystem) (ExprType, error) {
+	p := file
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(root, p)
+	}
+	b, err := fsys.ReadFile(p)
+	if err != nil {
+		return nil, fmt.Errorf("could not read JSON schema at \"repository-dispatch.client-payload-schema\" in config: %w", err)
+	}
+	var schema any
+	if err := json.Unmarshal(b, &schema); err != nil {
+		return nil, fmt.Errorf("could not parse JSON schema %q at \"repository-dispatch.client-payload-schema\" in config: %w", file, err)
+	}
+	return jsonSchemaToExprType(schema), nil
+}
+
+// matrixValueTypes is a table from type names at "matrix-schemas" in config file to their types.
+var matrixValueTypes = map[string]ExprType{
+	"string": StringType{},
+	"number": NumberType{},
+	"bool":   BoolType{},
+	"object": NewEmptyObjectType(),
+	"array":  &ArrayType{Elem: AnyType{}},
+	"any":    AnyType{},
+}
+
+// loadMatrixSchemas converts the matrix schemas configured at "matrix-schemas" in config file into
+// the types of fromJSON() results and adds them to the types table. Since each value of matrix
+// is an array of values, the type of each key is an array of the declared type. "include" and
+// "exclude" are also allowed as the matrix built dynamically may contain them.
+func loadMatrixSchemas(schemas map[string]map[string]string, types map[string]ExprType) error {
+	for expr, keys := range schemas {
+		key, err := parseFromJSONSchemaKey(expr, "matrix-schemas")
+		if err != nil {
+			return err
+		}
+		if _, ok := types[key]; ok {
+			return fmt.Errorf("expression %q at \"matrix-schemas\" in config is also defined at \"from-json-schemas\"", expr)
+		}
+
+		props := make(map[string]ExprType, len(keys)+2)
+		for k, n := range keys {
+			t, ok := matrixValueTypes[strings.ToLower(n)]
+			if !ok {
+				ns := make([]string, 0, len(matrixValueTypes))
+				for n := range matrixValueTypes {
+					ns = append(ns, n)
+				}
+				return fmt.Errorf("type %q of matrix key %q for %q at \"matrix-schemas\" in config is unknown. available types are %s", n, k, expr, sortedQuotes(ns))
+			}
+			props[strings.ToLower(k)] = &ArrayType{Elem: t}
+		}
+		props["include"] = &ArrayType{Elem: NewEmptyObjectType()}
+		props["exclude"] = &ArrayType{Elem: NewEmptyObjectType()}
+
+		types[key] = NewStrictObjectType(props)
+	}
+	return nil
+}
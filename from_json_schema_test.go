@@ -0,0 +1,158 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFromJSONSchemaToExprType(t *testing.T) {
+	testCases := []struct {
+		what   string
+		schema string
+		want   ExprType
+	}{
+		{"string", `{"type": "string"}`, StringType{}},
+		{"integer", `{"type": "integer"}`, NumberType{}},
+		{"boolean", `{"type": "boolean"}`, BoolType{}},
+		{"nullable", `{"type": ["string", "null"]}`, StringType{}},
+		{"union", `{"type": ["string", "number"]}`, AnyType{}},
+		{"one of", `{"oneOf": [{"type": "string"}]}`, AnyType{}},
+		{"array", `{"type": "array", "items": {"type": "number"}}`, &ArrayType{Elem: NumberType{}}},
+		{"array without items", `{"type": "array"}`, &ArrayType{Elem: AnyType{}}},
+		{
+			"strict object",
+			`{"type": "object", "properties": {"Foo": {"type": "string"}}, "additionalProperties": false}`,
+			NewStrictObjectType(map[string]ExprType{"foo": StringType{}}),
+		},
+		{
+			"loose object",
+			`{"type": "object", "properties": {"foo": {"type": "string"}}}`,
+			NewObjectType(map[string]ExprType{"foo": StringType{}}),
+		},
+		{
+			"map object",
+			`{"type": "object", "additionalProperties": {"type": "boolean"}}`,
+			NewMapObjectType(BoolType{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			var s any
+			if err := json.Unmarshal([]byte(tc.schema), &s); err != nil {
+				t.Fatal(err)
+			}
+			have := jsonSchemaToExprType(s)
+			if !cmp.Equal(tc.want, have) {
+				t.Fatal(cmp.Diff(tc.want, have))
+			}
+		})
+	}
+}
+
+func TestFromJSONSchemaLoadError(t *testing.T) {
+	root := filepath.Join("testdata", "projects", "from_json_schema")
+
+	testCases := []struct {
+		what    string
+		schemas map[string]string
+		want    string
+	}{
+		{"invalid expression", map[string]string{"vars.": "schemas/deploy.json"}, `could not parse expression "vars."`},
+		{"not property access", map[string]string{"format('{0}', vars.X)": "schemas/deploy.json"}, "must be a property access"},
+		{"file not found", map[string]string{"vars.X": "schemas/unknown.json"}, `could not read JSON schema for "vars.X"`},
+		{"broken JSON", map[string]string{"vars.X": "actionlint.yaml"}, `could not parse JSON schema "actionlint.yaml" for "vars.X"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := loadFromJSONSchemas(root, tc.schemas, osFileSystem{})
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Fatalf("error message %q does not contain %q", msg, tc.want)
+			}
+		})
+	}
+}
+
+func TestFromJSONSchemaLoadClientPayloadSchemaError(t *testing.T) {
+	root := filepath.Join("testdata", "projects", "repository_dispatch")
+
+	testCases := []struct {
+		what string
+		file string
+		want string
+	}{
+		{"file not found", "schemas/unknown.json", `could not read JSON schema at "repository-dispatch.client-payload-schema"`},
+		{"broken JSON", "actionlint.yaml", `could not parse JSON schema "actionlint.yaml" at "repository-dispatch.client-payload-schema"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := loadClientPayloadSchema(root, tc.file, osFileSystem{})
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Fatalf("error message %q does not contain %q", msg, tc.want)
+			}
+		})
+	}
+}
+
+func TestFromJSONSchemaLoadMatrixSchemas(t *testing.T) {
+	types := map[string]ExprType{}
+	schemas := map[string]map[string]string{
+		"needs.prep.outputs.MATRIX": {"OS": "string", "node": "number", "exp": "Bool", "pkg": "object", "args": "array", "x": "any"},
+	}
+	if err := loadMatrixSchemas(schemas, types); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]ExprType{
+		"needs.prep.outputs.matrix": NewStrictObjectType(map[string]ExprType{
+			"os":      &ArrayType{Elem: StringType{}},
+			"node":    &ArrayType{Elem: NumberType{}},
+			"exp":     &ArrayType{Elem: BoolType{}},
+			"pkg":     &ArrayType{Elem: NewEmptyObjectType()},
+			"args":    &ArrayType{Elem: &ArrayType{Elem: AnyType{}}},
+			"x":       &ArrayType{Elem: AnyType{}},
+			"include": &ArrayType{Elem: NewEmptyObjectType()},
+			"exclude": &ArrayType{Elem: NewEmptyObjectType()},
+		}),
+	}
+	if !cmp.Equal(want, types) {
+		t.Fatal(cmp.Diff(want, types))
+	}
+}
+
+func TestFromJSONSchemaLoadMatrixSchemasError(t *testing.T) {
+	testCases := []struct {
+		what    string
+		schemas map[string]map[string]string
+		want    string
+	}{
+		{"invalid expression", map[string]map[string]string{"needs.": {"os": "string"}}, `could not parse expression "needs." at "matrix-schemas"`},
+		{"not property access", map[string]map[string]string{"toJSON(needs)": {"os": "string"}}, "must be a property access"},
+		{"unknown type", map[string]map[string]string{"needs.prep.outputs.matrix": {"os": "str"}}, `type "str" of matrix key "os"`},
+		{"duplicate", map[string]map[string]string{"vars.X": {"os": "string"}}, `is also defined at "from-json-schemas"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			types := map[string]ExprType{"vars.x": AnyType{}}
+			err := loadMatrixSchemas(tc.schemas, types)
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Fatalf("error message %q does not contain %q", msg, tc.want)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ func FuzzCheck(data []byte) int {
 	rules := []actionlint.Rule{
 		actionlint.NewRuleMatrix(),
 		actionlint.NewRuleCredentials(),
+		actionlint.NewRuleContainer(),
 		actionlint.NewRuleShellName(),
 		actionlint.NewRuleRunnerLabel(),
 		actionlint.NewRuleEvents(),
@@ -36,6 +37,13 @@ func FuzzCheck(data []byte) int {
 		actionlint.NewRulePermissions(),
 		actionlint.NewRuleDeprecatedCommands(),
 		actionlint.NewRuleIfCond(),
+		actionlint.NewRuleImplicitConversion(),
+		actionlint.NewRuleTimeoutMinutes(),
+		actionlint.NewRuleEnvironmentURL(),
+		actionlint.NewRuleConcurrency(),
+		actionlint.NewRuleRedundantCache(),
+		actionlint.NewRuleArtifact(),
+		actionlint.NewRuleRunnerImage(),
 	}
 
 	v := actionlint.NewVisitor()
@@ -2,6 +2,7 @@ package actionlint
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"text/scanner"
 	"unicode"
@@ -253,3 +254,241 @@ func ValidatePathGlob(pat string) []InvalidGlobPattern {
 	}
 	return validateGlob(pat, false)
 }
+
+// compileFilterGlob converts a glob pattern in filters such as "branches" and "paths" into a regular
+// expression which matches the entire string. The pattern should be validated with ValidateRefGlob
+// or ValidatePathGlob in advance. Leading "!" for negation must be removed by the caller.
+// https://docs.github.com/en/actions/using-workflows/workflow-syntax-for-github-actions#filter-pattern-cheat-sheet
+func compileFilterGlob(pat string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteByte('^')
+	rs := []rune(pat)
+	for i := 0; i < len(rs); i++ {
+		c := rs[i]
+		switch c {
+		case '\\':
+			if i+1 < len(rs) && strings.ContainsRune(`[?*+\!`, rs[i+1]) {
+				i++
+				c = rs[i]
+			}
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		case '*':
+			if i+1 >= len(rs) || rs[i+1] != '*' {
+				b.WriteString(`[^/]*`)
+				break
+			}
+			i++
+			if i+1 < len(rs) && rs[i+1] == '/' {
+				i++
+				b.WriteString(`(?:.*/)?`) // "**/" matches zero or more directories
+			} else {
+				b.WriteString(`.*`)
+			}
+		case '?', '+':
+			// Zero or one, or one or more of the preceding character. They have the same meaning in
+			// regular expression
+			b.WriteRune(c)
+		case '[':
+			b.WriteByte('[')
+			for i++; i < len(rs) && rs[i] != ']'; i++ {
+				if rs[i] == '-' {
+					b.WriteByte('-')
+				} else {
+					b.WriteString(regexp.QuoteMeta(string(rs[i])))
+				}
+			}
+			b.WriteByte(']')
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteByte('$')
+	return regexp.Compile(b.String())
+}
+
+type globTokenKind int
+
+const (
+	globTokenLiteral globTokenKind = iota
+	// "*" matches zero or more characters except for "/"
+	globTokenStar
+	// "**" matches zero or more any characters
+	globTokenStarStar
+	// "**/" matches zero or more directories
+	globTokenStarStarSlash
+)
+
+type globToken struct {
+	kind globTokenKind
+	char rune
+}
+
+// tokenizeFilterGlob splits a glob pattern of filters into tokens. It returns false as the second
+// return value when the pattern contains special characters which are not supported by the coverage
+// analysis such as "?", "+", and "[...]".
+func tokenizeFilterGlob(pat string) ([]globToken, bool) {
+	rs := []rune(pat)
+	ts := make([]globToken, 0, len(rs))
+	for i := 0; i < len(rs); i++ {
+		c := rs[i]
+		switch c {
+		case '\\':
+			if i+1 < len(rs) && strings.ContainsRune(`[?*+\!`, rs[i+1]) {
+				i++
+				c = rs[i]
+			}
+			ts = append(ts, globToken{globTokenLiteral, c})
+		case '*':
+			if i+1 >= len(rs) || rs[i+1] != '*' {
+				ts = append(ts, globToken{globTokenStar, 0})
+				break
+			}
+			i++
+			if i+1 < len(rs) && rs[i+1] == '/' {
+				i++
+				ts = append(ts, globToken{globTokenStarStarSlash, 0})
+			} else {
+				ts = append(ts, globToken{globTokenStarStar, 0})
+			}
+		case '?', '+', '[':
+			return nil, false
+		default:
+			ts = append(ts, globToken{globTokenLiteral, c})
+		}
+	}
+	return ts, true
+}
+
+// globTokensCover returns true when all strings matched by the tokens b are also matched by the
+// tokens a. It may return false even if a covers b in some complicated cases.
+func globTokensCover(a, b []globToken) bool {
+	if len(a) == 0 {
+		return len(b) == 0
+	}
+
+	switch a[0].kind {
+	case globTokenStarStar:
+		for i := 0; i <= len(b); i++ {
+			if globTokensCover(a[1:], b[i:]) {
+				return true
+			}
+		}
+		return false
+	case globTokenStarStarSlash:
+		if globTokensCover(a[1:], b) {
+			return true
+		}
+		// Any strings ending with "/" are matched
+		for i, t := range b {
+			slash := t.kind == globTokenLiteral && t.char == '/' ||
+				t.kind == globTokenStarStarSlash && (i == 0 || b[i-1].kind == globTokenLiteral && b[i-1].char == '/')
+			if slash && globTokensCover(a[1:], b[i+1:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(b) == 0 {
+		// Only wildcards can match an empty string
+		for _, t := range a {
+			if t.kind == globTokenLiteral {
+				return false
+			}
+		}
+		return true
+	}
+
+	if b[0].kind == globTokenStarStarSlash {
+		// "**/" in b matches either an empty string or strings ending with "/"
+		ds := append([]globToken{{globTokenStarStar, 0}, {globTokenLiteral, '/'}}, b[1:]...)
+		return globTokensCover(a, b[1:]) && globTokensCover(a, ds)
+	}
+
+	switch a[0].kind {
+	case globTokenStar:
+		if globTokensCover(a[1:], b) {
+			return true
+		}
+		if b[0].kind == globTokenStar || b[0].kind == globTokenLiteral && b[0].char != '/' {
+			return globTokensCover(a, b[1:])
+		}
+		return false
+	default:
+		return b[0].kind == globTokenLiteral && b[0].char == a[0].char && globTokensCover(a[1:], b[1:])
+	}
+}
+
+// globCovers returns true when all strings matched by the filter glob pattern b are also matched by
+// the filter glob pattern a. Leading "!" for negation must be removed by the caller. This function
+// is conservative. It may return false even if a covers b when the patterns are complicated.
+func globCovers(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	tb, ok := tokenizeFilterGlob(b)
+	if !ok {
+		return false
+	}
+
+	literal := true
+	for _, t := range tb {
+		if t.kind != globTokenLiteral {
+			literal = false
+			break
+		}
+	}
+	if literal {
+		// When b matches only one string, it can be checked precisely with regular expression
+		rs := make([]rune, 0, len(tb))
+		for _, t := range tb {
+			rs = append(rs, t.char)
+		}
+		re, err := compileFilterGlob(a)
+		return err == nil && re.MatchString(string(rs))
+	}
+
+	ta, ok := tokenizeFilterGlob(a)
+	if !ok {
+		return false
+	}
+	return globTokensCover(ta, tb)
+}
+
+// globsDisjoint returns true when no string is matched by both of the filter glob patterns a and b.
+// Leading "!" for negation must be removed by the caller. This function is conservative. It may
+// return false even if the patterns are disjoint.
+func globsDisjoint(a, b string) bool {
+	ta, ok := tokenizeFilterGlob(a)
+	if !ok {
+		return false
+	}
+	tb, ok := tokenizeFilterGlob(b)
+	if !ok {
+		return false
+	}
+	// Compare the literal prefixes of the patterns
+	for i := 0; i < len(ta) && i < len(tb); i++ {
+		if ta[i].kind != globTokenLiteral || tb[i].kind != globTokenLiteral {
+			return false
+		}
+		if ta[i].char != tb[i].char {
+			return true
+		}
+	}
+	// One pattern is a literal prefix of the other. The shorter one matches only the single string.
+	// When the rest of the longer one requires some literal character, they never match the same string
+	var rest []globToken
+	if len(ta) > len(tb) {
+		rest = ta[len(tb):]
+	} else {
+		rest = tb[len(ta):]
+	}
+	for _, t := range rest {
+		if t.kind == globTokenLiteral {
+			return true
+		}
+	}
+	return false
+}
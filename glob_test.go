@@ -411,3 +411,121 @@ func TestValidateGlobQuoteCharacterInErrorMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestCompileFilterGlob(t *testing.T) {
+	testCases := []struct {
+		pat     string
+		match   []string
+		unmatch []string
+	}{
+		{"main", []string{"main"}, []string{"mai", "main2", "x/main"}},
+		{"releases/*", []string{"releases/v1", "releases/"}, []string{"releases", "releases/v1/fix"}},
+		{"releases/**", []string{"releases/v1", "releases/v1/fix"}, []string{"releases"}},
+		{"**/README.md", []string{"README.md", "docs/README.md", "a/b/README.md"}, []string{"README.mdx", "xREADME.md"}},
+		{"docs/**/*.md", []string{"docs/a.md", "docs/x/y/a.md"}, []string{"docs/a.txt", "a.md"}},
+		{"*.js", []string{"app.js", ".js"}, []string{"src/app.js", "app.jsx"}},
+		{"v[12].[0-9]+.[0-9]+", []string{"v1.0.0", "v2.10.3"}, []string{"v3.0.0", "v1.0", "v1x0.0"}},
+		{"feature?-x", []string{"feature-x", "featur-x"}, []string{"featurexx-x"}},
+		{`a\*b`, []string{"a*b"}, []string{"ab", "axb"}},
+		{`\!important`, []string{"!important"}, []string{"important"}},
+		{"a.b", []string{"a.b"}, []string{"axb"}},
+		{"(x)|y", []string{"(x)|y"}, []string{"x", "y"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pat, func(t *testing.T) {
+			re, err := compileFilterGlob(tc.pat)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range tc.match {
+				if !re.MatchString(s) {
+					t.Errorf("%q (%s) did not match to %q", tc.pat, re, s)
+				}
+			}
+			for _, s := range tc.unmatch {
+				if re.MatchString(s) {
+					t.Errorf("%q (%s) unexpectedly matched to %q", tc.pat, re, s)
+				}
+			}
+		})
+	}
+}
+
+func TestGlobCovers(t *testing.T) {
+	testCases := []struct {
+		a    string
+		b    string
+		want bool
+	}{
+		{"main", "main", true},
+		{"main", "master", false},
+		{"*", "main", true},
+		{"*", "releases/v1", false},
+		{"**", "releases/v1", true},
+		{"**", "releases/**", true},
+		{"**", "*", true},
+		{"*", "**", false},
+		{"releases/*", "releases/v*", true},
+		{"releases/v*", "releases/*", false},
+		{"releases/**", "releases/*", true},
+		{"releases/*", "releases/**", false},
+		{"releases/**", "releases/v1/**", true},
+		{"**/*.md", "docs/*.md", true},
+		{"**/*.md", "docs/**/*.md", true},
+		{"**/*.md", "*.md", true},
+		{"docs/**", "**/*.md", false},
+		{"**/README.md", "**/README.md", true},
+		{"**/README.md", "README.md", true},
+		{"**/README.md", "docs/**/README.md", true},
+		{"docs/**/README.md", "**/README.md", false},
+		{"**/*", "**/*.js", true},
+		{"**/*.md", "docs/**", false},
+		{"docs/*", "docs/**/x", false},
+		{"docs/**/x", "docs/*", false},
+		{"*.js", "**/*.js", false},
+		{"v[0-9]*", "v1.2", true},
+		{"v[0-9]*", "v*", false},
+		{"v*", "v[0-9]*", false},
+		{`a\*`, "a*", false},
+		{"a*", `a\*`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s covers %s", tc.a, tc.b), func(t *testing.T) {
+			if have := globCovers(tc.a, tc.b); have != tc.want {
+				t.Fatalf("wanted %v but got %v", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestGlobsDisjoint(t *testing.T) {
+	testCases := []struct {
+		a    string
+		b    string
+		want bool
+	}{
+		{"main", "main", false},
+		{"main", "dev", true},
+		{"main", "main*", false},
+		{"main", "main/*", true},
+		{"main/**", "main", true},
+		{"src/**", "docs/**", true},
+		{"src/**", "src/*.js", false},
+		{"src/**", "**/*.md", false},
+		{"*.js", "docs/**", false},
+		{"v[12]*", "v3*", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s and %s", tc.a, tc.b), func(t *testing.T) {
+			if have := globsDisjoint(tc.a, tc.b); have != tc.want {
+				t.Fatalf("wanted %v but got %v", tc.want, have)
+			}
+			if have := globsDisjoint(tc.b, tc.a); have != tc.want {
+				t.Fatalf("wanted %v for reversed arguments but got %v", tc.want, have)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package actionlint
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// JobGraphFormats is a list of output formats of job dependency graphs.
+var JobGraphFormats = []string{"dot", "mermaid"}
+
+type jobGraphWorkflow struct {
+	path  string
+	key   string
+	jobs  []string
+	needs map[string][]string
+	calls map[string]string
+}
+
+// JobGraph is a dependency graph of jobs. Jobs are connected with "needs:" in the same workflow and
+// with calls of reusable workflows across workflows.
+type JobGraph struct {
+	workflows []*jobGraphWorkflow
+}
+
+// NewJobGraph creates a new empty JobGraph instance.
+func NewJobGraph() *JobGraph {
+	return &JobGraph{}
+}
+
+// Add adds jobs in the workflow to the graph. The path parameter is a file path of the workflow.
+// The key parameter is how the workflow is called as a local reusable workflow like
+// "./.github/workflows/reusable.yml". It can be empty when it is unknown.
+func (g *JobGraph) Add(path, key string, w *Workflow) {
+	wf := &jobGraphWorkflow{
+		path:  path,
+		key:   key,
+		jobs:  sortedJobIDs(w.Jobs),
+		needs: map[string][]string{},
+		calls: map[string]string{},
+	}
+	for _, id := range wf.jobs {
+		j := w.Jobs[id]
+		for _, n := range j.Needs {
+			wf.needs[id] = append(wf.needs[id], strings.ToLower(n.Value))
+		}
+		if j.WorkflowCall != nil && j.WorkflowCall.Uses != nil && !j.WorkflowCall.Uses.ContainsExpression() {
+			wf.calls[id] = j.WorkflowCall.Uses.Value
+		}
+	}
+	g.workflows = append(g.workflows, wf)
+}
+
+type jobGraphEdge struct {
+	from, to string
+	call     bool
+}
+
+// build assigns IDs to nodes and resolves edges. Nodes are identified by indices like "w0_j1" since
+// job IDs may contain characters which are not available in node IDs of the output formats. Calls
+// of reusable workflows which are not in the graph are connected to external nodes.
+func (g *JobGraph) build() (map[string]string, []jobGraphEdge, map[string]string) {
+	ids := map[string]string{} // "{workflow index}\x00{job ID}" -> node ID
+	keys := map[string]int{}
+	for i, w := range g.workflows {
+		for j, id := range w.jobs {
+			ids[strconv.Itoa(i)+"\x00"+id] = fmt.Sprintf("w%d_j%d", i, j)
+		}
+		if w.key != "" {
+			keys[w.key] = i
+		}
+	}
+
+	edges := []jobGraphEdge{}
+	external := map[string]string{} // uses -> node ID
+	for i, w := range g.workflows {
+		for _, id := range w.jobs {
+			to := ids[strconv.Itoa(i)+"\x00"+id]
+			for _, n := range w.needs[id] {
+				if from, ok := ids[strconv.Itoa(i)+"\x00"+n]; ok {
+					edges = append(edges, jobGraphEdge{from, to, false})
+				}
+			}
+
+			uses, ok := w.calls[id]
+			if !ok {
+				continue
+			}
+			if c, ok := keys[uses]; ok {
+				// Connect the caller to the jobs which start first in the called workflow
+				for _, r := range g.workflows[c].jobs {
+					if len(g.workflows[c].needs[r]) == 0 {
+						edges = append(edges, jobGraphEdge{to, ids[strconv.Itoa(c)+"\x00"+r], true})
+					}
+				}
+				continue
+			}
+			e, ok := external[uses]
+			if !ok {
+				e = fmt.Sprintf("x%d", len(external))
+				external[uses] = e
+			}
+			edges = append(edges, jobGraphEdge{to, e, true})
+		}
+	}
+
+	return ids, edges, external
+}
+
+// Print prints the graph in the format. The format must be one of JobGraphFormats.
+func (g *JobGraph) Print(out io.Writer, format string) {
+	if format == "mermaid" {
+		g.printMermaid(out)
+		return
+	}
+	g.printDOT(out)
+}
+
+func sortedExternalNodes(external map[string]string) []string {
+	ret := make([]string, len(external))
+	for u, id := range external {
+		i, _ := strconv.Atoi(strings.TrimPrefix(id, "x"))
+		ret[i] = u
+	}
+	return ret
+}
+
+func (g *JobGraph) printDOT(out io.Writer) {
+	ids, edges, external := g.build()
+
+	fmt.Fprintln(out, "digraph jobs {")
+	fmt.Fprintln(out, "  rankdir=LR;")
+	for i, w := range g.workflows {
+		fmt.Fprintf(out, "  subgraph cluster_w%d {\n", i)
+		fmt.Fprintf(out, "    label=%s;\n", strconv.Quote(w.path))
+		for _, id := range w.jobs {
+			fmt.Fprintf(out, "    %s [label=%s];\n", ids[strconv.Itoa(i)+"\x00"+id], strconv.Quote(id))
+		}
+		fmt.Fprintln(out, "  }")
+	}
+	for i, u := range sortedExternalNodes(external) {
+		fmt.Fprintf(out, "  x%d [label=%s, shape=box];\n", i, strconv.Quote(u))
+	}
+	for _, e := range edges {
+		if e.call {
+			fmt.Fprintf(out, "  %s -> %s [style=dashed];\n", e.from, e.to)
+		} else {
+			fmt.Fprintf(out, "  %s -> %s;\n", e.from, e.to)
+		}
+	}
+	fmt.Fprintln(out, "}")
+}
+
+// mermaidLabel escapes the label of node in Mermaid. Double quotes cannot be escaped by backslash.
+func mermaidLabel(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
+
+func (g *JobGraph) printMermaid(out io.Writer) {
+	ids, edges, external := g.build()
+
+	fmt.Fprintln(out, "flowchart LR")
+	for i, w := range g.workflows {
+		fmt.Fprintf(out, "  subgraph w%d [%s]\n", i, mermaidLabel(w.path))
+		for _, id := range w.jobs {
+			fmt.Fprintf(out, "    %s[%s]\n", ids[strconv.Itoa(i)+"\x00"+id], mermaidLabel(id))
+		}
+		fmt.Fprintln(out, "  end")
+	}
+	for i, u := range sortedExternalNodes(external) {
+		fmt.Fprintf(out, "  x%d[[%s]]\n", i, mermaidLabel(u))
+	}
+	for _, e := range edges {
+		if e.call {
+			fmt.Fprintf(out, "  %s -.-> %s\n", e.from, e.to)
+		} else {
+			fmt.Fprintf(out, "  %s --> %s\n", e.from, e.to)
+		}
+	}
+}
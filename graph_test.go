@@ -0,0 +1,105 @@
+package actionlint
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testJobGraph(t *testing.T) *JobGraph {
+	g := NewJobGraph()
+	for _, f := range []string{"ci.yaml", "deploy.yaml"} {
+		b, err := os.ReadFile(filepath.Join("testdata", "graph", f))
+		if err != nil {
+			t.Fatal(err)
+		}
+		w, errs := Parse(b)
+		if len(errs) > 0 {
+			t.Fatal(errs)
+		}
+		g.Add(f, "./.github/workflows/"+f, w)
+	}
+	return g
+}
+
+func TestJobGraphPrintDOT(t *testing.T) {
+	var out bytes.Buffer
+	testJobGraph(t).Print(&out, "dot")
+	want := `digraph jobs {
+  rankdir=LR;
+  subgraph cluster_w0 {
+    label="ci.yaml";
+    w0_j0 [label="build"];
+    w0_j1 [label="deploy"];
+    w0_j2 [label="lint"];
+    w0_j3 [label="notify"];
+    w0_j4 [label="test"];
+  }
+  subgraph cluster_w1 {
+    label="deploy.yaml";
+    w1_j0 [label="prepare"];
+    w1_j1 [label="release"];
+  }
+  x0 [label="octo-org/workflows/.github/workflows/notify.yaml@v1", shape=box];
+  w0_j4 -> w0_j1;
+  w0_j2 -> w0_j1;
+  w0_j1 -> w1_j0 [style=dashed];
+  w0_j1 -> w0_j3;
+  w0_j3 -> x0 [style=dashed];
+  w0_j0 -> w0_j4;
+  w1_j0 -> w1_j1;
+}
+`
+	if have := out.String(); have != want {
+		t.Fatalf("wanted:\n%s\nbut have:\n%s", want, have)
+	}
+}
+
+func TestJobGraphPrintMermaid(t *testing.T) {
+	var out bytes.Buffer
+	testJobGraph(t).Print(&out, "mermaid")
+	want := `flowchart LR
+  subgraph w0 ["ci.yaml"]
+    w0_j0["build"]
+    w0_j1["deploy"]
+    w0_j2["lint"]
+    w0_j3["notify"]
+    w0_j4["test"]
+  end
+  subgraph w1 ["deploy.yaml"]
+    w1_j0["prepare"]
+    w1_j1["release"]
+  end
+  x0[["octo-org/workflows/.github/workflows/notify.yaml@v1"]]
+  w0_j4 --> w0_j1
+  w0_j2 --> w0_j1
+  w0_j1 -.-> w1_j0
+  w0_j1 --> w0_j3
+  w0_j3 -.-> x0
+  w0_j0 --> w0_j4
+  w1_j0 --> w1_j1
+`
+	if have := out.String(); have != want {
+		t.Fatalf("wanted:\n%s\nbut have:\n%s", want, have)
+	}
+}
+
+func TestJobGraphMermaidLabelEscape(t *testing.T) {
+	if have, want := mermaidLabel(`say "hi"`), `"say #quot;hi#quot;"`; have != want {
+		t.Fatalf("wanted %s but have %s", want, have)
+	}
+}
+
+func TestJobGraphUnknownFormat(t *testing.T) {
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = l.PrintJobGraph([]string{filepath.Join("testdata", "graph", "ci.yaml")}, "svg")
+	if err == nil || !strings.Contains(err.Error(), `format "svg" is not available`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package actionlint
+
+import (
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// hashFilesPatternError returns the reason why the pattern of hashFiles() is invalid or never
+// matches any file. It returns an empty string when the pattern is OK. hashFiles() matches files
+// with glob patterns relative to GITHUB_WORKSPACE using @actions/glob.
+// https://docs.github.com/en/actions/learn-github-actions/expressions#hashfiles
+func hashFilesPatternError(pat string) string {
+	p := strings.TrimPrefix(pat, "!")
+	if p == "" {
+		return "pattern is empty"
+	}
+	if strings.HasPrefix(p, "/") || len(p) >= 3 && p[1] == ':' && (p[2] == '/' || p[2] == '\\') {
+		return "absolute path is not available. files outside GITHUB_WORKSPACE are never matched so the pattern should be relative to the workspace"
+	}
+	for _, s := range hashFilesPatternSegments(p) {
+		if s == ".." {
+			return "\"..\" is not available. files outside GITHUB_WORKSPACE are never matched"
+		}
+		if _, err := path.Match(s, ""); err != nil {
+			return "syntax error in glob pattern. check brackets \"[...]\" are closed"
+		}
+	}
+	return ""
+}
+
+func hashFilesPatternSegments(pat string) []string {
+	ss := strings.Split(strings.TrimPrefix(pat, "./"), "/")
+	ret := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if s == "" || s == "." {
+			continue
+		}
+		// [!...] in @actions/glob is [^...] in path.Match
+		ret = append(ret, strings.ReplaceAll(s, "[!", "[^"))
+	}
+	return ret
+}
+
+// matchHashFilesPattern returns true when the file path relative to the workspace matches the
+// pattern. Files in the matched directory are also matched like @actions/glob.
+func matchHashFilesPattern(pat []string, file []string) bool {
+	if len(pat) == 0 {
+		return true // Implicit descendants of the matched directory
+	}
+	if pat[0] == "**" {
+		for i := 0; i <= len(file); i++ {
+			if matchHashFilesPattern(pat[1:], file[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(file) == 0 {
+		return false
+	}
+	if m, err := path.Match(pat[0], file[0]); err != nil || !m {
+		return false
+	}
+	return matchHashFilesPattern(pat[1:], file[1:])
+}
+
+// matchHashFiles returns true when some file matches the patterns of hashFiles(). Patterns starting
+// with "!" exclude files matched by the preceding patterns.
+func matchHashFiles(pats []string, files []string) bool {
+	segs := make([][]string, 0, len(pats))
+	for _, p := range pats {
+		segs = append(segs, hashFilesPatternSegments(strings.TrimPrefix(p, "!")))
+	}
+
+	for _, f := range files {
+		ss := strings.Split(f, "/")
+		matched := false
+		for i, p := range pats {
+			neg := strings.HasPrefix(p, "!")
+			if matched != neg {
+				continue // Only patterns which can change the result are checked
+			}
+			if matchHashFilesPattern(segs[i], ss) {
+				matched = !neg
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hashFilesWorkspace is a workspace directory to check patterns of hashFiles() match some files.
+// The list of files is collected lazily only once.
+type hashFilesWorkspace struct {
+	root  string
+	fs    fileSystem
+	once  sync.Once
+	files []string
+}
+
+func newHashFilesWorkspace(root string) *hashFilesWorkspace {
+	return &hashFilesWorkspace{root: root, fs: osFileSystem{}}
+}
+
+// list returns slash-separated paths of all files in the workspace relative to the root.
+func (w *hashFilesWorkspace) list() []string {
+	w.once.Do(func() {
+		w.fs.WalkDir(w.root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return nil // Skip unreadable entries
+			}
+			if d.IsDir() {
+				if d.Name() == ".git" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if r, err := filepath.Rel(w.root, p); err == nil {
+				w.files = append(w.files, filepath.ToSlash(r))
+			}
+			return nil
+		})
+	})
+	return w.files
+}
@@ -0,0 +1,57 @@
+package actionlint
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestHashFilesMatchFiles(t *testing.T) {
+	files := []string{
+		"go.mod",
+		"go.sum",
+		"web/package-lock.json",
+		"web/node_modules/foo/package-lock.json",
+		"docs/readme.md",
+	}
+
+	testCases := []struct {
+		pats []string
+		want bool
+	}{
+		{[]string{"go.sum"}, true},
+		{[]string{"./go.sum"}, true},
+		{[]string{"**/go.sum"}, true},
+		{[]string{"**/package-lock.json"}, true},
+		{[]string{"web/*.json"}, true},
+		{[]string{"docs"}, true},
+		{[]string{"**/*.md"}, true},
+		{[]string{"go.?um"}, true},
+		{[]string{"[!x]o.mod"}, true},
+		{[]string{"**/node_modules/**/package-lock.json", "!web/**"}, false},
+		{[]string{"**/package-lock.json", "!**/node_modules/**"}, true},
+		{[]string{"web/**", "!web/**", "web/package-lock.json"}, true},
+		{[]string{"**/yarn.lock"}, false},
+		{[]string{"*.json"}, false},
+		{[]string{"Go.sum"}, false},
+		{[]string{"docs/readme.md/foo"}, false},
+	}
+
+	for _, tc := range testCases {
+		if have := matchHashFiles(tc.pats, files); have != tc.want {
+			t.Errorf("wanted %v but got %v for patterns %q", tc.want, have, tc.pats)
+		}
+	}
+}
+
+func TestHashFilesWorkspaceList(t *testing.T) {
+	w := newHashFilesWorkspace(filepath.Join("testdata", "projects", "hash_files"))
+	files := w.list()
+	if !matchHashFiles([]string{"**/package-lock.json"}, files) {
+		t.Fatalf("package-lock.json was not found in %q", files)
+	}
+	for _, f := range files {
+		if f == ".git" || len(f) >= 5 && f[:5] == ".git/" {
+			t.Fatalf(".git directory must be skipped: %q", files)
+		}
+	}
+}
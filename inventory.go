@@ -0,0 +1,343 @@
+package actionlint
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// InventoryFormats is a list of output formats of inventories such as -list-actions.
+var InventoryFormats = []string{"table", "json", "csv"}
+
+// ActionUsage is a location where an action or a reusable workflow is used.
+type ActionUsage struct {
+	// Filepath is a path to the workflow file or the action metadata file.
+	Filepath string `json:"filepath"`
+	// Job is an ID of the job which uses the action. It is "action" for composite actions.
+	Job string `json:"job"`
+	// Line is a line number of the "uses:" value.
+	Line int `json:"line"`
+	// Column is a column number of the "uses:" value.
+	Column int `json:"column"`
+}
+
+// ActionReference is an action or a reusable workflow referred at "uses:" across workflows.
+type ActionReference struct {
+	// Uses is the raw value of "uses:" such as "actions/checkout@v4".
+	Uses string `json:"uses"`
+	// Kind is a kind of the reference. One of "repository", "local", "docker", and
+	// "reusable-workflow".
+	Kind string `json:"kind"`
+	// Name is the reference without its version like "actions/checkout".
+	Name string `json:"name"`
+	// Ref is a version of the reference like "v4" or a commit SHA. For Docker images, this is a
+	// tag or a digest. This field is empty for local actions and local reusable workflows.
+	Ref string `json:"ref"`
+	// Pinned is true when the reference is pinned to an immutable version: a full commit SHA for
+	// actions and reusable workflows in repositories, and a digest for Docker images. Local ones
+	// are always pinned since they are at the same revision as the workflow.
+	Pinned bool `json:"pinned"`
+	// Usages is locations where the reference is used.
+	Usages []*ActionUsage `json:"usages"`
+}
+
+func newActionReference(uses string) *ActionReference {
+	r := &ActionReference{Uses: uses, Name: uses}
+	switch {
+	case strings.HasPrefix(uses, "./"):
+		r.Kind = "local"
+		if strings.HasSuffix(uses, ".yml") || strings.HasSuffix(uses, ".yaml") {
+			r.Kind = "reusable-workflow"
+		}
+		r.Pinned = true
+	case strings.HasPrefix(uses, "docker://"):
+		r.Kind = "docker"
+		img := strings.TrimPrefix(uses, "docker://")
+		if i := strings.Index(img, "@"); i >= 0 {
+			r.Name, r.Ref = "docker://"+img[:i], img[i+1:]
+			r.Pinned = strings.HasPrefix(r.Ref, "sha256:")
+		} else if i := strings.LastIndex(img, ":"); i > strings.LastIndex(img, "/") {
+			r.Name, r.Ref = "docker://"+img[:i], img[i+1:]
+		}
+	default:
+		r.Kind = "repository"
+		if n, ref, ok := strings.Cut(uses, "@"); ok {
+			r.Name, r.Ref = n, ref
+			r.Pinned = reCommitSHA.MatchString(ref)
+			if strings.Contains(n, "/.github/workflows/") {
+				r.Kind = "reusable-workflow"
+			}
+		}
+	}
+	return r
+}
+
+// ActionsInventory is an inventory of actions and reusable workflows used across workflows. It is
+// useful as a software bill of materials (SBOM) of actions for audits.
+type ActionsInventory struct {
+	refs map[string]*ActionReference
+}
+
+// NewActionsInventory creates a new empty ActionsInventory instance.
+func NewActionsInventory() *ActionsInventory {
+	return &ActionsInventory{map[string]*ActionReference{}}
+}
+
+func (inv *ActionsInventory) add(uses *String, path, job string) {
+	if uses == nil || uses.Value == "" || uses.ContainsExpression() {
+		return
+	}
+	r, ok := inv.refs[uses.Value]
+	if !ok {
+		r = newActionReference(uses.Value)
+		inv.refs[uses.Value] = r
+	}
+	r.Usages = append(r.Usages, &ActionUsage{path, job, uses.Pos.Line, uses.Pos.Col})
+}
+
+// Add adds all actions and reusable workflows used in the workflow to the inventory. The path
+// parameter is a file path of the workflow.
+func (inv *ActionsInventory) Add(path string, w *Workflow) {
+	for _, id := range sortedJobIDs(w.Jobs) {
+		j := w.Jobs[id]
+		if j.WorkflowCall != nil {
+			inv.add(j.WorkflowCall.Uses, path, id)
+		}
+		for _, s := range j.Steps {
+			if e, ok := s.Exec.(*ExecAction); ok {
+				inv.add(e.Uses, path, id)
+			}
+		}
+	}
+}
+
+// References returns all references in the inventory sorted by their "uses:" values.
+func (inv *ActionsInventory) References() []*ActionReference {
+	ret := make([]*ActionReference, 0, len(inv.refs))
+	for _, r := range inv.refs {
+		ret = append(ret, r)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Uses < ret[j].Uses })
+	return ret
+}
+
+// Print prints the inventory in the format. The format must be one of InventoryFormats.
+func (inv *ActionsInventory) Print(out io.Writer, format string) error {
+	refs := inv.References()
+	if format == "json" {
+		return printInventoryJSON(out, refs)
+	}
+
+	if format == "csv" {
+		rows := [][]string{}
+		for _, r := range refs {
+			for _, u := range r.Usages {
+				rows = append(rows, []string{r.Uses, r.Kind, r.Name, r.Ref, strconv.FormatBool(r.Pinned), u.Filepath, u.Job, strconv.Itoa(u.Line), strconv.Itoa(u.Column)})
+			}
+		}
+		return printInventoryCSV(out, []string{"uses", "kind", "name", "ref", "pinned", "filepath", "job", "line", "column"}, rows)
+	}
+
+	rows := make([][]string, 0, len(refs))
+	for _, r := range refs {
+		p := "no"
+		if r.Pinned {
+			p = "yes"
+		}
+		ref := r.Ref
+		if ref == "" {
+			ref = "-"
+		}
+		used := make([]string, 0, len(r.Usages))
+		for _, u := range r.Usages {
+			used = append(used, fmt.Sprintf("%s:%d:%d (%s)", u.Filepath, u.Line, u.Column, u.Job))
+		}
+		rows = append(rows, []string{r.Name, ref, r.Kind, p, strings.Join(used, ", ")})
+	}
+	return printInventoryTable(out, []string{"NAME", "REF", "KIND", "PINNED", "USED AT"}, rows)
+}
+
+func printInventoryJSON(out io.Writer, v any) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("could not encode inventory into JSON: %w", err)
+	}
+	return nil
+}
+
+func printInventoryCSV(out io.Writer, header []string, rows [][]string) error {
+	w := csv.NewWriter(out)
+	w.Write(header)
+	w.WriteAll(rows) // This method calls Flush() internally
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("could not write inventory as CSV: %w", err)
+	}
+	return nil
+}
+
+func printInventoryTable(out io.Writer, header []string, rows [][]string) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, r := range rows {
+		fmt.Fprintln(w, strings.Join(r, "\t"))
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("could not write inventory as table: %w", err)
+	}
+	return nil
+}
+
+// InventoryLocation is a location in a workflow file or an action metadata file.
+type InventoryLocation struct {
+	// Filepath is a path to the file.
+	Filepath string `json:"filepath"`
+	// Line is a line number of the location.
+	Line int `json:"line"`
+	// Column is a column number of the location.
+	Column int `json:"column"`
+}
+
+// ContextReference is a secret, a configuration variable, or a deployment environment referred in
+// workflows.
+type ContextReference struct {
+	// Kind is a kind of the reference. One of "secret", "variable", and "environment".
+	Kind string `json:"kind"`
+	// Name is a name of the secret, the configuration variable, or the environment. Names of
+	// secrets and variables are in upper case since they are case-insensitive. "*" means the entire
+	// context is referred like `toJSON(secrets)`.
+	Name string `json:"name"`
+	// Locations is locations where the reference appears.
+	Locations []*InventoryLocation `json:"locations"`
+}
+
+// ContextsInventory is an inventory of secrets, configuration variables, and deployment
+// environments referred in workflows. It is useful to reconcile the usage in workflows with the
+// settings of repositories and organizations.
+type ContextsInventory struct {
+	refs map[string]*ContextReference
+}
+
+// NewContextsInventory creates a new empty ContextsInventory instance.
+func NewContextsInventory() *ContextsInventory {
+	return &ContextsInventory{map[string]*ContextReference{}}
+}
+
+func (inv *ContextsInventory) add(kind, name, path string, line, col int) {
+	k := kind + "\x00" + name
+	r, ok := inv.refs[k]
+	if !ok {
+		r = &ContextReference{Kind: kind, Name: name}
+		inv.refs[k] = r
+	}
+	r.Locations = append(r.Locations, &InventoryLocation{path, line, col})
+}
+
+// Add adds all secrets, configuration variables, and deployment environments referred in the
+// workflow to the inventory. The path parameter is a file path of the workflow and the src
+// parameter is its source. Expressions are collected from the source so that their positions are
+// accurate even in multi-line strings.
+func (inv *ContextsInventory) Add(path string, src []byte, w *Workflow) {
+	for _, id := range sortedJobIDs(w.Jobs) {
+		if e := w.Jobs[id].Environment; e != nil && e.Name != nil {
+			inv.add("environment", e.Name.Value, path, e.Name.Pos.Line, e.Name.Pos.Col)
+		}
+	}
+
+	for i, l := range strings.Split(string(src), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(l), "#") {
+			continue
+		}
+		offset := 0
+		for {
+			idx := strings.Index(l[offset:], "${{")
+			if idx == -1 {
+				break
+			}
+			start := offset + idx + 3
+			lex := NewExprLexer(l[start:])
+			expr, err := NewExprParser().Parse(lex)
+			if err != nil {
+				offset = start
+				continue
+			}
+			inv.addExpr(expr, path, i+1, start+1)
+			offset = start + lex.Offset()
+		}
+	}
+}
+
+func (inv *ContextsInventory) addExpr(expr ExprNode, path string, line, col int) {
+	kinds := map[string]string{"secrets": "secret", "vars": "variable"}
+	VisitExprNode(expr, func(n, p ExprNode, entering bool) {
+		if !entering {
+			return
+		}
+		v, ok := n.(*VariableNode)
+		if !ok {
+			return
+		}
+		kind, ok := kinds[v.Name]
+		if !ok {
+			return
+		}
+		name := "*"
+		switch p := p.(type) {
+		case *ObjectDerefNode:
+			name = strings.ToUpper(p.Property)
+		case *IndexAccessNode:
+			if s, ok := p.Index.(*StringNode); ok && p.Operand == n {
+				name = strings.ToUpper(s.Value)
+			}
+		}
+		t := v.Token()
+		inv.add(kind, name, path, line+t.Line-1, col+t.Column-1)
+	})
+}
+
+// References returns all references in the inventory sorted by their kinds and names.
+func (inv *ContextsInventory) References() []*ContextReference {
+	ret := make([]*ContextReference, 0, len(inv.refs))
+	for _, r := range inv.refs {
+		ret = append(ret, r)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Kind != ret[j].Kind {
+			return ret[i].Kind < ret[j].Kind
+		}
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
+
+// Print prints the inventory in the format. The format must be one of InventoryFormats.
+func (inv *ContextsInventory) Print(out io.Writer, format string) error {
+	refs := inv.References()
+	switch format {
+	case "json":
+		return printInventoryJSON(out, refs)
+	case "csv":
+		rows := [][]string{}
+		for _, r := range refs {
+			for _, l := range r.Locations {
+				rows = append(rows, []string{r.Kind, r.Name, l.Filepath, strconv.Itoa(l.Line), strconv.Itoa(l.Column)})
+			}
+		}
+		return printInventoryCSV(out, []string{"kind", "name", "filepath", "line", "column"}, rows)
+	default:
+		rows := make([][]string, 0, len(refs))
+		for _, r := range refs {
+			locs := make([]string, 0, len(r.Locations))
+			for _, l := range r.Locations {
+				locs = append(locs, fmt.Sprintf("%s:%d:%d", l.Filepath, l.Line, l.Column))
+			}
+			rows = append(rows, []string{r.Kind, r.Name, strings.Join(locs, ", ")})
+		}
+		return printInventoryTable(out, []string{"KIND", "NAME", "USED AT"}, rows)
+	}
+}
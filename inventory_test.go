@@ -0,0 +1,193 @@
+package actionlint
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInventoryListActions(t *testing.T) {
+	files := []string{
+		filepath.Join("testdata", "inventory", "ci.yaml"),
+		filepath.Join("testdata", "inventory", "action.yml"),
+	}
+	ci, action := filepath.ToSlash(files[0]), filepath.ToSlash(files[1])
+
+	var out bytes.Buffer
+	l, err := NewLinter(&out, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.ListActions(files, "json"); err != nil {
+		t.Fatal(err)
+	}
+
+	var have []*ActionReference
+	if err := json.Unmarshal(out.Bytes(), &have); err != nil {
+		t.Fatal(err, out.String())
+	}
+	for _, r := range have {
+		for _, u := range r.Usages {
+			u.Filepath = filepath.ToSlash(u.Filepath)
+		}
+	}
+
+	want := []*ActionReference{
+		{
+			Uses:   "./.github/actions/setup",
+			Kind:   "local",
+			Name:   "./.github/actions/setup",
+			Pinned: true,
+			Usages: []*ActionUsage{{ci, "test", 9, 15}},
+		},
+		{
+			Uses:   "./.github/workflows/reusable.yml",
+			Kind:   "reusable-workflow",
+			Name:   "./.github/workflows/reusable.yml",
+			Pinned: true,
+			Usages: []*ActionUsage{{ci, "local", 20, 11}},
+		},
+		{
+			Uses:   "actions/checkout@b4ffde65f46336ab88eb53be808477a3936bae11",
+			Kind:   "repository",
+			Name:   "actions/checkout",
+			Ref:    "b4ffde65f46336ab88eb53be808477a3936bae11",
+			Pinned: true,
+			Usages: []*ActionUsage{{ci, "lint", 16, 15}, {ci, "test", 7, 15}},
+		},
+		{
+			Uses:   "actions/setup-go@v5",
+			Kind:   "repository",
+			Name:   "actions/setup-go",
+			Ref:    "v5",
+			Usages: []*ActionUsage{{ci, "test", 8, 15}, {action, "action", 6, 13}},
+		},
+		{
+			Uses:   "docker://alpine:3.19",
+			Kind:   "docker",
+			Name:   "docker://alpine",
+			Ref:    "3.19",
+			Usages: []*ActionUsage{{ci, "test", 10, 15}},
+		},
+		{
+			Uses:   "docker://alpine@sha256:c5b1261d6d3e43071626931fc004f70149baeba2c8ec672bd4f27761f8e1ad6b",
+			Kind:   "docker",
+			Name:   "docker://alpine",
+			Ref:    "sha256:c5b1261d6d3e43071626931fc004f70149baeba2c8ec672bd4f27761f8e1ad6b",
+			Pinned: true,
+			Usages: []*ActionUsage{{ci, "test", 11, 15}},
+		},
+		{
+			Uses:   "octo-org/workflows/.github/workflows/reusable.yml@main",
+			Kind:   "reusable-workflow",
+			Name:   "octo-org/workflows/.github/workflows/reusable.yml",
+			Ref:    "main",
+			Usages: []*ActionUsage{{ci, "call", 18, 11}},
+		},
+	}
+
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestInventoryListActionsTableAndCSV(t *testing.T) {
+	files := []string{filepath.Join("testdata", "inventory", "ci.yaml")}
+
+	for _, tc := range []struct {
+		format string
+		want   []string
+	}{
+		{
+			format: "table",
+			want: []string{
+				"NAME  ",
+				"actions/setup-go  ",
+				"  v5  ",
+				"  repository  ",
+				"  no  ",
+				"ci.yaml:8:15 (test)",
+			},
+		},
+		{
+			format: "csv",
+			want: []string{
+				"uses,kind,name,ref,pinned,filepath,job,line,column\n",
+				"actions/setup-go@v5,repository,actions/setup-go,v5,false,",
+				"ci.yaml,test,8,15\n",
+			},
+		},
+	} {
+		t.Run(tc.format, func(t *testing.T) {
+			var out bytes.Buffer
+			l, err := NewLinter(&out, &LinterOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := l.ListActions(files, tc.format); err != nil {
+				t.Fatal(err)
+			}
+			s := out.String()
+			for _, w := range tc.want {
+				if !strings.Contains(s, w) {
+					t.Errorf("output does not contain %q: %q", w, s)
+				}
+			}
+		})
+	}
+}
+
+func TestInventoryUnknownFormat(t *testing.T) {
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = l.ListActions([]string{filepath.Join("testdata", "inventory", "ci.yaml")}, "xml")
+	if err == nil || !strings.Contains(err.Error(), `format "xml" is not available`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestInventoryListSecrets(t *testing.T) {
+	path := filepath.Join("testdata", "inventory", "secrets.yaml")
+	f := filepath.ToSlash(path)
+
+	var out bytes.Buffer
+	l, err := NewLinter(&out, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.ListSecrets([]string{path}, "json"); err != nil {
+		t.Fatal(err)
+	}
+
+	var have []*ContextReference
+	if err := json.Unmarshal(out.Bytes(), &have); err != nil {
+		t.Fatal(err, out.String())
+	}
+	for _, r := range have {
+		for _, l := range r.Locations {
+			l.Filepath = filepath.ToSlash(l.Filepath)
+		}
+	}
+
+	want := []*ContextReference{
+		{"environment", "production", []*InventoryLocation{{f, 11, 18}}},
+		{"environment", "staging", []*InventoryLocation{{f, 22, 13}}},
+		{"secret", "*", []*InventoryLocation{{f, 16, 28}}},
+		{"secret", "API_TOKEN", []*InventoryLocation{{f, 6, 14}, {f, 18, 22}}},
+		{"secret", "DEPLOY_KEY", []*InventoryLocation{{f, 15, 42}}},
+		{"secret", "GITHUB_TOKEN", []*InventoryLocation{{f, 18, 43}}},
+		{"variable", "ENABLE_STAGING", []*InventoryLocation{{f, 24, 13}}},
+		{"variable", "REGION", []*InventoryLocation{{f, 15, 21}}},
+	}
+
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatal(diff)
+	}
+}
@@ -0,0 +1,491 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// jsonSchema is an object of JSON Schema. Keys are sorted on encoding to JSON so the output is
+// stable.
+type jsonSchema map[string]any
+
+const (
+	// jsonSchemaExprPattern matches to a string which consists of one expression like "${{ x }}".
+	jsonSchemaExprPattern = `^\s*\$\{\{[\s\S]*\}\}\s*$`
+	// jsonSchemaContainsExprPattern matches to a string which contains some expression.
+	jsonSchemaContainsExprPattern = `\$\{\{[\s\S]*\}\}`
+)
+
+func jsonSchemaRef(name string) jsonSchema {
+	return jsonSchema{"$ref": "#/definitions/" + name}
+}
+
+func jsonSchemaAnyOf(ss ...jsonSchema) jsonSchema {
+	return jsonSchema{"anyOf": ss}
+}
+
+func jsonSchemaObject(props jsonSchema, required ...string) jsonSchema {
+	s := jsonSchema{
+		"type":                 "object",
+		"properties":           props,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+func jsonSchemaMapOf(v jsonSchema) jsonSchema {
+	return jsonSchema{"type": "object", "additionalProperties": v}
+}
+
+func jsonSchemaArrayOf(v jsonSchema, minItems int) jsonSchema {
+	s := jsonSchema{"type": "array", "items": v}
+	if minItems > 0 {
+		s["minItems"] = minItems
+	}
+	return s
+}
+
+func jsonSchemaEnum(vs []string) jsonSchema {
+	sorted := make([]string, len(vs))
+	copy(sorted, vs)
+	sort.Strings(sorted)
+	return jsonSchema{"type": "string", "enum": sorted}
+}
+
+// jsonSchemaEnumFold returns a schema of the values matched case-insensitively. The enum is kept
+// for completions and the pattern accepts the values in any case.
+func jsonSchemaEnumFold(vs []string) jsonSchema {
+	alts := make([]string, 0, len(vs))
+	for _, v := range vs {
+		var b strings.Builder
+		for _, r := range v {
+			l, u := strings.ToLower(string(r)), strings.ToUpper(string(r))
+			if l == u {
+				b.WriteString(regexp.QuoteMeta(l))
+			} else {
+				b.WriteString("[" + l + u + "]")
+			}
+		}
+		alts = append(alts, b.String())
+	}
+	sort.Strings(alts)
+	return jsonSchemaAnyOf(
+		jsonSchemaEnum(vs),
+		jsonSchema{"type": "string", "pattern": "^(?:" + strings.Join(alts, "|") + ")$"},
+	)
+}
+
+// jsonSchemaStringOrArray returns a schema of a string or a non-empty array of strings like
+// "types: [opened, closed]".
+func jsonSchemaStringOrArray(v jsonSchema) jsonSchema {
+	return jsonSchemaAnyOf(v, jsonSchemaArrayOf(v, 1))
+}
+
+// globToJSONSchemaPattern converts the glob pattern for path.Match into a regular expression
+// pattern of JSON Schema.
+func globToJSONSchemaPattern(glob string) string {
+	var b strings.Builder
+	b.WriteByte('^')
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteByte('.')
+		case '[':
+			j := strings.IndexByte(glob[i:], ']')
+			if j < 0 {
+				b.WriteString(`\[`)
+				continue
+			}
+			// Character class like "[^a-z]" has the same syntax in regular expression
+			b.WriteString(glob[i : i+j+1])
+			i += j
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteByte('$')
+	return b.String()
+}
+
+// jsonSchemaRunnerLabel returns a schema of runner labels at "runs-on:". It accepts the labels of
+// GitHub-hosted runners, the preset labels of self-hosted runners, and the labels configured at
+// "self-hosted-runner.labels" or "self-hosted-runner.groups" in the config file. Glob patterns in
+// the config are converted to regular expressions.
+func jsonSchemaRunnerLabel(cfg *Config) jsonSchema {
+	hosted := allGitHubHostedRunnerLabels
+	var custom []string
+	if cfg != nil {
+		custom = append(custom, cfg.SelfHostedRunner.Labels...)
+		for _, g := range sortedKeys(cfg.SelfHostedRunner.Groups) {
+			custom = append(custom, cfg.SelfHostedRunner.Groups[g]...)
+		}
+		if ghes := cfg.GitHubEnterprise; ghes != nil {
+			hosted = ghes.HostedLabels
+		}
+	}
+
+	labels := make([]string, 0, len(hosted)+len(selfHostedRunnerPresetOSLabels)+len(selfHostedRunnerPresetOtherLabels)+len(custom))
+	labels = append(labels, hosted...)
+	labels = append(labels, selfHostedRunnerPresetOSLabels...)
+	labels = append(labels, selfHostedRunnerPresetOtherLabels...)
+	ss := []jsonSchema{}
+	seen := map[string]struct{}{}
+	for _, l := range custom {
+		if _, ok := seen[l]; ok {
+			continue
+		}
+		seen[l] = struct{}{}
+		if strings.ContainsAny(l, "*?[") {
+			ss = append(ss, jsonSchema{"type": "string", "pattern": globToJSONSchemaPattern(l)})
+		} else {
+			labels = append(labels, l)
+		}
+	}
+	ss = append([]jsonSchema{jsonSchemaEnumFold(labels)}, ss...)
+	ss = append(ss, jsonSchema{"type": "string", "pattern": jsonSchemaContainsExprPattern})
+	return jsonSchemaAnyOf(ss...)
+}
+
+func jsonSchemaRunsOn(cfg *Config) jsonSchema {
+	group := jsonSchemaRef("string")
+	if cfg != nil && len(cfg.SelfHostedRunner.Groups) > 0 {
+		group = jsonSchemaAnyOf(jsonSchemaEnumFold(sortedKeys(cfg.SelfHostedRunner.Groups)), jsonSchemaRef("expression"))
+	}
+	label := jsonSchemaRef("runner-label")
+	return jsonSchemaAnyOf(
+		jsonSchemaStringOrArray(label),
+		jsonSchemaObject(jsonSchema{
+			"labels": jsonSchemaStringOrArray(label),
+			"group":  group,
+		}),
+	)
+}
+
+func jsonSchemaShell(cfg *Config) jsonSchema {
+	shells := getAvailableShellNames(platformKindAny)
+	if cfg != nil {
+		for _, c := range cfg.CustomShells {
+			shells = append(shells, c.Shell)
+		}
+	}
+	return jsonSchemaAnyOf(
+		jsonSchemaEnumFold(shells),
+		// Custom shell like "perl {0}"
+		jsonSchema{"type": "string", "pattern": `\{0\}`},
+		jsonSchemaRef("expression"),
+	)
+}
+
+func jsonSchemaPermissions() jsonSchema {
+	scopes := make(jsonSchema, len(allPermissionScopes))
+	for s, ls := range allPermissionScopes {
+		scopes[s] = jsonSchemaEnum(ls)
+	}
+	return jsonSchemaAnyOf(
+		jsonSchemaEnum([]string{"read-all", "write-all"}),
+		jsonSchemaObject(scopes),
+	)
+}
+
+func jsonSchemaWebhookEvent(hook string, types []string) jsonSchema {
+	filter := jsonSchemaStringOrArray(jsonSchemaRef("string"))
+	props := jsonSchema{}
+	if len(types) > 0 {
+		props["types"] = jsonSchemaStringOrArray(jsonSchemaEnum(types))
+	}
+	var exclusive [][]string
+	switch hook {
+	case "push":
+		props["branches"] = filter
+		props["branches-ignore"] = filter
+		props["tags"] = filter
+		props["tags-ignore"] = filter
+		props["paths"] = filter
+		props["paths-ignore"] = filter
+		exclusive = [][]string{{"branches", "branches-ignore"}, {"tags", "tags-ignore"}, {"paths", "paths-ignore"}}
+	case "pull_request", "pull_request_target":
+		props["branches"] = filter
+		props["branches-ignore"] = filter
+		props["paths"] = filter
+		props["paths-ignore"] = filter
+		exclusive = [][]string{{"branches", "branches-ignore"}, {"paths", "paths-ignore"}}
+	case "workflow_run":
+		props["branches"] = filter
+		props["branches-ignore"] = filter
+		props["workflows"] = filter
+		exclusive = [][]string{{"branches", "branches-ignore"}}
+	}
+
+	o := jsonSchemaObject(props)
+	if hook == "workflow_run" {
+		o["required"] = []string{"workflows"}
+	}
+	if len(exclusive) > 0 {
+		nots := make([]jsonSchema, 0, len(exclusive))
+		for _, e := range exclusive {
+			nots = append(nots, jsonSchema{"not": jsonSchema{"required": e}})
+		}
+		o["allOf"] = nots
+	}
+	return jsonSchemaAnyOf(jsonSchema{"type": "null"}, o)
+}
+
+func jsonSchemaEvents(cfg *Config) jsonSchema {
+	names := make([]string, 0, len(AllWebhookTypes)+len(nonWebhookEventNames))
+	props := make(jsonSchema, len(AllWebhookTypes)+len(nonWebhookEventNames))
+	for hook, types := range AllWebhookTypes {
+		names = append(names, hook)
+		props[hook] = jsonSchemaWebhookEvent(hook, types)
+	}
+	names = append(names, nonWebhookEventNames...)
+
+	null := jsonSchema{"type": "null"}
+	props["schedule"] = jsonSchemaArrayOf(jsonSchemaObject(jsonSchema{"cron": jsonSchemaRef("string")}, "cron"), 1)
+
+	dispatchInput := jsonSchemaObject(jsonSchema{
+		"description": jsonSchemaRef("nullable-string"),
+		"required":    jsonSchemaRef("boolean"),
+		"default":     jsonSchemaRef("nullable-string"),
+		"type":        jsonSchemaEnum([]string{"string", "number", "boolean", "choice", "environment"}),
+		"options":     jsonSchemaArrayOf(jsonSchemaRef("string"), 1),
+	})
+	props["workflow_dispatch"] = jsonSchemaAnyOf(null, jsonSchemaObject(jsonSchema{
+		"inputs": jsonSchemaAnyOf(null, jsonSchemaMapOf(jsonSchemaAnyOf(null, dispatchInput))),
+	}))
+
+	dispatchType := jsonSchemaRef("string")
+	if cfg != nil && cfg.RepositoryDispatch != nil && len(cfg.RepositoryDispatch.Types) > 0 {
+		dispatchType = jsonSchemaEnum(cfg.RepositoryDispatch.Types)
+	}
+	props["repository_dispatch"] = jsonSchemaAnyOf(null, jsonSchemaObject(jsonSchema{
+		"types": jsonSchemaStringOrArray(dispatchType),
+	}))
+
+	callInput := jsonSchemaObject(jsonSchema{
+		"description": jsonSchemaRef("nullable-string"),
+		"required":    jsonSchemaRef("boolean"),
+		"default":     jsonSchemaRef("nullable-string"),
+		"type":        jsonSchemaEnum([]string{"boolean", "number", "string"}),
+	}, "type")
+	callSecret := jsonSchemaObject(jsonSchema{
+		"description": jsonSchemaRef("nullable-string"),
+		"required":    jsonSchemaRef("boolean"),
+	})
+	callOutput := jsonSchemaObject(jsonSchema{
+		"description": jsonSchemaRef("nullable-string"),
+		"value":       jsonSchemaRef("string"),
+	}, "value")
+	props["workflow_call"] = jsonSchemaAnyOf(null, jsonSchemaObject(jsonSchema{
+		"inputs":  jsonSchemaAnyOf(null, jsonSchemaMapOf(callInput)),
+		"secrets": jsonSchemaAnyOf(null, jsonSchemaMapOf(jsonSchemaAnyOf(null, callSecret))),
+		"outputs": jsonSchemaAnyOf(null, jsonSchemaMapOf(callOutput)),
+	}))
+
+	// "schedule" and "repository_dispatch" cannot be listed in sequence
+	listed := make([]string, 0, len(names))
+	for _, n := range names {
+		if n != "schedule" && n != "repository_dispatch" {
+			listed = append(listed, n)
+		}
+	}
+	all := make([]string, 0, len(names))
+	for _, n := range names {
+		if n != "schedule" {
+			all = append(all, n)
+		}
+	}
+
+	o := jsonSchemaObject(props)
+	o["minProperties"] = 1
+	return jsonSchemaAnyOf(
+		jsonSchemaEnum(all),
+		jsonSchemaArrayOf(jsonSchemaEnum(listed), 1),
+		o,
+	)
+}
+
+func jsonSchemaContainer() jsonSchema {
+	str := jsonSchemaRef("string")
+	return jsonSchemaAnyOf(
+		str,
+		jsonSchemaObject(jsonSchema{
+			"image": str,
+			"credentials": jsonSchemaObject(jsonSchema{
+				"username": str,
+				"password": str,
+			}, "username", "password"),
+			"env":     jsonSchemaRef("env"),
+			"ports":   jsonSchemaAnyOf(jsonSchema{"type": "null"}, jsonSchemaArrayOf(str, 0)),
+			"volumes": jsonSchemaAnyOf(jsonSchema{"type": "null"}, jsonSchemaArrayOf(str, 0)),
+			"options": jsonSchemaRef("nullable-string"),
+		}),
+	)
+}
+
+func jsonSchemaMatrix() jsonSchema {
+	expr := jsonSchemaRef("expression")
+	combinations := jsonSchemaAnyOf(
+		expr,
+		jsonSchemaArrayOf(jsonSchemaAnyOf(expr, jsonSchema{"type": "object", "minProperties": 1}), 1),
+	)
+	return jsonSchemaAnyOf(
+		expr,
+		jsonSchema{
+			"type": "object",
+			"properties": jsonSchema{
+				"include": combinations,
+				"exclude": combinations,
+			},
+			"additionalProperties": jsonSchemaAnyOf(expr, jsonSchema{"type": "array", "minItems": 1}),
+			"minProperties":        1,
+		},
+	)
+}
+
+func jsonSchemaStep() jsonSchema {
+	str := jsonSchemaRef("string")
+	s := jsonSchemaObject(jsonSchema{
+		"id":                str,
+		"if":                jsonSchemaRef("condition"),
+		"name":              jsonSchemaRef("nullable-string"),
+		"env":               jsonSchemaRef("env"),
+		"continue-on-error": jsonSchemaRef("boolean"),
+		"timeout-minutes":   jsonSchemaRef("timeout-minutes"),
+		"uses":              str,
+		"with":              jsonSchemaMapOf(jsonSchemaRef("nullable-string")),
+		"run":               str,
+		"working-directory": str,
+		"shell":             jsonSchemaRef("shell"),
+	})
+	s["oneOf"] = []jsonSchema{
+		{"required": []string{"run"}, "properties": jsonSchema{"uses": false, "with": false}},
+		{"required": []string{"uses"}, "properties": jsonSchema{"run": false, "shell": false, "working-directory": false}},
+	}
+	return s
+}
+
+func jsonSchemaJob() jsonSchema {
+	str := jsonSchemaRef("string")
+	needs := jsonSchemaStringOrArray(str)
+	common := func() jsonSchema {
+		return jsonSchema{
+			"name":        jsonSchemaRef("nullable-string"),
+			"needs":       needs,
+			"permissions": jsonSchemaRef("permissions"),
+			"concurrency": jsonSchemaRef("concurrency"),
+			"if":          jsonSchemaRef("condition"),
+			"strategy":    jsonSchemaRef("strategy"),
+			"services":    jsonSchemaRef("services"),
+		}
+	}
+
+	normal := common()
+	normal["runs-on"] = jsonSchemaRef("runs-on")
+	normal["environment"] = jsonSchemaAnyOf(str, jsonSchemaObject(jsonSchema{"name": str, "url": str}, "name"))
+	normal["outputs"] = jsonSchema{"type": "object", "additionalProperties": jsonSchemaRef("nullable-string"), "minProperties": 1}
+	normal["env"] = jsonSchemaRef("env")
+	normal["defaults"] = jsonSchemaRef("defaults")
+	normal["steps"] = jsonSchemaArrayOf(jsonSchemaRef("step"), 1)
+	normal["timeout-minutes"] = jsonSchemaRef("timeout-minutes")
+	normal["continue-on-error"] = jsonSchemaRef("boolean")
+	normal["container"] = jsonSchemaRef("container")
+
+	// Only some keys are available on calling reusable workflows
+	// https://docs.github.com/en/actions/using-workflows/reusing-workflows#supported-keywords-for-jobs-that-call-a-reusable-workflow
+	call := common()
+	call["uses"] = str
+	call["with"] = jsonSchemaMapOf(jsonSchemaRef("nullable-string"))
+	call["secrets"] = jsonSchemaAnyOf(
+		jsonSchemaEnum([]string{"inherit"}),
+		jsonSchemaMapOf(jsonSchemaRef("nullable-string")),
+	)
+
+	return jsonSchema{
+		"oneOf": []jsonSchema{
+			jsonSchemaObject(normal, "runs-on", "steps"),
+			jsonSchemaObject(call, "uses"),
+		},
+	}
+}
+
+// WorkflowJSONSchema returns JSON Schema (draft-07) of workflow files which reflects the syntax
+// accepted by actionlint. Editors can use it through yaml-language-server for completions. The
+// runner labels, runner groups, custom shells, and types of repository_dispatch event are taken
+// from the given configuration. The configuration can be nil.
+func WorkflowJSONSchema(cfg *Config) map[string]any {
+	str := jsonSchema{"type": []string{"string", "number", "boolean"}}
+	nullable := jsonSchema{"type": []string{"string", "number", "boolean", "null"}}
+	expr := jsonSchema{"type": "string", "pattern": jsonSchemaExprPattern}
+
+	defs := jsonSchema{
+		"string":          str,
+		"nullable-string": nullable,
+		"expression":      expr,
+		"boolean":         jsonSchemaAnyOf(jsonSchema{"type": "boolean"}, jsonSchemaRef("expression")),
+		"condition":       str,
+		"timeout-minutes": jsonSchemaAnyOf(jsonSchema{"type": "number", "exclusiveMinimum": 0}, jsonSchemaRef("expression")),
+		"env":             jsonSchemaAnyOf(jsonSchemaRef("expression"), jsonSchema{"type": "object", "additionalProperties": jsonSchemaRef("nullable-string"), "minProperties": 1}),
+		"permissions":     jsonSchemaPermissions(),
+		"concurrency":     jsonSchemaAnyOf(jsonSchemaRef("string"), jsonSchemaObject(jsonSchema{"group": jsonSchemaRef("string"), "cancel-in-progress": jsonSchemaRef("boolean")}, "group")),
+		"defaults": jsonSchemaObject(jsonSchema{
+			"run": jsonSchema{
+				"type": "object",
+				"properties": jsonSchema{
+					"shell":             jsonSchemaRef("shell"),
+					"working-directory": jsonSchemaRef("string"),
+				},
+				"additionalProperties": false,
+				"minProperties":        1,
+			},
+		}, "run"),
+		"shell":        jsonSchemaShell(cfg),
+		"runner-label": jsonSchemaRunnerLabel(cfg),
+		"runs-on":      jsonSchemaRunsOn(cfg),
+		"container":    jsonSchemaContainer(),
+		"services":     jsonSchemaAnyOf(jsonSchemaRef("expression"), jsonSchemaMapOf(jsonSchemaRef("container"))),
+		"strategy": jsonSchemaObject(jsonSchema{
+			"matrix":       jsonSchemaMatrix(),
+			"fail-fast":    jsonSchemaRef("boolean"),
+			"max-parallel": jsonSchemaAnyOf(jsonSchema{"type": "integer", "exclusiveMinimum": 0}, jsonSchemaRef("expression")),
+		}),
+		"step": jsonSchemaStep(),
+		"job":  jsonSchemaJob(),
+	}
+
+	w := jsonSchemaObject(jsonSchema{
+		"name":        jsonSchemaRef("nullable-string"),
+		"run-name":    jsonSchemaRef("string"),
+		"on":          jsonSchemaEvents(cfg),
+		"permissions": jsonSchemaRef("permissions"),
+		"env":         jsonSchemaRef("env"),
+		"defaults":    jsonSchemaRef("defaults"),
+		"concurrency": jsonSchemaRef("concurrency"),
+		"jobs": jsonSchema{
+			"type":                 "object",
+			"propertyNames":        jsonSchema{"pattern": jobIDPattern.String()},
+			"additionalProperties": jsonSchemaRef("job"),
+			"minProperties":        1,
+		},
+	}, "on", "jobs")
+	w["$schema"] = "http://json-schema.org/draft-07/schema#"
+	w["title"] = "GitHub Actions workflow accepted by actionlint"
+	w["definitions"] = defs
+	return w
+}
+
+// printWorkflowJSONSchema prints JSON Schema of workflow files generated with the configuration
+// to the writer.
+func printWorkflowJSONSchema(out io.Writer, cfg *Config) error {
+	enc := json.NewEncoder(out)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(WorkflowJSONSchema(cfg))
+}
@@ -0,0 +1,87 @@
+package actionlint
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestWorkflowJSONSchemaConfig(t *testing.T) {
+	cfg := &Config{}
+	cfg.SelfHostedRunner.Labels = []string{"my-runner", "gpu-*"}
+	cfg.SelfHostedRunner.Groups = map[string][]string{"large": {"large-[0-9]"}}
+	cfg.CustomShells = []*CustomShellConfig{{Shell: "deno run {0}"}}
+	cfg.RepositoryDispatch = &RepositoryDispatchConfig{Types: []string{"deploy"}}
+
+	var b bytes.Buffer
+	if err := printWorkflowJSONSchema(&b, cfg); err != nil {
+		t.Fatal(err)
+	}
+	var v map[string]any
+	if err := json.Unmarshal(b.Bytes(), &v); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+
+	out := b.String()
+	for _, s := range []string{
+		`"my-runner"`,
+		`"pattern": "^gpu-.*$"`,
+		`"pattern": "^large-[0-9]$"`,
+		`"large"`,
+		`"deno run {0}"`,
+		`"deploy"`,
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("schema should contain %s", s)
+		}
+	}
+}
+
+func TestWorkflowJSONSchemaEnterpriseHostedLabels(t *testing.T) {
+	cfg := &Config{GitHubEnterprise: &GitHubEnterpriseConfig{HostedLabels: []string{"ghes-runner"}}}
+	b, err := json.Marshal(WorkflowJSONSchema(cfg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(b)
+	if !strings.Contains(out, `"ghes-runner"`) {
+		t.Error("hosted label of GHES is not included")
+	}
+	if strings.Contains(out, `"ubuntu-latest"`) {
+		t.Error("labels of GitHub-hosted runners should not be included on GHES")
+	}
+}
+
+func TestGlobToJSONSchemaPattern(t *testing.T) {
+	testCases := []struct {
+		glob    string
+		matches []string
+		unmatch []string
+	}{
+		{"gpu-*", []string{"gpu-", "gpu-a100"}, []string{"gpu", "my-gpu-a100"}},
+		{"node?", []string{"node1"}, []string{"node", "node12"}},
+		{"v[0-9].x", []string{"v1.x"}, []string{"va.x", "v1-x"}},
+		{"[^a]b", []string{"cb"}, []string{"ab"}},
+		{"a+b", []string{"a+b"}, []string{"aab"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.glob, func(t *testing.T) {
+			re, err := regexp.Compile(globToJSONSchemaPattern(tc.glob))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range tc.matches {
+				if !re.MatchString(s) {
+					t.Errorf("%q should match to %q", re, s)
+				}
+			}
+			for _, s := range tc.unmatch {
+				if re.MatchString(s) {
+					t.Errorf("%q should not match to %q", re, s)
+				}
+			}
+		})
+	}
+}
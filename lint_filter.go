@@ -0,0 +1,107 @@
+package actionlint
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// lintFilter narrows down workflow files and jobs to check with glob patterns given by
+// WorkflowFilters and JobFilters options.
+type lintFilter struct {
+	workflows []string
+	jobs      []string
+}
+
+// newLintFilter creates a new filter with the glob patterns. It returns nil when no pattern is
+// given. Syntax of the patterns is the same as filepath.Match.
+func newLintFilter(workflows, jobs []string) (*lintFilter, error) {
+	if len(workflows) == 0 && len(jobs) == 0 {
+		return nil, nil
+	}
+	for _, ps := range [][]string{workflows, jobs} {
+		for _, p := range ps {
+			if _, err := filepath.Match(p, ""); err != nil {
+				return nil, fmt.Errorf("invalid glob pattern %q to filter workflows or jobs: %w", p, err)
+			}
+		}
+	}
+	// Job IDs are case-insensitive
+	lower := make([]string, 0, len(jobs))
+	for _, p := range jobs {
+		lower = append(lower, strings.ToLower(p))
+	}
+	return &lintFilter{workflows, lower}, nil
+}
+
+func matchAnyGlob(pats []string, names ...string) bool {
+	for _, p := range pats {
+		for _, n := range names {
+			if ok, _ := filepath.Match(p, n); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchFile returns true when the file should be checked. Patterns are matched with the file name
+// with and without the extension like "release.yaml" and "release".
+func (f *lintFilter) matchFile(path string) bool {
+	if f == nil || len(f.workflows) == 0 {
+		return true
+	}
+	b := filepath.Base(path)
+	return matchAnyGlob(f.workflows, b, strings.TrimSuffix(b, filepath.Ext(b)))
+}
+
+// files returns the files which should be checked.
+func (f *lintFilter) files(paths []string) []string {
+	if f == nil || len(f.workflows) == 0 {
+		return paths
+	}
+	ret := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if f.matchFile(p) {
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
+
+// filterErrors returns errors in the jobs whose IDs or names match to the patterns. Lines from the
+// key of the job until the key of the next job are in the job. Errors outside the jobs such as
+// errors in "on:" section are removed. When the workflow is nil (e.g. the file could not be parsed),
+// the errors are returned as-is since the jobs cannot be determined.
+func (f *lintFilter) filterErrors(errs []*Error, w *Workflow) []*Error {
+	if f == nil || len(f.jobs) == 0 || w == nil || len(errs) == 0 {
+		return errs
+	}
+
+	type jobRange struct {
+		start   int
+		matched bool
+	}
+	rs := make([]jobRange, 0, len(w.Jobs))
+	for _, j := range w.Jobs {
+		if j.ID == nil {
+			continue
+		}
+		names := []string{strings.ToLower(j.ID.Value)}
+		if j.Name != nil && !j.Name.ContainsExpression() {
+			names = append(names, strings.ToLower(j.Name.Value))
+		}
+		rs = append(rs, jobRange{j.ID.Pos.Line, matchAnyGlob(f.jobs, names...)})
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i].start < rs[j].start })
+
+	ret := make([]*Error, 0, len(errs))
+	for _, err := range errs {
+		i := sort.Search(len(rs), func(i int) bool { return rs[i].start > err.Line }) - 1
+		if i >= 0 && rs[i].matched {
+			ret = append(ret, err)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,136 @@
+package actionlint
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+)
+
+func TestLintFilterMatchFile(t *testing.T) {
+	f, err := newLintFilter([]string{"release-*", "ci.yml"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		path string
+		want bool
+	}{
+		{filepath.Join(".github", "workflows", "release-prod.yaml"), true},
+		{filepath.Join(".github", "workflows", "release-.yml"), true},
+		{filepath.Join(".github", "workflows", "ci.yml"), true},
+		{filepath.Join(".github", "workflows", "ci.yaml"), false},
+		{filepath.Join(".github", "workflows", "pre-release.yaml"), false},
+		{filepath.Join("release-dir", "test.yaml"), false},
+	}
+	for _, tc := range testCases {
+		if have := f.matchFile(tc.path); have != tc.want {
+			t.Errorf("wanted %v for %q but got %v", tc.want, tc.path, have)
+		}
+	}
+}
+
+func TestLintFilterNoPattern(t *testing.T) {
+	f, err := newLintFilter(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f != nil {
+		t.Fatalf("filter should be nil: %v", f)
+	}
+	errs := []*Error{{Line: 1}}
+	if !f.matchFile("test.yaml") || len(f.files([]string{"a.yaml", "b.yaml"})) != 2 || len(f.filterErrors(errs, &Workflow{})) != 1 {
+		t.Fatal("nil filter should not filter anything")
+	}
+}
+
+func TestLintFilterInvalidPattern(t *testing.T) {
+	for _, tc := range []struct {
+		workflows []string
+		jobs      []string
+	}{
+		{[]string{"["}, nil},
+		{nil, []string{"ok", "a[b"}},
+	} {
+		if _, err := newLintFilter(tc.workflows, tc.jobs); err == nil {
+			t.Errorf("invalid pattern should cause an error: %v %v", tc.workflows, tc.jobs)
+		}
+	}
+}
+
+func TestLintFilterJobErrors(t *testing.T) {
+	src := `on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ github.evnt }}
+  Deploy:
+    name: Deploy to production
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ github.shaa }}
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	errs = []*Error{
+		{Line: 1, Message: "on"},
+		{Line: 6, Message: "build"},
+		{Line: 8, Message: "deploy name"},
+		{Line: 11, Message: "deploy step"},
+	}
+
+	testCases := []struct {
+		what string
+		jobs []string
+		want []string
+	}{
+		{"job ID", []string{"build"}, []string{"build"}},
+		{"job ID is case-insensitive", []string{"DEPLOY"}, []string{"deploy name", "deploy step"}},
+		{"job name glob", []string{"Deploy to *"}, []string{"deploy name", "deploy step"}},
+		{"multiple patterns", []string{"build", "deploy"}, []string{"build", "deploy name", "deploy step"}},
+		{"no job matches", []string{"test"}, []string{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			f, err := newLintFilter(nil, tc.jobs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			have := []string{}
+			for _, err := range f.filterErrors(errs, w) {
+				have = append(have, err.Message)
+			}
+			if len(have) != len(tc.want) {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+			for i := range have {
+				if have[i] != tc.want[i] {
+					t.Fatalf("wanted %q but got %q", tc.want, have)
+				}
+			}
+		})
+	}
+}
+
+func TestLintFilterLinter(t *testing.T) {
+	files := []string{
+		filepath.Join("testdata", "err", "one_error.yaml"),
+		filepath.Join("testdata", "err", "artifact_name_collision.yaml"),
+	}
+	opts := &LinterOptions{WorkflowFilters: []string{"one_*"}}
+	l, err := NewLinter(io.Discard, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = &Config{}
+
+	errs, err := l.LintFiles(files, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 || errs[0].Filepath != files[0] {
+		t.Fatalf("only errors in %q should be reported: %v", files[0], errs)
+	}
+}
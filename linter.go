@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
 	"runtime"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/fatih/color"
@@ -62,14 +64,43 @@ type LinterOptions struct {
 	// Oneline is flag if one line output is enabled. When enabling it, one error is output per one
 	// line. It is useful when reading outputs from programs.
 	Oneline bool
+	// SnippetLines is the number of lines shown before and after the line of each error in source
+	// snippets. When this value is zero, only the line of the error is shown.
+	SnippetLines int
+	// Quiet is flag to print only the paths of files which have at least one error instead of the
+	// errors. Each path is printed once per line. This cannot be used with Format.
+	Quiet bool
+	// Sort is the order of printed errors. "file" sorts errors by file path, line, column, and rule
+	// name. "rule" sorts errors by rule name and then by their positions. "severity" sorts errors
+	// from the most severe one and then by their positions. When this value is empty, files are
+	// printed in the order of checking them and errors in each file are sorted by their positions.
+	// Errors returned from the methods of Linter are not affected by this option.
+	Sort string
+	// Locale is the locale of error messages like "ja_JP.UTF-8". Error messages are translated when
+	// the language is supported. Otherwise they are output in English. "locale" in the config file
+	// takes precedence over this value. actionlint command sets this value from LC_ALL,
+	// LC_MESSAGES, or LANG environment variable.
+	Locale string
+	// Group is flag to group errors by file. The file path and the number of errors are printed
+	// once as the header of each group and the errors in the file follow it without repeating the
+	// path. This cannot be used with Format.
+	Group bool
 	// Shellcheck is executable for running shellcheck external command. It can be command name like
 	// "shellcheck" or file path like "/path/to/shellcheck", "path/to/shellcheck". When this value
 	// is empty, shellcheck won't run to check scripts in workflow file.
 	Shellcheck string
+	// ShellcheckArgs is extra command line arguments of shellcheck separated by whitespaces like
+	// "-o all -e SC2086". They are added after the arguments set by actionlint and "shellcheck"
+	// section in config file so that they take precedence.
+	ShellcheckArgs string
 	// Pyflakes is executable for running pyflakes external command. It can be command name like "pyflakes"
 	// or file path like "/path/to/pyflakes", "path/to/pyflakes". When this value is empty, pyflakes
 	// won't run to check scripts in workflow file.
 	Pyflakes string
+	// PSScriptAnalyzer is executable of PowerShell like "pwsh" to run PSScriptAnalyzer for PowerShell
+	// scripts in workflow file. It can be command name or file path. When this value is empty or
+	// PSScriptAnalyzer module is not installed, PowerShell scripts are not checked.
+	PSScriptAnalyzer string
 	// IgnorePatterns is list of regular expression to filter errors. The pattern is applied to error
 	// messages. When an error is matched, the error is ignored.
 	IgnorePatterns []string
@@ -90,23 +121,229 @@ type LinterOptions struct {
 	// function should return the modified rules.
 	// Note that syntax errors may be reported even if this function returns nil or an empty slice.
 	OnRulesCreated func([]Rule) []Rule
+	// CustomRules is a list of functions to create your own rules. The functions are called on
+	// checking every workflow files and action metadata files since rule instances have states
+	// while visiting a syntax tree. The created rules are applied in addition to the built-in rules
+	// and they are passed to OnRulesCreated. Use AddRule method to add a function.
+	CustomRules []func() Rule
+	// OnFileChecked is a hook called each time checking a file finishes with the path and the errors
+	// found in the file. It allows to show progress incrementally while linting many files. Calls of
+	// this function are serialized so it does not need to be thread-safe. Errors found across
+	// multiple files, such as duplicate workflow names, are only known after all files are checked.
+	// They are passed in an additional call for the same file after the first one.
+	OnFileChecked func(path string, errs []*Error)
+	// RemoteReusableWorkflows is a flag to fetch reusable workflows in remote repositories like
+	// "owner/repo/.github/workflows/x.yml@ref" at `jobs.<job_id>.uses` and validate the workflow calls
+	// in the same way as local reusable workflows. Fetched workflow files are cached on disk.
+	RemoteReusableWorkflows bool
+	// RemoteActions is a flag to fetch metadata files (action.yml) of actions in remote repositories
+	// like "owner/repo@ref" at `jobs.<job_id>.steps.uses` which are not in the popular actions data
+	// set and validate inputs at `with:` with them. Fetched files are cached on disk.
+	RemoteActions bool
+	// RemoteCodeowners is a flag to check owners in CODEOWNERS file exist on GitHub via REST API.
+	// Teams are checked only when an API token is set to $GITHUB_TOKEN environment variable.
+	// Results are not cached on disk.
+	RemoteCodeowners bool
+	// RemoteEnvironments is a flag to check deployment environments at `jobs.<job_id>.environment`
+	// exist in the repository via REST API. An API token with read access to the repository must be
+	// set to $GITHUB_TOKEN environment variable. The repository is detected from "origin" remote of
+	// the Git repository or $GITHUB_REPOSITORY environment variable. Results are not cached on disk.
+	RemoteEnvironments bool
+	// RemoteSecrets is a flag to check secrets at `secrets.X` and configuration variables at
+	// `vars.Y` are set in the repository, its organization, or the environment of the job via REST
+	// API. An API token with read access to the repository must be set to $GITHUB_TOKEN environment
+	// variable. Reusable workflows are not checked since secrets and variables are passed from the
+	// callers. Results are not cached on disk.
+	RemoteSecrets bool
+	// RemoteRunnerGroups is a flag to check runner groups at `jobs.<job_id>.runs-on.group` exist in
+	// the organization and are available to the repository via REST API. An API token with read
+	// access to the organization's runner groups must be set to $GITHUB_TOKEN environment variable.
+	// Results are not cached on disk.
+	RemoteRunnerGroups bool
+	// RemoteDockerImages is a flag to check images of Docker actions like "docker://alpine:3.20" at
+	// `jobs.<job_id>.steps.uses` exist in their registries via Docker Registry HTTP API. Images
+	// which cannot be confirmed, such as private images, are not reported. Results are not cached
+	// on disk. This flag is ignored when Offline is set.
+	RemoteDockerImages bool
+	// CacheDir is a directory path to cache files fetched from remote. When this value is empty,
+	// "actionlint" directory in the user cache directory (e.g. $XDG_CACHE_HOME/actionlint or
+	// ~/.cache/actionlint) is used.
+	CacheDir string
+	// CacheTTL is time to live of files cached on disk. Files cached for longer than this duration
+	// are fetched from remote again. When this value is zero, cached files never expire.
+	CacheTTL time.Duration
+	// Offline is a flag not to access network for fetching files from remote. Only cached files are
+	// used even if they are expired.
+	Offline bool
+	// HTTPClient is an HTTP client used for all network access such as fetching remote files and
+	// calling REST API. When this value is nil, a client is created with HTTPProxy and HTTPTimeout.
+	HTTPClient *http.Client
+	// HTTPProxy is a URL of the proxy server for network access like "http://proxy.example.com:8080".
+	// When this value is empty, $HTTPS_PROXY, $HTTP_PROXY, and $NO_PROXY environment variables are
+	// used. This value is ignored when HTTPClient is set.
+	HTTPProxy string
+	// HTTPTimeout is a timeout of each HTTP request. When this value is zero, 10 seconds is used.
+	// This value is ignored when HTTPClient is set.
+	HTTPTimeout time.Duration
+	// EstimateCost is a flag to estimate billable minutes of GitHub-hosted runners for each workflow.
+	// The estimations are output after errors. Average durations of jobs can be configured with
+	// "cost-estimate" in config file.
+	EstimateCost bool
+	// Profile is a flag to measure wall time spent by each rule and errors reported by it for each
+	// workflow file. The profile of each file and the aggregate of all files are output after
+	// errors. Lint results are not cached while profiling since rules are not run on cache hit.
+	Profile bool
+	// MaxErrors is the maximum number of errors printed in one run. The rest of errors are omitted
+	// from the output and the number of them is printed instead. All errors are still returned from
+	// the methods of Linter. When this value is zero, all errors are printed.
+	MaxErrors int
+	// MaxErrorsPerFile is the maximum number of errors printed for each file. It works in the same
+	// way as MaxErrors. When this value is zero, all errors are printed.
+	MaxErrorsPerFile int
+	// Jobs is the maximum number of workflow files and rules checked concurrently. Rules applied to
+	// one workflow file run concurrently when the number of files is smaller than this value. Zero
+	// means the number of CPUs. This is configured by -j option. Rules in CustomRules and rules added
+	// by OnRulesCreated run sequentially unless they implement ConcurrentRule interface.
+	Jobs int
+	// CacheResults is a flag to cache lint results of workflow files on disk. The results are stored
+	// in "results" directory in CacheDir and keyed by the hash of the file content, the config, the
+	// options, and the version of actionlint. Unchanged workflow files are not checked again on the
+	// next run. The results are not cached when CustomRules or OnRulesCreated is set or when files
+	// are fetched from remote since the results depend on them. Results of workflows which use local
+	// actions or local reusable workflows, or which are checked with files in the repository such as
+	// "verify-paths", are not cached either.
+	CacheResults bool
+	// FS is a file system where workflow files, configuration files, and local actions are read.
+	// When this value is set, file paths given to Linter are slash-separated paths relative to the
+	// root of the file system and WorkingDir is also resolved from the root. It allows to lint
+	// in-memory trees, Git object stores, or zip archives without touching the real file system.
+	// When this value is nil, the OS file system is used. Note that external commands such as
+	// shellcheck are still run on the OS.
+	FS fs.FS
+	// PathPrefixStrip is a prefix of file paths removed from paths in the output. It is useful when
+	// actionlint runs in a container where the repository is mounted at a different path from the
+	// checkout on the host. Paths which don't start with the prefix are output as-is.
+	PathPrefixStrip string
+	// PathPrefixAdd is a prefix of file paths prepended to paths in the output after removing
+	// PathPrefixStrip. Only paths in the output are changed. Errors returned from Linter's methods
+	// still have the original paths.
+	PathPrefixAdd string
+	// WorkflowFilters is a list of glob patterns to select workflow files to check. The patterns are
+	// matched with file names with and without the extension like "release-*". When this value is
+	// empty, all files are checked. Files given to LintFile and Lint are always checked.
+	WorkflowFilters []string
+	// JobFilters is a list of glob patterns to select jobs to check. The patterns are matched with
+	// job IDs and job names. Only errors in the matched jobs are reported. When this value is empty,
+	// errors in all jobs are reported.
+	JobFilters []string
 	// More options will come here
 }
 
+// AddRule adds the function to create your own rule to CustomRules. The function is called on
+// checking each file and the rule is applied in addition to the built-in rules.
+func (o *LinterOptions) AddRule(newRule func() Rule) {
+	o.CustomRules = append(o.CustomRules, newRule)
+}
+
 // Linter is struct to lint workflow files.
 type Linter struct {
-	projects       *Projects
-	out            io.Writer
-	logOut         io.Writer
-	logLevel       LogLevel
-	oneline        bool
-	shellcheck     string
-	pyflakes       string
-	ignorePats     []*regexp.Regexp
-	defaultConfig  *Config
-	errFmt         *ErrorFormatter
-	cwd            string
-	onRulesCreated func([]Rule) []Rule
+	projects        *Projects
+	out             io.Writer
+	logOut          io.Writer
+	logLevel        LogLevel
+	oneline         bool
+	snippetLines    int
+	quiet           bool
+	group           bool
+	sortBy          string
+	messages        *messageCatalog
+	shellcheck      string
+	shellcheckArgs  []string
+	pyflakes        string
+	pwsh            string
+	pwshAvailable   *psscriptanalyzerAvailability
+	ignorePats      []*regexp.Regexp
+	defaultConfig   *Config
+	errFmt          *ErrorFormatter
+	cwd             string
+	customRules     []func() Rule
+	onRulesCreated  func([]Rule) []Rule
+	remote          *RemoteFetcher
+	ghesRemotes     map[string]*RemoteFetcher
+	dockerRegistry  *dockerRegistry
+	remoteWorkflows bool
+	remoteActions   bool
+	remoteOwners    bool
+	remoteEnvs      bool
+	remoteSecrets   bool
+	remoteGroups    bool
+	estimateCost    bool
+	jobs            int
+	results         *resultCache
+	fs              fileSystem
+	onFileChecked   *fileCheckedNotifier
+	profiler        *ruleProfiler
+	maxErrors       int
+	maxFileErrors   int
+	pathStrip       string
+	pathAdd         string
+	filter          *lintFilter
+}
+
+// errorsLimiter limits the number of printed errors by MaxErrors and MaxErrorsPerFile options.
+type errorsLimiter struct {
+	max     int
+	perFile int
+	printed int
+	omitted int
+	files   map[string]int
+}
+
+// allow returns whether the error can be printed. It counts the error as printed or omitted.
+func (lim *errorsLimiter) allow(err *Error) bool {
+	if (lim.max > 0 && lim.printed >= lim.max) || (lim.perFile > 0 && lim.files[err.Filepath] >= lim.perFile) {
+		lim.omitted++
+		return false
+	}
+	lim.printed++
+	lim.files[err.Filepath]++
+	return true
+}
+
+// limit returns the errors to be printed.
+func (lim *errorsLimiter) limit(errs []*Error) []*Error {
+	ret := make([]*Error, 0, len(errs))
+	for _, err := range errs {
+		if lim.allow(err) {
+			ret = append(ret, err)
+		}
+	}
+	return ret
+}
+
+// sourcedError is an error with the source of the file where the error was found. It is used to
+// print errors in multiple files in a different order from the files.
+type sourcedError struct {
+	err *Error
+	src []byte
+}
+
+// fileCheckedNotifier calls the OnFileChecked hook in LinterOptions. Calls are serialized since
+// files are checked concurrently.
+type fileCheckedNotifier struct {
+	mu sync.Mutex
+	fn func(string, []*Error)
+}
+
+func (n *fileCheckedNotifier) notify(path string, errs []*Error) {
+	if n == nil {
+		return
+	}
+	// Copy the slice since the caller may append errors to it and sort it later
+	errs = append([]*Error{}, errs...)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.fn(path, errs)
 }
 
 // NewLinter creates a new Linter instance.
@@ -138,9 +375,11 @@ func NewLinter(out io.Writer, opts *LinterOptions) (*Linter, error) {
 		lout = opts.LogWriter
 	}
 
+	fsys := newFileSystem(opts.FS)
+
 	var cfg *Config
 	if opts.ConfigFile != "" {
-		c, err := ReadConfigFile(opts.ConfigFile)
+		c, err := readConfigFile(opts.ConfigFile, fsys)
 		if err != nil {
 			return nil, err
 		}
@@ -156,6 +395,11 @@ func NewLinter(out io.Writer, opts *LinterOptions) (*Linter, error) {
 		ignore = append(ignore, r)
 	}
 
+	filter, err := newLintFilter(opts.WorkflowFilters, opts.JobFilters)
+	if err != nil {
+		return nil, err
+	}
+
 	var formatter *ErrorFormatter
 	if opts.Format != "" {
 		f, err := NewErrorFormatter(opts.Format)
@@ -166,25 +410,143 @@ func NewLinter(out io.Writer, opts *LinterOptions) (*Linter, error) {
 	}
 
 	cwd := opts.WorkingDir
-	if cwd == "" {
+	if opts.FS != nil {
+		cwd = fsys.Abs(cwd)
+	} else if cwd == "" {
 		if d, err := os.Getwd(); err == nil {
 			cwd = d
 		}
 	}
 
+	client := opts.HTTPClient
+	if client == nil {
+		c, err := newHTTPClient(opts.HTTPProxy, opts.HTTPTimeout)
+		if err != nil {
+			return nil, err
+		}
+		client = c
+	}
+
+	var remote *RemoteFetcher
+	if opts.RemoteReusableWorkflows || opts.RemoteActions || opts.RemoteCodeowners || opts.RemoteEnvironments || opts.RemoteSecrets || opts.RemoteRunnerGroups {
+		var dbg io.Writer
+		if level >= LogLevelDebug {
+			dbg = lout
+		}
+		remote = NewRemoteFetcher(opts.CacheDir, dbg)
+		remote.SetHTTPClient(client)
+		remote.SetCacheTTL(opts.CacheTTL)
+		if opts.Offline {
+			remote.EnableOffline()
+		}
+	}
+
+	if opts.Format != "" {
+		if opts.Quiet {
+			return nil, errors.New("quiet output cannot be used with custom format of error messages")
+		}
+		if opts.Group {
+			return nil, errors.New("grouped output cannot be used with custom format of error messages")
+		}
+	}
+
+	switch opts.Sort {
+	case "", "file", "rule", "severity":
+	default:
+		return nil, fmt.Errorf("order of errors must be one of \"file\", \"rule\", or \"severity\" but got %q", opts.Sort)
+	}
+
+	if opts.SnippetLines < 0 {
+		return nil, fmt.Errorf("number of lines in source snippets must not be negative but got %d", opts.SnippetLines)
+	}
+
+	if opts.MaxErrors < 0 {
+		return nil, fmt.Errorf("maximum number of errors must not be negative but got %d", opts.MaxErrors)
+	}
+	if opts.MaxErrorsPerFile < 0 {
+		return nil, fmt.Errorf("maximum number of errors per file must not be negative but got %d", opts.MaxErrorsPerFile)
+	}
+
+	jobs := opts.Jobs
+	if jobs < 0 {
+		return nil, fmt.Errorf("number of jobs must not be negative but got %d", jobs)
+	}
+	if jobs == 0 {
+		jobs = runtime.NumCPU()
+	}
+
+	var registry *dockerRegistry
+	if opts.RemoteDockerImages && !opts.Offline {
+		var dbg io.Writer
+		if level >= LogLevelDebug {
+			dbg = lout
+		}
+		registry = newDockerRegistry(client, dbg)
+	}
+
+	var results *resultCache
+	if opts.CacheResults && len(opts.CustomRules) == 0 && opts.OnRulesCreated == nil && remote == nil && registry == nil && !opts.Profile {
+		var dbg io.Writer
+		if level >= LogLevelDebug {
+			dbg = lout
+		}
+		if v := resultCacheVersion(); v != "" {
+			results = newResultCache(opts.CacheDir, resultCacheBase(v, opts), dbg)
+		}
+	}
+
+	var profiler *ruleProfiler
+	if opts.Profile {
+		profiler = &ruleProfiler{}
+	}
+
+	var notifier *fileCheckedNotifier
+	if opts.OnFileChecked != nil {
+		notifier = &fileCheckedNotifier{fn: opts.OnFileChecked}
+	}
+
 	return &Linter{
-		NewProjects(),
+		newProjectsInFS(fsys),
 		out,
 		lout,
 		level,
 		opts.Oneline,
+		opts.SnippetLines,
+		opts.Quiet,
+		opts.Group,
+		opts.Sort,
+		findMessageCatalog(localeLanguage(opts.Locale)),
 		opts.Shellcheck,
+		strings.Fields(opts.ShellcheckArgs),
 		opts.Pyflakes,
+		opts.PSScriptAnalyzer,
+		&psscriptanalyzerAvailability{},
 		ignore,
 		cfg,
 		formatter,
 		cwd,
+		opts.CustomRules,
 		opts.OnRulesCreated,
+		remote,
+		map[string]*RemoteFetcher{},
+		registry,
+		opts.RemoteReusableWorkflows,
+		opts.RemoteActions,
+		opts.RemoteCodeowners,
+		opts.RemoteEnvironments,
+		opts.RemoteSecrets,
+		opts.RemoteRunnerGroups,
+		opts.EstimateCost,
+		jobs,
+		results,
+		fsys,
+		notifier,
+		profiler,
+		opts.MaxErrors,
+		opts.MaxErrorsPerFile,
+		opts.PathPrefixStrip,
+		opts.PathPrefixAdd,
+		filter,
 	}, nil
 }
 
@@ -196,6 +558,32 @@ func (l *Linter) log(args ...interface{}) {
 	fmt.Fprintln(l.logOut, args...)
 }
 
+// logCronSchedules outputs the descriptions and the next run times of "schedule" events in the
+// workflow to catch mistakes like a schedule running daily though it is intended to run weekly.
+func (l *Linter) logCronSchedules(w *Workflow, now time.Time) {
+	for _, e := range w.On {
+		s, ok := e.(*ScheduledEvent)
+		if !ok {
+			continue
+		}
+		for _, c := range s.Cron {
+			sched, err := parseCronSchedule(c.Value) // Defined at cron.go
+			if err != nil {
+				continue // Reported by "events" rule
+			}
+			ts := nextCronRuns(sched, now, 3)
+			next := make([]string, 0, len(ts))
+			for _, t := range ts {
+				next = append(next, t.Format("2006-01-02 15:04 Mon"))
+			}
+			if len(next) == 0 {
+				next = append(next, "never")
+			}
+			l.log(fmt.Sprintf("Schedule %q at", c.Value), c.Pos.String()+":", describeCronSchedule(sched), "(UTC). Next runs:", strings.Join(next, ", "))
+		}
+	}
+}
+
 func (l *Linter) debug(format string, args ...interface{}) {
 	if l.logLevel < LogLevelDebug {
 		return
@@ -239,31 +627,117 @@ func (l *Linter) GenerateDefaultConfig(dir string) error {
 
 // LintRepository lints YAML workflow files and outputs the errors to given writer. It finds the nearest
 // `.github/workflows` directory based on `dir` and applies lint rules to all YAML workflow files
-// under the directory.
+// under the directory. Action metadata files (action.yml) in the repository are also checked.
 func (l *Linter) LintRepository(dir string) ([]*Error, error) {
+	return l.LintRepositoryContext(context.Background(), dir)
+}
+
+// LintRepositoryContext is the same as LintRepository but it takes the context. When the context is
+// canceled, linting is stopped and the error of the context is returned.
+func (l *Linter) LintRepositoryContext(ctx context.Context, dir string) ([]*Error, error) {
 	l.log("Linting all workflow files in repository:", dir)
 
-	p, err := l.projects.At(dir)
+	p, files, err := l.repositoryFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	return l.LintFilesContext(ctx, files, p)
+}
+
+// LintRepositories lints all workflow files in multiple repositories in one run. Each directory is
+// resolved to its repository as the same as LintRepository and files in each repository are checked
+// with the config file of the repository. The summary of errors grouped by repository is output after
+// the errors.
+func (l *Linter) LintRepositories(dirs []string) ([]*Error, error) {
+	return l.LintRepositoriesContext(context.Background(), dirs)
+}
+
+// LintRepositoriesContext is the same as LintRepositories but it takes the context. When the context
+// is canceled, linting is stopped and the error of the context is returned.
+func (l *Linter) LintRepositoriesContext(ctx context.Context, dirs []string) ([]*Error, error) {
+	l.log("Linting all workflow files in", len(dirs), "repositories")
+
+	repos := make([]*repositorySummary, 0, len(dirs))
+	seen := map[*Project]struct{}{}
+	all := []string{}
+	for _, d := range dirs {
+		p, files, err := l.repositoryFiles(d)
+		if err != nil {
+			return nil, fmt.Errorf("could not lint repository %q: %w", d, err)
+		}
+		if _, ok := seen[p]; ok {
+			l.log("Skipped repository", d, "since it was already added")
+			continue
+		}
+		seen[p] = struct{}{}
+		repos = append(repos, &repositorySummary{name: d, proj: p, files: len(files)})
+		all = append(all, files...)
+	}
+
+	// Project is not given so that each file is checked with the project (and its config) which
+	// the file belongs to
+	errs, err := l.LintFilesContext(ctx, all, nil)
 	if err != nil {
 		return nil, err
 	}
+	l.printRepositoriesSummary(repos, errs)
+	return errs, nil
+}
+
+// repositoryFiles finds the project which the directory belongs to and collects all files to check
+// in the project.
+func (l *Linter) repositoryFiles(dir string) (*Project, []string, error) {
+	p, err := l.projects.At(dir)
+	if err != nil {
+		return nil, nil, err
+	}
 	if p == nil {
-		return nil, fmt.Errorf("no project was found in any parent directories of %q. check workflows directory is put correctly in your Git repository", dir)
+		return nil, nil, fmt.Errorf("no project was found in any parent directories of %q. check workflows directory is put correctly in your Git repository", dir)
 	}
 
 	l.log("Detected project:", p.RootDir())
 	wd := p.WorkflowsDir()
-	return l.LintDir(wd, p)
+	files, err := l.collectYAMLFiles(wd)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cfg := l.config(p); cfg == nil || !cfg.DiscoverFiles {
+		return p, files, nil
+	}
+
+	nested, actions, err := findProjectFiles(p.RootDir(), wd, l.fs)
+	if err != nil {
+		return nil, nil, err
+	}
+	l.log("Found", len(nested), "workflow files in nested workflows directories and", len(actions), "action metadata files")
+
+	files = append(files, nested...)
+	return p, append(files, actions...), nil
 }
 
 // LintDir lints all YAML workflow files in the given directory recursively.
 func (l *Linter) LintDir(dir string, project *Project) ([]*Error, error) {
+	return l.LintDirContext(context.Background(), dir, project)
+}
+
+// LintDirContext is the same as LintDir but it takes the context. When the context is canceled,
+// linting is stopped and the error of the context is returned.
+func (l *Linter) LintDirContext(ctx context.Context, dir string, project *Project) ([]*Error, error) {
+	files, err := l.collectYAMLFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	return l.LintFilesContext(ctx, files, project)
+}
+
+// collectYAMLFiles collects all YAML files in the directory recursively. The file paths are sorted.
+func (l *Linter) collectYAMLFiles(dir string) ([]string, error) {
 	files := []string{}
-	if err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+	if err := l.fs.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
-		if info.IsDir() {
+		if d.IsDir() {
 			return nil
 		}
 		if strings.HasSuffix(path, ".yml") || strings.HasSuffix(path, ".yaml") {
@@ -282,36 +756,149 @@ func (l *Linter) LintDir(dir string, project *Project) ([]*Error, error) {
 	// To make output deterministic, sort order of file paths
 	sort.Strings(files)
 
-	return l.LintFiles(files, project)
+	return files, nil
+}
+
+// Directories which are not searched for workflows and actions in the repository since they
+// usually contain third-party code or test data.
+var skippedDirsOnDiscovery = map[string]struct{}{
+	"node_modules": {},
+	"vendor":       {},
+	"testdata":     {},
+}
+
+// findProjectFiles finds workflow files and action metadata files in the repository other than the
+// workflows directory of the repository. It is used only when "discover-files" is enabled in the
+// config file. It finds the following files:
+//
+//   - Workflow files in nested ".github/workflows" directories of subprojects in monorepo, such as
+//     "packages/foo/.github/workflows/ci.yml"
+//   - Action metadata files "action.yml" and "action.yaml" at the root of the repository, in the
+//     directories at the root such as "my-action/action.yml", and in ".github" directories such as
+//     ".github/actions/my-action/action.yml"
+//   - Workflow templates and their properties files in "workflow-templates" directory at the root of
+//     the repository. The directory exists in ".github" repository of organization
+//   - Dependabot configuration file ".github/dependabot.yml" at the root of the repository
+//   - Issue forms in ".github/ISSUE_TEMPLATE" directory at the root of the repository
+//   - Release notes configuration file ".github/release.yml" at the root of the repository
+//   - CODEOWNERS files in ".github" directory, at the root, and in "docs" directory of the
+//     repository
+//
+// Hidden directories except for ".github", nested Git repositories, and the directories in
+// skippedDirsOnDiscovery are skipped. The file paths are sorted.
+func findProjectFiles(root, workflows string, fsys fileSystem) ([]string, []string, error) {
+	wfs, actions := []string{}, []string{}
+	if err := fsys.WalkDir(root, func(path string, info fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if path == root {
+				return nil
+			}
+			if path == workflows {
+				return filepath.SkipDir
+			}
+			n := info.Name()
+			if _, ok := skippedDirsOnDiscovery[n]; ok || strings.HasPrefix(n, ".") && n != ".github" {
+				return filepath.SkipDir
+			}
+			if _, err := fsys.Stat(filepath.Join(path, ".git")); err == nil {
+				return filepath.SkipDir // Nested repository such as Git submodule is another project
+			}
+			return nil
+		}
+
+		dir := filepath.Dir(path)
+		rel, err := filepath.Rel(root, dir)
+		if err != nil {
+			return nil
+		}
+		parts := strings.Split(filepath.ToSlash(rel), "/")
+		for i, p := range parts {
+			if p == ".github" && i+1 < len(parts) && parts[i+1] == "workflows" {
+				if strings.HasSuffix(path, ".yml") || strings.HasSuffix(path, ".yaml") {
+					wfs = append(wfs, path)
+				}
+				return nil
+			}
+		}
+		if rel == ".github" && (isDependabotConfigFile(path) || isReleaseNotesConfigFile(path)) {
+			wfs = append(wfs, path)
+			return nil
+		}
+		if (rel == ".github" || rel == "." || rel == "docs") && isCodeownersFile(path) {
+			wfs = append(wfs, path)
+			return nil
+		}
+		if rel == filepath.Join(".github", "ISSUE_TEMPLATE") && isIssueFormFile(path) {
+			wfs = append(wfs, path)
+			return nil
+		}
+		if rel == "workflow-templates" && (isWorkflowTemplateFile(path) || isWorkflowTemplatePropertiesFile(path)) {
+			wfs = append(wfs, path)
+			return nil
+		}
+		if isActionMetadataFile(path) && (len(parts) == 1 || contains(parts, ".github")) {
+			actions = append(actions, path)
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, fmt.Errorf("could not find workflow files and action metadata files in %q: %w", root, err)
+	}
+	sort.Strings(wfs)
+	sort.Strings(actions)
+	return wfs, actions, nil
 }
 
 // LintFiles lints YAML workflow files and outputs the errors to given writer. It applies lint
 // rules to all given files. The project parameter can be nil. In the case, a project is detected
 // from the file path.
 func (l *Linter) LintFiles(filepaths []string, project *Project) ([]*Error, error) {
+	return l.LintFilesContext(context.Background(), filepaths, project)
+}
+
+// LintFilesContext is the same as LintFiles but it takes the context. When the context is canceled,
+// running external commands such as shellcheck are killed and the error of the context is returned.
+// Embedding applications can use it to cancel long lint runs or to set deadlines.
+func (l *Linter) LintFilesContext(ctx context.Context, filepaths []string, project *Project) ([]*Error, error) {
+	if fs := l.filter.files(filepaths); len(fs) < len(filepaths) {
+		l.log("Skipped", len(filepaths)-len(fs), "files which don't match to workflow filters")
+		filepaths = fs
+	}
 	n := len(filepaths)
 	switch n {
 	case 0:
 		return []*Error{}, nil
 	case 1:
-		return l.LintFile(filepaths[0], project)
+		return l.LintFileContext(ctx, filepaths[0], project)
 	}
 
 	l.log("Linting", n, "files")
 
 	cwd := l.cwd
-	cpus := runtime.NumCPU()
-	proc := newConcurrentProcess(cpus)
-	sema := semaphore.NewWeighted(int64(cpus))
-	ctx := context.Background()
+	proc := newConcurrentProcessWithContext(ctx, l.jobs)
+	sema := semaphore.NewWeighted(int64(l.jobs))
+	// Files are already checked in parallel. Rules in each file can use the rest of the concurrency
+	ruleJobs := l.jobs / n
+	if ruleJobs < 1 {
+		ruleJobs = 1
+	}
 	dbg := l.debugWriter()
 	acf := NewLocalActionsCacheFactory(dbg)
 	rwcf := NewLocalReusableWorkflowCacheFactory(cwd, dbg)
+	rwcf.EnableRemote(l.remoteWorkflowsFetcher(nil))
 
 	type workspace struct {
 		path string
 		errs []*Error
 		src  []byte
+		proj *Project
+		name *String
+		wf   *Workflow
+		// extra is errors found across files after checking all files
+		extra []*Error
 	}
 
 	ws := make([]workspace, 0, len(filepaths))
@@ -319,6 +906,8 @@ func (l *Linter) LintFiles(filepaths []string, project *Project) ([]*Error, erro
 		ws = append(ws, workspace{path: p})
 	}
 
+	remoteEnabled := map[*LocalReusableWorkflowCache]struct{}{}
+	remoteActionsEnabled := map[*LocalActionsCache]struct{}{}
 	eg := errgroup.Group{}
 	for i := range ws {
 		// Each element of ws is accessed by single goroutine so mutex is unnecessary
@@ -334,12 +923,25 @@ func (l *Linter) LintFiles(filepaths []string, project *Project) ([]*Error, erro
 			proj = p
 		}
 		ac := acf.GetCache(proj) // #173
+		if _, ok := remoteActionsEnabled[ac]; !ok {
+			ac.EnableRemote(l.remoteActionsFetcher(proj))
+			ac.ctx = ctx
+			remoteActionsEnabled[ac] = struct{}{}
+		}
 		rwc := rwcf.GetCache(proj)
+		if _, ok := remoteEnabled[rwc]; !ok {
+			// The fetcher depends on the project config. Set it before any goroutine uses the cache
+			rwc.EnableRemote(l.remoteWorkflowsFetcher(proj))
+			rwc.ctx = ctx
+			remoteEnabled[rwc] = struct{}{}
+		}
 
 		eg.Go(func() error {
 			// Bound concurrency on reading files to avoid "too many files to open" error (issue #3)
-			sema.Acquire(ctx, 1)
-			src, err := os.ReadFile(w.path)
+			if err := sema.Acquire(ctx, 1); err != nil {
+				return err
+			}
+			src, err := l.fs.ReadFile(w.path)
 			sema.Release(1)
 			if err != nil {
 				return fmt.Errorf("could not read %q: %w", w.path, err)
@@ -350,17 +952,24 @@ func (l *Linter) LintFiles(filepaths []string, project *Project) ([]*Error, erro
 					w.path = r // Use relative path if possible
 				}
 			}
-			errs, err := l.check(w.path, src, proj, proc, ac, rwc)
+			errs, wf, err := l.check(w.path, src, proj, proc, ac, rwc, ruleJobs)
 			if err != nil {
 				return fmt.Errorf("fatal error while checking %s: %w", w.path, err)
 			}
+			l.onFileChecked.notify(w.path, errs)
 			w.src = src
 			w.errs = errs
+			w.proj = proj
+			w.wf = wf
+			if wf != nil {
+				w.name = wf.Name
+			}
 			return nil
 		})
 	}
 
 	if err := eg.Wait(); err != nil {
+		proc.wait()
 		return nil, err
 	}
 
@@ -371,6 +980,72 @@ func (l *Linter) LintFiles(filepaths []string, project *Project) ([]*Error, erro
 	// After traversing all workflows, `proc.run()` is no longer called so `proc.wait()` can be
 	// called safely.
 	proc.wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Check duplicate workflow names across files in the same project. This cannot be checked by
+	// rules since each rule only sees one workflow file.
+	type seenName struct {
+		proj *Project
+		name string
+	}
+	seen := map[seenName]string{}
+	for i := range ws {
+		w := &ws[i]
+		if w.name == nil || w.name.Value == "" || w.name.ContainsExpression() {
+			continue
+		}
+		k := seenName{w.proj, w.name.Value}
+		first, ok := seen[k]
+		if !ok {
+			seen[k] = w.path
+			continue
+		}
+		err := &Error{
+			Message:  fmt.Sprintf("workflow name %q is already used by workflow %q. workflows with the same name are ambiguous in Actions UI, branch protection rules, and \"workflow_run\" event", w.name.Value, first),
+			Filepath: w.path,
+			Line:     w.name.Pos.Line,
+			Column:   w.name.Pos.Col,
+			Kind:     "workflow-name",
+		}
+		if !l.ignored(err) {
+			w.errs = append(w.errs, err)
+			w.extra = append(w.extra, err)
+			sort.Stable(ByErrorPosition(w.errs))
+		}
+	}
+
+	// Check duplicate sequences of steps across workflows in the same project
+	projs := []*Project{}
+	targets := map[*Project][]duplicateStepsTarget{}
+	indices := map[*Project][]int{}
+	for i := range ws {
+		w := &ws[i]
+		if _, ok := targets[w.proj]; !ok {
+			projs = append(projs, w.proj)
+		}
+		targets[w.proj] = append(targets[w.proj], duplicateStepsTarget{w.path, w.wf})
+		indices[w.proj] = append(indices[w.proj], i)
+	}
+	for _, p := range projs {
+		for i, errs := range l.checkDuplicateSteps(targets[p], p) {
+			if len(errs) == 0 {
+				continue
+			}
+			w := &ws[indices[p][i]]
+			w.errs = append(w.errs, errs...)
+			w.extra = append(w.extra, errs...)
+			sort.Stable(ByErrorPosition(w.errs))
+		}
+	}
+	for i := range ws {
+		if w := &ws[i]; len(w.extra) > 0 {
+			l.messageCatalog(w.proj).localize(w.extra)
+			sort.Stable(ByErrorPosition(w.extra))
+			l.onFileChecked.notify(w.path, w.extra)
+		}
+	}
 
 	total := 0
 	for i := range ws {
@@ -378,25 +1053,55 @@ func (l *Linter) LintFiles(filepaths []string, project *Project) ([]*Error, erro
 	}
 
 	all := make([]*Error, 0, total)
+	printed := make([]sourcedError, 0, total)
+	for i := range ws {
+		w := &ws[i]
+		for _, err := range l.mapErrorPaths(w.errs) {
+			printed = append(printed, sourcedError{err, w.src})
+		}
+		all = append(all, w.errs...)
+	}
+	if l.sortBy != "" {
+		less := errorsLessBy(l.sortBy)
+		sort.SliceStable(printed, func(i, j int) bool {
+			return less(printed[i].err, printed[j].err)
+		})
+	}
+
+	lim := l.newErrorsLimiter()
 	if l.errFmt != nil {
 		temp := make([]*ErrorTemplateFields, 0, total)
-		for i := range ws {
-			w := &ws[i]
-			for _, err := range w.errs {
-				temp = append(temp, err.GetTemplateFields(w.src))
+		for _, e := range printed {
+			if lim.allow(e.err) {
+				temp = append(temp, e.err.GetTemplateFields(e.src))
 			}
-			all = append(all, w.errs...)
 		}
 		if err := l.errFmt.Print(l.out, temp); err != nil {
 			return nil, err
 		}
 	} else {
-		for i := range ws {
-			w := &ws[i]
-			l.printErrors(w.errs, w.src)
-			all = append(all, w.errs...)
+		for len(printed) > 0 {
+			// Print consecutive errors in the same file at once to group them
+			path := printed[0].err.Filepath
+			n := 0
+			errs := []*Error{}
+			for n < len(printed) && printed[n].err.Filepath == path {
+				if lim.allow(printed[n].err) {
+					errs = append(errs, printed[n].err)
+				}
+				n++
+			}
+			l.printErrors(errs, printed[0].src)
+			printed = printed[n:]
 		}
 	}
+	l.printOmittedErrors(lim)
+
+	for i := range ws {
+		w := &ws[i]
+		l.printCostEstimate(w.path, w.wf, w.proj)
+	}
+	l.printProfile()
 
 	l.log("Found", total, "errors in", n, "files")
 
@@ -406,6 +1111,12 @@ func (l *Linter) LintFiles(filepaths []string, project *Project) ([]*Error, erro
 // LintFile lints one YAML workflow file and outputs the errors to given writer. The project
 // parameter can be nil. In the case, the project is detected from the given path.
 func (l *Linter) LintFile(path string, project *Project) ([]*Error, error) {
+	return l.LintFileContext(context.Background(), path, project)
+}
+
+// LintFileContext is the same as LintFile but it takes the context. When the context is canceled,
+// linting is stopped and the error of the context is returned.
+func (l *Linter) LintFileContext(ctx context.Context, path string, project *Project) ([]*Error, error) {
 	if project == nil {
 		p, err := l.projects.At(path)
 		if err != nil {
@@ -414,7 +1125,7 @@ func (l *Linter) LintFile(path string, project *Project) ([]*Error, error) {
 		project = p
 	}
 
-	src, err := os.ReadFile(path)
+	src, err := l.fs.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("could not read %q: %w", path, err)
 	}
@@ -425,21 +1136,41 @@ func (l *Linter) LintFile(path string, project *Project) ([]*Error, error) {
 		}
 	}
 
-	proc := newConcurrentProcess(runtime.NumCPU())
+	proc := newConcurrentProcessWithContext(ctx, l.jobs)
 	dbg := l.debugWriter()
 	localActions := NewLocalActionsCache(project, dbg)
+	localActions.EnableRemote(l.remoteActionsFetcher(project))
+	localActions.ctx = ctx
 	localReusableWorkflows := NewLocalReusableWorkflowCache(project, l.cwd, dbg)
-	errs, err := l.check(path, src, project, proc, localActions, localReusableWorkflows)
+	localReusableWorkflows.EnableRemote(l.remoteWorkflowsFetcher(project))
+	localReusableWorkflows.ctx = ctx
+	errs, w, err := l.check(path, src, project, proc, localActions, localReusableWorkflows, l.jobs)
 	proc.wait()
 	if err != nil {
 		return nil, err
 	}
+	// Fetching remote files is aborted silently when the context is canceled. Do not report the
+	// incomplete result
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if dup := l.checkDuplicateSteps([]duplicateStepsTarget{{path, w}}, project); len(dup) > 0 && len(dup[0]) > 0 {
+		l.messageCatalog(project).localize(dup[0])
+		errs = append(errs, dup[0]...)
+		sort.Stable(ByErrorPosition(errs))
+	}
+	l.onFileChecked.notify(path, errs)
 
+	lim := l.newErrorsLimiter()
+	printed := l.mapErrorPaths(lim.limit(l.sortErrorsForPrint(errs)))
 	if l.errFmt != nil {
-		l.errFmt.PrintErrors(l.out, errs, src)
+		l.errFmt.PrintErrors(l.out, printed, src)
 	} else {
-		l.printErrors(errs, src)
+		l.printErrors(printed, src)
 	}
+	l.printOmittedErrors(lim)
+	l.printCostEstimate(path, w, project)
+	l.printProfile()
 	return errs, err
 }
 
@@ -448,8 +1179,14 @@ func (l *Linter) LintFile(path string, project *Project) ([]*Error, error) {
 // from STDIN.
 // When nil is passed to the project parameter, it tries to find the project from the path parameter.
 func (l *Linter) Lint(path string, content []byte, project *Project) ([]*Error, error) {
+	return l.LintContext(context.Background(), path, content, project)
+}
+
+// LintContext is the same as Lint but it takes the context. When the context is canceled, linting
+// is stopped and the error of the context is returned.
+func (l *Linter) LintContext(ctx context.Context, path string, content []byte, project *Project) ([]*Error, error) {
 	if project == nil && path != "<stdin>" {
-		if _, err := os.Stat(path); !errors.Is(err, fs.ErrNotExist) {
+		if _, err := l.fs.Stat(path); !errors.Is(err, fs.ErrNotExist) {
 			p, err := l.projects.At(path)
 			if err != nil {
 				return nil, err
@@ -457,23 +1194,125 @@ func (l *Linter) Lint(path string, content []byte, project *Project) ([]*Error,
 			project = p
 		}
 	}
-	proc := newConcurrentProcess(runtime.NumCPU())
+	proc := newConcurrentProcessWithContext(ctx, l.jobs)
 	dbg := l.debugWriter()
 	localActions := NewLocalActionsCache(project, dbg)
+	localActions.EnableRemote(l.remoteActionsFetcher(project))
+	localActions.ctx = ctx
 	localReusableWorkflows := NewLocalReusableWorkflowCache(project, l.cwd, dbg)
-	errs, err := l.check(path, content, project, proc, localActions, localReusableWorkflows)
+	localReusableWorkflows.EnableRemote(l.remoteWorkflowsFetcher(project))
+	localReusableWorkflows.ctx = ctx
+	errs, w, err := l.check(path, content, project, proc, localActions, localReusableWorkflows, l.jobs)
 	proc.wait()
 	if err != nil {
 		return nil, err
 	}
+	// Fetching remote files is aborted silently when the context is canceled. Do not report the
+	// incomplete result
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if dup := l.checkDuplicateSteps([]duplicateStepsTarget{{path, w}}, project); len(dup) > 0 && len(dup[0]) > 0 {
+		l.messageCatalog(project).localize(dup[0])
+		errs = append(errs, dup[0]...)
+		sort.Stable(ByErrorPosition(errs))
+	}
+	l.onFileChecked.notify(path, errs)
+	lim := l.newErrorsLimiter()
+	printed := l.mapErrorPaths(lim.limit(l.sortErrorsForPrint(errs)))
 	if l.errFmt != nil {
-		l.errFmt.PrintErrors(l.out, errs, content)
+		l.errFmt.PrintErrors(l.out, printed, content)
+	} else {
+		l.printErrors(printed, content)
+	}
+	l.printOmittedErrors(lim)
+	l.printCostEstimate(path, w, project)
+	l.printProfile()
+	return errs, nil
+}
+
+// LintExpression parses and type-checks the single expression given as a string and outputs the
+// errors and the type of the expression to the writer. The expression can be surrounded by ${{ }}.
+// When the event parameter is not empty, `github.event` is typed as the webhook payload of the
+// event. This is useful to check snippets of expressions which are not in workflow files.
+func (l *Linter) LintExpression(src, event string) ([]*Error, error) {
+	const path = "<expression>"
+
+	var ev *ObjectType
+	if event != "" {
+		if _, ok := webhookPayloadProps[event]; !ok && event != "workflow_call" {
+			ns := make([]string, 0, len(webhookPayloadProps)+1)
+			for n := range webhookPayloadProps {
+				ns = append(ns, n)
+			}
+			ns = append(ns, "workflow_call")
+			return nil, fmt.Errorf("unknown event %q for -context. available events are %s", event, sortedQuotes(ns))
+		}
+		ev = eventPayloadType([]Event{&WebhookEvent{Hook: &String{Value: event}}})
+	}
+
+	// Strip ${{ }} surrounding the expression. The column base is adjusted to point the position
+	// in the given string.
+	expr, col := src, 1
+	if t := strings.TrimSpace(src); strings.HasPrefix(t, "${{") && strings.HasSuffix(t, "}}") {
+		col = strings.Index(src, "${{") + 4
+		expr = strings.TrimSuffix(t, "}}")[3:]
+	}
+	expr += "}}" // }} is necessary since lexer lexes it as end of tokens
+
+	errs := []*Error{}
+	report := func(err *ExprError, severity string) {
+		e := &Error{
+			Message:  err.Message,
+			Filepath: path,
+			Line:     err.Line,
+			Column:   err.Column - 1 + col,
+			Kind:     "expression",
+			Severity: severity,
+		}
+		if !l.ignored(e) {
+			errs = append(errs, e)
+		}
+	}
+
+	var ty ExprType
+	n, perr := NewExprParser().Parse(NewExprLexer(expr))
+	if perr != nil {
+		report(perr, "")
 	} else {
-		l.printErrors(errs, content)
+		if ts, _, err := LexExpression(expr); err == nil {
+			for _, err := range newExprPrecedenceChecker(ts).check(n) {
+				report(err.ExprError, err.severity)
+			}
+		}
+		var vars []string
+		if cfg := l.config(nil); cfg != nil {
+			vars = cfg.ConfigVariables
+		}
+		c := NewExprSemanticsChecker(false, vars)
+		if ev != nil {
+			c.UpdateEvent(ev)
+		}
+		t, es := c.Check(n)
+		for _, err := range es {
+			report(err, "")
+		}
+		ty = t
+	}
+	sort.Stable(ByErrorPosition(errs))
+
+	if l.errFmt != nil {
+		return errs, l.errFmt.PrintErrors(l.out, errs, []byte(src))
+	}
+	l.printErrors(errs, []byte(src))
+	if ty != nil {
+		fmt.Fprintf(l.out, "type: %s\n", ty.String())
 	}
 	return errs, nil
 }
 
+// check checks the file and sets the byte offsets of the errors in the content. See checkFile for
+// the details.
 func (l *Linter) check(
 	path string,
 	content []byte,
@@ -481,9 +1320,35 @@ func (l *Linter) check(
 	proc *concurrentProcess,
 	localActions *LocalActionsCache,
 	localReusableWorkflows *LocalReusableWorkflowCache,
-) ([]*Error, error) {
+	jobs int,
+) ([]*Error, *Workflow, error) {
+	if err := proc.ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	errs, w, err := l.checkFile(path, content, project, proc, localActions, localReusableWorkflows, jobs)
+	if err != nil {
+		return nil, nil, err
+	}
+	errs = l.filter.filterErrors(errs, w)
+	newSourceLines(content).setOffsets(errs)
+	l.messageCatalog(project).localize(errs)
+	return errs, w, nil
+}
+
+// checkFile checks the file and returns the errors and the workflow syntax tree. The syntax tree is
+// nil when the file is not a workflow file or it could not be parsed.
+func (l *Linter) checkFile(
+	path string,
+	content []byte,
+	project *Project,
+	proc *concurrentProcess,
+	localActions *LocalActionsCache,
+	localReusableWorkflows *LocalReusableWorkflowCache,
+	jobs int,
+) ([]*Error, *Workflow, error) {
 	// Note: This method is called to check multiple files in parallel.
 	// It must be thread safe assuming fields of Linter are not modified while running.
+	// The jobs parameter is the maximum number of rules visiting the workflow concurrently.
 
 	var start time.Time
 	if l.logLevel >= LogLevelVerbose {
@@ -495,49 +1360,246 @@ func (l *Linter) check(
 		l.log("Using project at", project.RootDir())
 	}
 
-	var cfg *Config
-	if l.defaultConfig != nil {
-		// `-config-file` option has higher prioritiy than repository config file
-		cfg = l.defaultConfig
-	} else if project != nil {
-		cfg = project.Config()
-	}
+	cfg := l.config(project)
 	if cfg != nil {
 		l.debug("Config: %#v", cfg)
 	} else {
 		l.debug("No config was found")
 	}
 
-	w, all := Parse(content)
+	if isWorkflowTemplatePropertiesFile(path) {
+		return l.checkWorkflowTemplateProperties(path, content), nil, nil
+	}
+
+	if isDependabotConfigFile(path) {
+		all := l.checkDependabot(path, content)
+		if l.logLevel >= LogLevelVerbose {
+			elapsed := time.Since(start)
+			l.log("Found total", len(all), "errors in", elapsed.Milliseconds(), "ms for Dependabot configuration", path)
+		}
+		return all, nil, nil
+	}
+
+	if isCodeownersFile(path) {
+		all := l.checkCodeowners(proc.ctx, path, content, project)
+		if l.logLevel >= LogLevelVerbose {
+			elapsed := time.Since(start)
+			l.log("Found total", len(all), "errors in", elapsed.Milliseconds(), "ms for CODEOWNERS", path)
+		}
+		return all, nil, nil
+	}
+
+	if isReleaseNotesConfigFile(path) {
+		all := l.checkReleaseNotes(path, content)
+		if l.logLevel >= LogLevelVerbose {
+			elapsed := time.Since(start)
+			l.log("Found total", len(all), "errors in", elapsed.Milliseconds(), "ms for release notes configuration", path)
+		}
+		return all, nil, nil
+	}
+
+	if isIssueFormFile(path) {
+		all := l.checkIssueForm(path, content)
+		if l.logLevel >= LogLevelVerbose {
+			elapsed := time.Since(start)
+			l.log("Found total", len(all), "errors in", elapsed.Milliseconds(), "ms for issue form", path)
+		}
+		return all, nil, nil
+	}
+
+	if isActionMetadataFile(path) {
+		all, err := l.checkAction(path, content, project, proc, localActions, localReusableWorkflows)
+		if err != nil {
+			return nil, nil, err
+		}
+		if l.logLevel >= LogLevelVerbose {
+			elapsed := time.Since(start)
+			l.log("Found total", len(all), "errors in", elapsed.Milliseconds(), "ms for action metadata", path)
+		}
+		return all, nil, nil
+	}
+
+	w, all, srcMap := parseWorkflow(content)
 
 	if l.logLevel >= LogLevelVerbose {
 		elapsed := time.Since(start)
 		l.log("Found", len(all), "parse errors in", elapsed.Milliseconds(), "ms for", path)
 	}
+	if w != nil && l.logLevel >= LogLevelVerbose {
+		l.logCronSchedules(w, time.Now())
+	}
 
-	if w != nil {
-		dbg := l.debugWriter()
-
-		rules := []Rule{
+	// The syntax tree is still necessary on cache hit for checks across workflow files
+	cacheKey := ""
+	if l.results != nil && resultCacheable(path, w, cfg) {
+		cacheKey = l.results.key(path, content, cfg)
+	}
+	if cacheKey != "" {
+		if r, ok := l.results.get(cacheKey); ok {
+			if l.errFmt != nil {
+				for _, c := range r.Rules {
+					b := NewRuleBase(c.Name, c.Description)
+					l.errFmt.RegisterRule(&b)
+				}
+			}
+			for _, err := range r.Errors {
+				err.Filepath = path
+			}
+			l.log("Found", len(r.Errors), "errors in cached result for", path)
+			return r.Errors, w, nil
+		}
+	}
+	var checked []Rule
+
+	if w != nil {
+		dbg := l.debugWriter()
+
+		expr, err := newRuleExpressionForProject(cfg, project, localActions, localReusableWorkflows)
+		if err != nil {
+			return nil, nil, err
+		}
+		action := NewRuleAction(localActions)
+		action.privateActions = expr.privateActions
+		action.registry = l.dockerRegistry
+		action.ctx = proc.ctx
+		events := NewRuleEvents()
+		events.workflowTemplate = isWorkflowTemplateFile(path)
+
+		rules := []Rule{
 			NewRuleMatrix(),
 			NewRuleCredentials(),
+			NewRuleContainer(),
 			NewRuleShellName(),
 			NewRuleRunnerLabel(),
-			NewRuleEvents(),
+			events,
 			NewRuleJobNeeds(),
-			NewRuleAction(localActions),
+			action,
 			NewRuleEnvVar(),
 			NewRuleID(),
 			NewRuleGlob(),
 			NewRulePermissions(),
 			NewRuleWorkflowCall(path, localReusableWorkflows),
-			NewRuleExpression(localActions, localReusableWorkflows),
+			expr,
 			NewRuleDeprecatedCommands(),
 			NewRuleIfCond(),
+			NewRuleImplicitConversion(),
+			NewRuleTimeoutMinutes(),
+			NewRuleEnvironmentURL(),
+			NewRuleConcurrency(),
+			NewRuleRedundantCache(),
+			NewRuleArtifact(),
+			NewRuleRunnerImage(),
+			NewRuleGitHubScript(content),
+			NewRuleGHToken(content),
+		}
+		if cfg != nil {
+			if cfg.EnvShadowing {
+				rules = append(rules, NewRuleEnvShadowing())
+			}
+			if cfg.RequireTimeoutMinutes != nil {
+				rules = append(rules, NewRuleRequireTimeoutMinutes(cfg.RequireTimeoutMinutes))
+			}
+			if cfg.RecommendConcurrency != nil {
+				rules = append(rules, NewRuleRecommendConcurrency(cfg.RecommendConcurrency))
+			}
+			if cfg.Complexity != nil {
+				rules = append(rules, NewRuleComplexity(cfg.Complexity))
+			}
+			if cfg.RequireStepNames != nil {
+				rules = append(rules, NewRuleRequireStepNames(cfg.RequireStepNames))
+			}
+			if cfg.NamingConvention != nil {
+				r, err := NewRuleNamingConvention(cfg.NamingConvention)
+				if err != nil {
+					return nil, nil, err
+				}
+				rules = append(rules, r)
+			}
+			if cfg.SuggestMatrix {
+				rules = append(rules, NewRuleSuggestMatrix())
+			}
+			if cfg.CacheKey {
+				rules = append(rules, NewRuleCacheKey())
+			}
+			if cfg.CheckoutPerformance {
+				rules = append(rules, NewRuleCheckoutPerformance())
+			}
+			if cfg.ContinueOnError != nil {
+				rules = append(rules, NewRuleContinueOnError(cfg.ContinueOnError, content))
+			}
+			if cfg.PushFilters {
+				rules = append(rules, NewRulePushFilters())
+			}
+			if cfg.ScheduleDispatch {
+				rules = append(rules, NewRuleScheduleDispatch())
+			}
+			if cfg.RunnerPolicy != nil {
+				r, err := NewRuleRunnerPolicy(cfg.RunnerPolicy)
+				if err != nil {
+					return nil, nil, err
+				}
+				rules = append(rules, r)
+			}
+			if cfg.RequireEnvironment != nil {
+				r, err := NewRuleRequireEnvironment(cfg.RequireEnvironment)
+				if err != nil {
+					return nil, nil, err
+				}
+				rules = append(rules, r)
+			}
+			if cfg.PinnedRunner {
+				rules = append(rules, NewRulePinnedRunner())
+			}
+			if cfg.GitHubEnterprise != nil {
+				rules = append(rules, NewRuleGitHubEnterprise(cfg.GitHubEnterprise))
+			}
+			if cfg.RedundantPermissions {
+				rules = append(rules, NewRuleRedundantPermissions())
+			}
+			if cfg.YAMLStyle != nil {
+				r, err := NewRuleYAMLStyle(cfg.YAMLStyle, content)
+				if err != nil {
+					return nil, nil, err
+				}
+				rules = append(rules, r)
+			}
+			if cfg.VerifyPaths && project != nil {
+				r := NewRulePathExists(project.RootDir(), content)
+				r.setFileSystem(l.fs)
+				rules = append(rules, r)
+			}
+		}
+		fe, fs, fg := l.remoteEnvironmentsFetcher(project), l.remoteSecretsFetcher(project), l.remoteRunnerGroupsFetcher(project)
+		if fe != nil || fs != nil || fg != nil {
+			if slug := repositorySlug(project.RootDir(), l.fs); slug != "" {
+				if fe != nil {
+					rules = append(rules, NewRuleEnvironment(slug, func(s string) ([]string, bool, error) {
+						return fe.EnvironmentsContext(proc.ctx, s)
+					}))
+				}
+				if fs != nil {
+					secrets := func(s, e string) ([]string, bool, error) { return fs.SecretsContext(proc.ctx, s, e) }
+					vars := func(s, e string) ([]string, bool, error) { return fs.VariablesContext(proc.ctx, s, e) }
+					rules = append(rules, NewRuleSecretsAndVars(content, slug, secrets, vars))
+				}
+				if fg != nil {
+					rules = append(rules, NewRuleRunnerGroup(slug, func(s string) ([]string, bool, error) {
+						return fg.RunnerGroupsContext(proc.ctx, s)
+					}))
+				}
+			} else {
+				l.log("Rules to check the repository via REST API were disabled since the repository could not be detected from \"origin\" remote or $GITHUB_REPOSITORY")
+			}
+		}
+		if events.workflowTemplate {
+			r := NewRuleWorkflowTemplate(l.absPath(path), content)
+			r.fs = l.fs
+			rules = append(rules, r)
 		}
 		if l.shellcheck != "" {
 			r, err := NewRuleShellcheck(l.shellcheck, proc)
 			if err == nil {
+				r.extraArgs = l.shellcheckArgs
 				rules = append(rules, r)
 			} else {
 				l.log("Rule \"shellcheck\" was disabled:", err)
@@ -546,7 +1608,11 @@ func (l *Linter) check(
 			l.log("Rule \"shellcheck\" was disabled since shellcheck command name was empty")
 		}
 		if l.pyflakes != "" {
-			r, err := NewRulePyflakes(l.pyflakes, proc)
+			var c *PythonCheckerConfig
+			if cfg != nil {
+				c = cfg.PythonChecker
+			}
+			r, err := NewRulePythonChecker(c, l.pyflakes, proc, content)
 			if err == nil {
 				rules = append(rules, r)
 			} else {
@@ -555,14 +1621,76 @@ func (l *Linter) check(
 		} else {
 			l.log("Rule \"pyflakes\" was disabled since pyflakes command name was empty")
 		}
+		if l.pwsh != "" {
+			r, err := NewRulePSScriptAnalyzer(l.pwsh, proc, content)
+			if err == nil {
+				r.available = l.pwshAvailable
+				rules = append(rules, r)
+			} else {
+				l.log("Rule \"psscriptanalyzer\" was disabled:", err)
+			}
+		}
+		if cfg != nil && len(cfg.CustomShells) > 0 {
+			r, err := NewRuleScriptChecker(cfg.CustomShells, proc, content)
+			if err == nil {
+				rules = append(rules, r)
+			} else {
+				l.log("Rule \"script-checker\" was disabled:", err)
+			}
+		}
+		if cfg != nil {
+			for _, p := range cfg.Plugins {
+				r, err := NewRulePlugin(p, proc, path, content)
+				if err == nil {
+					rules = append(rules, r)
+				} else {
+					l.log(fmt.Sprintf("Plugin %q was disabled:", p.Name), err)
+				}
+			}
+		}
+		// Rules created by users may not be safe to run concurrently. Remember the built-in rules to
+		// run the other rules sequentially
+		builtin := append(make([]Rule, 0, len(rules)), rules...)
+		for _, newRule := range l.customRules {
+			rules = append(rules, newRule())
+		}
 		if l.onRulesCreated != nil {
 			rules = l.onRulesCreated(rules)
 		}
 
+		passes := make([]Pass, 0, len(rules))
+		var profiled []*profiledRule
+		if l.profiler != nil {
+			profiled = l.profiler.wrap(rules)
+			for _, p := range profiled {
+				passes = append(passes, p)
+			}
+		} else {
+			for _, r := range rules {
+				passes = append(passes, r)
+			}
+		}
+
 		v := NewVisitor()
-		for _, rule := range rules {
-			v.AddPass(rule)
+		dependent := []Pass{}
+		for i, rule := range rules {
+			v.AddPass(passes[i])
+			switch rule := rule.(type) {
+			case *RuleAction, *RuleExpression, *RuleWorkflowCall:
+				// These rules share the caches of local actions and reusable workflows
+				dependent = append(dependent, passes[i])
+			case ConcurrentRule:
+				if !rule.Concurrent() {
+					dependent = append(dependent, passes[i])
+				}
+			default:
+				if !containsRule(builtin, rule) {
+					dependent = append(dependent, passes[i])
+				}
+			}
 		}
+		v.SetDependentPasses(dependent...)
+		v.SetConcurrency(jobs)
 		if dbg != nil {
 			v.EnableDebug(dbg)
 			for _, r := range rules {
@@ -577,14 +1705,19 @@ func (l *Linter) check(
 
 		if err := v.Visit(w); err != nil {
 			l.debug("error occurred while visiting workflow syntax tree: %v", err)
-			return nil, err
+			return nil, nil, err
+		}
+		if profiled != nil {
+			l.profiler.record(path, profiled)
 		}
 
 		for _, rule := range rules {
 			errs := rule.Errs()
 			l.debug("%s found %d errors", rule.Name(), len(errs))
-			all = append(all, errs...)
+			// Errors on the syntax tree modified by parsing YAML need to be fixed
+			all = append(all, srcMap.fixErrors(errs)...)
 		}
+		checked = rules
 
 		if l.errFmt != nil {
 			for _, rule := range rules {
@@ -595,14 +1728,10 @@ func (l *Linter) check(
 
 	if len(l.ignorePats) > 0 {
 		filtered := make([]*Error, 0, len(all))
-	Loop:
 		for _, err := range all {
-			for _, pat := range l.ignorePats {
-				if pat.MatchString(err.Message) {
-					continue Loop
-				}
+			if !l.ignored(err) {
+				filtered = append(filtered, err)
 			}
-			filtered = append(filtered, err)
 		}
 		all = filtered
 	}
@@ -613,19 +1742,893 @@ func (l *Linter) check(
 
 	sort.Stable(ByErrorPosition(all))
 
+	if cacheKey != "" {
+		l.results.put(cacheKey, all, checked)
+	}
+
 	if l.logLevel >= LogLevelVerbose {
 		elapsed := time.Since(start)
 		l.log("Found total", len(all), "errors in", elapsed.Milliseconds(), "ms for", path)
 	}
 
+	return all, w, nil
+}
+
+// absPath returns the file path resolved from the current working directory of the linter.
+func (l *Linter) absPath(path string) string {
+	if filepath.IsAbs(path) || l.cwd == "" {
+		return path
+	}
+	return filepath.Join(l.cwd, path)
+}
+
+// checkWorkflowTemplateProperties checks the properties file (*.properties.json) of workflow
+// template.
+func (l *Linter) checkWorkflowTemplateProperties(path string, content []byte) []*Error {
+	rule := NewRuleWorkflowTemplate(l.absPath(path), content)
+	rule.fs = l.fs
+	if dbg := l.debugWriter(); dbg != nil {
+		rule.EnableDebug(dbg)
+	}
+	rule.CheckProperties()
+	if l.errFmt != nil {
+		l.errFmt.RegisterRule(rule)
+	}
+
+	all := []*Error{}
+	for _, err := range rule.Errs() {
+		if !l.ignored(err) {
+			err.Filepath = path
+			all = append(all, err)
+		}
+	}
+	sort.Stable(ByErrorPosition(all))
+	return all
+}
+
+// checkDependabot checks the Dependabot configuration file. Directories in the configuration are
+// resolved from the parent directory of ".github" directory.
+func (l *Linter) checkDependabot(path string, content []byte) []*Error {
+	d, all := ParseDependabot(content)
+
+	if d != nil {
+		rule := NewRuleDependabot(filepath.Dir(filepath.Dir(l.absPath(path))))
+		rule.fs = l.fs
+		if dbg := l.debugWriter(); dbg != nil {
+			rule.EnableDebug(dbg)
+		}
+		rule.CheckDependabot(d)
+		errs := rule.Errs()
+		l.debug("%s found %d errors", rule.Name(), len(errs))
+		all = append(all, errs...)
+		if l.errFmt != nil {
+			l.errFmt.RegisterRule(rule)
+		}
+	}
+
+	filtered := make([]*Error, 0, len(all))
+	for _, err := range all {
+		if !l.ignored(err) {
+			err.Filepath = path
+			filtered = append(filtered, err)
+		}
+	}
+	sort.Stable(ByErrorPosition(filtered))
+	return filtered
+}
+
+// checkIssueForm checks the issue form file in ".github/ISSUE_TEMPLATE" directory.
+func (l *Linter) checkIssueForm(path string, content []byte) []*Error {
+	f, all := ParseIssueForm(content)
+
+	if f != nil {
+		rule := NewRuleIssueForm()
+		if dbg := l.debugWriter(); dbg != nil {
+			rule.EnableDebug(dbg)
+		}
+		rule.CheckIssueForm(f)
+		errs := rule.Errs()
+		l.debug("%s found %d errors", rule.Name(), len(errs))
+		all = append(all, errs...)
+		if l.errFmt != nil {
+			l.errFmt.RegisterRule(rule)
+		}
+	}
+
+	filtered := make([]*Error, 0, len(all))
+	for _, err := range all {
+		if !l.ignored(err) {
+			err.Filepath = path
+			filtered = append(filtered, err)
+		}
+	}
+	sort.Stable(ByErrorPosition(filtered))
+	return filtered
+}
+
+// checkReleaseNotes checks the configuration file of automatically generated release notes.
+func (l *Linter) checkReleaseNotes(path string, content []byte) []*Error {
+	r, all := ParseReleaseNotes(content)
+
+	if r != nil {
+		rule := NewRuleReleaseNotes()
+		if dbg := l.debugWriter(); dbg != nil {
+			rule.EnableDebug(dbg)
+		}
+		rule.CheckReleaseNotes(r)
+		errs := rule.Errs()
+		l.debug("%s found %d errors", rule.Name(), len(errs))
+		all = append(all, errs...)
+		if l.errFmt != nil {
+			l.errFmt.RegisterRule(rule)
+		}
+	}
+
+	filtered := make([]*Error, 0, len(all))
+	for _, err := range all {
+		if !l.ignored(err) {
+			err.Filepath = path
+			filtered = append(filtered, err)
+		}
+	}
+	sort.Stable(ByErrorPosition(filtered))
+	return filtered
+}
+
+// checkCodeowners checks the CODEOWNERS file. When the project is given, the file is checked not to
+// be ignored due to other CODEOWNERS files in the project.
+func (l *Linter) checkCodeowners(ctx context.Context, path string, content []byte, project *Project) []*Error {
+	root := ""
+	if project != nil {
+		root = project.RootDir()
+	}
+	var exists func(string) (bool, error)
+	if f := l.remoteCodeownersFetcher(project); f != nil {
+		exists = func(owner string) (bool, error) { return f.OwnerExistsContext(ctx, owner) }
+	}
+
+	rule := NewRuleCodeowners(l.absPath(path), root, exists)
+	rule.fs = l.fs
+	if dbg := l.debugWriter(); dbg != nil {
+		rule.EnableDebug(dbg)
+	}
+	rule.CheckCodeowners(ParseCodeowners(content), len(content))
+	all := rule.Errs()
+	l.debug("%s found %d errors", rule.Name(), len(all))
+	if l.errFmt != nil {
+		l.errFmt.RegisterRule(rule)
+	}
+
+	filtered := make([]*Error, 0, len(all))
+	for _, err := range all {
+		if !l.ignored(err) {
+			err.Filepath = path
+			filtered = append(filtered, err)
+		}
+	}
+	sort.Stable(ByErrorPosition(filtered))
+	return filtered
+}
+
+// isActionMetadataFile returns true when the file path is an action metadata file "action.yml" or
+// "action.yaml". Files in "workflows" directory are always workflow files.
+func isActionMetadataFile(path string) bool {
+	b := filepath.Base(path)
+	if b != "action.yml" && b != "action.yaml" {
+		return false
+	}
+	return filepath.Base(filepath.Dir(path)) != "workflows"
+}
+
+// workflowOfAction builds the workflow syntax tree which runs steps of the composite action in one
+// job so that the rules for steps can check them. For other types of actions, the job has no step.
+func workflowOfAction(a *Action) *Workflow {
+	j := &Job{ID: &String{Value: "action", Pos: &Pos{}}, Pos: &Pos{}}
+	if a.Runs != nil {
+		j.Steps = a.Runs.Steps
+	}
+	return &Workflow{Jobs: map[string]*Job{"action": j}}
+}
+
+// checkAction checks the action metadata file. Steps of composite actions are checked by the rules
+// for steps in workflows.
+func (l *Linter) checkAction(
+	path string,
+	content []byte,
+	project *Project,
+	proc *concurrentProcess,
+	localActions *LocalActionsCache,
+	localReusableWorkflows *LocalReusableWorkflowCache,
+) ([]*Error, error) {
+	a, all := ParseAction(content)
+
+	if a != nil {
+		cfg := l.config(project)
+		dbg := l.debugWriter()
+
+		dir := ""
+		if path != "<stdin>" {
+			dir = filepath.Dir(l.absPath(path))
+		}
+		meta := NewRuleActionMetadata(dir)
+		meta.fs = l.fs
+		meta.CheckAction(a)
+
+		expr, err := newRuleExpressionForProject(cfg, project, localActions, localReusableWorkflows)
+		if err != nil {
+			return nil, err
+		}
+		expr.action = a
+		action := NewRuleAction(localActions)
+		action.privateActions = expr.privateActions
+		action.registry = l.dockerRegistry
+		action.ctx = proc.ctx
+
+		rules := []Rule{
+			meta,
+			NewRuleShellName(),
+			action,
+			NewRuleEnvVar(),
+			NewRuleID(),
+			NewRuleGlob(),
+			expr,
+			NewRuleDeprecatedCommands(),
+			NewRuleIfCond(),
+			NewRuleImplicitConversion(),
+			NewRuleRedundantCache(),
+			NewRuleArtifact(),
+			NewRuleGitHubScript(content),
+		}
+		if cfg != nil {
+			// Rules which check jobs are not applied since the job running the action is unknown
+			if cfg.EnvShadowing {
+				rules = append(rules, NewRuleEnvShadowing())
+			}
+			if cfg.RequireStepNames != nil {
+				rules = append(rules, NewRuleRequireStepNames(cfg.RequireStepNames))
+			}
+			if cfg.NamingConvention != nil {
+				c := *cfg.NamingConvention
+				c.JobID = "" // The job in the syntax tree is not written by users
+				r, err := NewRuleNamingConvention(&c)
+				if err != nil {
+					return nil, err
+				}
+				rules = append(rules, r)
+			}
+			if cfg.CacheKey {
+				rules = append(rules, NewRuleCacheKey())
+			}
+			if cfg.ContinueOnError != nil {
+				rules = append(rules, NewRuleContinueOnError(cfg.ContinueOnError, content))
+			}
+			if cfg.GitHubEnterprise != nil {
+				rules = append(rules, NewRuleGitHubEnterprise(cfg.GitHubEnterprise))
+			}
+			if cfg.YAMLStyle != nil {
+				r, err := NewRuleYAMLStyle(cfg.YAMLStyle, content)
+				if err != nil {
+					return nil, err
+				}
+				rules = append(rules, r)
+			}
+		}
+		if l.shellcheck != "" {
+			if r, err := NewRuleShellcheck(l.shellcheck, proc); err == nil {
+				r.extraArgs = l.shellcheckArgs
+				rules = append(rules, r)
+			} else {
+				l.log("Rule \"shellcheck\" was disabled:", err)
+			}
+		}
+		if l.pyflakes != "" {
+			var c *PythonCheckerConfig
+			if cfg != nil {
+				c = cfg.PythonChecker
+			}
+			if r, err := NewRulePythonChecker(c, l.pyflakes, proc, content); err == nil {
+				rules = append(rules, r)
+			} else {
+				l.log("Rule \"pyflakes\" was disabled:", err)
+			}
+		}
+		if l.pwsh != "" {
+			if r, err := NewRulePSScriptAnalyzer(l.pwsh, proc, content); err == nil {
+				r.available = l.pwshAvailable
+				rules = append(rules, r)
+			} else {
+				l.log("Rule \"psscriptanalyzer\" was disabled:", err)
+			}
+		}
+		if cfg != nil && len(cfg.CustomShells) > 0 {
+			if r, err := NewRuleScriptChecker(cfg.CustomShells, proc, content); err == nil {
+				rules = append(rules, r)
+			} else {
+				l.log("Rule \"script-checker\" was disabled:", err)
+			}
+		}
+		for _, newRule := range l.customRules {
+			rules = append(rules, newRule())
+		}
+		if l.onRulesCreated != nil {
+			rules = l.onRulesCreated(rules)
+		}
+
+		v := NewVisitor()
+		for _, r := range rules {
+			v.AddPass(r)
+			if dbg != nil {
+				r.EnableDebug(dbg)
+			}
+			if cfg != nil {
+				r.SetConfig(cfg)
+			}
+		}
+		if dbg != nil {
+			v.EnableDebug(dbg)
+		}
+
+		if err := v.Visit(workflowOfAction(a)); err != nil {
+			l.debug("error occurred while visiting action metadata syntax tree: %v", err)
+			return nil, err
+		}
+
+		for _, r := range rules {
+			errs := r.Errs()
+			l.debug("%s found %d errors", r.Name(), len(errs))
+			all = append(all, errs...)
+		}
+
+		if l.errFmt != nil {
+			for _, r := range rules {
+				l.errFmt.RegisterRule(r)
+			}
+		}
+	}
+
+	if len(l.ignorePats) > 0 {
+		filtered := make([]*Error, 0, len(all))
+		for _, err := range all {
+			if !l.ignored(err) {
+				filtered = append(filtered, err)
+			}
+		}
+		all = filtered
+	}
+
+	for _, err := range all {
+		err.Filepath = path
+	}
+
+	sort.Stable(ByErrorPosition(all))
 	return all, nil
 }
 
+// newRuleExpressionForProject creates RuleExpression instance configured for the project. The cfg
+// and project parameters can be nil.
+func newRuleExpressionForProject(cfg *Config, project *Project, localActions *LocalActionsCache, localReusableWorkflows *LocalReusableWorkflowCache) (*RuleExpression, error) {
+	expr := NewRuleExpression(localActions, localReusableWorkflows)
+	if cfg != nil && (cfg.VerifyHashFiles || cfg.VerifyPaths) && project != nil {
+		expr.workspace = newHashFilesWorkspace(project.RootDir())
+		expr.workspace.fs = project.fileSystem()
+	}
+	if cfg != nil && len(cfg.FromJSONSchemas) > 0 {
+		root := ""
+		if project != nil {
+			root = project.RootDir()
+		}
+		tys, err := loadFromJSONSchemas(root, cfg.FromJSONSchemas, project.fileSystem())
+		if err != nil {
+			return nil, err
+		}
+		expr.fromJSONTypes = tys
+	}
+	if cfg != nil {
+		expr.strictActionOutputs = cfg.StrictActionOutputs
+	}
+	if cfg != nil && len(cfg.Actions) > 0 {
+		root := ""
+		if project != nil {
+			root = project.RootDir()
+		}
+		as, err := loadPrivateActions(root, cfg.Actions, project.fileSystem())
+		if err != nil {
+			return nil, err
+		}
+		expr.privateActions = as
+	}
+	if cfg != nil && cfg.RepositoryDispatch != nil {
+		expr.dispatchTypes = cfg.RepositoryDispatch.Types
+		if f := cfg.RepositoryDispatch.ClientPayloadSchema; f != "" {
+			root := ""
+			if project != nil {
+				root = project.RootDir()
+			}
+			ty, err := loadClientPayloadSchema(root, f, project.fileSystem())
+			if err != nil {
+				return nil, err
+			}
+			expr.clientPayloadTy = ty
+		}
+	}
+	if cfg != nil && len(cfg.MatrixSchemas) > 0 {
+		if expr.fromJSONTypes == nil {
+			expr.fromJSONTypes = map[string]ExprType{}
+		}
+		if err := loadMatrixSchemas(cfg.MatrixSchemas, expr.fromJSONTypes); err != nil {
+			return nil, err
+		}
+	}
+	return expr, nil
+}
+
+// isNonWorkflowFile returns true when the file is checked by actionlint but it is neither a workflow
+// file nor an action metadata file.
+func isNonWorkflowFile(path string) bool {
+	return isWorkflowTemplatePropertiesFile(path) ||
+		isDependabotConfigFile(path) ||
+		isReleaseNotesConfigFile(path) ||
+		isIssueFormFile(path) ||
+		isCodeownersFile(path)
+}
+
+// visitWorkflowFiles parses the workflow files and action metadata files, and calls the callback
+// with each source and syntax tree. When no file is given, all files in the repository at the current
+// directory are visited. Steps of composite actions are visited as steps of the "action" job.
+// Other files like Dependabot configuration are skipped. Files which cannot be parsed are skipped
+// as well since they are reported by linting.
+func (l *Linter) visitWorkflowFiles(paths []string, visit func(path string, src []byte, w *Workflow)) error {
+	if len(paths) == 0 {
+		_, fs, err := l.repositoryFiles(".")
+		if err != nil {
+			return err
+		}
+		paths = fs
+	}
+
+	for _, p := range paths {
+		if isNonWorkflowFile(p) {
+			continue
+		}
+		src, err := l.fs.ReadFile(p)
+		if err != nil {
+			return fmt.Errorf("could not read %q: %w", p, err)
+		}
+		if l.cwd != "" {
+			if r, err := filepath.Rel(l.cwd, p); err == nil && !strings.HasPrefix(r, "..") {
+				p = r
+			}
+		}
+
+		var w *Workflow
+		if isActionMetadataFile(p) {
+			if a, _ := ParseAction(src); a != nil {
+				w = workflowOfAction(a)
+			}
+		} else {
+			w, _ = Parse(src)
+		}
+		if w == nil {
+			l.log("Skipped", p, "since it could not be parsed")
+			continue
+		}
+		visit(p, src, w)
+	}
+	return nil
+}
+
+// ListActions prints the inventory of all actions and reusable workflows used in the given files in
+// the format. The format is one of InventoryFormats. When no file is given, all files in the
+// repository at the current directory are listed.
+func (l *Linter) ListActions(paths []string, format string) error {
+	if !contains(InventoryFormats, format) {
+		return fmt.Errorf("format %q is not available for inventory. available formats are %s", format, sortedQuotes(InventoryFormats))
+	}
+	inv := NewActionsInventory()
+	if err := l.visitWorkflowFiles(paths, func(path string, _ []byte, w *Workflow) { inv.Add(path, w) }); err != nil {
+		return err
+	}
+	return inv.Print(l.out, format)
+}
+
+// ListSecrets prints the inventory of all secrets, configuration variables, and deployment
+// environments referred in the given files in the format. The format is one of InventoryFormats.
+// When no file is given, all files in the repository at the current directory are listed.
+func (l *Linter) ListSecrets(paths []string, format string) error {
+	if !contains(InventoryFormats, format) {
+		return fmt.Errorf("format %q is not available for inventory. available formats are %s", format, sortedQuotes(InventoryFormats))
+	}
+	inv := NewContextsInventory()
+	if err := l.visitWorkflowFiles(paths, inv.Add); err != nil {
+		return err
+	}
+	return inv.Print(l.out, format)
+}
+
+// PrintJobGraph prints the dependency graph of jobs in the given workflow files in the format. The
+// format is one of JobGraphFormats. Jobs are connected by "needs:" and calls of reusable workflows.
+// When no file is given, all workflow files in the repository at the current directory are put in
+// the graph.
+func (l *Linter) PrintJobGraph(paths []string, format string) error {
+	if !contains(JobGraphFormats, format) {
+		return fmt.Errorf("format %q is not available for job graph. available formats are %s", format, sortedQuotes(JobGraphFormats))
+	}
+	g := NewJobGraph()
+	err := l.visitWorkflowFiles(paths, func(path string, _ []byte, w *Workflow) {
+		if isActionMetadataFile(path) {
+			return // Composite actions have no job
+		}
+		key := ""
+		if p, err := l.projects.At(path); err == nil && p != nil {
+			if r, err := filepath.Rel(p.RootDir(), l.fs.Abs(path)); err == nil {
+				key = "./" + filepath.ToSlash(r)
+			}
+		}
+		g.Add(path, key, w)
+	})
+	if err != nil {
+		return err
+	}
+	g.Print(l.out, format)
+	return nil
+}
+
+// PrintWorkflowJSONSchema outputs JSON Schema of workflow files which reflects the syntax accepted
+// by actionlint. Runner labels and other extensions in the config file of the project at the
+// directory or the config file given by the option are reflected to the schema.
+func (l *Linter) PrintWorkflowJSONSchema(dir string) error {
+	p, err := l.projects.At(dir)
+	if err != nil {
+		return err
+	}
+	return printWorkflowJSONSchema(l.out, l.config(p))
+}
+
+// ExplainAt explains the expression at the position in the workflow file and outputs the
+// explanation to the writer. The explanation contains the source of the expression, its inferred
+// type, and which contexts or action metadata contributed to the type. The line and col parameters
+// are 1-based.
+func (l *Linter) ExplainAt(path string, line, col int) error {
+	project, err := l.projects.At(path)
+	if err != nil {
+		return err
+	}
+	src, err := l.fs.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read %q: %w", path, err)
+	}
+	w, errs := Parse(src)
+	if w == nil {
+		if len(errs) > 0 {
+			return fmt.Errorf("could not parse workflow %q: %s", path, errs[0].Error())
+		}
+		return fmt.Errorf("could not parse workflow %q", path)
+	}
+
+	cfg := l.config(project)
+	dbg := l.debugWriter()
+	localActions := NewLocalActionsCache(project, dbg)
+	localReusableWorkflows := NewLocalReusableWorkflowCache(project, l.cwd, dbg)
+	rule, err := newRuleExpressionForProject(cfg, project, localActions, localReusableWorkflows)
+	if err != nil {
+		return err
+	}
+	if cfg != nil {
+		rule.SetConfig(cfg)
+	}
+	e := newExprExplainer(line, col)
+	rule.explainer = e
+
+	v := NewVisitor()
+	v.AddPass(rule)
+	if err := v.Visit(w); err != nil {
+		return err
+	}
+
+	if e.result == nil {
+		return fmt.Errorf("no expression was found at line:%d,col:%d in %q", line, col, path)
+	}
+	e.result.Print(l.out)
+	return nil
+}
+
+func (l *Linter) config(project *Project) *Config {
+	if l.defaultConfig != nil {
+		// `-config-file` option has higher prioritiy than repository config file
+		return l.defaultConfig
+	}
+	if project != nil {
+		return project.Config()
+	}
+	return nil
+}
+
+// checkDuplicateSteps detects duplicate sequences of steps across the workflows in the same
+// project. The returned slice contains errors for each target at the same index. It returns nil
+// when the detection is disabled.
+func (l *Linter) checkDuplicateSteps(targets []duplicateStepsTarget, project *Project) [][]*Error {
+	cfg := l.config(project)
+	if cfg == nil || cfg.DuplicateSteps == nil {
+		return nil
+	}
+	all := findDuplicateStepSequences(targets, cfg.DuplicateSteps.MinLength)
+	for i, errs := range all {
+		filtered := make([]*Error, 0, len(errs))
+		for _, err := range errs {
+			if !l.ignored(err) {
+				filtered = append(filtered, err)
+			}
+		}
+		all[i] = filtered
+	}
+	return all
+}
+
+func (l *Linter) ignored(err *Error) bool {
+	for _, pat := range l.ignorePats {
+		if pat.MatchString(err.Message) {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteFetcher returns the fetcher of remote files for the project. When the project targets
+// GitHub Enterprise Server, the returned fetcher fetches files from the instance.
+func (l *Linter) remoteFetcher(project *Project) *RemoteFetcher {
+	if l.remote == nil {
+		return nil
+	}
+	cfg := l.config(project)
+	if cfg == nil || cfg.GitHubEnterprise == nil || cfg.GitHubEnterprise.APIURL == "" {
+		return l.remote
+	}
+	u := cfg.GitHubEnterprise.APIURL
+	if f, ok := l.ghesRemotes[u]; ok {
+		return f
+	}
+	f := l.remote.ForGitHubEnterprise(u)
+	l.ghesRemotes[u] = f
+	return f
+}
+
+// remoteWorkflowsFetcher returns the fetcher of remote reusable workflows for the project. It returns
+// nil when fetching remote reusable workflows is not enabled.
+func (l *Linter) remoteWorkflowsFetcher(project *Project) *RemoteFetcher {
+	if !l.remoteWorkflows {
+		return nil
+	}
+	return l.remoteFetcher(project)
+}
+
+// remoteActionsFetcher returns the fetcher of metadata of remote actions for the project. It returns
+// nil when fetching remote actions is not enabled.
+func (l *Linter) remoteActionsFetcher(project *Project) *RemoteFetcher {
+	if !l.remoteActions {
+		return nil
+	}
+	return l.remoteFetcher(project)
+}
+
+// remoteCodeownersFetcher returns the fetcher to look up owners in CODEOWNERS file for the project.
+// It returns nil when looking up owners is not enabled.
+func (l *Linter) remoteCodeownersFetcher(project *Project) *RemoteFetcher {
+	if !l.remoteOwners {
+		return nil
+	}
+	return l.remoteFetcher(project)
+}
+
+// remoteEnvironmentsFetcher returns the fetcher to look up deployment environments in the repository
+// of the project. It returns nil when looking up environments is not enabled or the project is unknown.
+func (l *Linter) remoteEnvironmentsFetcher(project *Project) *RemoteFetcher {
+	if !l.remoteEnvs || project == nil {
+		return nil
+	}
+	return l.remoteFetcher(project)
+}
+
+// remoteSecretsFetcher returns the fetcher to look up secrets and configuration variables in the
+// repository of the project. It returns nil when looking them up is not enabled or the project is
+// unknown.
+func (l *Linter) remoteSecretsFetcher(project *Project) *RemoteFetcher {
+	if !l.remoteSecrets || project == nil {
+		return nil
+	}
+	return l.remoteFetcher(project)
+}
+
+// remoteRunnerGroupsFetcher returns the fetcher to look up runner groups available to the
+// repository of the project. It returns nil when looking up runner groups is not enabled or the
+// project is unknown.
+func (l *Linter) remoteRunnerGroupsFetcher(project *Project) *RemoteFetcher {
+	if !l.remoteGroups || project == nil {
+		return nil
+	}
+	return l.remoteFetcher(project)
+}
+
+// printCostEstimate prints the estimation of billable minutes of the workflow when -estimate-cost
+// is enabled.
+func (l *Linter) printCostEstimate(path string, w *Workflow, project *Project) {
+	if !l.estimateCost || w == nil {
+		return
+	}
+	var c *CostEstimateConfig
+	if cfg := l.config(project); cfg != nil {
+		c = cfg.CostEstimate
+	}
+	EstimateCost(path, w, c).Print(l.out)
+}
+
+// messageCatalog returns the catalog to translate error messages in the project. It returns nil when
+// messages are not translated.
+func (l *Linter) messageCatalog(project *Project) *messageCatalog {
+	if cfg := l.config(project); cfg != nil && cfg.Locale != "" {
+		return findMessageCatalog(localeLanguage(cfg.Locale))
+	}
+	return l.messages
+}
+
+// mapPath replaces the prefix of the file path with PathPrefixStrip and PathPrefixAdd options. Paths
+// of pseudo files such as "<stdin>" are not changed.
+func (l *Linter) mapPath(p string) string {
+	if strings.HasPrefix(p, "<") {
+		return p
+	}
+	if l.pathStrip != "" {
+		s := strings.TrimSuffix(l.pathStrip, string(filepath.Separator))
+		if p == s {
+			p = "."
+		} else if strings.HasPrefix(p, s+string(filepath.Separator)) {
+			p = p[len(s)+1:]
+		}
+	}
+	if l.pathAdd != "" {
+		p = filepath.Join(l.pathAdd, p)
+	}
+	return p
+}
+
+// mapErrorPaths returns copies of the errors whose file paths are replaced with mapPath. The given
+// errors are not modified since they are returned to the caller.
+func (l *Linter) mapErrorPaths(errs []*Error) []*Error {
+	if l.pathStrip == "" && l.pathAdd == "" {
+		return errs
+	}
+	mapped := make([]*Error, 0, len(errs))
+	for _, err := range errs {
+		e := *err
+		e.Filepath = l.mapPath(err.Filepath)
+		mapped = append(mapped, &e)
+	}
+	return mapped
+}
+
+// sortErrorsForPrint returns the errors sorted in the order of Sort option. The given slice is not
+// modified since it is returned to the caller.
+func (l *Linter) sortErrorsForPrint(errs []*Error) []*Error {
+	if l.sortBy == "" {
+		return errs
+	}
+	sorted := append([]*Error{}, errs...)
+	less := errorsLessBy(l.sortBy)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+	return sorted
+}
+
+func (l *Linter) newErrorsLimiter() *errorsLimiter {
+	return &errorsLimiter{max: l.maxErrors, perFile: l.maxFileErrors, files: map[string]int{}}
+}
+
+// printOmittedErrors prints the number of errors omitted by the limiter. The notice is printed to
+// the log output when the errors are formatted with a custom template so that the output can
+// still be parsed by other programs (e.g. JSON).
+func (l *Linter) printOmittedErrors(lim *errorsLimiter) {
+	if lim.omitted == 0 {
+		return
+	}
+	out := l.out
+	if l.errFmt != nil {
+		out = l.logOut
+	}
+	fmt.Fprintf(out, "... and %d more errors (output is limited by -max-errors or -max-errors-per-file)\n", lim.omitted)
+}
+
+// repositorySummary is the number of errors in one repository checked by LintRepositories.
+type repositorySummary struct {
+	name  string
+	proj  *Project
+	files int
+	errs  int
+	// errFiles is a set of files which have at least one error
+	errFiles map[string]struct{}
+}
+
+// printRepositoriesSummary prints the number of errors in each repository. Like printOmittedErrors,
+// the summary is printed to the log output when the errors are formatted with a custom template.
+func (l *Linter) printRepositoriesSummary(repos []*repositorySummary, errs []*Error) {
+	if l.quiet || len(repos) == 0 {
+		return
+	}
+	for _, err := range errs {
+		p := l.absPath(err.Filepath)
+		for _, r := range repos {
+			if r.proj.Knows(p) {
+				if r.errFiles == nil {
+					r.errFiles = map[string]struct{}{}
+				}
+				r.errs++
+				r.errFiles[err.Filepath] = struct{}{}
+				break
+			}
+		}
+	}
+
+	out := l.out
+	if l.errFmt != nil {
+		out = l.logOut
+	}
+	w := 0
+	failed := 0
+	for _, r := range repos {
+		if len(r.name) > w {
+			w = len(r.name)
+		}
+		if r.errs > 0 {
+			failed++
+		}
+	}
+	fmt.Fprintf(out, "Checked %d repositories. %d repositories have errors\n", len(repos), failed)
+	for _, r := range repos {
+		fmt.Fprintf(out, "  %-*s %d errors in %d/%d files\n", w, r.name, r.errs, len(r.errFiles), r.files)
+	}
+}
+
+func (l *Linter) printProfile() {
+	if l.profiler == nil {
+		return
+	}
+	printProfileReport(l.out, l.profiler.flush())
+}
+
 func (l *Linter) printErrors(errs []*Error, src []byte) {
+	if l.quiet {
+		prev := ""
+		for _, err := range errs {
+			if err.Filepath != prev {
+				fmt.Fprintln(l.out, err.Filepath)
+				prev = err.Filepath
+			}
+		}
+		return
+	}
 	if l.oneline {
 		src = nil
 	}
-	for _, err := range errs {
-		err.PrettyPrint(l.out, src)
+	if !l.group {
+		for _, err := range errs {
+			err.PrettyPrintWithContext(l.out, src, l.snippetLines)
+		}
+		return
+	}
+	for len(errs) > 0 {
+		path := errs[0].Filepath
+		n := 1
+		for n < len(errs) && errs[n].Filepath == path {
+			n++
+		}
+		yellow.Fprint(l.out, path)
+		if n == 1 {
+			gray.Fprintln(l.out, " (1 error)")
+		} else {
+			gray.Fprintf(l.out, " (%d errors)\n", n)
+		}
+		for _, err := range errs[:n] {
+			err.prettyPrintGrouped(l.out, src, l.snippetLines)
+		}
+		errs = errs[n:]
 	}
 }
@@ -2,9 +2,13 @@ package actionlint
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -12,6 +16,8 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"testing/fstest"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/sys/execabs"
@@ -255,6 +261,77 @@ CheckFiles:
 	}
 }
 
+func TestLinterLintRulesConcurrently(t *testing.T) {
+	// Workflows in testdata/examples are not used since some errors are reported in random order
+	dir, files, err := testFindAllWorkflowsInDir("err")
+	if err != nil {
+		panic(err)
+	}
+	proj := &Project{root: dir}
+
+	lint := func(t *testing.T, jobs int, src []byte) []*Error {
+		l, err := NewLinter(io.Discard, &LinterOptions{Jobs: jobs})
+		if err != nil {
+			t.Fatal(err)
+		}
+		l.defaultConfig = &Config{}
+		errs, err := l.Lint("test.yaml", src, proj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return errs
+	}
+
+	for _, f := range files {
+		t.Run(filepath.Base(f), func(t *testing.T) {
+			b, err := os.ReadFile(f)
+			if err != nil {
+				panic(err)
+			}
+			want := lint(t, 1, b)
+			have := lint(t, 8, b)
+			if !cmp.Equal(want, have) {
+				t.Fatal(cmp.Diff(want, have))
+			}
+		})
+	}
+}
+
+func TestLinterNegativeJobs(t *testing.T) {
+	_, err := NewLinter(io.Discard, &LinterOptions{Jobs: -1})
+	if err == nil {
+		t.Fatal("error was not returned")
+	}
+	if !strings.Contains(err.Error(), "-1") {
+		t.Fatalf("unexpected error message: %q", err)
+	}
+}
+
+func TestLinterLintCanceledContext(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "ok", "*.yaml"))
+	if err != nil {
+		panic(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = &Config{}
+
+	if _, err := l.LintFilesContext(ctx, files, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("wanted context.Canceled error from LintFilesContext but got %v", err)
+	}
+	if _, err := l.LintFileContext(ctx, files[0], nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("wanted context.Canceled error from LintFileContext but got %v", err)
+	}
+	if _, err := l.LintContext(ctx, "test.yaml", []byte("on: push"), nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("wanted context.Canceled error from LintContext but got %v", err)
+	}
+}
+
 func TestLintFindProjectFromPath(t *testing.T) {
 	d := filepath.Join("testdata", "find_project")
 	f := filepath.Join(d, ".github", "workflows", "test.yaml")
@@ -332,6 +409,325 @@ func TestLinterLintProject(t *testing.T) {
 	}
 }
 
+func TestLinterOnFileChecked(t *testing.T) {
+	repo := filepath.Join("testdata", "projects", "duplicate_workflow_names")
+
+	type call struct {
+		path string
+		errs []*Error
+	}
+	calls := []call{}
+	opts := LinterOptions{
+		WorkingDir: repo,
+		OnFileChecked: func(path string, errs []*Error) {
+			calls = append(calls, call{path, errs})
+		},
+	}
+	linter, err := NewLinter(io.Discard, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proj := &Project{root: repo}
+	errs, err := linter.LintDir(filepath.Join(repo, "workflows"), proj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const files = 4
+	if len(calls) != files+1 {
+		t.Fatalf("wanted %d calls for each file and 1 call for errors across files but got %d calls: %v", files, len(calls), calls)
+	}
+
+	seen := map[string]bool{}
+	total := 0
+	for _, c := range calls[:files] {
+		if seen[c.path] {
+			t.Errorf("file %q was notified twice while checking files", c.path)
+		}
+		seen[c.path] = true
+		total += len(c.errs)
+	}
+
+	last := calls[files]
+	if want := filepath.Join("workflows", "ci_copy.yaml"); last.path != want {
+		t.Errorf("wanted errors across files are notified for %q but got %q", want, last.path)
+	}
+	if len(last.errs) != 1 || last.errs[0].Kind != "workflow-name" {
+		t.Errorf("wanted one workflow-name error but got %v", last.errs)
+	}
+	total += len(last.errs)
+
+	if total != len(errs) {
+		t.Errorf("wanted %d errors notified in total but got %d", len(errs), total)
+	}
+}
+
+func TestLinterLintActionMetadata(t *testing.T) {
+	root := filepath.Join("testdata", "action")
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, info := range entries {
+		if !info.IsDir() {
+			continue
+		}
+
+		name := info.Name()
+		t.Run("action/"+name, func(t *testing.T) {
+			dir := filepath.Join(root, name)
+			opts := LinterOptions{
+				WorkingDir: dir,
+			}
+			cfg := filepath.Join(dir, "actionlint.yaml")
+			if _, err := os.Stat(cfg); err == nil {
+				opts.ConfigFile = cfg
+			}
+			linter, err := NewLinter(io.Discard, &opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			proj := &Project{root: dir}
+			errs, err := linter.LintFiles([]string{filepath.Join(dir, "action.yml")}, proj)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			checkErrors(t, dir+".out", errs)
+		})
+	}
+}
+
+func TestLinterLintWorkflowTemplates(t *testing.T) {
+	dir := filepath.Join("testdata", "templates")
+	entries, err := os.ReadDir(filepath.Join(dir, "workflow-templates"))
+	if err != nil {
+		panic(err)
+	}
+	files := []string{}
+	for _, e := range entries {
+		p := filepath.Join(dir, "workflow-templates", e.Name())
+		if isWorkflowTemplateFile(p) || isWorkflowTemplatePropertiesFile(p) {
+			files = append(files, p)
+		}
+	}
+
+	linter, err := NewLinter(io.Discard, &LinterOptions{WorkingDir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs, err := linter.LintFiles(files, &Project{root: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkErrors(t, dir+".out", errs)
+}
+
+func TestLinterLintDependabot(t *testing.T) {
+	root := filepath.Join("testdata", "dependabot")
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, info := range entries {
+		if !info.IsDir() {
+			continue
+		}
+
+		name := info.Name()
+		t.Run("dependabot/"+name, func(t *testing.T) {
+			dir := filepath.Join(root, name)
+			linter, err := NewLinter(io.Discard, &LinterOptions{WorkingDir: dir})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			errs, err := linter.LintFile(filepath.Join(dir, ".github", "dependabot.yml"), &Project{root: dir})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			checkErrors(t, dir+".out", errs)
+		})
+	}
+}
+
+func TestLinterLintIssueForms(t *testing.T) {
+	dir := filepath.Join("testdata", "issue_forms")
+	entries, err := os.ReadDir(filepath.Join(dir, ".github", "ISSUE_TEMPLATE"))
+	if err != nil {
+		panic(err)
+	}
+	files := []string{}
+	for _, e := range entries {
+		p := filepath.Join(dir, ".github", "ISSUE_TEMPLATE", e.Name())
+		if isIssueFormFile(p) {
+			files = append(files, p)
+		}
+	}
+
+	linter, err := NewLinter(io.Discard, &LinterOptions{WorkingDir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs, err := linter.LintFiles(files, &Project{root: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkErrors(t, dir+".out", errs)
+}
+
+func TestLinterLintReleaseNotes(t *testing.T) {
+	root := filepath.Join("testdata", "release_notes")
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, info := range entries {
+		if !info.IsDir() {
+			continue
+		}
+
+		name := info.Name()
+		t.Run("release_notes/"+name, func(t *testing.T) {
+			dir := filepath.Join(root, name)
+			linter, err := NewLinter(io.Discard, &LinterOptions{WorkingDir: dir})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			errs, err := linter.LintFile(filepath.Join(dir, ".github", "release.yml"), &Project{root: dir})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			checkErrors(t, dir+".out", errs)
+		})
+	}
+}
+
+func TestLinterLintCodeowners(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/ghost", "/orgs/octo-org/teams/no-such-team":
+			w.WriteHeader(404)
+		default:
+			w.Write([]byte("{}"))
+		}
+	}))
+	defer s.Close()
+
+	dir, err := filepath.Abs(filepath.Join("testdata", "codeowners"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	linter, err := NewLinter(io.Discard, &LinterOptions{WorkingDir: dir, RemoteCodeowners: true, CacheDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	linter.remote.githubAPI = s.URL
+	linter.remote.token = "dummy"
+
+	files := []string{
+		filepath.Join(dir, ".github", "CODEOWNERS"),
+		filepath.Join(dir, "CODEOWNERS"),
+		filepath.Join(dir, "docs", "CODEOWNERS"),
+	}
+	errs, err := linter.LintFiles(files, &Project{root: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkErrors(t, filepath.Join("testdata", "codeowners.out"), errs)
+}
+
+func TestLinterFindProjectFiles(t *testing.T) {
+	root := t.TempDir()
+	for _, p := range []string{
+		"action.yml",
+		filepath.Join(".github", "actions", "foo", "action.yaml"),
+		filepath.Join(".github", "actions", "bar", "action.yml"),
+		filepath.Join(".github", "workflows", "action.yml"),
+		filepath.Join(".github", "workflows", "ci.yml"),
+		filepath.Join("my-action", "action.yml"),
+		filepath.Join("src", "lib", "action.yml"),
+		filepath.Join("packages", "foo", ".github", "workflows", "ci.yaml"),
+		filepath.Join("packages", "foo", ".github", "workflows", "README.md"),
+		filepath.Join("packages", "foo", ".github", "actions", "setup", "action.yml"),
+		filepath.Join("packages", "bar", ".github", "workflows", "sub", "test.yml"),
+		filepath.Join("node_modules", "foo", ".github", "workflows", "ci.yml"),
+		filepath.Join("node_modules", "action.yml"),
+		filepath.Join("testdata", "action.yml"),
+		filepath.Join(".cache", "action.yml"),
+		filepath.Join("submodule", ".git"),
+		filepath.Join("submodule", "action.yml"),
+		filepath.Join("submodule", ".github", "workflows", "ci.yml"),
+		filepath.Join("workflow-templates", "ci.yml"),
+		filepath.Join("workflow-templates", "ci.properties.json"),
+		filepath.Join("workflow-templates", "ci.svg"),
+		filepath.Join(".github", "dependabot.yml"),
+		filepath.Join("packages", "foo", ".github", "dependabot.yml"),
+		filepath.Join("packages", "foo", "workflow-templates", "ci.yml"),
+		filepath.Join(".github", "ISSUE_TEMPLATE", "bug.yml"),
+		filepath.Join(".github", "ISSUE_TEMPLATE", "feature.md"),
+		filepath.Join(".github", "ISSUE_TEMPLATE", "config.yml"),
+		filepath.Join(".github", "CODEOWNERS"),
+		filepath.Join(".github", "release.yml"),
+		filepath.Join("docs", "CODEOWNERS"),
+		filepath.Join("src", "CODEOWNERS"),
+	} {
+		p = filepath.Join(root, p)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte{}, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wfs, actions, err := findProjectFiles(root, filepath.Join(root, ".github", "workflows"), osFileSystem{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		filepath.Join(root, ".github", "dependabot.yml"),
+		filepath.Join(root, ".github", "ISSUE_TEMPLATE", "bug.yml"),
+		filepath.Join(root, ".github", "CODEOWNERS"),
+		filepath.Join(root, ".github", "release.yml"),
+		filepath.Join(root, "docs", "CODEOWNERS"),
+		filepath.Join(root, "packages", "bar", ".github", "workflows", "sub", "test.yml"),
+		filepath.Join(root, "packages", "foo", ".github", "workflows", "ci.yaml"),
+		filepath.Join(root, "workflow-templates", "ci.yml"),
+		filepath.Join(root, "workflow-templates", "ci.properties.json"),
+	}
+	sort.Strings(want)
+	if !cmp.Equal(want, wfs) {
+		t.Fatal(cmp.Diff(want, wfs))
+	}
+
+	want = []string{
+		filepath.Join(root, ".github", "actions", "bar", "action.yml"),
+		filepath.Join(root, ".github", "actions", "foo", "action.yaml"),
+		filepath.Join(root, "action.yml"),
+		filepath.Join(root, "my-action", "action.yml"),
+		filepath.Join(root, "packages", "foo", ".github", "actions", "setup", "action.yml"),
+	}
+	sort.Strings(want)
+	if !cmp.Equal(want, actions) {
+		t.Fatal(cmp.Diff(want, actions))
+	}
+}
+
 func TestLinterFormatErrorMessageOK(t *testing.T) {
 	tests := []struct {
 		file   string
@@ -467,12 +863,196 @@ func TestLinterPathsNotFound(t *testing.T) {
 		filepath.Join(cwd, "this-dir-doesnt-exist", "this-file-doesnt-exist.yaml"), // Absolute file path (parent doesn't exist)
 	}
 
-	_, err = l.LintFiles(paths, nil)
+	_, err = l.LintFiles(paths, nil)
+	if err == nil {
+		t.Fatal("no error happened")
+	}
+	msg := err.Error()
+	if !strings.Contains(msg, "could not read") {
+		t.Fatal("unexpected error:", msg)
+	}
+}
+
+func TestLinterLintExpression(t *testing.T) {
+	tests := []struct {
+		what  string
+		input string
+		event string
+		want  []string
+		ty    string
+	}{
+		{
+			what:  "no error",
+			input: "github.event_name == 'push'",
+			ty:    "bool",
+		},
+		{
+			what:  "surrounded by ${{ }}",
+			input: "  ${{ github.ref_name }}",
+			ty:    "string",
+		},
+		{
+			what:  "type error",
+			input: "${{ github.foo }}",
+			want:  []string{"<expression>:1:5: property \"foo\" is not defined in object type"},
+			ty:    "any",
+		},
+		{
+			what:  "parse error",
+			input: "foo(",
+			want:  []string{"<expression>:1:5: unexpected end of input"},
+		},
+		{
+			what:  "event payload",
+			input: "github.event.pull_request",
+			event: "push",
+			want:  []string{"<expression>:1:1: property \"pull_request\" is not defined in object type"},
+			ty:    "any",
+		},
+		{
+			what:  "event payload with known property",
+			input: "github.event.pull_request && github.event.pull_request.number",
+			event: "pull_request",
+			ty:    "number",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			var b strings.Builder
+			l, err := NewLinter(&b, &LinterOptions{Oneline: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			errs, err := l.LintExpression(tc.input, tc.event)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(errs) != len(tc.want) {
+				t.Fatalf("wanted %d errors but got %d: %v", len(tc.want), len(errs), errs)
+			}
+			for i, want := range tc.want {
+				if have := errs[i].String(); !strings.HasPrefix(have, want) {
+					t.Errorf("error %q does not start with %q", have, want)
+				}
+			}
+			out := b.String()
+			if tc.ty != "" && !strings.HasSuffix(out, "type: "+tc.ty+"\n") {
+				t.Errorf("type %q is not output: %q", tc.ty, out)
+			}
+			if tc.ty == "" && strings.Contains(out, "type:") {
+				t.Errorf("type should not be output on parse error: %q", out)
+			}
+		})
+	}
+}
+
+func TestLinterLintExpressionUnknownEvent(t *testing.T) {
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = l.LintExpression("github.event", "pull_requests")
+	if err == nil {
+		t.Fatal("no error happened")
+	}
+	if msg := err.Error(); !strings.Contains(msg, "unknown event \"pull_requests\"") {
+		t.Fatal("unexpected error:", msg)
+	}
+}
+
+func TestLinterExplainAt(t *testing.T) {
+	tests := []struct {
+		line int
+		col  int
+		want []string
+	}{
+		{
+			line: 14,
+			col:  20,
+			want: []string{
+				"expression: matrix.os\n",
+				"node: matrix\n",
+				"type: {os: string}\n",
+				"source: \"matrix\" context is typed from \"strategy.matrix\" of job \"build\" defined at line:12,col:7\n",
+			},
+		},
+		{
+			line: 16,
+			col:  29,
+			want: []string{
+				"expression: steps.checkout.outputs.ref\n",
+				"node: steps.checkout\n",
+				"source: outputs of step \"checkout\" are typed from metadata of popular action \"actions/checkout@v4\"\n",
+			},
+		},
+		{
+			line: 21,
+			col:  40,
+			want: []string{
+				"expression: github.event.pull_request && inputs.debug\n",
+				"type: bool\n",
+			},
+		},
+		{
+			line: 21,
+			col:  35,
+			want: []string{
+				"node: github.event.pull_request\n",
+				"type: object\n",
+				"source: \"github.event\" is typed from webhook payloads of \"pull_request\", \"push\", \"workflow_dispatch\" events\n",
+			},
+		},
+		{
+			line: 22,
+			col:  52,
+			want: []string{
+				"node: github.event.commits.*.id\n",
+				"type: array<string>\n",
+			},
+		},
+		{
+			line: 27,
+			col:  30,
+			want: []string{
+				"node: needs.build\n",
+				"type: {outputs: {ref: string}; result: string}\n",
+				"source: \"needs.build\" is typed from outputs of job \"build\" defined at line:10,col:3\n",
+			},
+		},
+	}
+
+	path := filepath.Join("testdata", "explain", "test.yaml")
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d:%d", tc.line, tc.col), func(t *testing.T) {
+			var b strings.Builder
+			l, err := NewLinter(&b, &LinterOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := l.ExplainAt(path, tc.line, tc.col); err != nil {
+				t.Fatal(err)
+			}
+			out := b.String()
+			for _, w := range tc.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("output does not contain %q: %q", w, out)
+				}
+			}
+		})
+	}
+}
+
+func TestLinterExplainAtNoExpression(t *testing.T) {
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = l.ExplainAt(filepath.Join("testdata", "explain", "test.yaml"), 3, 1)
 	if err == nil {
 		t.Fatal("no error happened")
 	}
-	msg := err.Error()
-	if !strings.Contains(msg, "could not read") {
+	if msg := err.Error(); !strings.Contains(msg, "no expression was found at line:3,col:1") {
 		t.Fatal("unexpected error:", msg)
 	}
 }
@@ -550,6 +1130,139 @@ jobs:
 	}
 }
 
+func TestLinterAddCustomRuleWithAddRule(t *testing.T) {
+	created := 0
+	o := &LinterOptions{}
+	o.AddRule(func() Rule {
+		created++
+		return &customRuleForTest{
+			RuleBase: NewRuleBase("this-is-test", ""),
+		}
+	})
+
+	l, err := NewLinter(io.Discard, o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &Config{}
+	l.defaultConfig = cfg
+
+	w := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo
+      - run: echo
+`
+	for i := 0; i < 2; i++ {
+		errs, err := l.Lint("test.yaml", []byte(w), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The rule instance is created for each file so the count is not shared
+		if len(errs) != 1 {
+			t.Fatalf("wanted 1 error at #%d but have %v", i, errs)
+		}
+		if errs[0].Kind != "this-is-test" || errs[0].Line != 7 {
+			t.Fatalf("unexpected error at #%d: %v", i, errs[0])
+		}
+	}
+	if created != 2 {
+		t.Fatalf("the rule should be created for each file but created %d times", created)
+	}
+}
+
+type orderedRuleForTest struct {
+	RuleBase
+	events *[]string
+	delay  time.Duration
+}
+
+func (r *orderedRuleForTest) VisitWorkflowPre(n *Workflow) error {
+	time.Sleep(r.delay)
+	*r.events = append(*r.events, r.Name())
+	return nil
+}
+
+type concurrentRuleForTest struct {
+	RuleBase
+	wait, done chan struct{}
+}
+
+func (r *concurrentRuleForTest) Concurrent() bool {
+	return true
+}
+
+func (r *concurrentRuleForTest) VisitWorkflowPre(n *Workflow) error {
+	if r.done != nil {
+		close(r.done)
+	}
+	if r.wait != nil {
+		select {
+		case <-r.wait:
+		case <-time.After(5 * time.Second):
+			r.Errorf(&Pos{Line: 1, Col: 1}, "other rule was not run concurrently")
+		}
+	}
+	return nil
+}
+
+func TestLinterRunCustomRulesSequentially(t *testing.T) {
+	events := []string{}
+	o := &LinterOptions{Jobs: 4}
+	o.AddRule(func() Rule {
+		return &orderedRuleForTest{NewRuleBase("rule-1", ""), &events, 10 * time.Millisecond}
+	})
+	o.OnRulesCreated = func(rules []Rule) []Rule {
+		return append(rules, &orderedRuleForTest{NewRuleBase("rule-2", ""), &events, 0})
+	}
+
+	l, err := NewLinter(io.Discard, o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = &Config{}
+
+	w := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n"
+	for i := 0; i < 3; i++ {
+		if _, err := l.Lint("test.yaml", []byte(w), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []string{"rule-1", "rule-2", "rule-1", "rule-2", "rule-1", "rule-2"}
+	if !cmp.Equal(want, events) {
+		t.Fatal(cmp.Diff(want, events))
+	}
+}
+
+func TestLinterRunConcurrentCustomRules(t *testing.T) {
+	ch := make(chan struct{})
+	o := &LinterOptions{Jobs: 4}
+	o.AddRule(func() Rule {
+		return &concurrentRuleForTest{RuleBase: NewRuleBase("waiting-rule", ""), wait: ch}
+	})
+	o.AddRule(func() Rule {
+		return &concurrentRuleForTest{RuleBase: NewRuleBase("notifying-rule", ""), done: ch}
+	})
+
+	l, err := NewLinter(io.Discard, o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = &Config{}
+
+	w := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n"
+	errs, err := l.Lint("test.yaml", []byte(w), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) > 0 {
+		t.Fatal("rules implementing ConcurrentRule should run concurrently:", errs)
+	}
+}
+
 func TestLinterRemoveRuleOnRulesCreatedHook(t *testing.T) {
 	o := &LinterOptions{
 		OnRulesCreated: func(rules []Rule) []Rule {
@@ -816,3 +1529,399 @@ func BenchmarkLintRepository(b *testing.B) {
 		}
 	}
 }
+
+func TestLinterLintRepositoryInFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		".git/HEAD": &fstest.MapFile{Data: []byte("ref: refs/heads/main\n")},
+		".github/actionlint.yaml": &fstest.MapFile{Data: []byte(`self-hosted-runner:
+  labels:
+    - my-runner
+`)},
+		".github/workflows/test.yaml": &fstest.MapFile{Data: []byte(`on: push
+jobs:
+  test:
+    runs-on: my-runner
+    steps:
+      - uses: ./.github/actions/my-action
+        with:
+          foo: hello
+          unknown: world
+`)},
+		".github/actions/my-action/action.yml": &fstest.MapFile{Data: []byte(`name: My action
+description: My action
+inputs:
+  foo:
+    description: foo
+runs:
+  using: node20
+  main: index.js
+`)},
+		".github/actions/my-action/index.js": &fstest.MapFile{Data: []byte("")},
+	}
+
+	l, err := NewLinter(io.Discard, &LinterOptions{FS: fsys})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs, err := l.LintRepository(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("wanted exactly one error but got %d errors: %v", len(errs), errs)
+	}
+
+	err0 := errs[0]
+	want := filepath.Join(".github", "workflows", "test.yaml")
+	if err0.Filepath != want {
+		t.Errorf("wanted file path %q but got %q", want, err0.Filepath)
+	}
+	if err0.Kind != "action" || !strings.Contains(err0.Message, `"unknown"`) {
+		t.Errorf("unexpected error: %v", err0)
+	}
+}
+
+func TestLinterLintRepositoryDiscoverFiles(t *testing.T) {
+	for _, discover := range []bool{false, true} {
+		t.Run(fmt.Sprintf("discover-files=%v", discover), func(t *testing.T) {
+			fsys := fstest.MapFS{
+				".git/HEAD":               &fstest.MapFile{Data: []byte("ref: refs/heads/main\n")},
+				".github/actionlint.yaml": &fstest.MapFile{Data: []byte(fmt.Sprintf("discover-files: %v\n", discover))},
+				".github/workflows/test.yaml": &fstest.MapFile{Data: []byte(`on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo
+`)},
+				"packages/foo/.github/workflows/test.yaml": &fstest.MapFile{Data: []byte(`on: push
+jobs:
+  test:
+    steps:
+      - run: echo
+`)},
+			}
+
+			l, err := NewLinter(io.Discard, &LinterOptions{FS: fsys})
+			if err != nil {
+				t.Fatal(err)
+			}
+			errs, err := l.LintRepository(".")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !discover {
+				if len(errs) > 0 {
+					t.Fatalf("nested workflow should not be checked by default but got errors: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("wanted exactly one error but got %d errors: %v", len(errs), errs)
+			}
+			if want := filepath.Join("packages", "foo", ".github", "workflows", "test.yaml"); errs[0].Filepath != want {
+				t.Errorf("wanted error in %q but got %v", want, errs[0])
+			}
+		})
+	}
+}
+
+func TestLinterMaxErrors(t *testing.T) {
+	files := []string{
+		filepath.Join("testdata", "err", "artifact_name_collision.yaml"),
+		filepath.Join("testdata", "err", "one_error.yaml"),
+	}
+
+	testCases := []struct {
+		what     string
+		max      int
+		perFile  int
+		printed  int
+		omitted  int
+		returned int
+	}{
+		{"no limit", 0, 0, 4, 0, 4},
+		{"max errors", 2, 0, 2, 2, 4},
+		{"max errors per file", 0, 1, 2, 2, 4},
+		{"both", 1, 1, 1, 3, 4},
+		{"not exceeded", 10, 10, 4, 0, 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			var b strings.Builder
+			opts := &LinterOptions{Oneline: true, MaxErrors: tc.max, MaxErrorsPerFile: tc.perFile}
+			l, err := NewLinter(&b, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			l.defaultConfig = &Config{}
+
+			errs, err := l.LintFiles(files, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(errs) != tc.returned {
+				t.Fatalf("wanted %d errors returned but got %d: %v", tc.returned, len(errs), errs)
+			}
+
+			out := b.String()
+			if n := strings.Count(out, ".yaml:"); n != tc.printed {
+				t.Errorf("wanted %d errors printed but got %d: %q", tc.printed, n, out)
+			}
+			notice := fmt.Sprintf("... and %d more errors", tc.omitted)
+			if tc.omitted > 0 && !strings.Contains(out, notice) {
+				t.Errorf("notice %q is not printed: %q", notice, out)
+			}
+			if tc.omitted == 0 && strings.Contains(out, "more errors") {
+				t.Errorf("notice should not be printed: %q", out)
+			}
+		})
+	}
+}
+
+func TestLinterMaxErrorsNegative(t *testing.T) {
+	for _, opts := range []*LinterOptions{{MaxErrors: -1}, {MaxErrorsPerFile: -1}} {
+		if _, err := NewLinter(io.Discard, opts); err == nil || !strings.Contains(err.Error(), "must not be negative") {
+			t.Errorf("unexpected error for %+v: %v", opts, err)
+		}
+	}
+}
+
+func TestLinterGroupedOutput(t *testing.T) {
+	files := []string{
+		filepath.Join("testdata", "err", "artifact_name_collision.yaml"),
+		filepath.Join("testdata", "err", "one_error.yaml"),
+	}
+
+	testCases := []struct {
+		what string
+		opts LinterOptions
+		want []string
+	}{
+		{
+			what: "quiet",
+			opts: LinterOptions{Quiet: true},
+			want: []string{
+				filepath.Join("testdata", "err", "artifact_name_collision.yaml"),
+				filepath.Join("testdata", "err", "one_error.yaml"),
+			},
+		},
+		{
+			what: "group",
+			opts: LinterOptions{Group: true, Oneline: true},
+			want: []string{
+				filepath.Join("testdata", "err", "artifact_name_collision.yaml") + " (3 errors)",
+				`  13:17: artifact "build" is uploaded with the same name`,
+				`  43:17: artifact "logs" is uploaded by job "second"`,
+				`  46:15: artifact "artifact" is uploaded by job "second"`,
+				filepath.Join("testdata", "err", "one_error.yaml") + " (1 error)",
+				`  6:41: "github.event.head_commit.message" is potentially untrusted`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			var b strings.Builder
+			l, err := NewLinter(&b, &tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			l.defaultConfig = &Config{}
+
+			if _, err := l.LintFiles(files, nil); err != nil {
+				t.Fatal(err)
+			}
+
+			lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+			if len(lines) != len(tc.want) {
+				t.Fatalf("wanted %d lines but got %d lines: %q", len(tc.want), len(lines), b.String())
+			}
+			for i, want := range tc.want {
+				if !strings.HasPrefix(lines[i], want) {
+					t.Errorf("line %d does not start with %q: %q", i+1, want, lines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLinterGroupedOutputWithFormat(t *testing.T) {
+	for _, opts := range []*LinterOptions{{Quiet: true, Format: "{{json .}}"}, {Group: true, Format: "{{json .}}"}} {
+		if _, err := NewLinter(io.Discard, opts); err == nil || !strings.Contains(err.Error(), "cannot be used with custom format") {
+			t.Errorf("unexpected error for %+v: %v", opts, err)
+		}
+	}
+}
+
+func TestLinterSortErrors(t *testing.T) {
+	files := []string{
+		filepath.Join("testdata", "err", "one_error.yaml"),
+		filepath.Join("testdata", "err", "artifact_name_collision.yaml"),
+		filepath.Join("testdata", "err", "issue170_empty_permissions.yaml"),
+	}
+	a := filepath.Join("testdata", "err", "artifact_name_collision.yaml")
+	i := filepath.Join("testdata", "err", "issue170_empty_permissions.yaml")
+	o := filepath.Join("testdata", "err", "one_error.yaml")
+
+	testCases := []struct {
+		sort string
+		max  int
+		want []string
+	}{
+		{
+			sort: "",
+			want: []string{
+				o + ":6:41: ", a + ":13:17: ", a + ":43:17: ", a + ":46:15: ", i + ":12:17: ", i + ":12:17: ",
+			},
+		},
+		{
+			sort: "file",
+			want: []string{
+				a + ":13:17: ", a + ":43:17: ", a + ":46:15: ", i + ":12:17: ", i + ":12:17: ", o + ":6:41: ",
+			},
+		},
+		{
+			sort: "rule",
+			want: []string{
+				i + ":12:17: ", a + ":13:17: ", a + ":43:17: ", a + ":46:15: ", o + ":6:41: ", i + ":12:17: ",
+			},
+		},
+		{
+			sort: "rule",
+			max:  2,
+			want: []string{
+				i + ":12:17: ", a + ":13:17: ",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%q max=%d", tc.sort, tc.max), func(t *testing.T) {
+			var b strings.Builder
+			l, err := NewLinter(&b, &LinterOptions{Oneline: true, Sort: tc.sort, MaxErrors: tc.max})
+			if err != nil {
+				t.Fatal(err)
+			}
+			l.defaultConfig = &Config{}
+
+			errs, err := l.LintFiles(files, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(errs) != 6 {
+				t.Fatalf("all errors should be returned: %v", errs)
+			}
+
+			lines := []string{}
+			for _, l := range strings.Split(b.String(), "\n") {
+				if strings.HasPrefix(l, "testdata") {
+					lines = append(lines, l)
+				}
+			}
+			if len(lines) != len(tc.want) {
+				t.Fatalf("wanted %d errors but got %d errors: %q", len(tc.want), len(lines), b.String())
+			}
+			for i, want := range tc.want {
+				if !strings.HasPrefix(lines[i], want) {
+					t.Errorf("error %d does not start with %q: %q", i+1, want, lines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLinterSortErrorsInvalidOrder(t *testing.T) {
+	_, err := NewLinter(io.Discard, &LinterOptions{Sort: "column"})
+	if err == nil || !strings.Contains(err.Error(), `order of errors must be one of "file", "rule", or "severity" but got "column"`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestErrorsLessBySeverity(t *testing.T) {
+	errs := []*Error{
+		{Filepath: "a.yaml", Line: 1, Column: 1, Kind: "shellcheck", Severity: "style"},
+		{Filepath: "b.yaml", Line: 3, Column: 1, Kind: "shellcheck", Severity: "warning"},
+		{Filepath: "b.yaml", Line: 2, Column: 1, Kind: "expression"},
+		{Filepath: "a.yaml", Line: 5, Column: 1, Kind: "shellcheck", Severity: "info"},
+		{Filepath: "a.yaml", Line: 4, Column: 1, Kind: "shellcheck", Severity: "error"},
+	}
+	less := errorsLessBy("severity")
+	sort.SliceStable(errs, func(i, j int) bool { return less(errs[i], errs[j]) })
+
+	want := []string{"a.yaml:4", "b.yaml:2", "b.yaml:3", "a.yaml:5", "a.yaml:1"}
+	for i, w := range want {
+		if have := fmt.Sprintf("%s:%d", errs[i].Filepath, errs[i].Line); have != w {
+			t.Errorf("%d: wanted %q but got %q", i, w, have)
+		}
+	}
+}
+
+func TestLinterSnippetLinesNegative(t *testing.T) {
+	_, err := NewLinter(io.Discard, &LinterOptions{SnippetLines: -1})
+	if err == nil || !strings.Contains(err.Error(), "number of lines in source snippets must not be negative") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestLinterPathPrefixMapping(t *testing.T) {
+	sep := string(filepath.Separator)
+	testCases := []struct {
+		what  string
+		strip string
+		add   string
+		path  string
+		want  string
+	}{
+		{"strip", "testdata", "", filepath.Join("testdata", "err", "a.yaml"), filepath.Join("err", "a.yaml")},
+		{"strip with trailing separator", "testdata" + sep, "", filepath.Join("testdata", "err", "a.yaml"), filepath.Join("err", "a.yaml")},
+		{"add", "", sep + "host", filepath.Join("testdata", "a.yaml"), filepath.Join(sep+"host", "testdata", "a.yaml")},
+		{"strip and add", sep + "repo", sep + "home", filepath.Join(sep+"repo", "a.yaml"), filepath.Join(sep+"home", "a.yaml")},
+		{"prefix not matched", "test", "", filepath.Join("testdata", "a.yaml"), filepath.Join("testdata", "a.yaml")},
+		{"pseudo file", "", sep + "host", "<stdin>", "<stdin>"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			l, err := NewLinter(io.Discard, &LinterOptions{PathPrefixStrip: tc.strip, PathPrefixAdd: tc.add})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have := l.mapPath(tc.path); have != tc.want {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestLinterPathPrefixInOutput(t *testing.T) {
+	path := filepath.Join("testdata", "err", "one_error.yaml")
+	host := filepath.Join(string(filepath.Separator)+"home", "runner", "work")
+	want := filepath.Join(host, "err", "one_error.yaml")
+
+	for _, format := range []string{"", "{{range $ := .}}{{$.Filepath}}\n{{end}}"} {
+		var b strings.Builder
+		opts := &LinterOptions{Oneline: true, Format: format, PathPrefixStrip: "testdata", PathPrefixAdd: host}
+		l, err := NewLinter(&b, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		l.defaultConfig = &Config{}
+
+		errs, err := l.LintFile(path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(errs) != 1 {
+			t.Fatalf("wanted one error but got %v", errs)
+		}
+		if errs[0].Filepath != path {
+			t.Errorf("path of returned error should not be changed: %q", errs[0].Filepath)
+		}
+		if out := b.String(); !strings.HasPrefix(out, want) {
+			t.Errorf("output should start with %q: %q", want, out)
+		}
+	}
+}
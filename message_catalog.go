@@ -0,0 +1,125 @@
+package actionlint
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// messageTranslation translates error messages matching to the pattern. Submatches of the pattern
+// can be referred in the replacement like "$1".
+type messageTranslation struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// messageCatalog is a set of translations of error messages into one language. Error messages are
+// translated after all checks are done so that rules can report errors in English as before. Kinds
+// of errors (rule names) are never translated since they are used for suppressing errors.
+type messageCatalog struct {
+	lang         string
+	translations []*messageTranslation
+}
+
+// translate returns the message translated with the catalog. All translations in the catalog are
+// applied in order so that a message and its suffix such as `did you mean "foo"?` are translated
+// separately. The message is returned as-is when no translation matches.
+func (c *messageCatalog) translate(msg string) string {
+	for _, t := range c.translations {
+		msg = t.pattern.ReplaceAllString(msg, t.replacement)
+	}
+	return msg
+}
+
+// localize translates messages of the errors in place. It does nothing when the catalog is nil.
+func (c *messageCatalog) localize(errs []*Error) {
+	if c == nil {
+		return
+	}
+	for _, err := range errs {
+		err.Message = c.translate(err.Message)
+	}
+}
+
+// messageCatalogSources is a mapping from languages to translations in the catalog. Each pair is a
+// regular expression matching to error messages in English and its replacement.
+var messageCatalogSources = map[string][][2]string{
+	"ja": messagesJa,
+}
+
+var (
+	messageCatalogsMu sync.Mutex
+	messageCatalogs   = map[string]*messageCatalog{}
+)
+
+// findMessageCatalog returns the catalog for the language like "ja". Regular expressions in the
+// catalog are compiled on the first call. It returns nil when the language is English or not
+// supported.
+func findMessageCatalog(lang string) *messageCatalog {
+	src, ok := messageCatalogSources[lang]
+	if !ok {
+		return nil
+	}
+
+	messageCatalogsMu.Lock()
+	defer messageCatalogsMu.Unlock()
+	if c, ok := messageCatalogs[lang]; ok {
+		return c
+	}
+	c := &messageCatalog{lang, make([]*messageTranslation, 0, len(src))}
+	for _, p := range src {
+		c.translations = append(c.translations, &messageTranslation{regexp.MustCompile(p[0]), p[1]})
+	}
+	messageCatalogs[lang] = c
+	return c
+}
+
+// supportedLocales returns the languages which error messages can be translated into. English is
+// always included.
+func supportedLocales() []string {
+	ls := make([]string, 0, len(messageCatalogSources)+1)
+	ls = append(ls, "en")
+	for l := range messageCatalogSources {
+		ls = append(ls, l)
+	}
+	sort.Strings(ls)
+	return ls
+}
+
+// localeLanguage returns the language part of the locale like "ja" for "ja_JP.UTF-8". "C" and
+// "POSIX" locales are treated as English.
+func localeLanguage(locale string) string {
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	if i := strings.IndexAny(locale, "_-"); i >= 0 {
+		locale = locale[:i]
+	}
+	l := strings.ToLower(locale)
+	if l == "c" || l == "posix" {
+		return "en"
+	}
+	return l
+}
+
+// localeFromEnv returns the locale of messages set via LC_ALL, LC_MESSAGES, or LANG environment
+// variables in the order of precedence defined by POSIX.
+func localeFromEnv() string {
+	for _, n := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := os.Getenv(n); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func validateLocale(locale string) error {
+	l := localeLanguage(locale)
+	if _, ok := messageCatalogSources[l]; ok || l == "en" {
+		return nil
+	}
+	return fmt.Errorf("unsupported locale %q. supported locales are %s", locale, sortedQuotes(supportedLocales()))
+}
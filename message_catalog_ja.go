@@ -0,0 +1,39 @@
+package actionlint
+
+// messagesJa is the catalog of error messages translated into Japanese. The suffix suggesting
+// similar names is translated first so that the following patterns can match to the rest.
+// Note that submatches must be referred like "${1}" since "$1" followed by Japanese letters is
+// parsed as a named submatch.
+var messagesJa = [][2]string{
+	{`\. did you mean (.+)\?$`, `。もしかして ${1} ですか?`},
+
+	// Syntax errors
+	{`^unexpected key (".*?") for (".*?") section\. expected one of `, `${2} セクションに予期しないキー ${1} があります。次のいずれかを指定してください: `},
+	{`^unexpected key (".*?") for (".*?") section$`, `${2} セクションに予期しないキー ${1} があります`},
+	{`^(".*?") section should not be empty$`, `${1} セクションを空にすることはできません`},
+	{`^string should not be empty$`, `文字列を空にすることはできません`},
+	{`^(".*?") section is missing in job (".*?")$`, `ジョブ ${2} に ${1} セクションがありません`},
+	{`^(".*?") section is missing in workflow$`, `ワークフローに ${1} セクションがありません`},
+	{`^could not parse as YAML: `, `YAML としてパースできませんでした: `},
+
+	// Expressions
+	{`^(".*?") is potentially untrusted\. avoid using it directly in (.+?)\. instead, pass it through an environment variable\. see (\S+) for more details$`, `${1} は信頼できない入力の可能性があります。${2} で直接使用せず、環境変数を経由して渡してください。詳細は ${3} を参照してください`},
+	{`^property (".*?") is not defined in object type (.+?)((?:。.*)?)$`, `プロパティ ${1} はオブジェクト型 ${2} に定義されていません${3}`},
+	{`^undefined variable (".*?")\. available variables are (.+?)((?:。.*)?)$`, `未定義の変数 ${1} です。利用可能な変数は ${2} です${3}`},
+	{`^undefined function (".*?")\. available functions are (.+?)((?:。.*)?)$`, `未定義の関数 ${1} です。利用可能な関数は ${2} です${3}`},
+	{`^(".*?") is accessed but job (".*?") has no "strategy\.matrix" section\. "matrix" context is always an empty object in the job and the access is evaluated to an empty value$`, `${1} にアクセスしていますが、ジョブ ${2} には "strategy.matrix" セクションがありません。このジョブの "matrix" コンテキストは常に空のオブジェクトで、アクセスは空の値に評価されます`},
+	{`^type of expression must be bool but found type (.+)$`, `式の型は bool である必要がありますが ${1} 型でした`},
+	{`^type of expression at (".*?") must be (object|array|number) but found type (.+)$`, `${1} の式の型は ${2} である必要がありますが ${3} 型でした`},
+
+	// Jobs, steps, and actions
+	{`^label (".*?") is unknown\. available labels are (.+)\. if it is a custom label for self-hosted runner, set list of labels in actionlint\.yaml config file((?:。.*)?)$`, `ラベル ${1} は不明です。利用可能なラベルは ${2} です。セルフホストランナーのカスタムラベルの場合は actionlint.yaml 設定ファイルにラベルのリストを設定してください${3}`},
+	{`^job (".*?") needs job (".*?") which does not exist in this workflow((?:。.*)?)$`, `ジョブ ${1} が必要とするジョブ ${2} はこのワークフローに存在しません${3}`},
+	{`^unknown Webhook event (".*?")\. see (\S+) for list of all Webhook event names((?:。.*)?)$`, `不明な Webhook イベント ${1} です。すべての Webhook イベント名は ${2} を参照してください${3}`},
+	{`^input (".*?") is not defined in action (.+?)\. available inputs are (.+?)((?:。.*)?)$`, `入力 ${1} はアクション ${2} に定義されていません。利用可能な入力は ${3} です${4}`},
+	{`^missing input (".*?") which is required by action (.+?)\. all required inputs are (.+)$`, `アクション ${2} に必須の入力 ${1} がありません。必須の入力は ${3} です`},
+	{`^the runner of (".*?") action is too old to run on GitHub Actions\. update the action's version to fix this issue$`, `${1} アクションのランナーは古すぎるため GitHub Actions で実行できません。アクションのバージョンを更新してください`},
+	{`^"timeout-minutes" is not set to job (".*?")\. the default timeout is 360 minutes and a hanging job wastes runner minutes until the timeout$`, `ジョブ ${1} に "timeout-minutes" が設定されていません。デフォルトのタイムアウトは 360 分で、ハングしたジョブはタイムアウトまでランナーの実行時間を浪費します`},
+
+	// External linters
+	{`^(\S+) reported issue in this script: `, `${1} がこのスクリプトの問題を報告しました: `},
+}
@@ -0,0 +1,160 @@
+package actionlint
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+)
+
+func TestMessageCatalogLocaleLanguage(t *testing.T) {
+	testCases := []struct {
+		locale string
+		want   string
+	}{
+		{"ja", "ja"},
+		{"ja_JP", "ja"},
+		{"ja_JP.UTF-8", "ja"},
+		{"ja-JP", "ja"},
+		{"JA_jp.eucJP@mod", "ja"},
+		{"en_US.UTF-8", "en"},
+		{"C", "en"},
+		{"C.UTF-8", "en"},
+		{"POSIX", "en"},
+		{"", ""},
+	}
+
+	for _, tc := range testCases {
+		if have := localeLanguage(tc.locale); have != tc.want {
+			t.Errorf("wanted %q for locale %q but got %q", tc.want, tc.locale, have)
+		}
+	}
+}
+
+func TestMessageCatalogFind(t *testing.T) {
+	for _, l := range []string{"", "en", "de", "zh"} {
+		if c := findMessageCatalog(l); c != nil {
+			t.Errorf("catalog should not be found for %q: %v", l, c)
+		}
+	}
+	c := findMessageCatalog("ja")
+	if c == nil {
+		t.Fatal("catalog for Japanese was not found")
+	}
+	if c2 := findMessageCatalog("ja"); c != c2 {
+		t.Error("catalog should be cached")
+	}
+}
+
+func TestMessageCatalogTranslateJapanese(t *testing.T) {
+	testCases := []struct {
+		msg  string
+		want string
+	}{
+		{
+			`unexpected key "branch" for "push" section. expected one of "branches", "tags"`,
+			`"push" セクションに予期しないキー "branch" があります。次のいずれかを指定してください: "branches", "tags"`,
+		},
+		{
+			`"jobs" section is missing in workflow`,
+			`ワークフローに "jobs" セクションがありません`,
+		},
+		{
+			`property "evnt" is not defined in object type {event: object; sha: string}. did you mean "event"?`,
+			`プロパティ "evnt" はオブジェクト型 {event: object; sha: string} に定義されていません。もしかして "event" ですか?`,
+		},
+		{
+			`job "bad" needs job "tst" which does not exist in this workflow`,
+			`ジョブ "bad" が必要とするジョブ "tst" はこのワークフローに存在しません`,
+		},
+		{
+			`shellcheck reported issue in this script: SC2086:info:1:6: Double quote to prevent globbing and word splitting`,
+			`shellcheck がこのスクリプトの問題を報告しました: SC2086:info:1:6: Double quote to prevent globbing and word splitting`,
+		},
+		{
+			`this message is not translated`,
+			`this message is not translated`,
+		},
+	}
+
+	c := findMessageCatalog("ja")
+	for _, tc := range testCases {
+		if have := c.translate(tc.msg); have != tc.want {
+			t.Errorf("translation mismatch\n  want: %q\n  have: %q", tc.want, have)
+		}
+	}
+}
+
+func TestMessageCatalogLocalizeNil(t *testing.T) {
+	var c *messageCatalog
+	errs := []*Error{{Message: `string should not be empty`}}
+	c.localize(errs)
+	if errs[0].Message != `string should not be empty` {
+		t.Fatalf("message should not be translated: %q", errs[0].Message)
+	}
+}
+
+func TestMessageCatalogLinterLocale(t *testing.T) {
+	path := filepath.Join("testdata", "err", "issue280_runs_on.yaml")
+
+	testCases := []struct {
+		what   string
+		locale string
+		config string
+		want   string
+	}{
+		{"English by default", "", "", "string should not be empty"},
+		{"Japanese by option", "ja_JP.UTF-8", "", "文字列を空にすることはできません"},
+		{"Japanese by config", "", "ja", "文字列を空にすることはできません"},
+		{"config takes precedence", "ja_JP.UTF-8", "en", "string should not be empty"},
+		{"unsupported locale by option", "de_DE.UTF-8", "", "string should not be empty"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			l, err := NewLinter(io.Discard, &LinterOptions{Locale: tc.locale})
+			if err != nil {
+				t.Fatal(err)
+			}
+			l.defaultConfig = &Config{Locale: tc.config}
+
+			errs, err := l.LintFile(path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			found := false
+			for _, err := range errs {
+				if err.Kind != "syntax-check" && err.Kind != "runner-label" {
+					t.Errorf("kind of error must not be translated: %q", err.Kind)
+				}
+				if err.Message == tc.want {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("message %q was not found in errors %v", tc.want, errs)
+			}
+		})
+	}
+}
+
+func TestMessageCatalogConfigLocale(t *testing.T) {
+	for _, l := range []string{"ja", "ja_JP.UTF-8", "en", "C"} {
+		c, err := ParseConfig([]byte("locale: "+l), "actionlint.yaml")
+		if err != nil {
+			t.Errorf("locale %q should be valid: %s", l, err)
+			continue
+		}
+		if c.Locale != l {
+			t.Errorf("wanted locale %q but got %q", l, c.Locale)
+		}
+	}
+
+	_, err := ParseConfig([]byte("locale: de"), "actionlint.yaml")
+	if err == nil {
+		t.Fatal("unsupported locale should cause an error")
+	}
+	want := `invalid "locale" in config file "actionlint.yaml": unsupported locale "de". supported locales are "en", "ja"`
+	if err.Error() != want {
+		t.Fatalf("unexpected error\n  want: %q\n  have: %q", want, err.Error())
+	}
+}
@@ -50,14 +50,17 @@ type workflowKeyVal struct {
 
 type parser struct {
 	errors []*Error
+	// lines is lines of the source. It is used for checking the raw text of nodes. This value can be
+	// nil when the source is not available.
+	lines []string
 }
 
 func (p *parser) error(n *yaml.Node, m string) {
-	p.errors = append(p.errors, &Error{m, "", n.Line, n.Column, "syntax-check"})
+	p.errors = append(p.errors, &Error{Message: m, Line: n.Line, Column: n.Column, Kind: "syntax-check"})
 }
 
 func (p *parser) errorAt(pos *Pos, m string) {
-	p.errors = append(p.errors, &Error{m, "", pos.Line, pos.Col, "syntax-check"})
+	p.errors = append(p.errors, &Error{Message: m, Line: pos.Line, Column: pos.Col, Kind: "syntax-check"})
 }
 
 func (p *parser) errorfAt(pos *Pos, format string, args ...interface{}) {
@@ -142,6 +145,54 @@ func (p *parser) parseString(n *yaml.Node, allowEmpty bool) *String {
 	return newString(n)
 }
 
+// parseCondition parses the condition at "if:". A condition starting with "!" like `!cancelled()`
+// is parsed as a YAML tag and the "!" is silently removed from the condition. This is reported and
+// the original condition is restored.
+func (p *parser) parseCondition(n *yaml.Node) *String {
+	if n.Kind != yaml.ScalarNode {
+		return p.parseString(n, false)
+	}
+	tag := n.Tag
+	if n.Style&yaml.TaggedStyle == 0 {
+		// Non-specific tag "!" like `! cond` is resolved to "!!str" and the node is not marked as
+		// tagged. It can be detected only from the source.
+		if tag != "!!str" || !p.startsWithBang(n) {
+			return p.parseString(n, false)
+		}
+		tag = "!"
+	} else if !strings.HasPrefix(tag, "!") || strings.HasPrefix(tag, "!!") {
+		return p.parseString(n, false)
+	}
+
+	cond := tag
+	if n.Value != "" {
+		cond += " " + n.Value
+	}
+	what := "empty"
+	if n.Value != "" {
+		what = strconv.Quote(n.Value)
+	}
+	p.errorf(
+		n,
+		"condition %q at \"if:\" starts with \"!\" which is parsed as YAML tag %q. the condition is %s. quote the condition like 'if: \"%s\"' or enclose it with ${{ }} like 'if: ${{ %s }}'",
+		cond,
+		tag,
+		what,
+		cond,
+		cond,
+	)
+	return &String{cond, false, posAt(n)}
+}
+
+// startsWithBang returns true when the node starts with "!" in the source.
+func (p *parser) startsWithBang(n *yaml.Node) bool {
+	l, c := n.Line-1, n.Column-1
+	if l < 0 || len(p.lines) <= l || c < 0 || len(p.lines[l]) <= c {
+		return false
+	}
+	return p.lines[l][c] == '!'
+}
+
 func (p *parser) parseStringSequence(sec string, n *yaml.Node, allowEmpty bool, allowElemEmpty bool) []*String {
 	if ok := p.checkSequence(sec, n, allowEmpty); !ok {
 		return nil
@@ -284,7 +335,7 @@ func (p *parser) parseMapping(what string, n *yaml.Node, allowEmpty, caseSensiti
 			if !caseSensitive {
 				note = ". note that this key is case insensitive"
 			}
-			p.errorfAt(k.Pos, "key %q is duplicated in %s. previously defined at %s%s", k.Value, what, pos.String(), note)
+			p.errorfAt(k.Pos, "key %q is duplicated in %s. previously defined at %s. the previous value is silently overridden by this value in YAML%s", k.Value, what, pos.String(), note)
 			continue
 		}
 		m = append(m, workflowKeyVal{id, k, n.Content[i+1]})
@@ -784,7 +835,8 @@ func (p *parser) parseOutputs(n *yaml.Node) map[string]*Output {
 func (p *parser) parseRawYAMLValue(n *yaml.Node) RawYAMLValue {
 	switch n.Kind {
 	case yaml.ScalarNode:
-		return &RawYAMLString{n.Value, posAt(n)}
+		quoted := n.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0
+		return &RawYAMLString{n.Value, quoted, posAt(n)}
 	case yaml.SequenceNode:
 		vs := make([]RawYAMLValue, 0, len(n.Content))
 		for _, c := range n.Content {
@@ -951,7 +1003,7 @@ func (p *parser) parseContainer(sec string, pos *Pos, n *yaml.Node) *Container {
 			case "ports":
 				ret.Ports = p.parseStringSequence("ports", kv.val, true, false)
 			case "volumes":
-				ret.Ports = p.parseStringSequence("volumes", kv.val, true, false)
+				ret.Volumes = p.parseStringSequence("volumes", kv.val, true, false)
 			case "options":
 				ret.Options = p.parseString(kv.val, true)
 			default:
@@ -1007,7 +1059,7 @@ func (p *parser) parseStep(n *yaml.Node) *Step {
 		case "id":
 			ret.ID = p.parseString(kv.val, false)
 		case "if":
-			ret.If = p.parseString(kv.val, false)
+			ret.If = p.parseCondition(kv.val)
 		case "name":
 			ret.Name = p.parseString(kv.val, true)
 		case "env":
@@ -1207,7 +1259,7 @@ func (p *parser) parseJob(id *String, n *yaml.Node) *Job {
 			ret.Defaults = p.parseDefaults(k.Pos, v)
 			stepsOnlyKey = k
 		case "if":
-			ret.If = p.parseString(v, false)
+			ret.If = p.parseCondition(v)
 		case "steps":
 			ret.Steps = p.parseSteps(v)
 			stepsOnlyKey = k
@@ -1399,7 +1451,7 @@ func handleYAMLError(err error) []*Error {
 			l, _ = strconv.Atoi(ss[1])
 		}
 		msg = fmt.Sprintf("could not parse as YAML: %s", msg)
-		return &Error{msg, "", l, 0, "syntax-check"}
+		return &Error{Message: msg, Line: l, Kind: "syntax-check"}
 	}
 
 	if te, ok := err.(*yaml.TypeError); ok {
@@ -1416,18 +1468,158 @@ func handleYAMLError(err error) []*Error {
 // Parse parses given source as byte sequence into workflow syntax tree. It returns all errors
 // detected while parsing the input. It means that detecting one error does not stop parsing. Even
 // if one or more errors are detected, parser will try to continue parsing and finding more errors.
+// When the source has a localized YAML syntax error such as one malformed step, the parser skips
+// the broken sequence item and continues parsing the rest of the source.
 func Parse(b []byte) (*Workflow, []*Error) {
-	var n yaml.Node
+	w, errs, _ := parseWorkflow(b)
+	return w, errs
+}
+
+// workflowSourceMap maps the syntax tree to the source. It is used to fix errors reported on the
+// syntax tree which was modified on parsing.
+type workflowSourceMap struct {
+	// skipped is the ranges of lines skipped due to YAML syntax errors.
+	skipped []lineRange
+	// aliases is the uses of YAML aliases expanded into the syntax tree.
+	aliases []*yamlAliasUse
+}
 
-	if err := yaml.Unmarshal(b, &n); err != nil {
-		return nil, handleYAMLError(err)
+// fixErrors removes the errors in the lines skipped due to YAML syntax errors since they are caused
+// by placeholders. Errors at YAML aliases are noted where the anchor is defined. Errors at aliases
+// which are the same as errors reported at the anchor are removed as duplicates.
+func (m *workflowSourceMap) fixErrors(errs []*Error) []*Error {
+	if m == nil {
+		return errs
+	}
+	errs = filterErrorsInLines(errs, m.skipped)
+	if len(m.aliases) == 0 {
+		return errs
+	}
+
+	ret := make([]*Error, 0, len(errs))
+	for _, err := range errs {
+		a := m.aliasAt(err)
+		if a == nil {
+			ret = append(ret, err)
+			continue
+		}
+		if m.reportedInAnchor(err, a, errs) {
+			continue
+		}
+		// Offsets in the copied values such as positions in expressions are not meaningful at the alias
+		err.Column = a.pos.Col
+		err.Message = fmt.Sprintf("%s. the value is from YAML anchor %q defined at %s", err.Message, a.name, a.anchor)
+		ret = append(ret, err)
+	}
+	return ret
+}
+
+// aliasAt returns the use of alias where the error was reported. Since copied nodes have the
+// position of the alias, the error is caused by the nearest alias before it on the same line.
+func (m *workflowSourceMap) aliasAt(err *Error) *yamlAliasUse {
+	var found *yamlAliasUse
+	for _, a := range m.aliases {
+		if a.pos.Line == err.Line && a.pos.Col <= err.Column && (found == nil || found.pos.Col < a.pos.Col) {
+			found = a
+		}
+	}
+	return found
+}
+
+func (m *workflowSourceMap) reportedInAnchor(err *Error, a *yamlAliasUse, errs []*Error) bool {
+	for _, e := range errs {
+		if e != err && e.Kind == err.Kind && e.Message == err.Message && a.anchor.Line <= e.Line && e.Line <= a.anchorEnd {
+			return true
+		}
+	}
+	return false
+}
+
+var reTaggedConditionLine = regexp.MustCompile(`^(\s*(?:-\s+)?if:\s+)(!.*)$`)
+
+// taggedConditionErrors reports conditions at "if:" starting with "!" like `if: !contains(x, 'y')`
+// in the source. "!" starts a YAML tag and such conditions often cause YAML syntax errors. When all
+// is false, only the lines in the skipped ranges are checked since other lines are checked while
+// parsing the YAML nodes.
+func taggedConditionErrors(b []byte, all bool, skipped []lineRange) []*Error {
+	errs := []*Error{}
+	for i, l := range strings.Split(string(b), "\n") {
+		m := reTaggedConditionLine.FindStringSubmatch(strings.TrimRight(l, "\r"))
+		if m == nil {
+			continue
+		}
+		line := i + 1
+		if !all {
+			found := false
+			for _, r := range skipped {
+				if r.contains(line) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+		cond := strings.TrimSpace(m[2])
+		errs = append(errs, &Error{
+			Message: fmt.Sprintf("condition %q at \"if:\" starts with \"!\" which is parsed as YAML tag. this may cause the YAML syntax error. quote the condition like 'if: \"%s\"' or enclose it with ${{ }} like 'if: ${{ %s }}'", cond, cond, cond),
+			Line:    line,
+			Column:  len(m[1]) + 1,
+			Kind:    "syntax-check",
+		})
+	}
+	return errs
+}
+
+// parseWorkflow parses the source into workflow syntax tree. In addition to Parse, it returns the
+// source map to fix errors reported on the syntax tree. Note that errors returned from this
+// function are already fixed.
+func parseWorkflow(b []byte) (*Workflow, []*Error, *workflowSourceMap) {
+	n, yamlErrs, skipped := parseYAMLWithRecovery(b) // Defined at parse_recovery.go
+	errs := []*Error{}
+	for _, err := range yamlErrs {
+		errs = append(errs, handleYAMLError(err)...)
+	}
+	if len(yamlErrs) > 0 {
+		// Lines which were not parsed as YAML nodes are checked from the source
+		errs = append(errs, taggedConditionErrors(b, n == nil, skipped)...)
+	}
+	if n == nil {
+		return nil, errs, nil
+	}
+
+	n, aliasErrs, aliases := resolveYAMLAliases(n) // Defined at yaml_alias.go
+	errs = append(errs, aliasErrs...)
+	if n == nil {
+		return nil, errs, nil
 	}
 
 	// Uncomment for checking YAML tree
-	// dumpYAML(&n, 0)
+	// dumpYAML(n, 0)
+
+	p := &parser{lines: strings.Split(string(b), "\n")}
+	w := p.parse(n)
 
-	p := &parser{}
-	w := p.parse(&n)
+	m := &workflowSourceMap{skipped, aliases}
+	errs = append(errs, m.fixErrors(p.errors)...)
+	return w, errs, m
+}
 
-	return w, p.errors
+// filterErrorsInLines removes the errors reported in the ranges of lines.
+func filterErrorsInLines(errs []*Error, ranges []lineRange) []*Error {
+	if len(ranges) == 0 {
+		return errs
+	}
+	ret := make([]*Error, 0, len(errs))
+Loop:
+	for _, err := range errs {
+		for _, r := range ranges {
+			if r.contains(err.Line) {
+				continue Loop
+			}
+		}
+		ret = append(ret, err)
+	}
+	return ret
 }
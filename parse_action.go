@@ -0,0 +1,217 @@
+package actionlint
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#inputs
+func (p *parser) parseActionInputs(n *yaml.Node) map[string]*ActionInput {
+	inputs := p.parseSectionMapping("inputs", n, true, false)
+	ret := make(map[string]*ActionInput, len(inputs))
+	for _, kv := range inputs {
+		input := &ActionInput{Name: kv.key}
+		for _, attr := range p.parseMapping("input of action", kv.val, true, true) {
+			switch attr.id {
+			case "description":
+				input.Description = p.parseString(attr.val, true)
+			case "required":
+				input.Required = p.parseBool(attr.val)
+			case "default":
+				input.Default = p.parseString(attr.val, true)
+			case "type":
+				input.Type = p.parseString(attr.val, false)
+				if input.Type != nil {
+					switch input.Type.Value {
+					case "boolean", "number", "string":
+					default:
+						p.errorf(attr.val, `input type of action must be one of "boolean", "number", "string" but got %q`, input.Type.Value)
+					}
+				}
+			case "deprecationMessage":
+				input.DeprecationMessage = p.parseString(attr.val, false)
+			default:
+				p.unexpectedKey(attr.key, "inputs", []string{"description", "required", "default", "type", "deprecationMessage"})
+			}
+		}
+		ret[kv.id] = input
+	}
+	return ret
+}
+
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#outputs-for-docker-container-and-javascript-actions
+func (p *parser) parseActionOutputs(n *yaml.Node) map[string]*ActionOutput {
+	outputs := p.parseSectionMapping("outputs", n, true, false)
+	ret := make(map[string]*ActionOutput, len(outputs))
+	for _, kv := range outputs {
+		output := &ActionOutput{Name: kv.key}
+		for _, attr := range p.parseMapping("output of action", kv.val, true, true) {
+			switch attr.id {
+			case "description":
+				output.Description = p.parseString(attr.val, true)
+			case "value":
+				output.Value = p.parseString(attr.val, false)
+			default:
+				p.unexpectedKey(attr.key, "outputs", []string{"description", "value"})
+			}
+		}
+		ret[kv.id] = output
+	}
+	return ret
+}
+
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#runs
+func (p *parser) parseActionRuns(pos *Pos, n *yaml.Node) *ActionRuns {
+	ret := &ActionRuns{Pos: pos}
+
+	for _, kv := range p.parseSectionMapping("runs", n, false, true) {
+		switch kv.id {
+		case "using":
+			ret.Using = p.parseString(kv.val, false)
+		case "main":
+			ret.Main = p.parseString(kv.val, false)
+		case "pre":
+			ret.Pre = p.parseString(kv.val, false)
+		case "pre-if":
+			ret.PreIf = p.parseString(kv.val, false)
+		case "post":
+			ret.Post = p.parseString(kv.val, false)
+		case "post-if":
+			ret.PostIf = p.parseString(kv.val, false)
+		case "steps":
+			ret.Steps = p.parseSteps(kv.val)
+			if ret.Steps == nil {
+				ret.Steps = []*Step{} // Distinguish the invalid "steps" section from the missing one
+			}
+		case "image":
+			ret.Image = p.parseString(kv.val, false)
+		case "pre-entrypoint":
+			ret.PreEntrypoint = p.parseString(kv.val, false)
+		case "entrypoint":
+			ret.Entrypoint = p.parseString(kv.val, false)
+		case "post-entrypoint":
+			ret.PostEntrypoint = p.parseString(kv.val, false)
+		case "args":
+			ret.Args = p.parseStringSequence("args", kv.val, true, true)
+			if ret.Args == nil {
+				ret.Args = []*String{}
+			}
+		case "env":
+			ret.Env = p.parseEnv(kv.val)
+		default:
+			p.unexpectedKey(kv.key, "runs", []string{
+				"using",
+				"main",
+				"pre",
+				"pre-if",
+				"post",
+				"post-if",
+				"steps",
+				"image",
+				"pre-entrypoint",
+				"entrypoint",
+				"post-entrypoint",
+				"args",
+				"env",
+			})
+		}
+	}
+
+	if ret.Using == nil {
+		p.error(n, "\"using\" is required in \"runs\" section of action metadata")
+	}
+
+	return ret
+}
+
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#branding
+func (p *parser) parseActionBranding(n *yaml.Node) *ActionBranding {
+	ret := &ActionBranding{}
+
+	for _, kv := range p.parseSectionMapping("branding", n, false, true) {
+		switch kv.id {
+		case "icon":
+			ret.Icon = p.parseString(kv.val, false)
+		case "color":
+			ret.Color = p.parseString(kv.val, false)
+		default:
+			p.unexpectedKey(kv.key, "branding", []string{"icon", "color"})
+		}
+	}
+
+	return ret
+}
+
+func (p *parser) parseAction(n *yaml.Node) *Action {
+	a := &Action{}
+
+	if n.Line == 0 {
+		n.Line = 1
+	}
+	if n.Column == 0 {
+		n.Column = 1
+	}
+
+	if len(n.Content) == 0 {
+		p.error(n, "action metadata is empty")
+		return a
+	}
+
+	for _, kv := range p.parseMapping("action metadata", n.Content[0], false, true) {
+		k, v := kv.key, kv.val
+		switch kv.id {
+		case "name":
+			a.Name = p.parseString(v, false)
+		case "author":
+			a.Author = p.parseString(v, true)
+		case "description":
+			a.Description = p.parseString(v, false)
+		case "inputs":
+			a.Inputs = p.parseActionInputs(v)
+		case "outputs":
+			a.Outputs = p.parseActionOutputs(v)
+		case "runs":
+			a.Runs = p.parseActionRuns(k.Pos, v)
+		case "branding":
+			a.Branding = p.parseActionBranding(v)
+		default:
+			p.unexpectedKey(k, "action metadata", []string{
+				"name",
+				"author",
+				"description",
+				"inputs",
+				"outputs",
+				"runs",
+				"branding",
+			})
+		}
+	}
+
+	if a.Name == nil {
+		p.error(n, "\"name\" section is missing in action metadata")
+	}
+	if a.Description == nil {
+		p.error(n, "\"description\" section is missing in action metadata")
+	}
+	if a.Runs == nil {
+		p.error(n, "\"runs\" section is missing in action metadata")
+	}
+
+	return a
+}
+
+// ParseAction parses given source as byte sequence into action metadata (action.yml) syntax tree.
+// Like Parse function, it returns all errors detected while parsing the input.
+func ParseAction(b []byte) (*Action, []*Error) {
+	var n yaml.Node
+
+	if err := yaml.Unmarshal(b, &n); err != nil {
+		return nil, handleYAMLError(err)
+	}
+
+	p := &parser{lines: strings.Split(string(b), "\n")}
+	a := p.parseAction(&n)
+
+	return a, p.errors
+}
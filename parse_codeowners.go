@@ -0,0 +1,74 @@
+package actionlint
+
+import (
+	"bytes"
+	"strings"
+)
+
+// splitCodeownersLine splits the line of CODEOWNERS file into fields separated by whitespaces. It
+// returns the fields and their 0-based byte offsets in the line. A backslash escapes the following
+// character like "\ " so that a pattern can contain spaces. Fields after "#" are a comment.
+func splitCodeownersLine(l string) ([]string, []int) {
+	fields, offsets := []string{}, []int{}
+	var b strings.Builder
+	start := -1
+	flush := func() {
+		if start >= 0 {
+			fields = append(fields, b.String())
+			offsets = append(offsets, start)
+			b.Reset()
+			start = -1
+		}
+	}
+
+	for i := 0; i < len(l); i++ {
+		c := l[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+		case c == '#' && start < 0:
+			return fields, offsets // The rest of line is a comment
+		case c == '\\' && i+1 < len(l):
+			if start < 0 {
+				start = i
+			}
+			b.WriteByte(c)
+			b.WriteByte(l[i+1])
+			i++
+		default:
+			if start < 0 {
+				start = i
+			}
+			b.WriteByte(c)
+		}
+	}
+	flush()
+
+	return fields, offsets
+}
+
+// ParseCodeowners parses given source as byte sequence into CODEOWNERS syntax tree. Each line
+// consists of a file pattern followed by zero or more owners. Empty lines and comments starting
+// with "#" are skipped. Unlike workflow files, the file has no syntax error since GitHub simply
+// ignores the lines it cannot understand. Invalid patterns and owners are checked by
+// RuleCodeowners.
+func ParseCodeowners(b []byte) *Codeowners {
+	c := &Codeowners{}
+	for i, l := range bytes.Split(b, []byte{'\n'}) {
+		fields, offsets := splitCodeownersLine(string(l))
+		if len(fields) == 0 {
+			continue
+		}
+		line := i + 1
+		r := &CodeownersRule{
+			Pattern: &String{Value: fields[0], Pos: &Pos{Line: line, Col: offsets[0] + 1}},
+			Owners:  make([]*String, 0, len(fields)-1),
+			Pos:     &Pos{Line: line, Col: offsets[0] + 1},
+		}
+		for j, f := range fields[1:] {
+			r.Owners = append(r.Owners, &String{Value: f, Pos: &Pos{Line: line, Col: offsets[j+1] + 1}})
+		}
+		c.Rules = append(c.Rules, r)
+	}
+	return c
+}
@@ -0,0 +1,242 @@
+package actionlint
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// https://docs.github.com/en/code-security/dependabot/dependabot-version-updates/configuration-options-for-the-dependabot.yml-file#schedule-
+func (p *parser) parseDependabotSchedule(pos *Pos, n *yaml.Node) *DependabotSchedule {
+	ret := &DependabotSchedule{Pos: pos}
+	for _, kv := range p.parseSectionMapping("schedule", n, false, true) {
+		switch kv.id {
+		case "interval":
+			ret.Interval = p.parseString(kv.val, false)
+		case "day":
+			ret.Day = p.parseString(kv.val, false)
+		case "time":
+			ret.Time = p.parseString(kv.val, false)
+		case "timezone":
+			ret.Timezone = p.parseString(kv.val, false)
+		case "cronjob":
+			ret.Cronjob = p.parseString(kv.val, false)
+		default:
+			p.unexpectedKey(kv.key, "schedule", []string{"interval", "day", "time", "timezone", "cronjob"})
+		}
+	}
+	if ret.Interval == nil {
+		p.error(n, "\"interval\" is required in \"schedule\" section")
+	}
+	return ret
+}
+
+// https://docs.github.com/en/code-security/dependabot/dependabot-version-updates/configuration-options-for-the-dependabot.yml-file#groups--
+func (p *parser) parseDependabotGroups(n *yaml.Node) map[string]*DependabotGroup {
+	groups := p.parseSectionMapping("groups", n, false, true)
+	ret := make(map[string]*DependabotGroup, len(groups))
+	for _, kv := range groups {
+		g := &DependabotGroup{Name: kv.key}
+		for _, attr := range p.parseMapping("group of dependencies", kv.val, false, true) {
+			switch attr.id {
+			case "applies-to":
+				g.AppliesTo = p.parseString(attr.val, false)
+			case "dependency-type":
+				g.DependencyType = p.parseString(attr.val, false)
+			case "patterns":
+				g.Patterns = p.parseStringSequence("patterns", attr.val, false, false)
+			case "exclude-patterns":
+				g.ExcludePatterns = p.parseStringSequence("exclude-patterns", attr.val, false, false)
+			case "update-types":
+				g.UpdateTypes = p.parseStringSequence("update-types", attr.val, false, false)
+			case "group-by":
+				p.parseString(attr.val, false)
+			default:
+				p.unexpectedKey(attr.key, "groups", []string{
+					"applies-to",
+					"dependency-type",
+					"patterns",
+					"exclude-patterns",
+					"update-types",
+					"group-by",
+				})
+			}
+		}
+		ret[kv.id] = g
+	}
+	return ret
+}
+
+// https://docs.github.com/en/code-security/dependabot/dependabot-version-updates/configuration-options-for-the-dependabot.yml-file#configuration-options-for-updates
+func (p *parser) parseDependabotUpdate(n *yaml.Node) *DependabotUpdate {
+	ret := &DependabotUpdate{Pos: posAt(n)}
+	for _, kv := range p.parseMapping("element of \"updates\" section", n, false, true) {
+		switch kv.id {
+		case "package-ecosystem":
+			ret.PackageEcosystem = p.parseString(kv.val, false)
+		case "directory":
+			ret.Directory = p.parseString(kv.val, false)
+		case "directories":
+			ret.Directories = p.parseStringSequence("directories", kv.val, false, false)
+			if ret.Directories == nil {
+				ret.Directories = []*String{}
+			}
+		case "schedule":
+			ret.Schedule = p.parseDependabotSchedule(kv.key.Pos, kv.val)
+		case "groups":
+			ret.Groups = p.parseDependabotGroups(kv.val)
+		case "registries":
+			ret.Registries = p.parseStringOrStringSequence("registries", kv.val, false, false)
+		case "target-branch":
+			ret.TargetBranch = p.parseString(kv.val, false)
+		case "allow",
+			"assignees",
+			"commit-message",
+			"cooldown",
+			"exclude-paths",
+			"ignore",
+			"insecure-external-code-execution",
+			"labels",
+			"milestone",
+			"multi-ecosystem-group",
+			"open-pull-requests-limit",
+			"patterns",
+			"pull-request-branch-name",
+			"rebase-strategy",
+			"reviewers",
+			"vendor",
+			"versioning-strategy":
+			// These sections are not checked for now
+		default:
+			p.unexpectedKey(kv.key, "updates", []string{
+				"allow",
+				"assignees",
+				"commit-message",
+				"cooldown",
+				"directories",
+				"directory",
+				"exclude-paths",
+				"groups",
+				"ignore",
+				"insecure-external-code-execution",
+				"labels",
+				"milestone",
+				"multi-ecosystem-group",
+				"open-pull-requests-limit",
+				"package-ecosystem",
+				"patterns",
+				"pull-request-branch-name",
+				"rebase-strategy",
+				"registries",
+				"reviewers",
+				"schedule",
+				"target-branch",
+				"vendor",
+				"versioning-strategy",
+			})
+		}
+	}
+
+	if ret.PackageEcosystem == nil {
+		p.error(n, "\"package-ecosystem\" is required in element of \"updates\" section")
+	}
+	if ret.Directory == nil && ret.Directories == nil {
+		p.error(n, "\"directory\" or \"directories\" is required in element of \"updates\" section")
+	}
+	if ret.Schedule == nil {
+		p.error(n, "\"schedule\" is required in element of \"updates\" section")
+	}
+
+	return ret
+}
+
+// https://docs.github.com/en/code-security/dependabot/working-with-dependabot/configuring-access-to-private-registries-for-dependabot
+func (p *parser) parseDependabotRegistries(n *yaml.Node) map[string]*DependabotRegistry {
+	regs := p.parseSectionMapping("registries", n, false, true)
+	ret := make(map[string]*DependabotRegistry, len(regs))
+	for _, kv := range regs {
+		r := &DependabotRegistry{Name: kv.key}
+		// Other keys such as "username" or "token" depend on the type of registry. They are not
+		// checked since they are passed to the registry as-is.
+		for _, attr := range p.parseMapping("registry", kv.val, false, true) {
+			switch attr.id {
+			case "type":
+				r.Type = p.parseString(attr.val, false)
+			case "url":
+				r.URL = p.parseString(attr.val, false)
+			}
+		}
+		if r.Type == nil {
+			p.errorf(kv.val, "\"type\" is required in registry %q", kv.key.Value)
+		}
+		ret[kv.id] = r
+	}
+	return ret
+}
+
+func (p *parser) parseDependabot(n *yaml.Node) *Dependabot {
+	d := &Dependabot{}
+
+	if n.Line == 0 {
+		n.Line = 1
+	}
+	if n.Column == 0 {
+		n.Column = 1
+	}
+
+	if len(n.Content) == 0 {
+		p.error(n, "Dependabot configuration is empty")
+		return d
+	}
+
+	for _, kv := range p.parseMapping("Dependabot configuration", n.Content[0], false, true) {
+		switch kv.id {
+		case "version":
+			d.Version = p.parseInt(kv.val)
+		case "updates":
+			d.Updates = []*DependabotUpdate{} // Distinguish the invalid "updates" section from the missing one
+			if p.checkSequence("updates", kv.val, false) {
+				for _, c := range kv.val.Content {
+					d.Updates = append(d.Updates, p.parseDependabotUpdate(c))
+				}
+			}
+		case "registries":
+			d.Registries = p.parseDependabotRegistries(kv.val)
+		case "enable-beta-ecosystems":
+			p.parseBool(kv.val)
+		case "multi-ecosystem-groups":
+			// This section is not checked for now
+		default:
+			p.unexpectedKey(kv.key, "Dependabot configuration", []string{
+				"version",
+				"updates",
+				"registries",
+				"enable-beta-ecosystems",
+				"multi-ecosystem-groups",
+			})
+		}
+	}
+
+	if d.Version == nil {
+		p.error(n, "\"version\" is required in Dependabot configuration")
+	}
+	if d.Updates == nil {
+		p.error(n, "\"updates\" is required in Dependabot configuration")
+	}
+
+	return d
+}
+
+// ParseDependabot parses given source as byte sequence into Dependabot configuration
+// (dependabot.yml) syntax tree. Like Parse function, it returns all errors detected while parsing
+// the input.
+func ParseDependabot(b []byte) (*Dependabot, []*Error) {
+	var n yaml.Node
+
+	if err := yaml.Unmarshal(b, &n); err != nil {
+		return nil, handleYAMLError(err)
+	}
+
+	p := &parser{}
+	d := p.parseDependabot(&n)
+
+	return d, p.errors
+}
@@ -0,0 +1,197 @@
+package actionlint
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// parseLiteralBool parses the boolean value which does not allow ${{ }} expression.
+func (p *parser) parseLiteralBool(n *yaml.Node) *Bool {
+	if n.Kind != yaml.ScalarNode || n.Tag != "!!bool" {
+		p.errorf(n, "expected bool value but found %s node with %q tag", nodeKindName(n.Kind), n.Tag)
+		return nil
+	}
+	return p.parseBool(n)
+}
+
+func (p *parser) parseIssueFormOption(n *yaml.Node) *IssueFormOption {
+	o := &IssueFormOption{Pos: posAt(n)}
+	if n.Kind != yaml.MappingNode {
+		o.Label = p.parseString(n, false)
+		return o
+	}
+
+	o.Mapping = true
+	for _, kv := range p.parseMapping("option", n, false, true) {
+		switch kv.id {
+		case "label":
+			o.Label = p.parseString(kv.val, false)
+		case "required":
+			o.Required = p.parseLiteralBool(kv.val)
+		default:
+			p.unexpectedKey(kv.key, "options", []string{"label", "required"})
+		}
+	}
+	if o.Label == nil {
+		p.error(n, "\"label\" is required in option of \"checkboxes\" element")
+	}
+	return o
+}
+
+// https://docs.github.com/en/communities/using-templates-to-encourage-useful-issues-and-pull-requests/syntax-for-githubs-form-schema
+func (p *parser) parseIssueFormAttributes(pos *Pos, n *yaml.Node) *IssueFormAttributes {
+	ret := &IssueFormAttributes{Pos: pos}
+	for _, kv := range p.parseSectionMapping("attributes", n, false, true) {
+		ret.Keys = append(ret.Keys, kv.key)
+		switch kv.id {
+		case "label":
+			ret.Label = p.parseString(kv.val, false)
+		case "description":
+			ret.Description = p.parseString(kv.val, true)
+		case "placeholder":
+			ret.Placeholder = p.parseString(kv.val, true)
+		case "value":
+			ret.Value = p.parseString(kv.val, false)
+		case "render":
+			ret.Render = p.parseString(kv.val, false)
+		case "multiple":
+			ret.Multiple = p.parseLiteralBool(kv.val)
+		case "options":
+			if p.checkSequence("options", kv.val, false) {
+				ret.Options = make([]*IssueFormOption, 0, len(kv.val.Content))
+				for _, c := range kv.val.Content {
+					ret.Options = append(ret.Options, p.parseIssueFormOption(c))
+				}
+			}
+			if ret.Options == nil {
+				ret.Options = []*IssueFormOption{} // Distinguish the invalid "options" section from the missing one
+			}
+		case "default":
+			ret.Default = p.parseInt(kv.val)
+		default:
+			p.unexpectedKey(kv.key, "attributes", []string{
+				"label",
+				"description",
+				"placeholder",
+				"value",
+				"render",
+				"multiple",
+				"options",
+				"default",
+			})
+		}
+	}
+	return ret
+}
+
+// https://docs.github.com/en/communities/using-templates-to-encourage-useful-issues-and-pull-requests/syntax-for-githubs-form-schema
+func (p *parser) parseIssueFormElement(n *yaml.Node) *IssueFormElement {
+	ret := &IssueFormElement{Pos: posAt(n)}
+	for _, kv := range p.parseMapping("element of \"body\" section", n, false, true) {
+		switch kv.id {
+		case "type":
+			ret.Type = p.parseString(kv.val, false)
+		case "id":
+			ret.ID = p.parseString(kv.val, false)
+		case "attributes":
+			ret.Attributes = p.parseIssueFormAttributes(kv.key.Pos, kv.val)
+		case "validations":
+			ret.Validations = kv.key.Pos
+			for _, v := range p.parseSectionMapping("validations", kv.val, false, true) {
+				switch v.id {
+				case "required":
+					ret.Required = p.parseLiteralBool(v.val)
+				default:
+					p.unexpectedKey(v.key, "validations", []string{"required"})
+				}
+			}
+		default:
+			p.unexpectedKey(kv.key, "body", []string{"type", "id", "attributes", "validations"})
+		}
+	}
+
+	if ret.Type == nil {
+		p.error(n, "\"type\" is required in element of \"body\" section")
+	}
+
+	return ret
+}
+
+func (p *parser) parseIssueForm(n *yaml.Node) *IssueForm {
+	f := &IssueForm{}
+
+	if n.Line == 0 {
+		n.Line = 1
+	}
+	if n.Column == 0 {
+		n.Column = 1
+	}
+
+	if len(n.Content) == 0 {
+		p.error(n, "issue form is empty")
+		return f
+	}
+
+	for _, kv := range p.parseMapping("issue form", n.Content[0], false, true) {
+		switch kv.id {
+		case "name":
+			f.Name = p.parseString(kv.val, false)
+		case "description":
+			f.Description = p.parseString(kv.val, false)
+		case "title":
+			f.Title = p.parseString(kv.val, true)
+		case "labels":
+			f.Labels = p.parseStringOrStringSequence("labels", kv.val, true, true)
+		case "assignees":
+			f.Assignees = p.parseStringOrStringSequence("assignees", kv.val, true, true)
+		case "projects":
+			f.Projects = p.parseStringOrStringSequence("projects", kv.val, true, false)
+		case "type":
+			f.Type = p.parseString(kv.val, false)
+		case "body":
+			f.Body = []*IssueFormElement{} // Distinguish the invalid "body" section from the missing one
+			if p.checkSequence("body", kv.val, false) {
+				for _, c := range kv.val.Content {
+					f.Body = append(f.Body, p.parseIssueFormElement(c))
+				}
+			}
+		default:
+			p.unexpectedKey(kv.key, "issue form", []string{
+				"name",
+				"description",
+				"title",
+				"labels",
+				"assignees",
+				"projects",
+				"type",
+				"body",
+			})
+		}
+	}
+
+	if f.Name == nil {
+		p.error(n, "\"name\" is required in issue form")
+	}
+	if f.Description == nil {
+		p.error(n, "\"description\" is required in issue form")
+	}
+	if f.Body == nil {
+		p.error(n, "\"body\" is required in issue form")
+	}
+
+	return f
+}
+
+// ParseIssueForm parses given source as byte sequence into issue form syntax tree. Like Parse
+// function, it returns all errors detected while parsing the input.
+func ParseIssueForm(b []byte) (*IssueForm, []*Error) {
+	var n yaml.Node
+
+	if err := yaml.Unmarshal(b, &n); err != nil {
+		return nil, handleYAMLError(err)
+	}
+
+	p := &parser{}
+	f := p.parseIssueForm(&n)
+
+	return f, p.errors
+}
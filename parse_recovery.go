@@ -0,0 +1,219 @@
+package actionlint
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// maxYAMLErrorRecoveries is the maximum number of YAML syntax errors recovered in one file.
+	maxYAMLErrorRecoveries = 10
+	// maxYAMLRecoveryAttempts is the maximum number of regions tried to recover from one YAML
+	// syntax error. Each attempt parses the entire file again.
+	maxYAMLRecoveryAttempts = 30
+)
+
+var reYAMLErrorLine = regexp.MustCompile(`\bline (\d+):`)
+
+// lineRange is a range of lines in source. Both start and end are 1-based and inclusive.
+type lineRange struct {
+	start int
+	end   int
+}
+
+func (r lineRange) contains(line int) bool {
+	return r.start <= line && line <= r.end
+}
+
+// yamlSeqItem is an item of YAML block sequence found in source like "- run: echo".
+type yamlSeqItem struct {
+	lines  lineRange
+	indent int // Column of '-'
+}
+
+func lineIndent(l []byte) (int, bool) {
+	i := 0
+	for i < len(l) && l[i] == ' ' {
+		i++
+	}
+	if i == len(l) || l[i] == '#' || l[i] == '\r' {
+		return 0, false // Blank or comment line
+	}
+	return i, true
+}
+
+// isYAMLSeqItemStart returns true when the line trimmed at the start starts with the indicator of
+// block sequence item. "-" followed by nothing like an empty item at the end of file is also an
+// item, but "-foo" is a plain scalar.
+func isYAMLSeqItemStart(l []byte) bool {
+	if len(l) == 0 || l[0] != '-' {
+		return false
+	}
+	if len(l) == 1 {
+		return true
+	}
+	switch l[1] {
+	case ' ', '\t', '\r':
+		return true
+	default:
+		return false
+	}
+}
+
+// findYAMLSeqItems finds block sequence items in the lines by their indentation. Lines in block
+// scalars may be found as items by mistake, but it is harmless since removing them does not fix
+// any syntax error.
+func findYAMLSeqItems(lines [][]byte) []yamlSeqItem {
+	items := []yamlSeqItem{}
+	for i, l := range lines {
+		ind, ok := lineIndent(l)
+		if !ok || !isYAMLSeqItemStart(l[ind:]) {
+			continue
+		}
+		end := i
+		for j := i + 1; j < len(lines); j++ {
+			if k, ok := lineIndent(lines[j]); ok {
+				if k <= ind {
+					break
+				}
+				end = j
+			}
+		}
+		items = append(items, yamlSeqItem{lineRange{i + 1, end + 1}, ind})
+	}
+	return items
+}
+
+// yamlErrorLine returns the line number of the YAML syntax error. It returns 0 when the line is
+// unknown.
+func yamlErrorLine(err error) int {
+	if ss := reYAMLErrorLine.FindStringSubmatch(err.Error()); len(ss) > 1 {
+		l, _ := strconv.Atoi(ss[1])
+		return l
+	}
+	return 0
+}
+
+// replaceYAMLSeqItem replaces the sequence item with an empty item "- {}" keeping positions of
+// other lines.
+func replaceYAMLSeqItem(lines [][]byte, item yamlSeqItem) [][]byte {
+	ret := make([][]byte, len(lines))
+	copy(ret, lines)
+	ret[item.lines.start-1] = append(bytes.Repeat([]byte{' '}, item.indent), "- {}"...)
+	for l := item.lines.start + 1; l <= item.lines.end; l++ {
+		ret[l-1] = nil
+	}
+	return ret
+}
+
+// recoveryCandidates returns the sequence items which may cause the syntax error at the line in
+// the order to try. Items containing the line are tried from the innermost one. Since the line
+// reported by the YAML parser is sometimes the start of the enclosing block, items after the line
+// are tried next.
+func recoveryCandidates(items []yamlSeqItem, line int) []yamlSeqItem {
+	inner := []yamlSeqItem{}
+	after := []yamlSeqItem{}
+	for _, it := range items {
+		if it.lines.contains(line) {
+			inner = append(inner, it)
+		} else if it.lines.start > line {
+			after = append(after, it)
+		}
+	}
+	sort.SliceStable(inner, func(i, j int) bool {
+		return inner[i].lines.end-inner[i].lines.start < inner[j].lines.end-inner[j].lines.start
+	})
+	return append(inner, after...)
+}
+
+// parseYAMLBeforeError parses the lines before the syntax error which could not be recovered so
+// that the sequence items recovered until the error can still be checked. The lines from the error
+// to the end are skipped. Since the line reported by the YAML parser is sometimes after the broken
+// item, the source is also cut at the start of each item containing the line. It returns nil when
+// no sequence item was recovered yet or the lines before the error could not be parsed.
+func parseYAMLBeforeError(lines [][]byte, line int, recovered []lineRange) (*yaml.Node, []lineRange) {
+	if len(recovered) == 0 || line <= 1 || line > len(lines) {
+		return nil, nil
+	}
+
+	cuts := []int{line}
+	for _, it := range findYAMLSeqItems(lines) {
+		if it.lines.contains(line) && it.lines.start < line {
+			cuts = append(cuts, it.lines.start)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(cuts)))
+
+	for _, c := range cuts {
+		var n yaml.Node
+		if err := yaml.Unmarshal(bytes.Join(lines[:c-1], []byte{'\n'}), &n); err != nil || n.Kind == 0 {
+			continue
+		}
+		return &n, append(recovered, lineRange{c, len(lines)})
+	}
+	return nil, nil
+}
+
+// parseYAMLWithRecovery parses the source as YAML. When the source has a localized syntax error
+// such as one malformed step, it replaces the sequence item containing the error with an empty
+// item and parses the source again so that the rest of the file can be checked. It returns the
+// parsed node, the errors of YAML syntax, and the ranges of lines which were replaced. When some
+// errors were recovered but the rest could not be, the node parsed from the lines before the
+// remaining error is returned. The node is nil when no syntax error could be recovered.
+func parseYAMLWithRecovery(b []byte) (*yaml.Node, []error, []lineRange) {
+	var n yaml.Node
+	err := yaml.Unmarshal(b, &n)
+	if err == nil {
+		return &n, nil, nil
+	}
+	if _, ok := err.(*yaml.TypeError); ok {
+		return nil, []error{err}, nil
+	}
+
+	lines := bytes.Split(b, []byte{'\n'})
+	errs := []error{err}
+	recovered := []lineRange{}
+	for {
+		line := yamlErrorLine(err)
+		if line == 0 {
+			return nil, errs, nil
+		}
+		if len(errs) > maxYAMLErrorRecoveries {
+			n, skipped := parseYAMLBeforeError(lines, line, recovered)
+			return n, errs, skipped
+		}
+
+		found := false
+		for i, it := range recoveryCandidates(findYAMLSeqItems(lines), line) {
+			if i >= maxYAMLRecoveryAttempts {
+				break
+			}
+			replaced := replaceYAMLSeqItem(lines, it)
+			var m yaml.Node
+			e := yaml.Unmarshal(bytes.Join(replaced, []byte{'\n'}), &m)
+			if e == nil {
+				return &m, errs, append(recovered, it.lines)
+			}
+			if _, ok := e.(*yaml.TypeError); ok {
+				continue
+			}
+			// Another syntax error after the line means this error was recovered
+			if l := yamlErrorLine(e); l > line {
+				lines = replaced
+				recovered = append(recovered, it.lines)
+				errs = append(errs, e)
+				err = e
+				found = true
+				break
+			}
+		}
+		if !found {
+			n, skipped := parseYAMLBeforeError(lines, line, recovered)
+			return n, errs, skipped
+		}
+	}
+}
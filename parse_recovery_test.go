@@ -0,0 +1,214 @@
+package actionlint
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testRecoveredLines(start, count int) []lineRange {
+	ret := make([]lineRange, 0, count)
+	for l := start; l < start+count; l++ {
+		ret = append(ret, lineRange{l, l})
+	}
+	return ret
+}
+
+func TestFindYAMLSeqItems(t *testing.T) {
+	testCases := []struct {
+		what  string
+		input string
+		want  []yamlSeqItem
+	}{
+		{"item at end of file", "a:\n  - b\n  - c", []yamlSeqItem{{lineRange{2, 2}, 2}, {lineRange{3, 3}, 2}}},
+		{"empty item at end of file", "a:\n  - b\n  -", []yamlSeqItem{{lineRange{2, 2}, 2}, {lineRange{3, 3}, 2}}},
+		{"empty item with CRLF", "a:\r\n  -\r\n  - b\r\n", []yamlSeqItem{{lineRange{2, 2}, 2}, {lineRange{3, 3}, 2}}},
+		{"multi-line item at end of file", "a:\n  - b: c\n    d: e", []yamlSeqItem{{lineRange{2, 3}, 2}}},
+		{"plain scalar starting with hyphen", "a:\n  -b\n  --c\n", []yamlSeqItem{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			lines := bytes.Split([]byte(tc.input), []byte{'\n'})
+			have := findYAMLSeqItems(lines)
+			if !cmp.Equal(tc.want, have, cmp.AllowUnexported(yamlSeqItem{}, lineRange{})) {
+				t.Fatal(cmp.Diff(tc.want, have, cmp.AllowUnexported(yamlSeqItem{}, lineRange{})))
+			}
+		})
+	}
+}
+
+func TestParseYAMLWithRecovery(t *testing.T) {
+	testCases := []struct {
+		what    string
+		input   string
+		errs    int
+		skipped []lineRange
+	}{
+		{
+			what: "no error",
+			input: `on: push
+jobs:
+  test:
+    steps:
+      - run: echo
+`,
+		},
+		{
+			what: "broken step",
+			input: `on: push
+jobs:
+  test:
+    steps:
+      - run: echo: foo
+      - run: echo
+`,
+			errs:    1,
+			skipped: []lineRange{{5, 5}},
+		},
+		{
+			what: "broken multi-line step",
+			input: `on: push
+jobs:
+  test:
+    steps:
+      - run: echo
+      - name: foo
+        with: {a: b
+      - run: echo
+`,
+			errs:    1,
+			skipped: []lineRange{{6, 7}},
+		},
+		{
+			what: "wrong indentation of step",
+			input: `on: push
+jobs:
+  test:
+    steps:
+      - name: foo
+        run: echo
+       - run: echo
+`,
+			errs:    1,
+			skipped: []lineRange{{5, 7}},
+		},
+		{
+			what: "multiple broken steps",
+			input: `on: push
+jobs:
+  test:
+    steps:
+      - run: echo: foo
+      - run: echo
+  test2:
+    steps:
+      - run: echo
+      - run: echo: foo
+`,
+			errs:    2,
+			skipped: []lineRange{{5, 5}, {10, 10}},
+		},
+		{
+			what: "broken step at end of file",
+			input: `on: push
+jobs:
+  test:
+    steps:
+      - run: echo
+      - run: echo: foo`,
+			errs:    1,
+			skipped: []lineRange{{6, 6}},
+		},
+		{
+			what: "broken step before empty item at end of file",
+			input: `on: push
+jobs:
+  test:
+    steps:
+      - run: echo: foo
+      -`,
+			errs:    1,
+			skipped: []lineRange{{5, 5}},
+		},
+		{
+			what: "partially recovered",
+			input: `on: push
+jobs:
+  test:
+    steps:
+      - run: echo: foo
+      - run: echo
+  test2: foo: bar
+`,
+			errs:    2,
+			skipped: []lineRange{{5, 5}, {7, 8}},
+		},
+		{
+			what:    "too many errors",
+			input:   "steps:\n" + strings.Repeat("  - run: echo: foo\n", maxYAMLErrorRecoveries+1),
+			errs:    maxYAMLErrorRecoveries + 1,
+			skipped: append(testRecoveredLines(2, maxYAMLErrorRecoveries), lineRange{maxYAMLErrorRecoveries + 2, maxYAMLErrorRecoveries + 3}),
+		},
+		{
+			what: "item in block scalar is not skipped",
+			input: `on: push
+jobs:
+  test:
+    steps:
+      - run: |
+          - echo
+      - run: echo: foo
+`,
+			errs:    1,
+			skipped: []lineRange{{7, 7}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			n, errs, skipped := parseYAMLWithRecovery([]byte(tc.input))
+			if n == nil {
+				t.Fatalf("could not recover: %v", errs)
+			}
+			if len(errs) != tc.errs {
+				t.Fatalf("wanted %d errors but got %v", tc.errs, errs)
+			}
+			if !cmp.Equal(tc.skipped, skipped, cmp.AllowUnexported(lineRange{})) {
+				t.Fatal(cmp.Diff(tc.skipped, skipped, cmp.AllowUnexported(lineRange{})))
+			}
+		})
+	}
+}
+
+func TestParseYAMLWithRecoveryFailure(t *testing.T) {
+	testCases := []struct {
+		what  string
+		input string
+	}{
+		{
+			what: "broken mapping outside sequence",
+			input: `on: push
+jobs:
+  test: foo: bar
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			n, errs, skipped := parseYAMLWithRecovery([]byte(tc.input))
+			if n != nil {
+				t.Fatal("recovered unexpectedly")
+			}
+			if len(errs) == 0 {
+				t.Fatal("no error was returned")
+			}
+			if len(skipped) != 0 {
+				t.Fatalf("no line should be skipped: %v", skipped)
+			}
+		})
+	}
+}
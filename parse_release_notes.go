@@ -0,0 +1,110 @@
+package actionlint
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+func (p *parser) parseReleaseNotesExclude(pos *Pos, n *yaml.Node) *ReleaseNotesExclude {
+	ret := &ReleaseNotesExclude{Pos: pos}
+	for _, kv := range p.parseSectionMapping("exclude", n, false, true) {
+		switch kv.id {
+		case "labels":
+			ret.Labels = p.parseStringSequence("labels", kv.val, false, true)
+		case "authors":
+			ret.Authors = p.parseStringSequence("authors", kv.val, false, true)
+		default:
+			p.unexpectedKey(kv.key, "exclude", []string{"labels", "authors"})
+		}
+	}
+	return ret
+}
+
+func (p *parser) parseReleaseNotesCategory(n *yaml.Node) *ReleaseNotesCategory {
+	ret := &ReleaseNotesCategory{Pos: posAt(n)}
+	for _, kv := range p.parseMapping("element of \"categories\" section", n, false, true) {
+		switch kv.id {
+		case "title":
+			ret.Title = p.parseString(kv.val, false)
+		case "labels":
+			ret.Labels = p.parseStringSequence("labels", kv.val, false, true)
+			if ret.Labels == nil {
+				ret.Labels = []*String{} // Distinguish the invalid "labels" section from the missing one
+			}
+		case "exclude":
+			ret.Exclude = p.parseReleaseNotesExclude(kv.key.Pos, kv.val)
+		default:
+			p.unexpectedKey(kv.key, "categories", []string{"title", "labels", "exclude"})
+		}
+	}
+
+	if ret.Title == nil {
+		p.error(n, "\"title\" is required in element of \"categories\" section")
+	}
+	if ret.Labels == nil {
+		p.error(n, "\"labels\" is required in element of \"categories\" section")
+	}
+
+	return ret
+}
+
+// https://docs.github.com/en/repositories/releasing-projects-on-github/automatically-generated-release-notes#configuration-options
+func (p *parser) parseReleaseNotes(n *yaml.Node) *ReleaseNotes {
+	r := &ReleaseNotes{}
+
+	if n.Line == 0 {
+		n.Line = 1
+	}
+	if n.Column == 0 {
+		n.Column = 1
+	}
+
+	if len(n.Content) == 0 {
+		p.error(n, "release notes configuration is empty")
+		return r
+	}
+
+	found := false
+	for _, kv := range p.parseMapping("release notes configuration", n.Content[0], false, true) {
+		if kv.id != "changelog" {
+			p.unexpectedKey(kv.key, "release notes configuration", []string{"changelog"})
+			continue
+		}
+		found = true
+		for _, c := range p.parseSectionMapping("changelog", kv.val, false, true) {
+			switch c.id {
+			case "exclude":
+				r.Exclude = p.parseReleaseNotesExclude(c.key.Pos, c.val)
+			case "categories":
+				if p.checkSequence("categories", c.val, false) {
+					for _, e := range c.val.Content {
+						r.Categories = append(r.Categories, p.parseReleaseNotesCategory(e))
+					}
+				}
+			default:
+				p.unexpectedKey(c.key, "changelog", []string{"exclude", "categories"})
+			}
+		}
+	}
+
+	if !found {
+		p.error(n, "\"changelog\" is required in release notes configuration")
+	}
+
+	return r
+}
+
+// ParseReleaseNotes parses given source as byte sequence into release notes configuration
+// (.github/release.yml) syntax tree. Like Parse function, it returns all errors detected while
+// parsing the input.
+func ParseReleaseNotes(b []byte) (*ReleaseNotes, []*Error) {
+	var n yaml.Node
+
+	if err := yaml.Unmarshal(b, &n); err != nil {
+		return nil, handleYAMLError(err)
+	}
+
+	p := &parser{}
+	r := p.parseReleaseNotes(&n)
+
+	return r, p.errors
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 	"time"
+
+	"golang.org/x/sync/errgroup"
 )
 
 // Pass is an interface to traverse a workflow syntax tree
@@ -22,8 +24,10 @@ type Pass interface {
 
 // Visitor visits syntax tree from root in depth-first order
 type Visitor struct {
-	passes []Pass
-	dbg    io.Writer
+	passes      []Pass
+	dbg         io.Writer
+	concurrency int
+	dependent   map[Pass]struct{}
 }
 
 // NewVisitor creates Visitor instance
@@ -42,12 +46,37 @@ func (v *Visitor) EnableDebug(w io.Writer) {
 	v.dbg = w
 }
 
+// SetConcurrency sets the maximum number of goroutines to visit a syntax tree. When it is larger
+// than 1, the passes are split into groups and each group visits the tree in its own goroutine.
+// Each pass still visits nodes in depth-first order, but the order of callbacks across passes in
+// different groups is not guaranteed. So passes must not depend on each other's state. The syntax
+// tree must not be modified by passes. Errors reported by rules are not mixed since each rule has
+// its own error buffer.
+func (v *Visitor) SetConcurrency(n int) {
+	v.concurrency = n
+}
+
+// SetDependentPasses marks the passes as depending on each other's state. For example, some rules
+// share caches of action metadata and only the first rule looking up the metadata reports an error
+// for it. The dependent passes are always visited in the same goroutine in the order they were
+// added so that the results are the same as visiting the tree sequentially.
+func (v *Visitor) SetDependentPasses(ps ...Pass) {
+	v.dependent = make(map[Pass]struct{}, len(ps))
+	for _, p := range ps {
+		v.dependent[p] = struct{}{}
+	}
+}
+
 func (v *Visitor) reportElapsedTime(what string, start time.Time) {
 	fmt.Fprintf(v.dbg, "[Visitor] %s took %vms\n", what, time.Since(start).Milliseconds())
 }
 
 // Visit visits given syntax tree in depth-first order
 func (v *Visitor) Visit(n *Workflow) error {
+	if v.concurrency > 1 && len(v.passes) > 1 {
+		return v.visitConcurrently(n)
+	}
+
 	var t time.Time
 	if v.dbg != nil {
 		t = time.Now()
@@ -147,3 +176,46 @@ func (v *Visitor) visitStep(n *Step) error {
 
 	return nil
 }
+
+func (v *Visitor) visitConcurrently(n *Workflow) error {
+	var t time.Time
+	if v.dbg != nil {
+		t = time.Now()
+	}
+
+	c := v.concurrency
+	if c > len(v.passes) {
+		c = len(v.passes)
+	}
+	// Dependent passes are put in the first group. Other passes are distributed in round-robin.
+	// Expensive rules tend to be added at first, so they are likely in different groups
+	groups := make([]*Visitor, c)
+	for i := range groups {
+		groups[i] = NewVisitor()
+	}
+	i := 0
+	for _, p := range v.passes {
+		if _, ok := v.dependent[p]; ok {
+			groups[0].passes = append(groups[0].passes, p)
+			continue
+		}
+		g := groups[i%c]
+		g.passes = append(g.passes, p)
+		i++
+	}
+
+	var eg errgroup.Group
+	for _, g := range groups {
+		g := g
+		eg.Go(func() error {
+			return g.Visit(n)
+		})
+	}
+	err := eg.Wait()
+
+	if v.dbg != nil {
+		v.reportElapsedTime(fmt.Sprintf("Visiting workflow with %d passes in %d goroutines", len(v.passes), c), t)
+	}
+
+	return err
+}
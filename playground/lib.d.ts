@@ -5,6 +5,44 @@ interface ActionlintError {
     column: number;
 }
 
+interface ActionlintFile {
+    path: string;
+    content: string;
+}
+
+interface ActionlintLintOptions {
+    config?: string;
+}
+
+interface ActionlintDiagnostic {
+    message: string;
+    filepath?: string;
+    line: number;
+    column: number;
+    kind: string;
+    snippet?: string;
+    end_column: number;
+    offset: number;
+    end_offset: number;
+    suggestions?: string[];
+    severity?: string;
+    source_line?: string;
+    indicator?: string;
+    doc_url?: string;
+}
+
+interface ActionlintLintResult {
+    errors: ActionlintDiagnostic[];
+    fatal?: string;
+}
+
+interface ActionlintAPI {
+    lint(files: ActionlintFile[] | string, options?: ActionlintLintOptions): ActionlintLintResult;
+}
+
+// eslint-disable-next-line no-var
+declare var actionlint: ActionlintAPI | undefined;
+
 interface Window {
     runActionlint?(src: string): void;
     getYamlSource(): string;
@@ -3,8 +3,13 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
 	"io"
+	"path"
+	"strings"
 	"syscall/js"
+	"testing/fstest"
 
 	"github.com/rhysd/actionlint"
 )
@@ -55,7 +60,119 @@ func runActionlint(_this js.Value, args []js.Value) interface{} {
 	return lint(source)
 }
 
+// lintedFile is a file passed to actionlint.lint() from JavaScript.
+type lintedFile struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+}
+
+// lintOptions is options passed to actionlint.lint() from JavaScript.
+type lintOptions struct {
+	// Config is the content of actionlint.yaml config file.
+	Config string `json:"config"`
+}
+
+// lintResult is the result of actionlint.lint(). Fatal is set when linting could not be done. The
+// errors are in the same format as the output of `-format '{{json .}}'`.
+type lintResult struct {
+	Errors []*actionlint.ErrorTemplateFields `json:"errors"`
+	Fatal  string                            `json:"fatal,omitempty"`
+}
+
+func lintFiles(files []lintedFile, opts *lintOptions) (*lintResult, error) {
+	fsys := fstest.MapFS{}
+	srcs := make(map[string][]byte, len(files))
+	paths := make([]string, 0, len(files))
+	for _, f := range files {
+		p := strings.TrimPrefix(path.Clean("/"+f.Path), "/")
+		if p == "" {
+			return nil, fmt.Errorf("invalid file path %q", f.Path)
+		}
+		if _, ok := srcs[p]; ok {
+			return nil, fmt.Errorf("file %q is given twice", p)
+		}
+		b := []byte(f.Content)
+		fsys[p] = &fstest.MapFile{Data: b}
+		srcs[p] = b
+		paths = append(paths, p)
+	}
+
+	lopts := &actionlint.LinterOptions{FS: fsys}
+	if opts.Config != "" {
+		// This path never conflicts with workflow files since they are in ".github" directory
+		const cfgPath = ".actionlint-playground-config.yaml"
+		fsys[cfgPath] = &fstest.MapFile{Data: []byte(opts.Config)}
+		lopts.ConfigFile = cfgPath
+	}
+	linter, err := actionlint.NewLinter(io.Discard, lopts)
+	if err != nil {
+		return nil, err
+	}
+
+	errs, err := linter.LintFiles(paths, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*actionlint.ErrorTemplateFields, 0, len(errs))
+	for _, err := range errs {
+		ret = append(ret, err.GetTemplateFields(srcs[err.Filepath]))
+	}
+	return &lintResult{Errors: ret}, nil
+}
+
+func jsonToJS(v interface{}) js.Value {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return js.ValueOf(map[string]interface{}{"errors": []interface{}{}, "fatal": err.Error()})
+	}
+	return js.Global().Get("JSON").Call("parse", string(b))
+}
+
+// lintAPI implements actionlint.lint(files, options) in JavaScript. The files parameter is an array
+// of objects with "path" and "content" properties, or a string of single workflow source. The
+// options parameter is optional. It returns an object with "errors" property. "fatal" property is
+// set when linting could not be done, for example, when the config is broken.
+func lintAPI(_this js.Value, args []js.Value) interface{} {
+	if len(args) == 0 {
+		return jsonToJS(&lintResult{Errors: []*actionlint.ErrorTemplateFields{}, Fatal: "files to lint must be given as the first argument"})
+	}
+
+	var files []lintedFile
+	if args[0].Type() == js.TypeString {
+		files = []lintedFile{{".github/workflows/test.yaml", args[0].String()}}
+	} else {
+		s := js.Global().Get("JSON").Call("stringify", args[0]).String()
+		if err := json.Unmarshal([]byte(s), &files); err != nil {
+			return jsonToJS(&lintResult{Errors: []*actionlint.ErrorTemplateFields{}, Fatal: "files must be an array of {path, content} objects: " + err.Error()})
+		}
+	}
+
+	var opts lintOptions
+	if len(args) > 1 && !args[1].IsUndefined() && !args[1].IsNull() {
+		s := js.Global().Get("JSON").Call("stringify", args[1]).String()
+		if err := json.Unmarshal([]byte(s), &opts); err != nil {
+			return jsonToJS(&lintResult{Errors: []*actionlint.ErrorTemplateFields{}, Fatal: "invalid options: " + err.Error()})
+		}
+	}
+
+	r, err := lintFiles(files, &opts)
+	if err != nil {
+		return jsonToJS(&lintResult{Errors: []*actionlint.ErrorTemplateFields{}, Fatal: err.Error()})
+	}
+	return jsonToJS(r)
+}
+
 func main() {
+	js.Global().Set("actionlint", js.ValueOf(map[string]interface{}{
+		"lint": js.FuncOf(lintAPI),
+	}))
+
+	if window.IsUndefined() {
+		// Used as a library outside browsers such as Node.js
+		select {}
+	}
+
 	window.Set("runActionlint", js.FuncOf(runActionlint))
 	window.Call("dismissLoading")
 	lint(window.Call("getYamlSource").String()) // Show the first result
@@ -128,4 +128,43 @@ jobs:
         const json = JSON.stringify(errors);
         assert.equal(errors.length, 0, json);
     });
+
+    it('lints multiple files with config by actionlint.lint', function () {
+        assert.ok(globalThis.actionlint);
+
+        const files = [
+            {
+                path: '.github/workflows/a.yaml',
+                content: 'on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo ${{ github.evnt }}\n',
+            },
+            {
+                path: '.github/workflows/b.yaml',
+                content: 'on: push\njobs:\n  test:\n    runs-on: my-runner\n    steps:\n      - run: echo hi\n',
+            },
+        ];
+        const config = 'self-hosted-runner:\n  labels: [my-runner]\n';
+        const result = globalThis.actionlint.lint(files, { config });
+        const json = JSON.stringify(result);
+        assert.equal(result.fatal, undefined, json);
+        assert.equal(result.errors.length, 1, json);
+
+        // eslint-disable-next-line @typescript-eslint/no-non-null-assertion
+        const err = result.errors[0]!;
+        assert.equal(err.filepath, '.github/workflows/a.yaml', json);
+        assert.equal(err.line, 6, json);
+        assert.equal(err.column, 23, json);
+        assert.equal(err.end_column, 33, json);
+        assert.equal(err.kind, 'expression', json);
+        assert.deepEqual(err.suggestions, ['event'], json);
+        assert.equal(err.source_line, '      - run: echo ${{ github.evnt }}', json);
+        assert.equal(err.indicator, '                      ^~~~~~~~~~~', json);
+        assert.equal(err.doc_url, 'https://github.com/rhysd/actionlint/blob/main/docs/checks.md#check-syntax-expression', json);
+    });
+
+    it('reports fatal error by actionlint.lint when config is broken', function () {
+        assert.ok(globalThis.actionlint);
+        const result = globalThis.actionlint.lint('on: push', { config: 'foo: [' });
+        assert.equal(result.errors.length, 0, JSON.stringify(result));
+        assert.ok(result.fatal?.includes('could not parse config file'), JSON.stringify(result));
+    });
 });
@@ -0,0 +1,786 @@
+package actionlint
+
+// pluginProtocolVersion is the version of the JSON object passed to plugins. It is incremented when
+// the structure of the object is changed in an incompatible way.
+const pluginProtocolVersion = 1
+
+// Types in this file are the syntax tree of workflow passed to plugins as JSON. They are separated
+// from the Go API so that refactoring the syntax tree does not break plugins. Keys are the field
+// names of the syntax tree in snake_case. Nodes of interface types have "kind" key to know the
+// concrete type. Nodes which have a position have "line" and "column" keys. See docs/usage.md for
+// the details.
+
+type pluginPos struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+func newPluginPos(p *Pos) *pluginPos {
+	if p == nil {
+		return nil
+	}
+	return &pluginPos{p.Line, p.Col}
+}
+
+type pluginString struct {
+	Value  string `json:"value"`
+	Quoted bool   `json:"quoted"`
+	*pluginPos
+}
+
+func newPluginString(s *String) *pluginString {
+	if s == nil {
+		return nil
+	}
+	return &pluginString{s.Value, s.Quoted, newPluginPos(s.Pos)}
+}
+
+func newPluginStrings(ss []*String) []*pluginString {
+	if ss == nil {
+		return nil
+	}
+	ret := make([]*pluginString, 0, len(ss))
+	for _, s := range ss {
+		ret = append(ret, newPluginString(s))
+	}
+	return ret
+}
+
+type pluginBool struct {
+	Value      bool          `json:"value"`
+	Expression *pluginString `json:"expression"`
+	*pluginPos
+}
+
+func newPluginBool(b *Bool) *pluginBool {
+	if b == nil {
+		return nil
+	}
+	return &pluginBool{b.Value, newPluginString(b.Expression), newPluginPos(b.Pos)}
+}
+
+type pluginInt struct {
+	Value      int           `json:"value"`
+	Expression *pluginString `json:"expression"`
+	*pluginPos
+}
+
+func newPluginInt(i *Int) *pluginInt {
+	if i == nil {
+		return nil
+	}
+	return &pluginInt{i.Value, newPluginString(i.Expression), newPluginPos(i.Pos)}
+}
+
+type pluginFloat struct {
+	Value      float64       `json:"value"`
+	Expression *pluginString `json:"expression"`
+	*pluginPos
+}
+
+func newPluginFloat(f *Float) *pluginFloat {
+	if f == nil {
+		return nil
+	}
+	return &pluginFloat{f.Value, newPluginString(f.Expression), newPluginPos(f.Pos)}
+}
+
+type pluginWebhookEventFilter struct {
+	Name   *pluginString   `json:"name"`
+	Values []*pluginString `json:"values"`
+}
+
+func newPluginWebhookEventFilter(f *WebhookEventFilter) *pluginWebhookEventFilter {
+	if f == nil {
+		return nil
+	}
+	return &pluginWebhookEventFilter{newPluginString(f.Name), newPluginStrings(f.Values)}
+}
+
+type pluginWebhookEvent struct {
+	Kind           string                    `json:"kind"`
+	Hook           *pluginString             `json:"hook"`
+	Types          []*pluginString           `json:"types"`
+	Branches       *pluginWebhookEventFilter `json:"branches"`
+	BranchesIgnore *pluginWebhookEventFilter `json:"branches_ignore"`
+	Tags           *pluginWebhookEventFilter `json:"tags"`
+	TagsIgnore     *pluginWebhookEventFilter `json:"tags_ignore"`
+	Paths          *pluginWebhookEventFilter `json:"paths"`
+	PathsIgnore    *pluginWebhookEventFilter `json:"paths_ignore"`
+	Workflows      []*pluginString           `json:"workflows"`
+	*pluginPos
+}
+
+type pluginScheduledEvent struct {
+	Kind string          `json:"kind"`
+	Cron []*pluginString `json:"cron"`
+	*pluginPos
+}
+
+type pluginDispatchInput struct {
+	Name        *pluginString   `json:"name"`
+	Description *pluginString   `json:"description"`
+	Required    *pluginBool     `json:"required"`
+	Default     *pluginString   `json:"default"`
+	Type        string          `json:"type"`
+	Options     []*pluginString `json:"options"`
+}
+
+type pluginWorkflowDispatchEvent struct {
+	Kind   string                          `json:"kind"`
+	Inputs map[string]*pluginDispatchInput `json:"inputs"`
+	*pluginPos
+}
+
+type pluginRepositoryDispatchEvent struct {
+	Kind  string          `json:"kind"`
+	Types []*pluginString `json:"types"`
+	*pluginPos
+}
+
+type pluginWorkflowCallEventInput struct {
+	ID          string        `json:"id"`
+	Name        *pluginString `json:"name"`
+	Description *pluginString `json:"description"`
+	Default     *pluginString `json:"default"`
+	Required    *pluginBool   `json:"required"`
+	Type        string        `json:"type"`
+}
+
+type pluginWorkflowCallEventSecret struct {
+	Name        *pluginString `json:"name"`
+	Description *pluginString `json:"description"`
+	Required    *pluginBool   `json:"required"`
+}
+
+type pluginWorkflowCallEventOutput struct {
+	Name        *pluginString `json:"name"`
+	Description *pluginString `json:"description"`
+	Value       *pluginString `json:"value"`
+}
+
+type pluginWorkflowCallEvent struct {
+	Kind    string                                    `json:"kind"`
+	Inputs  []*pluginWorkflowCallEventInput           `json:"inputs"`
+	Secrets map[string]*pluginWorkflowCallEventSecret `json:"secrets"`
+	Outputs map[string]*pluginWorkflowCallEventOutput `json:"outputs"`
+	*pluginPos
+}
+
+func newPluginEvent(e Event) interface{} {
+	switch e := e.(type) {
+	case *WebhookEvent:
+		return &pluginWebhookEvent{
+			Kind:           "webhook",
+			Hook:           newPluginString(e.Hook),
+			Types:          newPluginStrings(e.Types),
+			Branches:       newPluginWebhookEventFilter(e.Branches),
+			BranchesIgnore: newPluginWebhookEventFilter(e.BranchesIgnore),
+			Tags:           newPluginWebhookEventFilter(e.Tags),
+			TagsIgnore:     newPluginWebhookEventFilter(e.TagsIgnore),
+			Paths:          newPluginWebhookEventFilter(e.Paths),
+			PathsIgnore:    newPluginWebhookEventFilter(e.PathsIgnore),
+			Workflows:      newPluginStrings(e.Workflows),
+			pluginPos:      newPluginPos(e.Pos),
+		}
+	case *ScheduledEvent:
+		return &pluginScheduledEvent{"schedule", newPluginStrings(e.Cron), newPluginPos(e.Pos)}
+	case *WorkflowDispatchEvent:
+		var is map[string]*pluginDispatchInput
+		if e.Inputs != nil {
+			is = make(map[string]*pluginDispatchInput, len(e.Inputs))
+			for k, i := range e.Inputs {
+				is[k] = &pluginDispatchInput{
+					Name:        newPluginString(i.Name),
+					Description: newPluginString(i.Description),
+					Required:    newPluginBool(i.Required),
+					Default:     newPluginString(i.Default),
+					Type:        pluginDispatchInputType(i.Type),
+					Options:     newPluginStrings(i.Options),
+				}
+			}
+		}
+		return &pluginWorkflowDispatchEvent{"workflow_dispatch", is, newPluginPos(e.Pos)}
+	case *RepositoryDispatchEvent:
+		return &pluginRepositoryDispatchEvent{"repository_dispatch", newPluginStrings(e.Types), newPluginPos(e.Pos)}
+	case *WorkflowCallEvent:
+		ret := &pluginWorkflowCallEvent{Kind: "workflow_call", pluginPos: newPluginPos(e.Pos)}
+		if e.Inputs != nil {
+			ret.Inputs = make([]*pluginWorkflowCallEventInput, 0, len(e.Inputs))
+			for _, i := range e.Inputs {
+				ret.Inputs = append(ret.Inputs, &pluginWorkflowCallEventInput{
+					ID:          i.ID,
+					Name:        newPluginString(i.Name),
+					Description: newPluginString(i.Description),
+					Default:     newPluginString(i.Default),
+					Required:    newPluginBool(i.Required),
+					Type:        pluginWorkflowCallEventInputType(i.Type),
+				})
+			}
+		}
+		if e.Secrets != nil {
+			ret.Secrets = make(map[string]*pluginWorkflowCallEventSecret, len(e.Secrets))
+			for k, s := range e.Secrets {
+				ret.Secrets[k] = &pluginWorkflowCallEventSecret{
+					newPluginString(s.Name),
+					newPluginString(s.Description),
+					newPluginBool(s.Required),
+				}
+			}
+		}
+		if e.Outputs != nil {
+			ret.Outputs = make(map[string]*pluginWorkflowCallEventOutput, len(e.Outputs))
+			for k, o := range e.Outputs {
+				ret.Outputs[k] = &pluginWorkflowCallEventOutput{
+					newPluginString(o.Name),
+					newPluginString(o.Description),
+					newPluginString(o.Value),
+				}
+			}
+		}
+		return ret
+	default:
+		return nil
+	}
+}
+
+func pluginDispatchInputType(t WorkflowDispatchEventInputType) string {
+	switch t {
+	case WorkflowDispatchEventInputTypeString:
+		return "string"
+	case WorkflowDispatchEventInputTypeNumber:
+		return "number"
+	case WorkflowDispatchEventInputTypeBoolean:
+		return "boolean"
+	case WorkflowDispatchEventInputTypeChoice:
+		return "choice"
+	case WorkflowDispatchEventInputTypeEnvironment:
+		return "environment"
+	default:
+		return ""
+	}
+}
+
+func pluginWorkflowCallEventInputType(t WorkflowCallEventInputType) string {
+	switch t {
+	case WorkflowCallEventInputTypeBoolean:
+		return "boolean"
+	case WorkflowCallEventInputTypeNumber:
+		return "number"
+	case WorkflowCallEventInputTypeString:
+		return "string"
+	default:
+		return ""
+	}
+}
+
+type pluginPermissionScope struct {
+	Name  *pluginString `json:"name"`
+	Value *pluginString `json:"value"`
+}
+
+type pluginPermissions struct {
+	All    *pluginString                     `json:"all"`
+	Scopes map[string]*pluginPermissionScope `json:"scopes"`
+	*pluginPos
+}
+
+func newPluginPermissions(p *Permissions) *pluginPermissions {
+	if p == nil {
+		return nil
+	}
+	ret := &pluginPermissions{All: newPluginString(p.All), pluginPos: newPluginPos(p.Pos)}
+	if p.Scopes != nil {
+		ret.Scopes = make(map[string]*pluginPermissionScope, len(p.Scopes))
+		for k, s := range p.Scopes {
+			ret.Scopes[k] = &pluginPermissionScope{newPluginString(s.Name), newPluginString(s.Value)}
+		}
+	}
+	return ret
+}
+
+type pluginDefaultsRun struct {
+	Shell            *pluginString `json:"shell"`
+	WorkingDirectory *pluginString `json:"working_directory"`
+	*pluginPos
+}
+
+type pluginDefaults struct {
+	Run *pluginDefaultsRun `json:"run"`
+	*pluginPos
+}
+
+func newPluginDefaults(d *Defaults) *pluginDefaults {
+	if d == nil {
+		return nil
+	}
+	ret := &pluginDefaults{pluginPos: newPluginPos(d.Pos)}
+	if r := d.Run; r != nil {
+		ret.Run = &pluginDefaultsRun{newPluginString(r.Shell), newPluginString(r.WorkingDirectory), newPluginPos(r.Pos)}
+	}
+	return ret
+}
+
+type pluginConcurrency struct {
+	Group            *pluginString `json:"group"`
+	CancelInProgress *pluginBool   `json:"cancel_in_progress"`
+	*pluginPos
+}
+
+func newPluginConcurrency(c *Concurrency) *pluginConcurrency {
+	if c == nil {
+		return nil
+	}
+	return &pluginConcurrency{newPluginString(c.Group), newPluginBool(c.CancelInProgress), newPluginPos(c.Pos)}
+}
+
+type pluginEnvironment struct {
+	Name *pluginString `json:"name"`
+	URL  *pluginString `json:"url"`
+	*pluginPos
+}
+
+func newPluginEnvironment(e *Environment) *pluginEnvironment {
+	if e == nil {
+		return nil
+	}
+	return &pluginEnvironment{newPluginString(e.Name), newPluginString(e.URL), newPluginPos(e.Pos)}
+}
+
+type pluginExecRun struct {
+	Kind             string        `json:"kind"`
+	Run              *pluginString `json:"run"`
+	Shell            *pluginString `json:"shell"`
+	WorkingDirectory *pluginString `json:"working_directory"`
+	*pluginPos
+}
+
+type pluginActionInput struct {
+	Name  *pluginString `json:"name"`
+	Value *pluginString `json:"value"`
+}
+
+type pluginExecAction struct {
+	Kind       string                        `json:"kind"`
+	Uses       *pluginString                 `json:"uses"`
+	Inputs     map[string]*pluginActionInput `json:"inputs"`
+	Entrypoint *pluginString                 `json:"entrypoint"`
+	Args       *pluginString                 `json:"args"`
+}
+
+func newPluginExec(e Exec) interface{} {
+	switch e := e.(type) {
+	case *ExecRun:
+		return &pluginExecRun{
+			Kind:             "run",
+			Run:              newPluginString(e.Run),
+			Shell:            newPluginString(e.Shell),
+			WorkingDirectory: newPluginString(e.WorkingDirectory),
+			pluginPos:        newPluginPos(e.RunPos),
+		}
+	case *ExecAction:
+		ret := &pluginExecAction{
+			Kind:       "action",
+			Uses:       newPluginString(e.Uses),
+			Entrypoint: newPluginString(e.Entrypoint),
+			Args:       newPluginString(e.Args),
+		}
+		if e.Inputs != nil {
+			ret.Inputs = make(map[string]*pluginActionInput, len(e.Inputs))
+			for k, i := range e.Inputs {
+				ret.Inputs[k] = &pluginActionInput{newPluginString(i.Name), newPluginString(i.Value)}
+			}
+		}
+		return ret
+	default:
+		return nil
+	}
+}
+
+type pluginRawYAMLObject struct {
+	Kind  string                 `json:"kind"`
+	Props map[string]interface{} `json:"props"`
+	*pluginPos
+}
+
+type pluginRawYAMLArray struct {
+	Kind  string        `json:"kind"`
+	Elems []interface{} `json:"elems"`
+	*pluginPos
+}
+
+type pluginRawYAMLString struct {
+	Kind   string `json:"kind"`
+	Value  string `json:"value"`
+	Quoted bool   `json:"quoted"`
+	*pluginPos
+}
+
+func newPluginRawYAMLValue(v RawYAMLValue) interface{} {
+	switch v := v.(type) {
+	case *RawYAMLObject:
+		ps := make(map[string]interface{}, len(v.Props))
+		for k, p := range v.Props {
+			ps[k] = newPluginRawYAMLValue(p)
+		}
+		return &pluginRawYAMLObject{"object", ps, newPluginPos(v.Pos())}
+	case *RawYAMLArray:
+		return &pluginRawYAMLArray{"array", newPluginRawYAMLValues(v.Elems), newPluginPos(v.Pos())}
+	case *RawYAMLString:
+		return &pluginRawYAMLString{"string", v.Value, v.Quoted, newPluginPos(v.Pos())}
+	default:
+		return nil
+	}
+}
+
+func newPluginRawYAMLValues(vs []RawYAMLValue) []interface{} {
+	if vs == nil {
+		return nil
+	}
+	ret := make([]interface{}, 0, len(vs))
+	for _, v := range vs {
+		ret = append(ret, newPluginRawYAMLValue(v))
+	}
+	return ret
+}
+
+type pluginMatrixRow struct {
+	Name       *pluginString `json:"name"`
+	Values     []interface{} `json:"values"`
+	Expression *pluginString `json:"expression"`
+}
+
+type pluginMatrixAssign struct {
+	Key   *pluginString `json:"key"`
+	Value interface{}   `json:"value"`
+}
+
+type pluginMatrixCombination struct {
+	Assigns    map[string]*pluginMatrixAssign `json:"assigns"`
+	Expression *pluginString                  `json:"expression"`
+}
+
+type pluginMatrixCombinations struct {
+	Combinations []*pluginMatrixCombination `json:"combinations"`
+	Expression   *pluginString              `json:"expression"`
+}
+
+func newPluginMatrixCombinations(cs *MatrixCombinations) *pluginMatrixCombinations {
+	if cs == nil {
+		return nil
+	}
+	ret := &pluginMatrixCombinations{Expression: newPluginString(cs.Expression)}
+	if cs.Combinations != nil {
+		ret.Combinations = make([]*pluginMatrixCombination, 0, len(cs.Combinations))
+		for _, c := range cs.Combinations {
+			pc := &pluginMatrixCombination{Expression: newPluginString(c.Expression)}
+			if c.Assigns != nil {
+				pc.Assigns = make(map[string]*pluginMatrixAssign, len(c.Assigns))
+				for k, a := range c.Assigns {
+					pc.Assigns[k] = &pluginMatrixAssign{newPluginString(a.Key), newPluginRawYAMLValue(a.Value)}
+				}
+			}
+			ret.Combinations = append(ret.Combinations, pc)
+		}
+	}
+	return ret
+}
+
+type pluginMatrix struct {
+	Rows       map[string]*pluginMatrixRow `json:"rows"`
+	Include    *pluginMatrixCombinations   `json:"include"`
+	Exclude    *pluginMatrixCombinations   `json:"exclude"`
+	Expression *pluginString               `json:"expression"`
+	*pluginPos
+}
+
+func newPluginMatrix(m *Matrix) *pluginMatrix {
+	if m == nil {
+		return nil
+	}
+	ret := &pluginMatrix{
+		Include:    newPluginMatrixCombinations(m.Include),
+		Exclude:    newPluginMatrixCombinations(m.Exclude),
+		Expression: newPluginString(m.Expression),
+		pluginPos:  newPluginPos(m.Pos),
+	}
+	if m.Rows != nil {
+		ret.Rows = make(map[string]*pluginMatrixRow, len(m.Rows))
+		for k, r := range m.Rows {
+			ret.Rows[k] = &pluginMatrixRow{newPluginString(r.Name), newPluginRawYAMLValues(r.Values), newPluginString(r.Expression)}
+		}
+	}
+	return ret
+}
+
+type pluginStrategy struct {
+	Matrix      *pluginMatrix `json:"matrix"`
+	FailFast    *pluginBool   `json:"fail_fast"`
+	MaxParallel *pluginInt    `json:"max_parallel"`
+	*pluginPos
+}
+
+func newPluginStrategy(s *Strategy) *pluginStrategy {
+	if s == nil {
+		return nil
+	}
+	return &pluginStrategy{newPluginMatrix(s.Matrix), newPluginBool(s.FailFast), newPluginInt(s.MaxParallel), newPluginPos(s.Pos)}
+}
+
+type pluginEnvVar struct {
+	Name  *pluginString `json:"name"`
+	Value *pluginString `json:"value"`
+}
+
+type pluginEnv struct {
+	Vars       map[string]*pluginEnvVar `json:"vars"`
+	Expression *pluginString            `json:"expression"`
+}
+
+func newPluginEnv(e *Env) *pluginEnv {
+	if e == nil {
+		return nil
+	}
+	ret := &pluginEnv{Expression: newPluginString(e.Expression)}
+	if e.Vars != nil {
+		ret.Vars = make(map[string]*pluginEnvVar, len(e.Vars))
+		for k, v := range e.Vars {
+			ret.Vars[k] = &pluginEnvVar{newPluginString(v.Name), newPluginString(v.Value)}
+		}
+	}
+	return ret
+}
+
+type pluginStep struct {
+	ID              *pluginString `json:"id"`
+	If              *pluginString `json:"if"`
+	Name            *pluginString `json:"name"`
+	Exec            interface{}   `json:"exec"`
+	Env             *pluginEnv    `json:"env"`
+	ContinueOnError *pluginBool   `json:"continue_on_error"`
+	TimeoutMinutes  *pluginFloat  `json:"timeout_minutes"`
+	*pluginPos
+}
+
+func newPluginStep(s *Step) *pluginStep {
+	return &pluginStep{
+		ID:              newPluginString(s.ID),
+		If:              newPluginString(s.If),
+		Name:            newPluginString(s.Name),
+		Exec:            newPluginExec(s.Exec),
+		Env:             newPluginEnv(s.Env),
+		ContinueOnError: newPluginBool(s.ContinueOnError),
+		TimeoutMinutes:  newPluginFloat(s.TimeoutMinutes),
+		pluginPos:       newPluginPos(s.Pos),
+	}
+}
+
+type pluginCredentials struct {
+	Username *pluginString `json:"username"`
+	Password *pluginString `json:"password"`
+	*pluginPos
+}
+
+type pluginContainer struct {
+	Image       *pluginString      `json:"image"`
+	Credentials *pluginCredentials `json:"credentials"`
+	Env         *pluginEnv         `json:"env"`
+	Ports       []*pluginString    `json:"ports"`
+	Volumes     []*pluginString    `json:"volumes"`
+	Options     *pluginString      `json:"options"`
+	*pluginPos
+}
+
+func newPluginContainer(c *Container) *pluginContainer {
+	if c == nil {
+		return nil
+	}
+	ret := &pluginContainer{
+		Image:     newPluginString(c.Image),
+		Env:       newPluginEnv(c.Env),
+		Ports:     newPluginStrings(c.Ports),
+		Volumes:   newPluginStrings(c.Volumes),
+		Options:   newPluginString(c.Options),
+		pluginPos: newPluginPos(c.Pos),
+	}
+	if cr := c.Credentials; cr != nil {
+		ret.Credentials = &pluginCredentials{newPluginString(cr.Username), newPluginString(cr.Password), newPluginPos(cr.Pos)}
+	}
+	return ret
+}
+
+type pluginService struct {
+	Name      *pluginString    `json:"name"`
+	Container *pluginContainer `json:"container"`
+}
+
+type pluginServices struct {
+	Value      map[string]*pluginService `json:"value"`
+	Expression *pluginString             `json:"expression"`
+	*pluginPos
+}
+
+func newPluginServices(s *Services) *pluginServices {
+	if s == nil {
+		return nil
+	}
+	ret := &pluginServices{Expression: newPluginString(s.Expression), pluginPos: newPluginPos(s.Pos)}
+	if s.Value != nil {
+		ret.Value = make(map[string]*pluginService, len(s.Value))
+		for k, v := range s.Value {
+			ret.Value[k] = &pluginService{newPluginString(v.Name), newPluginContainer(v.Container)}
+		}
+	}
+	return ret
+}
+
+type pluginOutput struct {
+	Name  *pluginString `json:"name"`
+	Value *pluginString `json:"value"`
+}
+
+type pluginRunner struct {
+	Labels     []*pluginString `json:"labels"`
+	LabelsExpr *pluginString   `json:"labels_expr"`
+	Group      *pluginString   `json:"group"`
+}
+
+func newPluginRunner(r *Runner) *pluginRunner {
+	if r == nil {
+		return nil
+	}
+	return &pluginRunner{newPluginStrings(r.Labels), newPluginString(r.LabelsExpr), newPluginString(r.Group)}
+}
+
+type pluginWorkflowCallInput struct {
+	Name  *pluginString `json:"name"`
+	Value *pluginString `json:"value"`
+}
+
+type pluginWorkflowCallSecret struct {
+	Name  *pluginString `json:"name"`
+	Value *pluginString `json:"value"`
+}
+
+type pluginWorkflowCall struct {
+	Uses           *pluginString                        `json:"uses"`
+	Inputs         map[string]*pluginWorkflowCallInput  `json:"inputs"`
+	Secrets        map[string]*pluginWorkflowCallSecret `json:"secrets"`
+	InheritSecrets bool                                 `json:"inherit_secrets"`
+}
+
+func newPluginWorkflowCall(c *WorkflowCall) *pluginWorkflowCall {
+	if c == nil {
+		return nil
+	}
+	ret := &pluginWorkflowCall{Uses: newPluginString(c.Uses), InheritSecrets: c.InheritSecrets}
+	if c.Inputs != nil {
+		ret.Inputs = make(map[string]*pluginWorkflowCallInput, len(c.Inputs))
+		for k, i := range c.Inputs {
+			ret.Inputs[k] = &pluginWorkflowCallInput{newPluginString(i.Name), newPluginString(i.Value)}
+		}
+	}
+	if c.Secrets != nil {
+		ret.Secrets = make(map[string]*pluginWorkflowCallSecret, len(c.Secrets))
+		for k, s := range c.Secrets {
+			ret.Secrets[k] = &pluginWorkflowCallSecret{newPluginString(s.Name), newPluginString(s.Value)}
+		}
+	}
+	return ret
+}
+
+type pluginJob struct {
+	ID              *pluginString            `json:"id"`
+	Name            *pluginString            `json:"name"`
+	Needs           []*pluginString          `json:"needs"`
+	RunsOn          *pluginRunner            `json:"runs_on"`
+	Permissions     *pluginPermissions       `json:"permissions"`
+	Environment     *pluginEnvironment       `json:"environment"`
+	Concurrency     *pluginConcurrency       `json:"concurrency"`
+	Outputs         map[string]*pluginOutput `json:"outputs"`
+	Env             *pluginEnv               `json:"env"`
+	Defaults        *pluginDefaults          `json:"defaults"`
+	If              *pluginString            `json:"if"`
+	Steps           []*pluginStep            `json:"steps"`
+	TimeoutMinutes  *pluginFloat             `json:"timeout_minutes"`
+	Strategy        *pluginStrategy          `json:"strategy"`
+	ContinueOnError *pluginBool              `json:"continue_on_error"`
+	Container       *pluginContainer         `json:"container"`
+	Services        *pluginServices          `json:"services"`
+	WorkflowCall    *pluginWorkflowCall      `json:"workflow_call"`
+	*pluginPos
+}
+
+func newPluginJob(j *Job) *pluginJob {
+	ret := &pluginJob{
+		ID:              newPluginString(j.ID),
+		Name:            newPluginString(j.Name),
+		Needs:           newPluginStrings(j.Needs),
+		RunsOn:          newPluginRunner(j.RunsOn),
+		Permissions:     newPluginPermissions(j.Permissions),
+		Environment:     newPluginEnvironment(j.Environment),
+		Concurrency:     newPluginConcurrency(j.Concurrency),
+		Env:             newPluginEnv(j.Env),
+		Defaults:        newPluginDefaults(j.Defaults),
+		If:              newPluginString(j.If),
+		TimeoutMinutes:  newPluginFloat(j.TimeoutMinutes),
+		Strategy:        newPluginStrategy(j.Strategy),
+		ContinueOnError: newPluginBool(j.ContinueOnError),
+		Container:       newPluginContainer(j.Container),
+		Services:        newPluginServices(j.Services),
+		WorkflowCall:    newPluginWorkflowCall(j.WorkflowCall),
+		pluginPos:       newPluginPos(j.Pos),
+	}
+	if j.Outputs != nil {
+		ret.Outputs = make(map[string]*pluginOutput, len(j.Outputs))
+		for k, o := range j.Outputs {
+			ret.Outputs[k] = &pluginOutput{newPluginString(o.Name), newPluginString(o.Value)}
+		}
+	}
+	if j.Steps != nil {
+		ret.Steps = make([]*pluginStep, 0, len(j.Steps))
+		for _, s := range j.Steps {
+			ret.Steps = append(ret.Steps, newPluginStep(s))
+		}
+	}
+	return ret
+}
+
+type pluginWorkflow struct {
+	Name        *pluginString         `json:"name"`
+	RunName     *pluginString         `json:"run_name"`
+	On          []interface{}         `json:"on"`
+	Permissions *pluginPermissions    `json:"permissions"`
+	Env         *pluginEnv            `json:"env"`
+	Defaults    *pluginDefaults       `json:"defaults"`
+	Concurrency *pluginConcurrency    `json:"concurrency"`
+	Jobs        map[string]*pluginJob `json:"jobs"`
+}
+
+// newPluginWorkflow converts the syntax tree of the workflow into the structure passed to plugins.
+func newPluginWorkflow(w *Workflow) *pluginWorkflow {
+	if w == nil {
+		return nil
+	}
+	ret := &pluginWorkflow{
+		Name:        newPluginString(w.Name),
+		RunName:     newPluginString(w.RunName),
+		Permissions: newPluginPermissions(w.Permissions),
+		Env:         newPluginEnv(w.Env),
+		Defaults:    newPluginDefaults(w.Defaults),
+		Concurrency: newPluginConcurrency(w.Concurrency),
+	}
+	if w.On != nil {
+		ret.On = make([]interface{}, 0, len(w.On))
+		for _, e := range w.On {
+			ret.On = append(ret.On, newPluginEvent(e))
+		}
+	}
+	if w.Jobs != nil {
+		ret.Jobs = make(map[string]*pluginJob, len(w.Jobs))
+		for k, j := range w.Jobs {
+			ret.Jobs[k] = newPluginJob(j)
+		}
+	}
+	return ret
+}
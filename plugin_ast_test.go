@@ -0,0 +1,139 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// testPluginNode is a node decoded from JSON passed to plugins.
+type testPluginNode struct {
+	Value  interface{} `json:"value"`
+	Line   int         `json:"line"`
+	Column int         `json:"column"`
+}
+
+func TestPluginWorkflowJSON(t *testing.T) {
+	src := `on:
+  push:
+    branches: [main]
+  schedule:
+    - cron: '0 0 * * *'
+  workflow_dispatch:
+    inputs:
+      level:
+        type: choice
+        options: [info, debug]
+  repository_dispatch:
+    types: [deploy]
+  workflow_call:
+    inputs:
+      name:
+        type: string
+        required: true
+
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    timeout-minutes: 10
+    strategy:
+      matrix:
+        os: [ubuntu, macos]
+        config: [{debug: true}]
+    steps:
+      - uses: actions/checkout@v4
+        with:
+          fetch-depth: 0
+      - run: echo hello
+        shell: bash
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	b, err := json.Marshal(newPluginWorkflow(w))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v struct {
+		On []struct {
+			Kind string `json:"kind"`
+			Line int    `json:"line"`
+		} `json:"on"`
+		Jobs map[string]struct {
+			ID             testPluginNode `json:"id"`
+			TimeoutMinutes testPluginNode `json:"timeout_minutes"`
+			Strategy       struct {
+				Matrix struct {
+					Rows map[string]struct {
+						Values []struct {
+							Kind string `json:"kind"`
+						} `json:"values"`
+					} `json:"rows"`
+				} `json:"matrix"`
+			} `json:"strategy"`
+			Steps []struct {
+				Exec struct {
+					Kind   string                     `json:"kind"`
+					Uses   *testPluginNode            `json:"uses"`
+					Inputs map[string]json.RawMessage `json:"inputs"`
+					Run    *testPluginNode            `json:"run"`
+					Shell  *testPluginNode            `json:"shell"`
+				} `json:"exec"`
+			} `json:"steps"`
+		} `json:"jobs"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatalf("%v: %s", err, b)
+	}
+
+	kinds := []string{}
+	for _, e := range v.On {
+		kinds = append(kinds, e.Kind)
+		if e.Line == 0 {
+			t.Errorf("position of %q event is missing", e.Kind)
+		}
+	}
+	want := []string{"webhook", "schedule", "workflow_dispatch", "repository_dispatch", "workflow_call"}
+	if diff := cmp.Diff(want, kinds); diff != "" {
+		t.Fatalf("kinds of events mismatch: %s", diff)
+	}
+
+	j, ok := v.Jobs["test"]
+	if !ok {
+		t.Fatalf("job is missing: %s", b)
+	}
+	if j.ID.Value != "test" || j.ID.Line != 20 || j.ID.Column != 3 {
+		t.Fatalf("unexpected job ID: %#v", j.ID)
+	}
+	if j.TimeoutMinutes.Value != 10.0 {
+		t.Fatalf("unexpected timeout-minutes: %#v", j.TimeoutMinutes)
+	}
+	if vs := j.Strategy.Matrix.Rows["os"].Values; len(vs) != 2 || vs[0].Kind != "string" {
+		t.Fatalf("unexpected matrix row: %s", b)
+	}
+	if vs := j.Strategy.Matrix.Rows["config"].Values; len(vs) != 1 || vs[0].Kind != "object" {
+		t.Fatalf("unexpected matrix row: %s", b)
+	}
+	if len(j.Steps) != 2 {
+		t.Fatalf("unexpected steps: %s", b)
+	}
+	if e := j.Steps[0].Exec; e.Kind != "action" || e.Uses.Value != "actions/checkout@v4" || e.Inputs["fetch-depth"] == nil {
+		t.Fatalf("unexpected action step: %#v", e)
+	}
+	if e := j.Steps[1].Exec; e.Kind != "run" || e.Run.Value != "echo hello" || e.Shell.Value != "bash" {
+		t.Fatalf("unexpected run step: %#v", e)
+	}
+}
+
+func TestPluginWorkflowJSONNil(t *testing.T) {
+	b, err := json.Marshal(newPluginWorkflow(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "null" {
+		t.Fatalf("nil workflow should be null but got %s", b)
+	}
+}
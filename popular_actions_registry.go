@@ -0,0 +1,141 @@
+package actionlint
+
+import (
+	"sort"
+	"sync"
+)
+
+var (
+	// PopularActions is data set of known popular actions. Keys are specs (owner/repo@ref) of
+	// actions and values are their metadata.
+	//
+	// Deprecated: The data set is now loaded on the first access since building it at init makes
+	// startup slow and keeps the large map in memory even when no action is checked. This map is
+	// empty until the data set is loaded by LoadPopularActions or by any function accessing the data
+	// set. Entries added to this map before the loading are kept and take precedence over the data
+	// set. Use FindPopularAction, PopularActionSpecs, and RegisterPopularAction instead.
+	PopularActions = map[string]*ActionMetadata{}
+	// OutdatedPopularActionSpecs is a spec set of known outdated popular actions. The word 'outdated'
+	// means that the runner used by the action is no longer available such as "node12".
+	//
+	// Deprecated: This map is empty until the data set is loaded as well as PopularActions. Use
+	// IsOutdatedPopularAction and RegisterOutdatedPopularAction instead.
+	OutdatedPopularActionSpecs = map[string]struct{}{}
+
+	popularActionsOnce sync.Once
+	// popularActionsMu guards PopularActions and OutdatedPopularActionSpecs while they are updated
+	// by RegisterPopularAction and RegisterOutdatedPopularAction.
+	popularActionsMu sync.RWMutex
+	// popularActionsNumLoaded is the number of entries in PopularActions and
+	// OutdatedPopularActionSpecs just after the data set was loaded. It is used to detect direct
+	// writes to the deprecated variables.
+	popularActionsNumLoaded int
+	// popularActionsModified is true when the data set was modified by callers.
+	popularActionsModified bool
+)
+
+// LoadPopularActions loads the data set of popular actions into PopularActions and
+// OutdatedPopularActionSpecs variables. Entries already added to the variables are not
+// overwritten. It does nothing when the data set is already loaded. It is not necessary to call
+// this function before using FindPopularAction and other functions to access the data set. Calling
+// this function is thread-safe.
+func LoadPopularActions() {
+	popularActionsOnce.Do(func() {
+		popularActionsMu.Lock()
+		defer popularActionsMu.Unlock()
+
+		popularActionsModified = len(PopularActions) > 0 || len(OutdatedPopularActionSpecs) > 0
+		if PopularActions == nil {
+			PopularActions = map[string]*ActionMetadata{}
+		}
+		if OutdatedPopularActionSpecs == nil {
+			OutdatedPopularActionSpecs = map[string]struct{}{}
+		}
+
+		// Merge the data set into the maps so that entries set by callers before loading the data
+		// set are not lost. A spec set to either of the maps is not added to the other map.
+		for spec, meta := range popularActionsData() { // Defined at popular_actions.go
+			if _, ok := OutdatedPopularActionSpecs[spec]; ok {
+				continue
+			}
+			if _, ok := PopularActions[spec]; !ok {
+				PopularActions[spec] = meta
+			}
+		}
+		for spec := range outdatedPopularActionSpecsData() { // Defined at popular_actions.go
+			if _, ok := PopularActions[spec]; !ok {
+				OutdatedPopularActionSpecs[spec] = struct{}{}
+			}
+		}
+		popularActionsNumLoaded = len(PopularActions) + len(OutdatedPopularActionSpecs)
+	})
+}
+
+// popularActionsChanged returns true when the data set was modified by RegisterPopularAction,
+// RegisterOutdatedPopularAction, or writes to the deprecated variables. Only writes which change
+// the number of entries are detected for the variables.
+func popularActionsChanged() bool {
+	LoadPopularActions()
+	popularActionsMu.RLock()
+	defer popularActionsMu.RUnlock()
+	return popularActionsModified || len(PopularActions)+len(OutdatedPopularActionSpecs) != popularActionsNumLoaded
+}
+
+// FindPopularAction finds the metadata of the popular action by its spec like "actions/checkout@v4"
+// from the data set. The metadata contains inputs and outputs of the action. It returns false when
+// the action is not in the data set. Calling this function is thread-safe.
+func FindPopularAction(spec string) (*ActionMetadata, bool) {
+	LoadPopularActions()
+	popularActionsMu.RLock()
+	m, ok := PopularActions[spec]
+	popularActionsMu.RUnlock()
+	return m, ok
+}
+
+// IsOutdatedPopularAction returns true when the popular action of the spec like
+// "actions/checkout@v2" is outdated. An action is outdated when its runner is no longer supported
+// by GitHub Actions. Calling this function is thread-safe.
+func IsOutdatedPopularAction(spec string) bool {
+	LoadPopularActions()
+	popularActionsMu.RLock()
+	_, ok := OutdatedPopularActionSpecs[spec]
+	popularActionsMu.RUnlock()
+	return ok
+}
+
+// PopularActionSpecs returns the sorted specs of all actions in the data set. Outdated actions are
+// not included. Calling this function is thread-safe.
+func PopularActionSpecs() []string {
+	LoadPopularActions()
+	popularActionsMu.RLock()
+	ss := make([]string, 0, len(PopularActions))
+	for s := range PopularActions {
+		ss = append(ss, s)
+	}
+	popularActionsMu.RUnlock()
+	sort.Strings(ss)
+	return ss
+}
+
+// RegisterPopularAction adds the action metadata to the data set with the spec like
+// "owner/repo@ref". When the action is already in the data set, its metadata is replaced. Keys of
+// Inputs and Outputs of the metadata must be in lower case. Calling this function is thread-safe.
+func RegisterPopularAction(spec string, meta *ActionMetadata) {
+	LoadPopularActions()
+	popularActionsMu.Lock()
+	PopularActions[spec] = meta
+	delete(OutdatedPopularActionSpecs, spec)
+	popularActionsModified = true
+	popularActionsMu.Unlock()
+}
+
+// RegisterOutdatedPopularAction marks the action of the spec like "owner/repo@ref" as outdated. Its
+// metadata is removed from the data set. Calling this function is thread-safe.
+func RegisterOutdatedPopularAction(spec string) {
+	LoadPopularActions()
+	popularActionsMu.Lock()
+	delete(PopularActions, spec)
+	OutdatedPopularActionSpecs[spec] = struct{}{}
+	popularActionsModified = true
+	popularActionsMu.Unlock()
+}
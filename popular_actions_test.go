@@ -1,11 +1,15 @@
 package actionlint
 
 import (
+	"io"
+	"sort"
 	"strings"
+	"sync"
 	"testing"
 )
 
 func TestPopularActionsDataset(t *testing.T) {
+	LoadPopularActions()
 	if len(PopularActions) == 0 {
 		t.Fatal("popular actions data set is empty")
 	}
@@ -24,6 +28,11 @@ func TestPopularActionsDataset(t *testing.T) {
 			if i.Name == "" {
 				t.Errorf("input name is not empty at ID %q at %q", id, n)
 			}
+			switch i.Type {
+			case "", "boolean", "number", "string":
+			default:
+				t.Errorf("unknown input type %q at ID %q at %q", i.Type, id, n)
+			}
 		}
 		for id, o := range meta.Outputs {
 			if id != strings.ToLower(id) {
@@ -35,3 +44,143 @@ func TestPopularActionsDataset(t *testing.T) {
 		}
 	}
 }
+
+func TestPopularActionOfSameMajorVersion(t *testing.T) {
+	testCases := []struct {
+		spec string
+		want string
+	}{
+		{"actions/cache@v4.1.2", "actions/cache@v4"},
+		{"actions/cache@v4.1", "actions/cache@v4"},
+		{"actions/cache@4.1.2", "actions/cache@v4"},
+		{"octokit/request-action@v2.1.0", "octokit/request-action@v2.x"},
+		{"actions/cache@v999.0.0", ""},
+		{"actions/cache@main", ""},
+		{"actions/cache@8e5e7e5ab8b370d6c329ec480221332ada57f0ab", ""},
+		{"some/unknown-action@v1.2.3", ""},
+		{"actions/cache", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			have, meta := popularActionOfSameMajorVersion(tc.spec)
+			if have != tc.want {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+			if (meta == nil) != (tc.want == "") {
+				t.Fatalf("metadata is unexpected for %q: %v", tc.spec, meta)
+			}
+		})
+	}
+}
+
+func TestPopularActionsFindAndRegister(t *testing.T) {
+	if m, ok := FindPopularAction("actions/checkout@v4"); !ok || m.Name != "Checkout" {
+		t.Fatalf("actions/checkout@v4 was not found: %v", m)
+	}
+	if !IsOutdatedPopularAction("actions/checkout@v2") {
+		t.Fatal("actions/checkout@v2 is not outdated")
+	}
+	if IsOutdatedPopularAction("actions/checkout@v4") {
+		t.Fatal("actions/checkout@v4 is outdated")
+	}
+
+	ss := PopularActionSpecs()
+	if len(ss) != len(PopularActions) {
+		t.Fatalf("number of specs is %d but data set has %d actions", len(ss), len(PopularActions))
+	}
+	if !sort.StringsAreSorted(ss) {
+		t.Fatalf("specs are not sorted: %v", ss)
+	}
+
+	const spec = "my-org/my-action@v1"
+	defer func() {
+		delete(PopularActions, spec)
+		delete(OutdatedPopularActionSpecs, spec)
+		popularActionsModified = false
+	}()
+
+	meta := &ActionMetadata{
+		Name:    "My action",
+		Inputs:  ActionMetadataInputs{"foo": {"foo", true, ""}},
+		Outputs: ActionMetadataOutputs{"bar": {"bar"}},
+	}
+	RegisterPopularAction(spec, meta)
+	if m, ok := FindPopularAction(spec); !ok || m != meta {
+		t.Fatalf("registered action was not found: %v", m)
+	}
+	if IsOutdatedPopularAction(spec) {
+		t.Fatal("registered action is outdated")
+	}
+
+	RegisterOutdatedPopularAction(spec)
+	if m, ok := FindPopularAction(spec); ok {
+		t.Fatalf("outdated action was found: %v", m)
+	}
+	if !IsOutdatedPopularAction(spec) {
+		t.Fatal("registered action is not outdated")
+	}
+}
+
+func TestPopularActionsWriteVariableBeforeLoading(t *testing.T) {
+	// Reset the data set to the state before it is loaded
+	acts, outdated, loaded := PopularActions, OutdatedPopularActionSpecs, popularActionsNumLoaded
+	defer func() {
+		PopularActions, OutdatedPopularActionSpecs = acts, outdated
+		popularActionsNumLoaded, popularActionsModified = loaded, false
+	}()
+	PopularActions = map[string]*ActionMetadata{}
+	OutdatedPopularActionSpecs = map[string]struct{}{}
+	popularActionsOnce = sync.Once{}
+
+	custom := &ActionMetadata{
+		Name:   "My checkout",
+		Inputs: ActionMetadataInputs{"foo": {"foo", true, ""}},
+	}
+	PopularActions["my-org/my-action@v1"] = custom
+	PopularActions["actions/checkout@v4"] = custom
+
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: my-org/my-action@v1
+        with:
+          foo: bar
+      - uses: actions/checkout@v4
+      - uses: actions/setup-go@v5
+        with:
+          unknown: 42
+`
+	errs, err := l.Lint("test.yaml", []byte(src), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`missing input "foo" which is required by action "actions/checkout@v4"`,
+		`input "unknown" is not defined in action "actions/setup-go@v5"`,
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("wanted %d errors but got %d errors: %v", len(want), len(errs), errs)
+	}
+	for i, w := range want {
+		if !strings.Contains(errs[i].Message, w) {
+			t.Errorf("error %q does not contain %q", errs[i].Message, w)
+		}
+	}
+
+	if m, ok := FindPopularAction("my-org/my-action@v1"); !ok || m != custom {
+		t.Fatalf("action written before loading was lost: %v", m)
+	}
+	if !IsOutdatedPopularAction("actions/checkout@v2") {
+		t.Fatal("outdated actions were not loaded")
+	}
+	if !popularActionsChanged() {
+		t.Fatal("actions written before loading were not detected as change of the data set")
+	}
+}
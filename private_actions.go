@@ -0,0 +1,74 @@
+package actionlint
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// privateActions is a table from action specs to metadata of actions declared at "actions" in
+// config file. Keys are specs like "owner/repo@ref" or "owner/repo" which matches any ref.
+type privateActions map[string]*ActionMetadata
+
+// find finds the metadata of the action. The spec with the ref is preferred to the one without ref.
+// It returns nil when the action is not declared.
+func (p privateActions) find(spec string) *ActionMetadata {
+	if len(p) == 0 {
+		return nil
+	}
+	if m, ok := p[spec]; ok {
+		return m
+	}
+	if i := strings.IndexRune(spec, '@'); i >= 0 {
+		return p[spec[:i]]
+	}
+	return nil
+}
+
+// loadPrivateActions converts the action schemas configured at "actions" in config file into the
+// table of action metadata. Action metadata files of the schemas are read from the root directory
+// when their paths are relative.
+func loadPrivateActions(root string, schemas map[string]*ActionSchemaConfig, fsys fileSystem) (privateActions, error) {
+	ret := make(privateActions, len(schemas))
+	for spec, s := range schemas {
+		if strings.HasPrefix(spec, "./") || strings.HasPrefix(spec, "docker://") || !strings.ContainsRune(spec, '/') {
+			return nil, fmt.Errorf("action %q at \"actions\" in config must be in \"owner/repo\" or \"owner/repo@ref\" format", spec)
+		}
+		if s == nil {
+			return nil, fmt.Errorf("schema of action %q at \"actions\" in config is empty", spec)
+		}
+
+		if s.File == "" {
+			ret[spec] = &ActionMetadata{
+				Name:        spec,
+				Inputs:      s.Inputs,
+				Outputs:     s.Outputs,
+				SkipInputs:  s.Inputs == nil,
+				SkipOutputs: s.Outputs == nil,
+			}
+			continue
+		}
+
+		if s.Inputs != nil || s.Outputs != nil {
+			return nil, fmt.Errorf("\"file\" cannot be used with \"inputs\" or \"outputs\" for action %q at \"actions\" in config", spec)
+		}
+		p := s.File
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(root, p)
+		}
+		b, err := fsys.ReadFile(p)
+		if err != nil {
+			return nil, fmt.Errorf("could not read action metadata for %q at \"actions\" in config: %w", spec, err)
+		}
+		var m ActionMetadata
+		if err := yaml.Unmarshal(b, &m); err != nil {
+			msg := strings.ReplaceAll(err.Error(), "\n", " ")
+			return nil, fmt.Errorf("could not parse action metadata %q for %q at \"actions\" in config: %s", s.File, spec, msg)
+		}
+		m.dir, m.file = filepath.Dir(p), filepath.Base(p)
+		ret[spec] = &m
+	}
+	return ret, nil
+}
@@ -0,0 +1,73 @@
+package actionlint
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPrivateActionsFind(t *testing.T) {
+	as := privateActions{
+		"myorg/deploy@v2": {Name: "v2"},
+		"myorg/deploy":    {Name: "any"},
+		"myorg/lint@v1":   {Name: "lint"},
+	}
+
+	testCases := []struct {
+		spec string
+		want string
+	}{
+		{"myorg/deploy@v2", "v2"},
+		{"myorg/deploy@v3", "any"},
+		{"myorg/lint@v1", "lint"},
+		{"myorg/lint@v2", ""},
+		{"myorg/unknown@v1", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			m := as.find(tc.spec)
+			if tc.want == "" {
+				if m != nil {
+					t.Fatalf("metadata %q was found", m.Name)
+				}
+				return
+			}
+			if m == nil {
+				t.Fatal("metadata was not found")
+			}
+			if m.Name != tc.want {
+				t.Fatalf("wanted %q but got %q", tc.want, m.Name)
+			}
+		})
+	}
+}
+
+func TestPrivateActionsLoadError(t *testing.T) {
+	root := filepath.Join("testdata", "projects", "private_actions")
+
+	testCases := []struct {
+		what    string
+		schemas map[string]*ActionSchemaConfig
+		want    string
+	}{
+		{"local action", map[string]*ActionSchemaConfig{"./foo": {}}, `action "./foo" at "actions" in config must be`},
+		{"no owner", map[string]*ActionSchemaConfig{"foo@v1": {}}, `action "foo@v1" at "actions" in config must be`},
+		{"empty schema", map[string]*ActionSchemaConfig{"foo/bar@v1": nil}, `schema of action "foo/bar@v1" at "actions" in config is empty`},
+		{"file with inputs", map[string]*ActionSchemaConfig{"foo/bar@v1": {File: "schemas/setup-tools.yml", Inputs: ActionMetadataInputs{}}}, `"file" cannot be used with "inputs" or "outputs"`},
+		{"file not found", map[string]*ActionSchemaConfig{"foo/bar@v1": {File: "schemas/unknown.yml"}}, `could not read action metadata for "foo/bar@v1"`},
+		{"broken file", map[string]*ActionSchemaConfig{"foo/bar@v1": {File: "schemas/broken.yml"}}, `could not parse action metadata "schemas/broken.yml" for "foo/bar@v1"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := loadPrivateActions(root, tc.schemas, osFileSystem{})
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Fatalf("error message %q does not contain %q", msg, tc.want)
+			}
+		})
+	}
+}
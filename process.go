@@ -3,38 +3,44 @@ package actionlint
 import (
 	"context"
 	"fmt"
-	"io"
 	"os/exec"
+	"strings"
 	"sync"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
 	"golang.org/x/sys/execabs"
 )
 
+// cmdFunc runs a command in this process instead of spawning an external process such as a
+// WebAssembly module. It returns stdout of the command. The errors are the same as running an
+// external process: a non-zero exit status is an error only when stdout is empty.
+type cmdFunc func(ctx context.Context, args []string, stdin string) ([]byte, error)
+
 // cmdExecution represents a single command line execution.
 type cmdExecution struct {
 	cmd           string
 	args          []string
 	stdin         string
 	combineOutput bool
+	fn            cmdFunc
+	timeout       time.Duration
 }
 
-func (e *cmdExecution) run() ([]byte, error) {
-	cmd := exec.Command(e.cmd, e.args...)
-	cmd.Stderr = nil
-
-	p, err := cmd.StdinPipe()
-	if err != nil {
-		return nil, fmt.Errorf("could not make stdin pipe for %s process: %w", e.cmd, err)
-	}
-	if _, err := io.WriteString(p, e.stdin); err != nil {
-		p.Close()
-		return nil, fmt.Errorf("could not write to stdin of %s process: %w", e.cmd, err)
+func (e *cmdExecution) run(ctx context.Context) ([]byte, error) {
+	if e.fn != nil {
+		return e.fn(ctx, e.args, e.stdin)
 	}
-	p.Close()
+
+	cmd := exec.CommandContext(ctx, e.cmd, e.args...)
+	cmd.Stderr = nil
+	// Writing stdin to a pipe before starting the process blocks when the input is larger than the
+	// pipe buffer. The reader is copied to the process concurrently.
+	cmd.Stdin = strings.NewReader(e.stdin)
 
 	var stdout []byte
+	var err error
 	if e.combineOutput {
 		stdout, err = cmd.CombinedOutput()
 	} else {
@@ -82,19 +88,45 @@ type concurrentProcess struct {
 // many processes can be run in parallel. It is recommended to use the value returned from
 // runtime.NumCPU() for the argument.
 func newConcurrentProcess(par int) *concurrentProcess {
+	return newConcurrentProcessWithContext(context.Background(), par)
+}
+
+// newConcurrentProcessWithContext creates a new ConcurrentProcess instance with the context. When
+// the context is canceled, running processes are killed and no new process is started.
+func newConcurrentProcessWithContext(ctx context.Context, par int) *concurrentProcess {
 	return &concurrentProcess{
-		ctx:  context.Background(),
+		ctx:  ctx,
 		sema: semaphore.NewWeighted(int64(par)),
 	}
 }
 
 func (proc *concurrentProcess) run(eg *errgroup.Group, exec *cmdExecution, callback func([]byte, error) error) {
-	proc.sema.Acquire(proc.ctx, 1)
 	proc.wg.Add(1)
+	if err := proc.sema.Acquire(proc.ctx, 1); err != nil {
+		// The context was canceled while waiting for other processes
+		eg.Go(func() error {
+			defer proc.wg.Done()
+			return fmt.Errorf("could not run %s: %w", exec.cmd, err)
+		})
+		return
+	}
 	eg.Go(func() error {
 		defer proc.wg.Done()
-		stdout, err := exec.run()
+		ctx := proc.ctx
+		if exec.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, exec.timeout)
+			defer cancel()
+		}
+		stdout, err := exec.run(ctx)
 		proc.sema.Release(1)
+		if cerr := proc.ctx.Err(); cerr != nil {
+			// The process was killed due to the cancellation. It is not an error of the command
+			return fmt.Errorf("could not run %s: %w", exec.cmd, cerr)
+		}
+		if err != nil && ctx.Err() == context.DeadlineExceeded {
+			err = fmt.Errorf("%s was killed since it did not finish within %s", exec.cmd, exec.timeout)
+		}
 		return callback(stdout, err)
 	})
 }
@@ -119,6 +151,37 @@ func (proc *concurrentProcess) newCommandRunner(exe string, combineOutput bool)
 	return cmd, nil
 }
 
+// newFuncCommandRunner creates new command runner which calls the function instead of running an
+// external process. The name is used as the executable name in messages.
+func (proc *concurrentProcess) newFuncCommandRunner(name string, fn cmdFunc) *externalCommand {
+	return &externalCommand{
+		proc: proc,
+		exe:  name,
+		fn:   fn,
+	}
+}
+
+// newWASMCommand compiles the WebAssembly module built for WASI and returns the function to run it
+// as a command. The name identifies the module and compiled modules are reused by the name. When
+// the mount parameter is true, directories of files given as absolute paths in arguments are
+// mounted as read-only so that the module can read the files. Otherwise the module cannot access
+// any file. This variable is nil unless actionlint is built with "wazero" build tag. See
+// wazero.go.
+var newWASMCommand func(name string, bin []byte, mount bool) (cmdFunc, error)
+
+// newWASMCommandRunner creates new command runner which runs the WebAssembly module in this
+// process. It returns an error when actionlint was built without WebAssembly runtime.
+func (proc *concurrentProcess) newWASMCommandRunner(name string, bin []byte, mount bool) (*externalCommand, error) {
+	if newWASMCommand == nil {
+		return nil, fmt.Errorf("WebAssembly module %s cannot be run since actionlint was built without \"wazero\" build tag", name)
+	}
+	fn, err := newWASMCommand(name, bin, mount)
+	if err != nil {
+		return nil, err
+	}
+	return proc.newFuncCommandRunner(name, fn), nil
+}
+
 // externalCommand is struct to run specific command concurrently with concurrentProcess bounding
 // number of processes at the same time. This type manages fatal errors while running the command
 // by using errgroup.Group. The wait() method must be called at the end for checking if some fatal
@@ -128,13 +191,16 @@ type externalCommand struct {
 	eg            errgroup.Group
 	exe           string
 	combineOutput bool
+	fn            cmdFunc
+	// timeout is the maximum duration of each execution of the command. Zero means no timeout.
+	timeout time.Duration
 }
 
 // run runs the command with given arguments and stdin. The callback function is called after the
 // process runs. First argument is stdout and the second argument is an error while running the
 // process.
 func (cmd *externalCommand) run(args []string, stdin string, callback func([]byte, error) error) {
-	exec := &cmdExecution{cmd.exe, args, stdin, cmd.combineOutput}
+	exec := &cmdExecution{cmd.exe, args, stdin, cmd.combineOutput, cmd.fn, cmd.timeout}
 	cmd.proc.run(&cmd.eg, exec, callback)
 }
 
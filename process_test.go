@@ -1,6 +1,8 @@
 package actionlint
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"runtime"
 	"strings"
@@ -204,6 +206,53 @@ func TestProcessInputStdin(t *testing.T) {
 	}
 }
 
+func TestProcessInputLargeStdin(t *testing.T) {
+	p := newConcurrentProcess(1)
+	cat := testSkipIfNoCommand(t, p, "cat")
+	// Larger than the buffer of pipe
+	in := strings.Repeat("this is test\n", 100000)
+	out := ""
+
+	cat.run([]string{}, in, func(b []byte, err error) error {
+		if err != nil {
+			t.Error(err)
+			return err
+		}
+		out = string(b)
+		return nil
+	})
+
+	if err := cat.wait(); err != nil {
+		t.Fatal(err)
+	}
+	p.wait()
+
+	if out != in {
+		t.Fatalf("stdin was not input to `cat` command: %d bytes were output", len(out))
+	}
+}
+
+func TestProcessKillCommandOnTimeout(t *testing.T) {
+	p := newConcurrentProcess(1)
+	sleep := testSkipIfNoCommand(t, p, "sleep")
+	sleep.timeout = 100 * time.Millisecond
+	var err error
+
+	sleep.run([]string{"10"}, "", func(b []byte, e error) error {
+		err = e
+		return nil
+	})
+
+	if err := sleep.wait(); err != nil {
+		t.Fatal(err)
+	}
+	p.wait()
+
+	if err == nil || !strings.Contains(err.Error(), "did not finish within 100ms") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestProcessErrorCommandNotFound(t *testing.T) {
 	p := newConcurrentProcess(1)
 	c := &externalCommand{
@@ -371,3 +420,91 @@ func TestProcessCommandExitStatusNonZero(t *testing.T) {
 		t.Fatalf("Unexpected error happened: %q", msg)
 	}
 }
+
+func TestProcessCancelRunningCommand(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := newConcurrentProcessWithContext(ctx, 1)
+	sleep := testSkipIfNoCommand(t, p, "sleep")
+
+	called := false
+	start := time.Now()
+	sleep.run([]string{"10"}, "", func(b []byte, err error) error {
+		called = true
+		return nil
+	})
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+
+	err := sleep.wait()
+	p.wait()
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("wanted context.Canceled error but got %v", err)
+	}
+	if called {
+		t.Fatal("callback should not be called on cancellation")
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Fatalf("process was not killed on cancellation: %s", d)
+	}
+}
+
+func TestProcessNotRunCommandAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p := newConcurrentProcessWithContext(ctx, 1)
+	echo := testSkipIfNoCommand(t, p, "echo")
+
+	called := false
+	echo.run([]string{"hello"}, "", func(b []byte, err error) error {
+		called = true
+		return nil
+	})
+
+	err := echo.wait()
+	p.wait()
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("wanted context.Canceled error but got %v", err)
+	}
+	if called {
+		t.Fatal("callback should not be called after cancellation")
+	}
+}
+
+func TestProcessRunFuncCommand(t *testing.T) {
+	p := newConcurrentProcess(1)
+	cmd := p.newFuncCommandRunner("upper", func(ctx context.Context, args []string, stdin string) ([]byte, error) {
+		return []byte(strings.Join(args, " ") + " " + strings.ToUpper(stdin)), nil
+	})
+	done := make(chan string)
+
+	cmd.run([]string{"foo", "bar"}, "piyo", func(b []byte, err error) error {
+		if err != nil {
+			t.Error(err)
+		}
+		done <- string(b)
+		return nil
+	})
+
+	out := <-done
+	if err := cmd.wait(); err != nil {
+		t.Fatal(err)
+	}
+	p.wait()
+	if out != "foo bar PIYO" {
+		t.Fatalf("Unexpected output: %q", out)
+	}
+}
+
+func TestProcessWASMCommandWithoutRuntime(t *testing.T) {
+	if newWASMCommand != nil {
+		t.Skip("actionlint is built with WebAssembly runtime")
+	}
+	p := newConcurrentProcess(1)
+	_, err := p.newWASMCommandRunner("plugin.wasm", []byte{}, false)
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	if msg := err.Error(); !strings.Contains(msg, `built without "wazero" build tag`) {
+		t.Fatalf("Unexpected error: %q", msg)
+	}
+}
@@ -0,0 +1,169 @@
+package actionlint
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ruleProfile is a profile of a rule applied to one file.
+type ruleProfile struct {
+	path string
+	// rule is a name of the rule like "shellcheck".
+	rule string
+	// elapsed is wall time spent by the rule. It includes time to wait for external commands run
+	// by the rule such as shellcheck.
+	elapsed time.Duration
+	errors  int
+}
+
+// profiledRule wraps a rule to measure wall time spent in its callbacks. Rules which run external
+// commands wait for the commands in VisitWorkflowPost so the time of the commands is also counted.
+type profiledRule struct {
+	Rule
+	elapsed time.Duration
+}
+
+func (r *profiledRule) measure(f func() error) error {
+	start := time.Now()
+	err := f()
+	r.elapsed += time.Since(start)
+	return err
+}
+
+func (r *profiledRule) VisitStep(n *Step) error {
+	return r.measure(func() error { return r.Rule.VisitStep(n) })
+}
+
+func (r *profiledRule) VisitJobPre(n *Job) error {
+	return r.measure(func() error { return r.Rule.VisitJobPre(n) })
+}
+
+func (r *profiledRule) VisitJobPost(n *Job) error {
+	return r.measure(func() error { return r.Rule.VisitJobPost(n) })
+}
+
+func (r *profiledRule) VisitWorkflowPre(n *Workflow) error {
+	return r.measure(func() error { return r.Rule.VisitWorkflowPre(n) })
+}
+
+func (r *profiledRule) VisitWorkflowPost(n *Workflow) error {
+	return r.measure(func() error { return r.Rule.VisitWorkflowPost(n) })
+}
+
+// ruleProfiler collects profiles of rules applied to files. It is thread-safe since files are
+// checked in parallel.
+type ruleProfiler struct {
+	mu       sync.Mutex
+	profiles []*ruleProfile
+}
+
+// wrap wraps the rules to measure them. Use the returned passes to visit the syntax tree.
+func (p *ruleProfiler) wrap(rules []Rule) []*profiledRule {
+	ps := make([]*profiledRule, 0, len(rules))
+	for _, r := range rules {
+		ps = append(ps, &profiledRule{Rule: r})
+	}
+	return ps
+}
+
+func (p *ruleProfiler) record(path string, rules []*profiledRule) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, r := range rules {
+		p.profiles = append(p.profiles, &ruleProfile{
+			path:    path,
+			rule:    r.Name(),
+			elapsed: r.elapsed,
+			errors:  len(r.Errs()),
+		})
+	}
+}
+
+// flush returns the collected profiles and clears them.
+func (p *ruleProfiler) flush() []*ruleProfile {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	ps := p.profiles
+	p.profiles = nil
+	return ps
+}
+
+func sortRuleProfiles(ps []*ruleProfile) {
+	sort.SliceStable(ps, func(i, j int) bool {
+		if ps[i].elapsed != ps[j].elapsed {
+			return ps[i].elapsed > ps[j].elapsed
+		}
+		return ps[i].rule < ps[j].rule
+	})
+}
+
+// aggregateRuleProfiles sums up the profiles of each rule across all files. The returned profiles
+// have empty path and are sorted by the elapsed time in descending order.
+func aggregateRuleProfiles(ps []*ruleProfile) []*ruleProfile {
+	idx := map[string]*ruleProfile{}
+	ret := []*ruleProfile{}
+	for _, p := range ps {
+		a, ok := idx[p.rule]
+		if !ok {
+			a = &ruleProfile{rule: p.rule}
+			idx[p.rule] = a
+			ret = append(ret, a)
+		}
+		a.elapsed += p.elapsed
+		a.errors += p.errors
+	}
+	sortRuleProfiles(ret)
+	return ret
+}
+
+func printRuleProfiles(out io.Writer, ps []*ruleProfile) {
+	w := 0
+	for _, p := range ps {
+		if len(p.rule) > w {
+			w = len(p.rule)
+		}
+	}
+	for _, p := range ps {
+		fmt.Fprintf(out, "  %-*s %10.3fms %4d error(s)\n", w, p.rule, float64(p.elapsed.Microseconds())/1000, p.errors)
+	}
+}
+
+// printProfileReport prints the profiles of rules for each file and the aggregate of all files.
+// Rules in each section are sorted by the elapsed time in descending order.
+func printProfileReport(out io.Writer, ps []*ruleProfile) {
+	if len(ps) == 0 {
+		return
+	}
+
+	files := []string{}
+	byFile := map[string][]*ruleProfile{}
+	for _, p := range ps {
+		if _, ok := byFile[p.path]; !ok {
+			files = append(files, p.path)
+		}
+		byFile[p.path] = append(byFile[p.path], p)
+	}
+	sort.Strings(files)
+
+	for _, f := range files {
+		fps := append([]*ruleProfile{}, byFile[f]...)
+		sortRuleProfiles(fps)
+		var total time.Duration
+		for _, p := range fps {
+			total += p.elapsed
+		}
+		fmt.Fprintf(out, "Profile of rules at %s (total %.3fms):\n", f, float64(total.Microseconds())/1000)
+		printRuleProfiles(out, fps)
+	}
+
+	agg := aggregateRuleProfiles(ps)
+	var total time.Duration
+	for _, p := range agg {
+		total += p.elapsed
+	}
+	fmt.Fprintf(out, "Profile of rules in %d file(s) (total %.3fms):\n", len(files), float64(total.Microseconds())/1000)
+	printRuleProfiles(out, agg)
+}
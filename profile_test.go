@@ -0,0 +1,98 @@
+package actionlint
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProfileAggregateRuleProfiles(t *testing.T) {
+	ps := []*ruleProfile{
+		{"a.yaml", "expression", 2 * time.Millisecond, 1},
+		{"a.yaml", "shellcheck", 10 * time.Millisecond, 2},
+		{"b.yaml", "expression", 3 * time.Millisecond, 0},
+		{"b.yaml", "shellcheck", 20 * time.Millisecond, 1},
+	}
+	have := aggregateRuleProfiles(ps)
+	want := []*ruleProfile{
+		{"", "shellcheck", 30 * time.Millisecond, 3},
+		{"", "expression", 5 * time.Millisecond, 1},
+	}
+	if !cmp.Equal(want, have, cmp.AllowUnexported(ruleProfile{})) {
+		t.Fatal(cmp.Diff(want, have, cmp.AllowUnexported(ruleProfile{})))
+	}
+}
+
+func TestProfilePrintReport(t *testing.T) {
+	ps := []*ruleProfile{
+		{"b.yaml", "expression", 3 * time.Millisecond, 0},
+		{"b.yaml", "shellcheck", 20 * time.Millisecond, 1},
+		{"a.yaml", "expression", 2 * time.Millisecond, 1},
+		{"a.yaml", "shellcheck", 10 * time.Millisecond, 2},
+	}
+	var b bytes.Buffer
+	printProfileReport(&b, ps)
+	want := `Profile of rules at a.yaml (total 12.000ms):
+  shellcheck     10.000ms    2 error(s)
+  expression      2.000ms    1 error(s)
+Profile of rules at b.yaml (total 23.000ms):
+  shellcheck     20.000ms    1 error(s)
+  expression      3.000ms    0 error(s)
+Profile of rules in 2 file(s) (total 35.000ms):
+  shellcheck     30.000ms    3 error(s)
+  expression      5.000ms    1 error(s)
+`
+	if have := b.String(); have != want {
+		t.Fatal(cmp.Diff(want, have))
+	}
+
+	b.Reset()
+	printProfileReport(&b, nil)
+	if b.Len() != 0 {
+		t.Fatalf("nothing should be output without profiles but got %q", b.String())
+	}
+}
+
+func TestProfileLinterOutputProfile(t *testing.T) {
+	var b bytes.Buffer
+	l, err := NewLinter(&b, &LinterOptions{Profile: true, Shellcheck: "", Pyflakes: ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = &Config{}
+
+	src := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo ${{ foo }}\n"
+	errs, err := l.Lint("test.yaml", []byte(src), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("wanted one error but got %v", errs)
+	}
+
+	out := b.String()
+	for _, want := range []string{
+		"Profile of rules at test.yaml",
+		"Profile of rules in 1 file(s)",
+		"1 error(s)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("%q is not included in output:\n%s", want, out)
+		}
+	}
+	if !strings.Contains(out, "  expression ") {
+		t.Errorf("profile of expression rule is not included in output:\n%s", out)
+	}
+
+	// Profiles are cleared after output
+	b.Reset()
+	if _, err := l.Lint("test.yaml", []byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(b.String(), "Profile of rules at test.yaml"); n != 1 {
+		t.Fatalf("profile should be output once but got %d times:\n%s", n, b.String())
+	}
+}
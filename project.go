@@ -1,7 +1,6 @@
 package actionlint
 
 import (
-	"os"
 	"path/filepath"
 	"strings"
 )
@@ -10,23 +9,22 @@ import (
 type Project struct {
 	root   string
 	config *Config
+	// fs is the file system where the project is. nil means the OS file system.
+	fs fileSystem
 }
 
 func absPath(path string) string {
-	if p, err := filepath.Abs(path); err == nil {
-		path = p
-	}
-	return path
+	return osFileSystem{}.Abs(path)
 }
 
 // findProject creates new Project instance by finding a project which the given path belongs to.
 // A project must be a Git repository and have ".github/workflows" directory.
-func findProject(path string) (*Project, error) {
-	d := absPath(path)
+func findProject(path string, fsys fileSystem) (*Project, error) {
+	d := fsys.Abs(path)
 	for {
-		if s, err := os.Stat(filepath.Join(d, ".github", "workflows")); err == nil && s.IsDir() {
-			if _, err := os.Stat(filepath.Join(d, ".git")); err == nil { // Note: .git may be a file
-				return NewProject(d)
+		if s, err := fsys.Stat(filepath.Join(d, ".github", "workflows")); err == nil && s.IsDir() {
+			if _, err := fsys.Stat(filepath.Join(d, ".git")); err == nil { // Note: .git may be a file
+				return newProjectInFS(d, fsys)
 			}
 		}
 
@@ -41,11 +39,23 @@ func findProject(path string) (*Project, error) {
 // NewProject creates a new instance with a file path to the root directory of the repository.
 // This function returns an error when failing to parse an actionlint config file in the repository.
 func NewProject(root string) (*Project, error) {
-	c, err := loadRepoConfig(root)
+	return newProjectInFS(root, osFileSystem{})
+}
+
+func newProjectInFS(root string, fsys fileSystem) (*Project, error) {
+	c, err := loadRepoConfig(root, fsys)
 	if err != nil {
 		return nil, err
 	}
-	return &Project{root, c}, nil
+	return &Project{root, c, fsys}, nil
+}
+
+// fileSystem returns the file system where the project is.
+func (p *Project) fileSystem() fileSystem {
+	if p == nil || p.fs == nil {
+		return osFileSystem{}
+	}
+	return p.fs
 }
 
 // RootDir returns a root directory path of the GitHub project repository.
@@ -62,8 +72,16 @@ func (p *Project) WorkflowsDir() string {
 // Knows returns true when the project knows the given file. When a file is included in the
 // project's directory, the project knows the file.
 func (p *Project) Knows(path string) bool {
-	// TODO: strings.HasPrefix is not perfect to check file path
-	return strings.HasPrefix(absPath(path), p.root)
+	a := p.fileSystem().Abs(path)
+	if a == p.root {
+		return true
+	}
+	// Check the separator not to confuse sibling directories like "foo" and "foo-bar"
+	r := p.root
+	if !strings.HasSuffix(r, string(filepath.Separator)) {
+		r += string(filepath.Separator)
+	}
+	return strings.HasPrefix(a, r)
 }
 
 // Config returns config object of the GitHub project repository. The config file was read from
@@ -78,11 +96,17 @@ func (p *Project) Config() *Config {
 // and reuses them.
 type Projects struct {
 	known []*Project
+	fs    fileSystem
 }
 
 // NewProjects creates new Projects instance.
 func NewProjects() *Projects {
-	return &Projects{}
+	return &Projects{fs: osFileSystem{}}
+}
+
+// newProjectsInFS creates new Projects instance which finds projects in the file system.
+func newProjectsInFS(fsys fileSystem) *Projects {
+	return &Projects{fs: fsys}
 }
 
 // At returns the Project instance which the path belongs to. It returns nil if no project is found
@@ -94,7 +118,11 @@ func (ps *Projects) At(path string) (*Project, error) {
 		}
 	}
 
-	p, err := findProject(path)
+	fsys := ps.fs
+	if fsys == nil {
+		fsys = osFileSystem{}
+	}
+	p, err := findProject(path, fsys)
 	if err != nil {
 		return nil, err
 	}
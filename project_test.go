@@ -147,3 +147,20 @@ func TestProjectsLoadingBrokenProjectConfig(t *testing.T) {
 		t.Fatalf("wanted error %q but have error %q", want, msg)
 	}
 }
+
+func TestProjectKnowsPathInSiblingDirectory(t *testing.T) {
+	p := &Project{root: filepath.Join(string(filepath.Separator), "repos", "api")}
+	for _, tc := range []struct {
+		path string
+		want bool
+	}{
+		{filepath.Join(string(filepath.Separator), "repos", "api"), true},
+		{filepath.Join(string(filepath.Separator), "repos", "api", ".github", "workflows", "ci.yaml"), true},
+		{filepath.Join(string(filepath.Separator), "repos", "api-v2", ".github", "workflows", "ci.yaml"), false},
+		{filepath.Join(string(filepath.Separator), "repos"), false},
+	} {
+		if have := p.Knows(tc.path); have != tc.want {
+			t.Errorf("wanted %v for %q but got %v", tc.want, tc.path, have)
+		}
+	}
+}
@@ -60,6 +60,8 @@ func quotes(ss []string) string {
 }
 
 func sortedQuotes(ss []string) string {
+	// Copy the slice since it may be a global table shared by rules running in parallel
+	ss = append(make([]string, 0, len(ss)), ss...)
 	sort.Strings(ss)
 	return quotes(ss)
 }
@@ -0,0 +1,545 @@
+package actionlint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+var reCommitSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// defaultHTTPTimeout is the default timeout of each HTTP request to remote.
+const defaultHTTPTimeout = 10 * time.Second
+
+// newHTTPClient creates a new HTTP client used for all network access of actionlint. The 'proxy'
+// parameter is a URL of the proxy server like "http://proxy.example.com:8080". When it is empty,
+// the proxy is configured with $HTTPS_PROXY, $HTTP_PROXY, and $NO_PROXY environment variables. The
+// 'timeout' parameter is a timeout of each request. When it is zero, the default timeout is used.
+func newHTTPClient(proxy string, timeout time.Duration) (*http.Client, error) {
+	if timeout < 0 {
+		return nil, fmt.Errorf("timeout of HTTP requests must not be negative but got %s", timeout)
+	}
+	if timeout == 0 {
+		timeout = defaultHTTPTimeout
+	}
+
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if proxy != "" {
+		u, err := url.Parse(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse proxy URL %q: %w", proxy, err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("proxy URL %q must have scheme and host like \"http://proxy.example.com:8080\"", proxy)
+		}
+		t.Proxy = http.ProxyURL(u)
+	}
+
+	return &http.Client{Transport: t, Timeout: timeout}, nil
+}
+
+// RemoteFetcher fetches files in remote GitHub repositories such as reusable workflows. Fetched
+// files are cached on disk so that the same file is not downloaded again on the next run. When a
+// file cannot be fetched due to network issues, the fetcher falls back to the cached file if it
+// exists. Otherwise the file is treated as not found so that checks which require the file are
+// skipped.
+type RemoteFetcher struct {
+	client    *http.Client
+	baseURL   string
+	apiURL    string
+	githubAPI string
+	token     string
+	cacheDir  string
+	ttl       time.Duration
+	offline   bool
+	dbg       io.Writer
+	ownersMu  sync.Mutex
+	owners    map[string]bool
+	namesMu   sync.Mutex
+	names     map[string][]string
+}
+
+// NewRemoteFetcher creates a new RemoteFetcher instance. The 'cacheDir' parameter is a directory
+// path to cache fetched files. When it is empty, the default cache directory "actionlint" in
+// os.UserCacheDir() is used. When the default cache directory is not available, files are not
+// cached on disk. The token in $GITHUB_TOKEN environment variable is used for REST API requests to
+// look up owners.
+func NewRemoteFetcher(cacheDir string, dbg io.Writer) *RemoteFetcher {
+	if cacheDir == "" {
+		if d, err := os.UserCacheDir(); err == nil {
+			cacheDir = filepath.Join(d, "actionlint")
+		}
+	}
+	return &RemoteFetcher{
+		client:    &http.Client{Timeout: defaultHTTPTimeout},
+		baseURL:   "https://raw.githubusercontent.com",
+		githubAPI: "https://api.github.com",
+		token:     os.Getenv("GITHUB_TOKEN"),
+		cacheDir:  cacheDir,
+		dbg:       dbg,
+		owners:    map[string]bool{},
+		names:     map[string][]string{},
+	}
+}
+
+// ForGitHubEnterprise returns a new RemoteFetcher instance which fetches files from the GitHub
+// Enterprise Server instance via its REST API. The 'apiURL' parameter is the base URL of the API
+// like "https://ghe.example.com/api/v3". Files fetched from the instance are cached separately from
+// files fetched from GitHub.com.
+func (f *RemoteFetcher) ForGitHubEnterprise(apiURL string) *RemoteFetcher {
+	apiURL = strings.TrimRight(apiURL, "/")
+	cacheDir := f.cacheDir
+	if cacheDir != "" {
+		if u, err := url.Parse(apiURL); err == nil && u.Host != "" {
+			cacheDir = filepath.Join(cacheDir, "ghes", u.Host)
+		} else {
+			cacheDir = "" // Do not mix cache files of GHES with GitHub.com
+		}
+	}
+	return &RemoteFetcher{
+		client:   f.client,
+		apiURL:   apiURL,
+		token:    f.token,
+		cacheDir: cacheDir,
+		ttl:      f.ttl,
+		offline:  f.offline,
+		dbg:      f.dbg,
+		owners:   map[string]bool{},
+		names:    map[string][]string{},
+	}
+}
+
+// SetCacheTTL sets the time to live of cached files. Files cached for longer than the TTL are
+// fetched again since refs like tags and branches may be updated. When the TTL is zero, which is
+// the default, cached files never expire. Files cached at full commit SHAs never expire since
+// their contents are never changed.
+func (f *RemoteFetcher) SetCacheTTL(ttl time.Duration) {
+	f.ttl = ttl
+}
+
+// SetHTTPClient sets the HTTP client to fetch files and to call REST API. It is useful to configure
+// proxy, timeout, and transport of requests.
+func (f *RemoteFetcher) SetHTTPClient(c *http.Client) {
+	f.client = c
+}
+
+// EnableOffline makes the fetcher never access network. Only cached files are used even if they
+// are expired.
+func (f *RemoteFetcher) EnableOffline() {
+	f.offline = true
+}
+
+func (f *RemoteFetcher) debug(format string, args ...interface{}) {
+	if f.dbg == nil {
+		return
+	}
+	format = "[RemoteFetcher] " + format + "\n"
+	fmt.Fprintf(f.dbg, format, args...)
+}
+
+func (f *RemoteFetcher) cachePath(slug, ref, path string) string {
+	if f.cacheDir == "" {
+		return ""
+	}
+	p := filepath.Join(f.cacheDir, "remote", filepath.FromSlash(slug), ref, filepath.FromSlash(path))
+	// Prevent escaping the cache directory with a malicious ref or path like "../../foo"
+	if !strings.HasPrefix(p, filepath.Join(f.cacheDir, "remote")+string(filepath.Separator)) {
+		return ""
+	}
+	return p
+}
+
+// readCache reads the cached file. The second return value is true when the cached file exists.
+// The third return value is true when the cached file is not expired.
+func (f *RemoteFetcher) readCache(path, ref string) ([]byte, bool, bool) {
+	if path == "" {
+		return nil, false, false
+	}
+	s, err := os.Stat(path)
+	if err != nil {
+		return nil, false, false
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false, false
+	}
+	fresh := f.ttl <= 0 || reCommitSHA.MatchString(ref) || time.Since(s.ModTime()) < f.ttl
+	return b, true, fresh
+}
+
+func (f *RemoteFetcher) writeCache(path string, content []byte) {
+	if path == "" {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		f.debug("Could not create cache directory for %s: %s", path, err)
+		return
+	}
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		f.debug("Could not write cache file %s: %s", path, err)
+	}
+}
+
+func (f *RemoteFetcher) newRequest(ctx context.Context, slug, ref, path string) (string, *http.Request, error) {
+	if f.apiURL == "" {
+		u := fmt.Sprintf("%s/%s/%s/%s", f.baseURL, slug, ref, path)
+		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+		if err != nil {
+			return "", nil, fmt.Errorf("could not create request for %s: %w", u, err)
+		}
+		return u, req, nil
+	}
+
+	// https://docs.github.com/en/enterprise-server@latest/rest/repos/contents#get-repository-content
+	u := fmt.Sprintf("%s/repos/%s/contents/%s?ref=%s", f.apiURL, slug, path, url.QueryEscape(ref))
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not create request for %s: %w", u, err)
+	}
+	req.Header.Set("Accept", "application/vnd.github.raw")
+	return u, req, nil
+}
+
+// Fetch fetches the file at 'path' in the repository 'slug' ("owner/repo") at the revision 'ref'.
+// When the file is not found or it could not be fetched due to network issues or unsuccessful
+// responses such as 403, this method returns nil without an error. An error is returned only when
+// the request could not be created. Calling this method is thread-safe.
+func (f *RemoteFetcher) Fetch(slug, ref, path string) ([]byte, error) {
+	return f.FetchContext(context.Background(), slug, ref, path)
+}
+
+// FetchContext is the same as Fetch but it takes the context. When the context is canceled, the
+// request in flight is aborted and the file is treated as it could not be fetched.
+func (f *RemoteFetcher) FetchContext(ctx context.Context, slug, ref, path string) ([]byte, error) {
+	cache := f.cachePath(slug, ref, path)
+	cached, ok, fresh := f.readCache(cache, ref)
+	if ok && (fresh || f.offline) {
+		f.debug("Cache hit for %s/%s@%s: %s", slug, path, ref, cache)
+		return cached, nil
+	}
+	if f.offline {
+		f.debug("Cache was not found for %s/%s@%s. Skip fetching the file since offline mode is enabled", slug, path, ref)
+		return nil, nil
+	}
+
+	url, req, err := f.newRequest(ctx, slug, ref, path)
+	if err != nil {
+		return nil, err
+	}
+	f.debug("Fetching %s", url)
+	res, err := f.client.Do(req)
+	if err != nil {
+		// Network is not available. Fall back to the expired cache or give up fetching the file
+		f.debug("Could not fetch %s: %s", url, err)
+		if ok {
+			f.debug("Using expired cache for %s/%s@%s: %s", slug, path, ref, cache)
+			return cached, nil
+		}
+		return nil, nil
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case 200:
+		b, err := io.ReadAll(res.Body)
+		if err != nil {
+			f.debug("Could not read response body from %s: %s", url, err)
+			return nil, nil
+		}
+		f.writeCache(cache, b)
+		return b, nil
+	case 404:
+		// The repository may be private. It is not always a mistake
+		f.debug("File was not found at %s", url)
+		return nil, nil
+	default:
+		// Authentication failure, rate limit, or server error. They are not mistakes in workflows so
+		// they are handled as well as network issues
+		f.debug("Could not fetch %s: %s", url, res.Status)
+		if ok {
+			f.debug("Using expired cache for %s/%s@%s: %s", slug, path, ref, cache)
+			return cached, nil
+		}
+		return nil, nil
+	}
+}
+
+// OwnerExists checks the owner in CODEOWNERS file exists via REST API. The 'owner' parameter is a
+// user name like "@octocat" or a team name like "@org/team". Teams can be looked up only when an
+// API token is available since the API requires authentication. This method returns true when the
+// existence could not be confirmed, for example, in offline mode or on network issues, so that
+// callers do not report false positives. An error is returned only when the server returned an
+// unexpected response. Results are cached in memory. Calling this method is thread-safe.
+func (f *RemoteFetcher) OwnerExists(owner string) (bool, error) {
+	return f.OwnerExistsContext(context.Background(), owner)
+}
+
+// OwnerExistsContext is the same as OwnerExists but it takes the context. When the context is
+// canceled, the request in flight is aborted and the existence is treated as it could not be
+// confirmed.
+func (f *RemoteFetcher) OwnerExistsContext(ctx context.Context, owner string) (bool, error) {
+	name := strings.TrimPrefix(owner, "@")
+	var path string
+	if org, team, ok := strings.Cut(name, "/"); ok {
+		if f.token == "" {
+			f.debug("Skip looking up team %s since API token is not set", owner)
+			return true, nil
+		}
+		// https://docs.github.com/en/rest/teams/teams#get-a-team-by-name
+		path = fmt.Sprintf("/orgs/%s/teams/%s", url.PathEscape(org), url.PathEscape(team))
+	} else {
+		// https://docs.github.com/en/rest/users/users#get-a-user
+		path = "/users/" + url.PathEscape(name)
+	}
+
+	f.ownersMu.Lock()
+	defer f.ownersMu.Unlock()
+
+	if b, ok := f.owners[path]; ok {
+		return b, nil
+	}
+	if f.offline {
+		f.debug("Skip looking up owner %s since offline mode is enabled", owner)
+		return true, nil
+	}
+
+	api := f.apiURL
+	if api == "" {
+		api = f.githubAPI
+	}
+	u := api + path
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return false, fmt.Errorf("could not create request for %s: %w", u, err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if f.token != "" {
+		req.Header.Set("Authorization", "Bearer "+f.token)
+	}
+
+	f.debug("Looking up owner %s at %s", owner, u)
+	res, err := f.client.Do(req)
+	if err != nil {
+		f.debug("Could not look up owner %s: %s", owner, err)
+		return true, nil
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case 200:
+		f.owners[path] = true
+		return true, nil
+	case 404:
+		f.owners[path] = false
+		return false, nil
+	case 401, 403, 429:
+		// Authentication failure or rate limit. The owner may exist
+		f.debug("Could not look up owner %s at %s: %s", owner, u, res.Status)
+		return true, nil
+	default:
+		return false, fmt.Errorf("request was not successful for %s: %s", u, res.Status)
+	}
+}
+
+// listNames fetches names of all items from the list API of REST API like "/repos/owner/repo/environments".
+// The 'key' parameter is the key of the items in the response body like "environments". All pages
+// are fetched. The second return value is false when the names could not be fetched, for example,
+// when API token is not set, in offline mode, on network issues, or when the token does not have
+// the permission. When 'notFoundAsEmpty' is true, 404 response is treated as no item. Results are
+// cached in memory. Calling this method is thread-safe.
+func (f *RemoteFetcher) listNames(ctx context.Context, path, key string, notFoundAsEmpty bool) ([]string, bool, error) {
+	if f.token == "" {
+		f.debug("Skip fetching %s since API token is not set", path)
+		return nil, false, nil
+	}
+
+	f.namesMu.Lock()
+	defer f.namesMu.Unlock()
+
+	if ns, ok := f.names[path]; ok {
+		return ns, ns != nil, nil
+	}
+	if f.offline {
+		f.debug("Skip fetching %s since offline mode is enabled", path)
+		return nil, false, nil
+	}
+
+	api := f.apiURL
+	if api == "" {
+		api = f.githubAPI
+	}
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	names := []string{}
+	for page := 1; ; page++ {
+		u := fmt.Sprintf("%s%s%sper_page=100&page=%d", api, path, sep, page)
+		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+		if err != nil {
+			return nil, false, fmt.Errorf("could not create request for %s: %w", u, err)
+		}
+		req.Header.Set("Accept", "application/vnd.github+json")
+		req.Header.Set("Authorization", "Bearer "+f.token)
+
+		f.debug("Fetching %s", u)
+		res, err := f.client.Do(req)
+		if err != nil {
+			f.debug("Could not fetch %s: %s", u, err)
+			if ctx.Err() == nil {
+				f.names[path] = nil // Do not remember the result of the aborted request
+			}
+			return nil, false, nil
+		}
+		b, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			f.debug("Could not read response body from %s: %s", u, err)
+			f.names[path] = nil
+			return nil, false, nil
+		}
+
+		if res.StatusCode == 404 && notFoundAsEmpty {
+			f.debug("%s was not found. It is treated as no item", u)
+			break
+		}
+		switch res.StatusCode {
+		case 200:
+		case 401, 403, 404, 429:
+			// Authentication failure, no permission, or rate limit. The repository or the
+			// organization may be private
+			f.debug("Could not fetch %s: %s", u, res.Status)
+			f.names[path] = nil
+			return nil, false, nil
+		default:
+			return nil, false, fmt.Errorf("request was not successful for %s: %s", u, res.Status)
+		}
+
+		var body map[string]json.RawMessage
+		if err := json.Unmarshal(b, &body); err != nil {
+			return nil, false, fmt.Errorf("could not parse response from %s: %w", u, err)
+		}
+		var total int
+		var items []struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(body["total_count"], &total); err != nil {
+			return nil, false, fmt.Errorf("could not parse \"total_count\" in response from %s: %w", u, err)
+		}
+		if err := json.Unmarshal(body[key], &items); err != nil {
+			return nil, false, fmt.Errorf("could not parse %q in response from %s: %w", key, u, err)
+		}
+		for _, i := range items {
+			names = append(names, i.Name)
+		}
+		if len(items) == 0 || len(names) >= total {
+			break
+		}
+	}
+
+	f.debug("Fetched %d names from %s", len(names), path)
+	f.names[path] = names
+	return names, true, nil
+}
+
+// Environments fetches names of deployment environments in the repository 'slug' ("owner/repo")
+// via REST API. API token is required. The second return value is false when the environments
+// could not be fetched. See listNames for more details.
+func (f *RemoteFetcher) Environments(slug string) ([]string, bool, error) {
+	return f.EnvironmentsContext(context.Background(), slug)
+}
+
+// EnvironmentsContext is the same as Environments but it takes the context. When the context is
+// canceled, the request in flight is aborted.
+func (f *RemoteFetcher) EnvironmentsContext(ctx context.Context, slug string) ([]string, bool, error) {
+	// https://docs.github.com/en/rest/deployments/environments#list-environments
+	return f.listNames(ctx, fmt.Sprintf("/repos/%s/environments", slug), "environments", false)
+}
+
+// RunnerGroups fetches names of runner groups in the organization which owns the repository 'slug'
+// ("owner/repo") and which the repository is allowed to use via REST API. API token with read access
+// to the organization's runner groups is required. The second return value is false when the runner
+// groups could not be fetched, for example, when the repository is owned by a user. See listNames
+// for more details.
+func (f *RemoteFetcher) RunnerGroups(slug string) ([]string, bool, error) {
+	return f.RunnerGroupsContext(context.Background(), slug)
+}
+
+// RunnerGroupsContext is the same as RunnerGroups but it takes the context. When the context is
+// canceled, the request in flight is aborted.
+func (f *RemoteFetcher) RunnerGroupsContext(ctx context.Context, slug string) ([]string, bool, error) {
+	owner, repo, ok := strings.Cut(slug, "/")
+	if !ok {
+		return nil, false, nil
+	}
+	// https://docs.github.com/en/rest/actions/self-hosted-runner-groups#list-self-hosted-runner-groups-for-an-organization
+	p := fmt.Sprintf("/orgs/%s/actions/runner-groups?visible_to_repository=%s", owner, url.QueryEscape(repo))
+	return f.listNames(ctx, p, "runner_groups", false)
+}
+
+// listNamesInScopes fetches names of secrets or configuration variables available in the repository
+// 'slug'. The 'kind' parameter is "secrets" or "variables". Names in the repository, in the
+// organization and shared with the repository, and in the environment 'env' when it is not empty
+// are merged.
+func (f *RemoteFetcher) listNamesInScopes(ctx context.Context, slug, env, kind string) ([]string, bool, error) {
+	// https://docs.github.com/en/rest/actions/secrets#list-repository-secrets
+	// https://docs.github.com/en/rest/actions/variables#list-repository-variables
+	repo, ok, err := f.listNames(ctx, fmt.Sprintf("/repos/%s/actions/%s", slug, kind), kind, false)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	// https://docs.github.com/en/rest/actions/secrets#list-repository-organization-secrets
+	// https://docs.github.com/en/rest/actions/variables#list-repository-organization-variables
+	// Repositories owned by users don't have organization secrets and variables
+	org, ok, err := f.listNames(ctx, fmt.Sprintf("/repos/%s/actions/organization-%s", slug, kind), kind, true)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	names := append(append([]string{}, repo...), org...)
+	if env == "" {
+		return names, true, nil
+	}
+	// https://docs.github.com/en/rest/actions/secrets#list-environment-secrets
+	// https://docs.github.com/en/rest/actions/variables#list-environment-variables
+	e, ok, err := f.listNames(ctx, fmt.Sprintf("/repos/%s/environments/%s/%s", slug, url.PathEscape(env), kind), kind, false)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return append(names, e...), true, nil
+}
+
+// Secrets fetches names of secrets available in the repository 'slug' ("owner/repo") via REST API.
+// Secrets in the organization shared with the repository and secrets in the deployment environment
+// 'env' are included. The environment is not looked up when 'env' is empty. API token is required.
+// The second return value is false when the secrets could not be fetched. See listNames for more
+// details.
+func (f *RemoteFetcher) Secrets(slug, env string) ([]string, bool, error) {
+	return f.SecretsContext(context.Background(), slug, env)
+}
+
+// SecretsContext is the same as Secrets but it takes the context. When the context is canceled, the
+// request in flight is aborted.
+func (f *RemoteFetcher) SecretsContext(ctx context.Context, slug, env string) ([]string, bool, error) {
+	return f.listNamesInScopes(ctx, slug, env, "secrets")
+}
+
+// Variables fetches names of configuration variables available in the repository 'slug' via REST
+// API in the same way as Secrets.
+func (f *RemoteFetcher) Variables(slug, env string) ([]string, bool, error) {
+	return f.VariablesContext(context.Background(), slug, env)
+}
+
+// VariablesContext is the same as Variables but it takes the context. When the context is canceled,
+// the request in flight is aborted.
+func (f *RemoteFetcher) VariablesContext(ctx context.Context, slug, env string) ([]string, bool, error) {
+	return f.listNamesInScopes(ctx, slug, env, "variables")
+}
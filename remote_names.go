@@ -0,0 +1,50 @@
+package actionlint
+
+// remoteNamesChecker checks names such as deployment environments and runner groups exist in the
+// repository. The names are fetched via REST API on the first check and compared case-insensitively.
+// Errors are reported as warnings since the fetched names may not reflect the settings when the
+// workflow runs.
+type remoteNamesChecker struct {
+	slug      string
+	fetch     func(slug string) ([]string, bool, error)
+	what      string // Kind of names in plural form like "environments"
+	notExist  string // Format of the message when the name does not exist. It takes the name and the slug
+	empty     string // Message when no name is fetched
+	names     []string
+	fetched   bool
+	available bool
+}
+
+// check reports an error to the rule when the name does not exist. Empty names and names containing
+// expressions are not checked. When the names could not be fetched, it reports nothing.
+func (c *remoteNamesChecker) check(rule *RuleBase, s *String) error {
+	if s.Value == "" || s.ContainsExpression() {
+		return nil
+	}
+
+	if !c.fetched {
+		c.fetched = true
+		ns, ok, err := c.fetch(c.slug)
+		if err != nil {
+			return err
+		}
+		c.names = ns
+		c.available = ok
+	}
+	if !c.available || containsFold(c.names, s.Value) {
+		return nil
+	}
+
+	avail := c.empty
+	if len(c.names) > 0 {
+		avail = "available " + c.what + " are " + sortedQuotes(c.names)
+	}
+	err := errorfAt(s.Pos, rule.name, c.notExist+". %s", s.Value, c.slug, avail)
+	err.Severity = "warning"
+	if ns := similarNames(s.Value, c.names); len(ns) > 0 {
+		err.Message += ". " + didYouMean(ns)
+		err.Suggestions = ns
+	}
+	rule.errs = append(rule.errs, err)
+	return nil
+}
@@ -0,0 +1,142 @@
+package actionlint
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRemoteNamesCheckerRules(t *testing.T) {
+	type remoteNamesRule interface {
+		Rule
+		VisitJobPre(*Job) error
+	}
+
+	rules := []struct {
+		kind     string
+		newRule  func(func(string) ([]string, bool, error)) remoteNamesRule
+		newJob   func(s *String) *Job
+		notExist string
+		empty    string
+		avail    string
+	}{
+		{
+			kind: "environment",
+			newRule: func(f func(string) ([]string, bool, error)) remoteNamesRule {
+				return NewRuleEnvironment("owner/repo", f)
+			},
+			newJob: func(s *String) *Job {
+				return &Job{Environment: &Environment{Name: s}}
+			},
+			notExist: `environment "prodution" does not exist in repository "owner/repo". a new environment without protection rules and secrets is created on running the job. `,
+			empty:    "no environment is configured in the repository",
+			avail:    `available environments are "Production", "staging". did you mean "Production"?`,
+		},
+		{
+			kind: "runner-group",
+			newRule: func(f func(string) ([]string, bool, error)) remoteNamesRule {
+				return NewRuleRunnerGroup("owner/repo", f)
+			},
+			newJob: func(s *String) *Job {
+				return &Job{RunsOn: &Runner{Group: s}}
+			},
+			notExist: `runner group "prodution" does not exist or is not available to repository "owner/repo". the job waits for a runner until it times out. `,
+			empty:    "no runner group is available to the repository",
+			avail:    `available runner groups are "Production", "staging". did you mean "Production"?`,
+		},
+	}
+
+	testCases := []struct {
+		what  string
+		name  string
+		names []string
+		ok    bool
+		want  func(notExist, empty, avail string) string
+	}{
+		{"existing name", "staging", []string{"Production", "staging"}, true, nil},
+		{"case-insensitive", "production", []string{"Production"}, true, nil},
+		{"expression", "${{ inputs.name }}", []string{"Production"}, true, nil},
+		{"empty", "", []string{"Production"}, true, nil},
+		{"names not available", "foo", nil, false, nil},
+		{
+			"typo",
+			"prodution",
+			[]string{"staging", "Production"},
+			true,
+			func(notExist, empty, avail string) string { return notExist + avail },
+		},
+		{
+			"no name",
+			"prodution",
+			[]string{},
+			true,
+			func(notExist, empty, avail string) string { return notExist + empty },
+		},
+	}
+
+	for _, r := range rules {
+		t.Run(r.kind, func(t *testing.T) {
+			for _, tc := range testCases {
+				t.Run(tc.what, func(t *testing.T) {
+					calls := 0
+					rule := r.newRule(func(slug string) ([]string, bool, error) {
+						calls++
+						if slug != "owner/repo" {
+							t.Errorf("unexpected slug %q", slug)
+						}
+						return tc.names, tc.ok, nil
+					})
+					for i := 0; i < 2; i++ {
+						if err := rule.VisitJobPre(r.newJob(&String{Value: tc.name, Pos: &Pos{}})); err != nil {
+							t.Fatal(err)
+						}
+					}
+					if calls > 1 {
+						t.Errorf("names should be fetched once but fetched %d times", calls)
+					}
+
+					errs := rule.Errs()
+					if tc.want == nil {
+						if len(errs) > 0 {
+							t.Fatalf("no error should be reported: %v", errs)
+						}
+						return
+					}
+					if len(errs) != 2 {
+						t.Fatalf("wanted 2 errors but got %v", errs)
+					}
+					err := errs[0]
+					if want := tc.want(r.notExist, r.empty, r.avail); err.Message != want {
+						t.Errorf("unexpected message\n  want: %q\n  have: %q", want, err.Message)
+					}
+					if err.Severity != "warning" || err.Kind != r.kind {
+						t.Errorf("unexpected error: %#v", err)
+					}
+				})
+			}
+
+			t.Run("no node", func(t *testing.T) {
+				rule := r.newRule(func(string) ([]string, bool, error) {
+					t.Error("names should not be fetched")
+					return nil, false, nil
+				})
+				if err := rule.VisitJobPre(&Job{}); err != nil {
+					t.Fatal(err)
+				}
+				if errs := rule.Errs(); len(errs) > 0 {
+					t.Fatalf("no error should be reported: %v", errs)
+				}
+			})
+
+			t.Run("fetch error", func(t *testing.T) {
+				rule := r.newRule(func(string) ([]string, bool, error) {
+					return nil, false, errors.New("dummy error")
+				})
+				err := rule.VisitJobPre(r.newJob(&String{Value: "production", Pos: &Pos{}}))
+				if err == nil || !strings.Contains(err.Error(), "dummy error") {
+					t.Fatalf("error should be returned: %v", err)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,892 @@
+package actionlint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testNewRemoteFetcherServer(t *testing.T, files map[string]string) (*httptest.Server, *int) {
+	t.Helper()
+	count := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if r.URL.Path == "/owner/repo/v1/broken.yaml" {
+			w.WriteHeader(500)
+			return
+		}
+		if r.URL.Path == "/owner/repo/v1/forbidden.yaml" {
+			w.WriteHeader(403)
+			return
+		}
+		c, ok := files[r.URL.Path]
+		if !ok {
+			w.WriteHeader(404)
+			return
+		}
+		w.Write([]byte(c))
+	}))
+	t.Cleanup(s.Close)
+	return s, &count
+}
+
+// testNewBlockingServer creates a server which never responds until the request is aborted by the
+// client. The returned channel receives a value each time a request is aborted.
+func testNewBlockingServer(t *testing.T) (*httptest.Server, chan struct{}) {
+	t.Helper()
+	aborted := make(chan struct{}, 10)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body) // Closed connection is not detected until the body is read
+		<-r.Context().Done()
+		aborted <- struct{}{}
+	}))
+	t.Cleanup(s.Close)
+	return s, aborted
+}
+
+func testWaitAborted(t *testing.T, aborted chan struct{}) {
+	t.Helper()
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not aborted by canceling the context")
+	}
+}
+
+func TestRemoteFetcherFetchAndCache(t *testing.T) {
+	s, count := testNewRemoteFetcherServer(t, map[string]string{
+		"/owner/repo/v1/.github/workflows/reusable.yaml": "on: workflow_call",
+	})
+	dir := t.TempDir()
+	f := NewRemoteFetcher(dir, nil)
+	f.baseURL = s.URL
+
+	for i := 0; i < 2; i++ {
+		b, err := f.Fetch("owner/repo", "v1", ".github/workflows/reusable.yaml")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "on: workflow_call" {
+			t.Fatalf("unexpected content: %q", b)
+		}
+	}
+	if *count != 1 {
+		t.Fatalf("file should be fetched only once but fetched %d times", *count)
+	}
+
+	p := filepath.Join(dir, "remote", "owner", "repo", "v1", ".github", "workflows", "reusable.yaml")
+	if _, err := os.Stat(p); err != nil {
+		t.Fatalf("cache file was not created at %q: %s", p, err)
+	}
+
+	// Even if the server is not available, the cached file is used
+	s.Close()
+	f = NewRemoteFetcher(dir, nil)
+	f.baseURL = s.URL
+	b, err := f.Fetch("owner/repo", "v1", ".github/workflows/reusable.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "on: workflow_call" {
+		t.Fatalf("unexpected content: %q", b)
+	}
+}
+
+func TestRemoteFetcherNotFound(t *testing.T) {
+	s, _ := testNewRemoteFetcherServer(t, map[string]string{})
+	f := NewRemoteFetcher(t.TempDir(), nil)
+	f.baseURL = s.URL
+
+	b, err := f.Fetch("owner/repo", "v1", "not-found.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b != nil {
+		t.Fatalf("content should be nil but got %q", b)
+	}
+
+	// Unsuccessful responses are handled as well as network issues
+	for _, p := range []string{"broken.yaml", "forbidden.yaml"} {
+		b, err := f.Fetch("owner/repo", "v1", p)
+		if err != nil {
+			t.Fatalf("error occurred for %s: %s", p, err)
+		}
+		if b != nil {
+			t.Fatalf("content should be nil for %s but got %q", p, b)
+		}
+	}
+}
+
+func TestRemoteFetcherOffline(t *testing.T) {
+	s, _ := testNewRemoteFetcherServer(t, map[string]string{})
+	s.Close()
+	f := NewRemoteFetcher(t.TempDir(), nil)
+	f.baseURL = s.URL
+
+	b, err := f.Fetch("owner/repo", "v1", "workflow.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b != nil {
+		t.Fatalf("content should be nil but got %q", b)
+	}
+}
+
+func TestRemoteFetcherCacheTTL(t *testing.T) {
+	sha := "0123456789abcdef0123456789abcdef01234567"
+	s, count := testNewRemoteFetcherServer(t, map[string]string{
+		"/owner/repo/v1/action.yml":          "name: v1",
+		"/owner/repo/" + sha + "/action.yml": "name: sha",
+	})
+	dir := t.TempDir()
+	f := NewRemoteFetcher(dir, nil)
+	f.baseURL = s.URL
+	f.SetCacheTTL(time.Hour)
+
+	for _, ref := range []string{"v1", sha} {
+		if _, err := f.Fetch("owner/repo", ref, "action.yml"); err != nil {
+			t.Fatal(err)
+		}
+		// Make the cache file expired
+		old := time.Now().Add(-2 * time.Hour)
+		if err := os.Chtimes(f.cachePath("owner/repo", ref, "action.yml"), old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if *count != 2 {
+		t.Fatalf("files should be fetched twice but fetched %d times", *count)
+	}
+
+	// Expired cache is fetched again but cache at commit SHA never expires
+	for _, ref := range []string{"v1", sha} {
+		if _, err := f.Fetch("owner/repo", ref, "action.yml"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if *count != 3 {
+		t.Fatalf("only expired file should be fetched again but fetched %d times in total", *count)
+	}
+
+	// Expired cache is used when the file cannot be fetched
+	old := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(f.cachePath("owner/repo", "v1", "action.yml"), old, old); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+	b, err := f.Fetch("owner/repo", "v1", "action.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "name: v1" {
+		t.Fatalf("expired cache should be used but got %q", b)
+	}
+}
+
+func TestRemoteFetcherOfflineMode(t *testing.T) {
+	s, count := testNewRemoteFetcherServer(t, map[string]string{
+		"/owner/repo/v1/action.yml": "name: v1",
+		"/owner/repo/v2/action.yml": "name: v2",
+	})
+	dir := t.TempDir()
+	f := NewRemoteFetcher(dir, nil)
+	f.baseURL = s.URL
+	if _, err := f.Fetch("owner/repo", "v1", "action.yml"); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(f.cachePath("owner/repo", "v1", "action.yml"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	f = NewRemoteFetcher(dir, nil)
+	f.baseURL = s.URL
+	f.SetCacheTTL(time.Hour)
+	f.EnableOffline()
+
+	// Expired cache is used in offline mode
+	b, err := f.Fetch("owner/repo", "v1", "action.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "name: v1" {
+		t.Fatalf("cached content should be returned but got %q", b)
+	}
+
+	// File which is not cached is not fetched in offline mode
+	b, err = f.Fetch("owner/repo", "v2", "action.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b != nil {
+		t.Fatalf("content should be nil but got %q", b)
+	}
+	if *count != 1 {
+		t.Fatalf("network should not be accessed in offline mode but files were fetched %d times", *count)
+	}
+}
+
+func TestRemoteFetcherCachePathDoesNotEscape(t *testing.T) {
+	f := NewRemoteFetcher(t.TempDir(), nil)
+	if p := f.cachePath("owner/repo", "..", "../../x.yaml"); p != "" {
+		t.Fatalf("cache path should be empty but got %q", p)
+	}
+}
+
+func TestRuleWorkflowCallRemoteReusableWorkflow(t *testing.T) {
+	s, _ := testNewRemoteFetcherServer(t, map[string]string{
+		"/owner/repo/v1/.github/workflows/reusable.yaml": `
+on:
+  workflow_call:
+    inputs:
+      name:
+        type: string
+        required: true
+    secrets:
+      token:
+        required: true
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo hi
+`,
+	})
+	f := NewRemoteFetcher(t.TempDir(), nil)
+	f.baseURL = s.URL
+
+	c := NewLocalReusableWorkflowCache(nil, "", nil)
+	c.EnableRemote(f)
+	r := NewRuleWorkflowCall("", c)
+	j := &Job{
+		WorkflowCall: &WorkflowCall{
+			Uses: &String{
+				Value: "owner/repo/.github/workflows/reusable.yaml@v1",
+				Pos:   &Pos{},
+			},
+			Inputs: map[string]*WorkflowCallInput{
+				"user": {
+					Name:  &String{Value: "user", Pos: &Pos{}},
+					Value: &String{Value: "foo", Pos: &Pos{}},
+				},
+			},
+		},
+	}
+	if err := r.VisitJobPre(j); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`input "name" is required by "owner/repo/.github/workflows/reusable.yaml@v1" reusable workflow`,
+		`secret "token" is required by "owner/repo/.github/workflows/reusable.yaml@v1" reusable workflow`,
+		`input "user" is not defined in "owner/repo/.github/workflows/reusable.yaml@v1" reusable workflow. defined input is "name"`,
+	}
+	errs := r.Errs()
+	if len(errs) != len(want) {
+		t.Fatalf("wanted %d errors but got %v", len(want), errs)
+	}
+	for _, w := range want {
+		found := false
+		for _, e := range errs {
+			if e.Message == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("error %q was not found in %v", w, errs)
+		}
+	}
+}
+
+func TestRuleWorkflowCallRemoteReusableWorkflowDisabled(t *testing.T) {
+	c := NewLocalReusableWorkflowCache(nil, "", nil)
+	m, err := c.FindMetadata("owner/repo/.github/workflows/reusable.yaml@v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != nil {
+		t.Fatalf("metadata should not be found when remote is disabled: %v", m)
+	}
+}
+
+func TestSplitWorkflowCallUsesRepoFormat(t *testing.T) {
+	slug, path, ref := splitWorkflowCallUsesRepoFormat("owner/repo/.github/workflows/x.yml@release/v1")
+	if slug != "owner/repo" || path != ".github/workflows/x.yml" || ref != "release/v1" {
+		t.Fatalf("unexpected result: %q %q %q", slug, path, ref)
+	}
+}
+
+func TestRemoteFetcherGitHubEnterprise(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/repos/owner/repo/contents/.github/workflows/reusable.yaml" || r.URL.Query().Get("ref") != "release/v1" {
+			w.WriteHeader(404)
+			return
+		}
+		if h := r.Header.Get("Accept"); h != "application/vnd.github.raw" {
+			t.Errorf("unexpected Accept header: %q", h)
+		}
+		w.Write([]byte("on: workflow_call"))
+	}))
+	t.Cleanup(s.Close)
+
+	dir := t.TempDir()
+	f := NewRemoteFetcher(dir, nil).ForGitHubEnterprise(s.URL + "/api/v3/")
+	b, err := f.Fetch("owner/repo", "release/v1", ".github/workflows/reusable.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "on: workflow_call" {
+		t.Fatalf("unexpected content: %q", b)
+	}
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := filepath.Join(dir, "ghes", u.Host, "remote", "owner", "repo", "release", "v1", ".github", "workflows", "reusable.yaml")
+	if _, err := os.Stat(p); err != nil {
+		t.Fatalf("cache file was not created at %q: %s", p, err)
+	}
+}
+
+func TestRuleActionRemoteAction(t *testing.T) {
+	s, count := testNewRemoteFetcherServer(t, map[string]string{
+		"/owner/repo/v1/action.yml": `
+name: My action
+description: my action
+inputs:
+  name:
+    required: true
+  dry-run:
+    type: boolean
+runs:
+  using: node20
+  main: index.js
+`,
+		"/owner/repo/v1/sub/dir/action.yaml": `
+name: Sub action
+description: sub action
+inputs:
+  message:
+    required: true
+runs:
+  using: node20
+  main: index.js
+`,
+	})
+	f := NewRemoteFetcher(t.TempDir(), nil)
+	f.baseURL = s.URL
+
+	c := NewLocalActionsCache(nil, nil)
+	c.EnableRemote(f)
+	r := NewRuleAction(c)
+
+	step := func(spec string, inputs ...string) *Step {
+		m := map[string]*Input{}
+		for i := 0; i < len(inputs); i += 2 {
+			m[inputs[i]] = &Input{
+				Name:  &String{Value: inputs[i], Pos: &Pos{}},
+				Value: &String{Value: inputs[i+1], Pos: &Pos{}},
+			}
+		}
+		return &Step{
+			Exec: &ExecAction{
+				Uses:   &String{Value: spec, Pos: &Pos{}},
+				Inputs: m,
+			},
+		}
+	}
+
+	for _, s := range []*Step{
+		step("owner/repo@v1", "nmae", "foo", "dry-run", "yes"),
+		step("owner/repo@v1", "name", "foo"),
+		step("owner/repo/sub/dir@v1"),
+		step("owner/unknown@v1", "foo", "bar"),
+	} {
+		if err := r.VisitStep(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []string{
+		`input "nmae" is not defined in action "My action" defined at "owner/repo@v1". available inputs are "dry-run", "name". did you mean "name"?`,
+		`input "dry-run" of action "My action" defined at "owner/repo@v1" is a boolean input but the value "yes" is not a boolean. available values are "true" and "false"`,
+		`missing input "name" which is required by action "My action" defined at "owner/repo@v1". all required inputs are "name"`,
+		`missing input "message" which is required by action "Sub action" defined at "owner/repo/sub/dir@v1". all required inputs are "message"`,
+	}
+	errs := r.Errs()
+	if len(errs) != len(want) {
+		t.Fatalf("wanted %d errors but got %v", len(want), errs)
+	}
+	for _, w := range want {
+		found := false
+		for _, e := range errs {
+			if e.Message == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("error %q was not found in %v", w, errs)
+		}
+	}
+
+	// "owner/repo@v1": action.yml
+	// "owner/repo/sub/dir@v1": action.yml (404) and action.yaml
+	// "owner/unknown@v1": action.yml (404) and action.yaml (404)
+	if *count != 5 {
+		t.Fatalf("metadata of the same action should be fetched only once but %d requests were sent", *count)
+	}
+}
+
+func TestRuleActionRemoteActionDisabled(t *testing.T) {
+	c := NewLocalActionsCache(nil, nil)
+	m, _, err := c.FindMetadata("owner/repo@v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != nil {
+		t.Fatalf("metadata should not be found when remote is disabled: %v", m)
+	}
+}
+
+func TestSplitRepoActionSpec(t *testing.T) {
+	testCases := []struct {
+		spec string
+		slug string
+		dir  string
+		ref  string
+		ok   bool
+	}{
+		{"owner/repo@v1", "owner/repo", "", "v1", true},
+		{"owner/repo/path/to/action@release/v1", "owner/repo", "path/to/action", "release/v1", true},
+		{"owner/repo/dir/@v1", "owner/repo", "dir", "v1", true},
+		{"owner@v1", "", "", "", false},
+		{"owner/repo", "", "", "", false},
+		{"owner/repo@", "", "", "", false},
+		{"owner/@v1", "", "", "", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			slug, dir, ref, ok := splitRepoActionSpec(tc.spec)
+			if slug != tc.slug || dir != tc.dir || ref != tc.ref || ok != tc.ok {
+				t.Fatalf("wanted (%q, %q, %q, %v) but got (%q, %q, %q, %v)", tc.slug, tc.dir, tc.ref, tc.ok, slug, dir, ref, ok)
+			}
+		})
+	}
+}
+
+func TestRemoteFetcherOwnerExists(t *testing.T) {
+	count := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		switch r.URL.Path {
+		case "/users/octocat", "/orgs/octo-org/teams/core":
+			if r.URL.Path != "/users/octocat" && r.Header.Get("Authorization") != "Bearer dummy" {
+				w.WriteHeader(404)
+				return
+			}
+			w.Write([]byte("{}"))
+		case "/users/broken":
+			w.WriteHeader(500)
+		case "/users/limited":
+			w.WriteHeader(403)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer s.Close()
+
+	f := NewRemoteFetcher(t.TempDir(), nil)
+	f.githubAPI = s.URL
+	f.token = ""
+
+	for _, tc := range []struct {
+		owner string
+		want  bool
+	}{
+		{"@octocat", true},
+		{"@ghost", false},
+		{"@limited", true},
+		{"@octo-org/unknown", true}, // Teams are not looked up without token
+	} {
+		ok, err := f.OwnerExists(tc.owner)
+		if err != nil {
+			t.Fatal(tc.owner, err)
+		}
+		if ok != tc.want {
+			t.Errorf("existence of %q should be %v but got %v", tc.owner, tc.want, ok)
+		}
+	}
+	if count != 3 {
+		t.Fatalf("server should be called 3 times but called %d times", count)
+	}
+
+	// Results are cached in memory
+	if ok, _ := f.OwnerExists("@ghost"); ok {
+		t.Error("@ghost should not exist")
+	}
+	if count != 3 {
+		t.Fatalf("cached result should be used but server was called %d times", count)
+	}
+
+	if _, err := f.OwnerExists("@broken"); err == nil {
+		t.Error("error should be returned on unexpected response")
+	}
+
+	f.token = "dummy"
+	for _, tc := range []struct {
+		owner string
+		want  bool
+	}{
+		{"@octo-org/core", true},
+		{"@octo-org/unknown", false},
+	} {
+		ok, err := f.OwnerExists(tc.owner)
+		if err != nil {
+			t.Fatal(tc.owner, err)
+		}
+		if ok != tc.want {
+			t.Errorf("existence of %q should be %v but got %v", tc.owner, tc.want, ok)
+		}
+	}
+
+	f.EnableOffline()
+	if ok, err := f.OwnerExists("@other"); err != nil || !ok {
+		t.Errorf("owner should be treated as existing in offline mode: %v %v", ok, err)
+	}
+}
+
+func TestNewHTTPClientProxyAndTimeout(t *testing.T) {
+	c, err := newHTTPClient("http://proxy.example.com:8080", 3*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Timeout != 3*time.Second {
+		t.Errorf("wanted timeout 3s but got %s", c.Timeout)
+	}
+	req, err := http.NewRequest("GET", "https://raw.githubusercontent.com/owner/repo/v1/action.yml", nil)
+	if err != nil {
+		panic(err)
+	}
+	u, err := c.Transport.(*http.Transport).Proxy(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u == nil || u.Host != "proxy.example.com:8080" {
+		t.Errorf("wanted proxy \"proxy.example.com:8080\" but got %v", u)
+	}
+
+	c, err = newHTTPClient("", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Timeout != defaultHTTPTimeout {
+		t.Errorf("wanted default timeout %s but got %s", defaultHTTPTimeout, c.Timeout)
+	}
+}
+
+func TestNewHTTPClientError(t *testing.T) {
+	testCases := []struct {
+		what    string
+		proxy   string
+		timeout time.Duration
+		want    string
+	}{
+		{"proxy without scheme", "proxy.example.com", 0, "must have scheme and host"},
+		{"broken proxy URL", "http://[::1", 0, "could not parse proxy URL"},
+		{"negative timeout", "", -time.Second, "must not be negative"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := newHTTPClient(tc.proxy, tc.timeout)
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, err.Error())
+			}
+		})
+	}
+}
+
+func TestRemoteFetcherUseHTTPClientOfLinter(t *testing.T) {
+	s, count := testNewRemoteFetcherServer(t, map[string]string{
+		"/owner/repo/v1/action.yml": "name: Test",
+	})
+	c := &http.Client{}
+	l, err := NewLinter(io.Discard, &LinterOptions{RemoteActions: true, CacheDir: t.TempDir(), HTTPClient: c})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.remote.client != c {
+		t.Fatal("HTTP client in options is not used by RemoteFetcher")
+	}
+	l.remote.baseURL = s.URL
+	b, err := l.remote.Fetch("owner/repo", "v1", "action.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "name: Test" || *count != 1 {
+		t.Fatalf("unexpected content %q or request count %d", b, *count)
+	}
+}
+
+func TestRemoteFetcherEnvironments(t *testing.T) {
+	count := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if r.Header.Get("Authorization") != "Bearer dummy" {
+			w.WriteHeader(401)
+			return
+		}
+		switch r.URL.Path {
+		case "/repos/owner/repo/environments":
+			// Two pages
+			if r.URL.Query().Get("page") == "1" {
+				w.Write([]byte(`{"total_count": 3, "environments": [{"name": "production"}, {"name": "staging"}]}`))
+			} else {
+				w.Write([]byte(`{"total_count": 3, "environments": [{"name": "dev"}]}`))
+			}
+		case "/repos/owner/empty/environments":
+			w.Write([]byte(`{"total_count": 0, "environments": []}`))
+		case "/repos/owner/private/environments":
+			w.WriteHeader(404)
+		case "/repos/owner/broken/environments":
+			w.WriteHeader(500)
+		default:
+			w.WriteHeader(400)
+		}
+	}))
+	defer s.Close()
+
+	f := NewRemoteFetcher(t.TempDir(), nil)
+	f.githubAPI = s.URL
+	f.token = ""
+
+	// Environments are not fetched without token
+	if _, ok, err := f.Environments("owner/repo"); ok || err != nil || count != 0 {
+		t.Fatalf("environments should not be fetched without token: %v %v %d", ok, err, count)
+	}
+
+	f.token = "dummy"
+	ns, ok, err := f.Environments("owner/repo")
+	if err != nil || !ok {
+		t.Fatalf("environments should be fetched: %v %v", ok, err)
+	}
+	if !cmp.Equal(ns, []string{"production", "staging", "dev"}) {
+		t.Fatalf("unexpected environments: %q", ns)
+	}
+	if count != 2 {
+		t.Fatalf("server should be called twice for two pages but called %d times", count)
+	}
+
+	// Results are cached in memory
+	if _, ok, _ := f.Environments("owner/repo"); !ok || count != 2 {
+		t.Fatalf("cached result should be used but server was called %d times", count)
+	}
+
+	if ns, ok, err := f.Environments("owner/empty"); !ok || err != nil || len(ns) != 0 {
+		t.Errorf("empty environments should be fetched: %q %v %v", ns, ok, err)
+	}
+	if _, ok, err := f.Environments("owner/private"); ok || err != nil {
+		t.Errorf("environments of private repository should not be available: %v %v", ok, err)
+	}
+	if _, _, err := f.Environments("owner/broken"); err == nil {
+		t.Error("error should be returned on unexpected response")
+	}
+
+	f.EnableOffline()
+	if _, ok, err := f.Environments("owner/other"); ok || err != nil {
+		t.Errorf("environments should not be fetched in offline mode: %v %v", ok, err)
+	}
+}
+
+func TestRemoteFetcherSecretsAndVariables(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/repos/owner/repo/actions/secrets":
+			w.Write([]byte(`{"total_count": 1, "secrets": [{"name": "REPO_SECRET"}]}`))
+		case "/repos/owner/repo/actions/organization-secrets":
+			w.Write([]byte(`{"total_count": 1, "secrets": [{"name": "ORG_SECRET"}]}`))
+		case "/repos/owner/repo/environments/prod%2Feu/secrets":
+			w.Write([]byte(`{"total_count": 1, "secrets": [{"name": "ENV_SECRET"}]}`))
+		case "/repos/owner/repo/actions/variables":
+			w.Write([]byte(`{"total_count": 1, "variables": [{"name": "REPO_VAR", "value": "foo"}]}`))
+		case "/repos/owner/repo/actions/organization-variables":
+			// Repository owned by user has no organization
+			w.WriteHeader(404)
+		case "/repos/owner/repo/environments/missing/variables":
+			w.WriteHeader(404)
+		default:
+			w.WriteHeader(400)
+		}
+	}))
+	defer s.Close()
+
+	f := NewRemoteFetcher(t.TempDir(), nil)
+	f.githubAPI = s.URL
+	f.token = "dummy"
+
+	ns, ok, err := f.Secrets("owner/repo", "")
+	if err != nil || !ok {
+		t.Fatalf("secrets should be fetched: %v %v", ok, err)
+	}
+	if !cmp.Equal(ns, []string{"REPO_SECRET", "ORG_SECRET"}) {
+		t.Fatalf("unexpected secrets: %q", ns)
+	}
+
+	ns, ok, err = f.Secrets("owner/repo", "prod/eu")
+	if err != nil || !ok {
+		t.Fatalf("secrets in environment should be fetched: %v %v", ok, err)
+	}
+	if !cmp.Equal(ns, []string{"REPO_SECRET", "ORG_SECRET", "ENV_SECRET"}) {
+		t.Fatalf("unexpected secrets in environment: %q", ns)
+	}
+
+	ns, ok, err = f.Variables("owner/repo", "")
+	if err != nil || !ok {
+		t.Fatalf("variables should be fetched even if organization variables are not found: %v %v", ok, err)
+	}
+	if !cmp.Equal(ns, []string{"REPO_VAR"}) {
+		t.Fatalf("unexpected variables: %q", ns)
+	}
+
+	if _, ok, err := f.Variables("owner/repo", "missing"); ok || err != nil {
+		t.Errorf("variables should not be available when environment is not found: %v %v", ok, err)
+	}
+	if _, _, err := f.Secrets("owner/broken", ""); err == nil {
+		t.Error("error should be returned on unexpected response")
+	}
+}
+
+func TestRemoteFetcherRunnerGroups(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orgs/org/actions/runner-groups":
+			if r.URL.Query().Get("visible_to_repository") != "repo" || r.URL.Query().Get("per_page") != "100" {
+				w.WriteHeader(400)
+				return
+			}
+			w.Write([]byte(`{"total_count": 2, "runner_groups": [{"id": 1, "name": "Default"}, {"id": 2, "name": "gpu-runners"}]}`))
+		case "/orgs/user/actions/runner-groups":
+			w.WriteHeader(404)
+		default:
+			w.WriteHeader(400)
+		}
+	}))
+	defer s.Close()
+
+	f := NewRemoteFetcher(t.TempDir(), nil)
+	f.githubAPI = s.URL
+	f.token = "dummy"
+
+	ns, ok, err := f.RunnerGroups("org/repo")
+	if err != nil || !ok {
+		t.Fatalf("runner groups should be fetched: %v %v", ok, err)
+	}
+	if !cmp.Equal(ns, []string{"Default", "gpu-runners"}) {
+		t.Fatalf("unexpected runner groups: %q", ns)
+	}
+	if _, ok, err := f.RunnerGroups("user/repo"); ok || err != nil {
+		t.Errorf("runner groups of repository owned by user should not be available: %v %v", ok, err)
+	}
+	if _, ok, err := f.RunnerGroups("invalid"); ok || err != nil {
+		t.Errorf("runner groups should not be fetched with invalid slug: %v %v", ok, err)
+	}
+}
+
+func TestRemoteFetcherAbortRequestsByCanceledContext(t *testing.T) {
+	s, aborted := testNewBlockingServer(t)
+
+	testCases := []struct {
+		what string
+		call func(ctx context.Context, f *RemoteFetcher) error
+	}{
+		{
+			what: "FetchContext",
+			call: func(ctx context.Context, f *RemoteFetcher) error {
+				b, err := f.FetchContext(ctx, "owner/repo", "v1", "action.yml")
+				if b != nil {
+					return fmt.Errorf("unexpected content %q", b)
+				}
+				return err
+			},
+		},
+		{
+			what: "OwnerExistsContext",
+			call: func(ctx context.Context, f *RemoteFetcher) error {
+				_, err := f.OwnerExistsContext(ctx, "@octocat")
+				return err
+			},
+		},
+		{
+			what: "EnvironmentsContext",
+			call: func(ctx context.Context, f *RemoteFetcher) error {
+				if _, ok, err := f.EnvironmentsContext(ctx, "owner/repo"); ok || err != nil {
+					return fmt.Errorf("environments should not be fetched: %v", err)
+				}
+				return nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			f := NewRemoteFetcher(t.TempDir(), nil)
+			f.SetHTTPClient(&http.Client{}) // No timeout
+			f.baseURL = s.URL
+			f.githubAPI = s.URL
+			f.token = "dummy-token"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			if err := tc.call(ctx, f); err != nil {
+				t.Fatal(err)
+			}
+			testWaitAborted(t, aborted)
+
+			// The aborted result is not remembered
+			if _, ok := f.names["/repos/owner/repo/environments"]; ok {
+				t.Fatal("result of aborted request was cached")
+			}
+		})
+	}
+}
+
+func TestLinterAbortFetchingRemoteActionByCanceledContext(t *testing.T) {
+	s, aborted := testNewBlockingServer(t)
+	l, err := NewLinter(io.Discard, &LinterOptions{RemoteActions: true, CacheDir: t.TempDir(), HTTPClient: &http.Client{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.remote.baseURL = s.URL
+	l.defaultConfig = &Config{}
+
+	src := []byte(`on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: owner/repo@v1
+`)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = l.LintContext(ctx, "test.yaml", src, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("deadline exceeded error should be returned but got %v", err)
+	}
+	testWaitAborted(t, aborted)
+}
@@ -0,0 +1,77 @@
+package actionlint
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// reRemoteURLSlug matches to the "owner/repo" part at the end of Git remote URLs such as
+// "https://github.com/owner/repo.git", "git@github.com:owner/repo.git", and
+// "ssh://git@github.com/owner/repo".
+var reRemoteURLSlug = regexp.MustCompile(`[:/]([^/:]+/[^/]+?)(?:\.git)?/?$`)
+
+// gitConfigPath returns the path to the config file of the Git repository at the root. It follows
+// ".git" file of worktrees and submodules like "gitdir: /path/to/.git/worktrees/foo".
+func gitConfigPath(root string, fsys fileSystem) string {
+	d := filepath.Join(root, ".git")
+	s, err := fsys.Stat(d)
+	if err != nil {
+		return ""
+	}
+	if !s.IsDir() {
+		b, err := fsys.ReadFile(d)
+		if err != nil {
+			return ""
+		}
+		g := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(string(b)), "gitdir:"))
+		if !filepath.IsAbs(g) {
+			g = filepath.Join(root, g)
+		}
+		d = g
+		// Worktrees share the config file of the main repository
+		if b, err := fsys.ReadFile(filepath.Join(d, "commondir")); err == nil {
+			c := strings.TrimSpace(string(b))
+			if !filepath.IsAbs(c) {
+				c = filepath.Join(d, c)
+			}
+			d = c
+		}
+	}
+	return filepath.Join(d, "config")
+}
+
+// originURL returns the URL of "origin" remote in the Git config file.
+func originURL(config []byte) string {
+	inOrigin := false
+	for _, l := range strings.Split(string(config), "\n") {
+		l = strings.TrimSpace(l)
+		if strings.HasPrefix(l, "[") {
+			inOrigin = l == `[remote "origin"]`
+			continue
+		}
+		if !inOrigin {
+			continue
+		}
+		if k, v, ok := strings.Cut(l, "="); ok && strings.TrimSpace(k) == "url" {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
+// repositorySlug returns the "owner/repo" of the repository at the root directory. It is detected
+// from the URL of "origin" remote of the Git repository. When it cannot be detected, the value of
+// $GITHUB_REPOSITORY environment variable set on GitHub Actions is returned. It returns an empty
+// string when the slug is not found.
+func repositorySlug(root string, fsys fileSystem) string {
+	if p := gitConfigPath(root, fsys); p != "" {
+		if b, err := fsys.ReadFile(p); err == nil {
+			if m := reRemoteURLSlug.FindStringSubmatch(originURL(b)); m != nil {
+				return m[1]
+			}
+		}
+	}
+	return os.Getenv("GITHUB_REPOSITORY")
+}
@@ -0,0 +1,60 @@
+package actionlint
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestRepositorySlugFromOriginURL(t *testing.T) {
+	testCases := []struct {
+		url  string
+		want string
+	}{
+		{"https://github.com/owner/repo.git", "owner/repo"},
+		{"https://github.com/owner/repo", "owner/repo"},
+		{"https://github.com/owner/repo/", "owner/repo"},
+		{"git@github.com:owner/repo.git", "owner/repo"},
+		{"ssh://git@github.com/owner/repo.git", "owner/repo"},
+		{"https://ghe.example.com/owner/my.repo.git", "owner/my.repo"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			t.Setenv("GITHUB_REPOSITORY", "")
+			cfg := "[core]\n\tbare = false\n[remote \"upstream\"]\n\turl = https://github.com/other/repo.git\n[remote \"origin\"]\n\turl = " + tc.url + "\n\tfetch = +refs/heads/*:refs/remotes/origin/*\n"
+			fsys := newFileSystem(fstest.MapFS{
+				"repo/.git/config": &fstest.MapFile{Data: []byte(cfg)},
+			})
+			if have := repositorySlug("/repo", fsys); have != tc.want {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestRepositorySlugInWorktree(t *testing.T) {
+	t.Setenv("GITHUB_REPOSITORY", "")
+	fsys := newFileSystem(fstest.MapFS{
+		"main/.git/config":                 &fstest.MapFile{Data: []byte("[remote \"origin\"]\n\turl = git@github.com:owner/repo.git\n")},
+		"main/.git/worktrees/wt/commondir": &fstest.MapFile{Data: []byte("../..\n")},
+		"wt/.git":                          &fstest.MapFile{Data: []byte("gitdir: /main/.git/worktrees/wt\n")},
+	})
+	if have := repositorySlug("/wt", fsys); have != "owner/repo" {
+		t.Fatalf("wanted %q but got %q", "owner/repo", have)
+	}
+}
+
+func TestRepositorySlugFromEnv(t *testing.T) {
+	t.Setenv("GITHUB_REPOSITORY", "owner/from-env")
+	fsys := newFileSystem(fstest.MapFS{
+		"repo/.git/config": &fstest.MapFile{Data: []byte("[core]\n\tbare = false\n")},
+	})
+	if have := repositorySlug("/repo", fsys); have != "owner/from-env" {
+		t.Fatalf("wanted %q but got %q", "owner/from-env", have)
+	}
+
+	t.Setenv("GITHUB_REPOSITORY", "")
+	if have := repositorySlug("/repo", fsys); have != "" {
+		t.Fatalf("slug should not be detected but got %q", have)
+	}
+}
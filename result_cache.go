@@ -0,0 +1,238 @@
+package actionlint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// cachedRule is a name and a description of the rule which checked the cached file. They are
+// necessary to register the rule to the error formatter without running the rule.
+type cachedRule struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// cachedResult is an entry of the result cache.
+type cachedResult struct {
+	Errors []*Error      `json:"errors"`
+	Rules  []*cachedRule `json:"rules"`
+}
+
+// resultCache is an on-disk cache of lint results of workflow files. Each entry is keyed by the
+// hash of the file path, the file content, the configuration, the options of linter, and the
+// version of actionlint so that the cache is invalidated when any of them is changed. Files referred
+// by the workflow such as local actions and reusable workflows are not part of the key. Results of
+// such workflows are not cached. See resultCacheable.
+type resultCache struct {
+	dir  string
+	base []byte
+	dbg  io.Writer
+}
+
+// newResultCache creates a new resultCache instance. The 'cacheDir' parameter is a directory path
+// to put the cache. When it is empty, the default cache directory "actionlint" in os.UserCacheDir()
+// is used. The 'base' parameter is hashed into all keys. It returns nil when no cache directory is
+// available.
+func newResultCache(cacheDir string, base []byte, dbg io.Writer) *resultCache {
+	if cacheDir == "" {
+		d, err := os.UserCacheDir()
+		if err != nil {
+			return nil
+		}
+		cacheDir = filepath.Join(d, "actionlint")
+	}
+	return &resultCache{
+		dir:  filepath.Join(cacheDir, "results"),
+		base: base,
+		dbg:  dbg,
+	}
+}
+
+func (c *resultCache) debug(format string, args ...interface{}) {
+	if c.dbg == nil {
+		return
+	}
+	format = "[ResultCache] " + format + "\n"
+	fmt.Fprintf(c.dbg, format, args...)
+}
+
+// key returns the cache key of the file. It returns an empty string when the key cannot be
+// calculated.
+func (c *resultCache) key(path string, content []byte, cfg *Config) string {
+	h := sha256.New()
+	h.Write(c.base)
+	h.Write([]byte{0})
+	h.Write([]byte(filepath.ToSlash(path)))
+	h.Write([]byte{0})
+	if cfg != nil {
+		b, err := yaml.Marshal(cfg)
+		if err != nil {
+			c.debug("Could not encode config for %s: %s", path, err)
+			return ""
+		}
+		h.Write(b)
+	}
+	h.Write([]byte{0})
+	h.Write(content)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *resultCache) path(key string) string {
+	return filepath.Join(c.dir, key[:2], key+".json")
+}
+
+// get returns the cached result of the key. The second return value is false when the result is
+// not cached.
+func (c *resultCache) get(key string) (*cachedResult, bool) {
+	b, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var r cachedResult
+	if err := json.Unmarshal(b, &r); err != nil {
+		c.debug("Broken cache entry %s: %s", key, err)
+		return nil, false
+	}
+	return &r, true
+}
+
+// put stores the result to the cache. Failing to write the cache is not an error since the result
+// can be calculated again on the next run.
+func (c *resultCache) put(key string, errs []*Error, rules []Rule) {
+	r := &cachedResult{
+		Errors: errs,
+		Rules:  make([]*cachedRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		r.Rules = append(r.Rules, &cachedRule{rule.Name(), rule.Description()})
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		c.debug("Could not encode result for %s: %s", key, err)
+		return
+	}
+
+	p := c.path(key)
+	d := filepath.Dir(p)
+	if err := os.MkdirAll(d, 0755); err != nil {
+		c.debug("Could not create cache directory %s: %s", d, err)
+		return
+	}
+	// Write to a temporary file and rename it so that other processes never read a partial entry
+	f, err := os.CreateTemp(d, key+".*.tmp")
+	if err != nil {
+		c.debug("Could not create cache file for %s: %s", key, err)
+		return
+	}
+	_, err = f.Write(b)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), p)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		c.debug("Could not write cache file %s: %s", p, err)
+		return
+	}
+	c.debug("Cached %d errors at %s", len(errs), p)
+}
+
+// resultCacheVersion returns the version of actionlint which is a part of the cache key. The
+// version of development builds such as "(devel)" is not changed on rebuilding actionlint, so the
+// digest of the executable is used instead. It returns an empty string when the version cannot be
+// determined.
+func resultCacheVersion() string {
+	v := getCommandVersion()
+	if v != "" && v != "(devel)" && v != "unknown" && !strings.HasSuffix(v, "+dirty") {
+		return v
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return ""
+	}
+	f, err := os.Open(exe)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return ""
+	}
+	return "build-" + hex.EncodeToString(h.Sum(nil))
+}
+
+// resultCacheBase returns the part of the cache key which is common to all files. It consists of
+// the version of actionlint and the options which affect lint results.
+func resultCacheBase(version string, opts *LinterOptions) []byte {
+	parts := []string{
+		version,
+		opts.Shellcheck,
+		opts.ShellcheckArgs,
+		opts.Pyflakes,
+		opts.PSScriptAnalyzer,
+		strings.Join(opts.IgnorePatterns, "\n"),
+		opts.ConfigFile,
+	}
+	return []byte(strings.Join(parts, "\x00"))
+}
+
+// resultCacheable returns true when the lint result of the workflow depends only on the workflow
+// file and the configuration. Results which depend on other state are not cached since the cache
+// key does not cover it. They are local actions, local reusable workflows, paths checked by
+// "verify-paths" and "verify-hash-files", schema files in the configuration, properties files and
+// icons of workflow templates, executables of plugins and custom script checkers, and popular
+// actions registered at runtime.
+func resultCacheable(path string, w *Workflow, cfg *Config) bool {
+	if isWorkflowTemplateFile(path) || isWorkflowTemplatePropertiesFile(path) {
+		return false
+	}
+	if popularActionsChanged() {
+		return false
+	}
+	if cfg != nil {
+		if cfg.VerifyPaths || cfg.VerifyHashFiles || len(cfg.FromJSONSchemas) > 0 {
+			return false
+		}
+		if cfg.RepositoryDispatch != nil && cfg.RepositoryDispatch.ClientPayloadSchema != "" {
+			return false
+		}
+		if len(cfg.Plugins) > 0 || len(cfg.CustomShells) > 0 {
+			return false
+		}
+		if cfg.PythonChecker != nil && cfg.PythonChecker.Executable != "" {
+			return false
+		}
+		for _, a := range cfg.Actions {
+			if a != nil && a.File != "" {
+				return false
+			}
+		}
+	}
+
+	if w == nil {
+		return true
+	}
+	for _, j := range w.Jobs {
+		if c := j.WorkflowCall; c != nil && c.Uses != nil && strings.HasPrefix(c.Uses.Value, "./") {
+			return false
+		}
+		for _, s := range j.Steps {
+			if e, ok := s.Exec.(*ExecAction); ok && e.Uses != nil && strings.HasPrefix(e.Uses.Value, "./") {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,251 @@
+package actionlint
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResultCacheReuseResults(t *testing.T) {
+	dir := t.TempDir()
+	src := []byte(`on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ unknown }}
+`)
+
+	lint := func(src []byte) []*Error {
+		l, err := NewLinter(io.Discard, &LinterOptions{CacheResults: true, CacheDir: dir})
+		if err != nil {
+			t.Fatal(err)
+		}
+		l.defaultConfig = &Config{}
+		errs, err := l.Lint("test.yaml", src, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return errs
+	}
+
+	want := lint(src)
+	if len(want) == 0 {
+		t.Fatal("no error was found")
+	}
+
+	es, err := filepath.Glob(filepath.Join(dir, "results", "*", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(es) != 1 {
+		t.Fatalf("one cache entry should be created but got %v", es)
+	}
+
+	have := lint(src)
+	if !cmp.Equal(want, have) {
+		t.Fatal(cmp.Diff(want, have))
+	}
+
+	// Rewrite the entry to check the cached result is actually used
+	if err := os.WriteFile(es[0], []byte(`{"errors":[{"Message":"cached","Line":1,"Column":1,"Kind":"test"}],"rules":[]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	errs := lint(src)
+	if len(errs) != 1 || errs[0].Message != "cached" || errs[0].Filepath != "test.yaml" {
+		t.Fatalf("cached result was not used: %v", errs)
+	}
+
+	// Changing the content invalidates the cache
+	errs = lint(append(src, []byte("      - run: echo ${{ unknown2 }}\n")...))
+	if len(errs) != 2 {
+		t.Fatalf("wanted 2 errors but got %v", errs)
+	}
+}
+
+func TestResultCacheKey(t *testing.T) {
+	c := newResultCache(t.TempDir(), []byte("base"), nil)
+	src := []byte("on: push")
+
+	k := c.key("test.yaml", src, nil)
+	if k == "" {
+		t.Fatal("key is empty")
+	}
+	if k != c.key("test.yaml", src, nil) {
+		t.Fatal("key is not stable")
+	}
+
+	cfg := &Config{}
+	cfg.SelfHostedRunner.Labels = []string{"foo"}
+	others := []string{
+		c.key("other.yaml", src, nil),
+		c.key("test.yaml", []byte("on: pull_request"), nil),
+		c.key("test.yaml", src, cfg),
+		newResultCache(t.TempDir(), []byte("other"), nil).key("test.yaml", src, nil),
+	}
+	for i, o := range others {
+		if o == k {
+			t.Errorf("key #%d should be different from %q", i, k)
+		}
+	}
+}
+
+func TestResultCacheNotEnabledWithCustomRules(t *testing.T) {
+	o := &LinterOptions{CacheResults: true, CacheDir: t.TempDir()}
+	o.AddRule(func() Rule { return &RuleBase{} })
+	l, err := NewLinter(io.Discard, o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.results != nil {
+		t.Fatal("result cache should be disabled with custom rules")
+	}
+}
+
+func TestResultCacheNotReusedAfterEditingLocalAction(t *testing.T) {
+	root := t.TempDir()
+	cache := t.TempDir()
+	for _, d := range []string{filepath.Join(root, ".github", "workflows"), filepath.Join(root, "action")} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wf := filepath.Join(root, ".github", "workflows", "test.yaml")
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: ./action
+        with:
+          name: foo
+`
+	if err := os.WriteFile(wf, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	action := filepath.Join(root, "action", "action.yml")
+	writeAction := func(input string) {
+		src := "name: My action\ndescription: my action\ninputs:\n  " + input + ":\n    description: test\nruns:\n  using: composite\n  steps:\n    - run: echo\n      shell: bash\n"
+		if err := os.WriteFile(action, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	lint := func() []*Error {
+		l, err := NewLinter(io.Discard, &LinterOptions{CacheResults: true, CacheDir: cache})
+		if err != nil {
+			t.Fatal(err)
+		}
+		l.defaultConfig = &Config{}
+		errs, err := l.LintFile(wf, &Project{root: root})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return errs
+	}
+
+	writeAction("name")
+	if errs := lint(); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	writeAction("message")
+	errs := lint()
+	if len(errs) != 1 || !strings.Contains(errs[0].Message, `input "name" is not defined in action "My action"`) {
+		t.Fatalf("error for the edited local action should be reported but got %v", errs)
+	}
+}
+
+func TestResultCacheable(t *testing.T) {
+	local := &Workflow{
+		Jobs: map[string]*Job{
+			"test": {
+				Steps: []*Step{
+					{Exec: &ExecAction{Uses: &String{Value: "./action"}}},
+				},
+			},
+		},
+	}
+	reusable := &Workflow{
+		Jobs: map[string]*Job{
+			"test": {WorkflowCall: &WorkflowCall{Uses: &String{Value: "./.github/workflows/reusable.yaml"}}},
+		},
+	}
+	remote := &Workflow{
+		Jobs: map[string]*Job{
+			"test": {
+				Steps: []*Step{
+					{Exec: &ExecAction{Uses: &String{Value: "actions/checkout@v4"}}},
+					{Exec: &ExecRun{Run: &String{Value: "echo hello"}}},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		what string
+		path string
+		w    *Workflow
+		cfg  *Config
+		want bool
+	}{
+		{"no workflow", "test.yaml", nil, nil, true},
+		{"remote action", "test.yaml", remote, nil, true},
+		{"remote action with empty config", "test.yaml", remote, &Config{}, true},
+		{"local action", "test.yaml", local, nil, false},
+		{"local reusable workflow", "test.yaml", reusable, nil, false},
+		{"verify-paths", "test.yaml", remote, &Config{VerifyPaths: true}, false},
+		{"verify-hash-files", "test.yaml", remote, &Config{VerifyHashFiles: true}, false},
+		{"from-json-schemas", "test.yaml", remote, &Config{FromJSONSchemas: map[string]string{"vars.FOO": "foo.json"}}, false},
+		{"file of private action", "test.yaml", remote, &Config{Actions: map[string]*ActionSchemaConfig{"myorg/foo": {File: "foo.yml"}}}, false},
+		{"inline private action", "test.yaml", remote, &Config{Actions: map[string]*ActionSchemaConfig{"myorg/foo": {}}}, true},
+		{"client-payload-schema", "test.yaml", remote, &Config{RepositoryDispatch: &RepositoryDispatchConfig{ClientPayloadSchema: "foo.json"}}, false},
+		{"workflow template", filepath.Join("workflow-templates", "ci.yml"), remote, nil, false},
+		{"properties of workflow template", filepath.Join("workflow-templates", "ci.properties.json"), nil, nil, false},
+		{"plugin", "test.yaml", remote, &Config{Plugins: []*PluginConfig{{Name: "my-rule", Command: []string{"my-rule"}}}}, false},
+		{"custom shell", "test.yaml", remote, &Config{CustomShells: []*CustomShellConfig{{Shell: "deno run {0}", Checker: []string{"deno", "check"}}}}, false},
+		{"executable of python checker", "test.yaml", remote, &Config{PythonChecker: &PythonCheckerConfig{Executable: "/opt/ruff"}}, false},
+		{"name of python checker", "test.yaml", remote, &Config{PythonChecker: &PythonCheckerConfig{Name: "ruff"}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			if have := resultCacheable(tc.path, tc.w, tc.cfg); have != tc.want {
+				t.Fatalf("wanted %v but got %v", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestResultCacheableWithRegisteredPopularAction(t *testing.T) {
+	const spec = "my-org/my-action@v1"
+	defer func() {
+		popularActionsMu.Lock()
+		delete(PopularActions, spec)
+		popularActionsModified = false
+		popularActionsMu.Unlock()
+	}()
+
+	if !resultCacheable("test.yaml", nil, nil) {
+		t.Fatal("result should be cacheable before registering action")
+	}
+	RegisterPopularAction(spec, &ActionMetadata{Name: "My action"})
+	if resultCacheable("test.yaml", nil, nil) {
+		t.Fatal("result should not be cacheable after registering action")
+	}
+}
+
+func TestResultCacheVersionOfDevelopmentBuild(t *testing.T) {
+	// Test binaries are not released versions so the digest of the executable is used
+	v := resultCacheVersion()
+	if !strings.HasPrefix(v, "build-") {
+		t.Fatalf("version of development build should be digest of the executable but got %q", v)
+	}
+	if v != resultCacheVersion() {
+		t.Fatal("version is not stable")
+	}
+}
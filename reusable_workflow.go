@@ -1,9 +1,9 @@
 package actionlint
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"os"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -167,11 +167,15 @@ type ReusableWorkflowMetadata struct {
 // indicated by 'proj' field. One LocalReusableWorkflowCache instance needs to be created per one
 // project.
 type LocalReusableWorkflowCache struct {
-	mu    sync.RWMutex
-	proj  *Project // maybe nil
-	cache map[string]*ReusableWorkflowMetadata
-	cwd   string
-	dbg   io.Writer
+	mu     sync.RWMutex
+	proj   *Project // maybe nil
+	cache  map[string]*ReusableWorkflowMetadata
+	cwd    string
+	dbg    io.Writer
+	remote *RemoteFetcher // maybe nil
+	// ctx is the context of fetching remote reusable workflows. Canceling it aborts the requests in
+	// flight. context.Background() is used when this value is nil.
+	ctx context.Context
 }
 
 func (c *LocalReusableWorkflowCache) debug(format string, args ...interface{}) {
@@ -197,6 +201,9 @@ func (c *LocalReusableWorkflowCache) writeCache(key string, val *ReusableWorkflo
 
 // FindMetadata finds/parses a reusable workflow metadata located by the 'spec' argument. When project
 // is not set to 'proj' field or the spec does not start with "./", this method immediately returns with nil.
+// As an exception, when fetching remote reusable workflows is enabled by EnableRemote method, the spec
+// "owner/repo/path/to/workflow.yml@ref" is also resolved by fetching the workflow file from the remote
+// repository.
 //
 // Note that an error is not cached. At first search, let's say this method returned an error since
 // the reusable workflow is invalid. In this case, calling this method with the same spec later will
@@ -205,6 +212,10 @@ func (c *LocalReusableWorkflowCache) writeCache(key string, val *ReusableWorkflo
 //
 // Calling this method is thread-safe.
 func (c *LocalReusableWorkflowCache) FindMetadata(spec string) (*ReusableWorkflowMetadata, error) {
+	if c.remote != nil && isWorkflowCallUsesRepoFormat(spec) && !ContainsExpression(spec) {
+		return c.findRemoteMetadata(spec)
+	}
+
 	if c.proj == nil || !strings.HasPrefix(spec, "./") || ContainsExpression(spec) {
 		return nil, nil
 	}
@@ -215,7 +226,7 @@ func (c *LocalReusableWorkflowCache) FindMetadata(spec string) (*ReusableWorkflo
 	}
 
 	file := filepath.Join(c.proj.RootDir(), filepath.FromSlash(spec))
-	src, err := os.ReadFile(file)
+	src, err := c.proj.fileSystem().ReadFile(file)
 	if err != nil {
 		c.writeCache(spec, nil) // Remember the workflow file was not found
 		return nil, fmt.Errorf("could not read reusable workflow file for %q: %w", spec, err)
@@ -233,6 +244,47 @@ func (c *LocalReusableWorkflowCache) FindMetadata(spec string) (*ReusableWorkflo
 	return m, nil
 }
 
+func (c *LocalReusableWorkflowCache) findRemoteMetadata(spec string) (*ReusableWorkflowMetadata, error) {
+	if m, ok := c.readCache(spec); ok {
+		c.debug("Cache hit for %s: %v", spec, m)
+		return m, nil
+	}
+
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	slug, path, ref := splitWorkflowCallUsesRepoFormat(spec)
+	src, err := c.remote.FetchContext(ctx, slug, ref, path)
+	if err != nil {
+		c.writeCache(spec, nil)
+		return nil, fmt.Errorf("could not fetch reusable workflow %q: %w", spec, err)
+	}
+	if src == nil {
+		c.debug("Remote reusable workflow %s was not found", spec)
+		c.writeCache(spec, nil)
+		return nil, nil
+	}
+
+	m, err := parseReusableWorkflowMetadata(src)
+	if err != nil {
+		c.writeCache(spec, nil) // Remember the workflow file was invalid
+		msg := strings.ReplaceAll(err.Error(), "\n", " ")
+		return nil, fmt.Errorf("error while parsing reusable workflow %q: %s", spec, msg)
+	}
+
+	c.debug("New remote reusable workflow metadata for %s: %v", spec, m)
+	c.writeCache(spec, m)
+	return m, nil
+}
+
+// EnableRemote enables fetching reusable workflows in remote repositories with the given fetcher.
+// Fetched workflows are validated in the same way as local reusable workflows. Setting nil disables
+// fetching remote reusable workflows.
+func (c *LocalReusableWorkflowCache) EnableRemote(f *RemoteFetcher) {
+	c.remote = f
+}
+
 func (c *LocalReusableWorkflowCache) convWorkflowPathToSpec(p string) (string, bool) {
 	if c.proj == nil {
 		return "", false
@@ -384,31 +436,58 @@ func newNullLocalReusableWorkflowCache(dbg io.Writer) *LocalReusableWorkflowCach
 	return &LocalReusableWorkflowCache{dbg: dbg}
 }
 
+func newRemoteOnlyLocalReusableWorkflowCache(remote *RemoteFetcher, dbg io.Writer) *LocalReusableWorkflowCache {
+	// Cache for remote reusable workflows. It is used when project is not found
+	return &LocalReusableWorkflowCache{
+		cache:  map[string]*ReusableWorkflowMetadata{},
+		dbg:    dbg,
+		remote: remote,
+	}
+}
+
 // LocalReusableWorkflowCacheFactory is a factory object to create a LocalReusableWorkflowCache
 // instance per project.
 type LocalReusableWorkflowCacheFactory struct {
 	caches map[string]*LocalReusableWorkflowCache
 	cwd    string
 	dbg    io.Writer
+	remote *RemoteFetcher
+	// Cache for workflows which do not belong to any project. Remote reusable workflows fetched
+	// while checking them are shared
+	remoteOnly *LocalReusableWorkflowCache
 }
 
 // NewLocalReusableWorkflowCacheFactory creates a new LocalReusableWorkflowCacheFactory instance.
 func NewLocalReusableWorkflowCacheFactory(cwd string, dbg io.Writer) *LocalReusableWorkflowCacheFactory {
-	return &LocalReusableWorkflowCacheFactory{map[string]*LocalReusableWorkflowCache{}, cwd, dbg}
+	return &LocalReusableWorkflowCacheFactory{map[string]*LocalReusableWorkflowCache{}, cwd, dbg, nil, nil}
+}
+
+// EnableRemote enables fetching reusable workflows in remote repositories for all caches created by
+// this factory. See LocalReusableWorkflowCache.EnableRemote for more details.
+func (f *LocalReusableWorkflowCacheFactory) EnableRemote(r *RemoteFetcher) {
+	f.remote = r
 }
 
 // GetCache returns a new or existing LocalReusableWorkflowCache instance per project. When a instance
 // was already created for the project, this method returns the existing instance. Otherwise it creates
-// a new instance and returns it.
+// a new instance and returns it. When the project is nil and fetching remote reusable workflows is
+// enabled, one cache instance is shared by all workflows outside projects.
 func (f *LocalReusableWorkflowCacheFactory) GetCache(p *Project) *LocalReusableWorkflowCache {
 	if p == nil {
-		return newNullLocalReusableWorkflowCache(f.dbg)
+		if f.remote == nil {
+			return newNullLocalReusableWorkflowCache(f.dbg)
+		}
+		if f.remoteOnly == nil {
+			f.remoteOnly = newRemoteOnlyLocalReusableWorkflowCache(f.remote, f.dbg)
+		}
+		return f.remoteOnly
 	}
 	r := p.RootDir()
 	if c, ok := f.caches[r]; ok {
 		return c
 	}
 	c := NewLocalReusableWorkflowCache(p, f.cwd, f.dbg)
+	c.remote = f.remote
 	f.caches[r] = c
 	return c
 }
@@ -53,6 +53,25 @@ func (r *RuleBase) Errorf(pos *Pos, format string, args ...interface{}) {
 	r.errs = append(r.errs, err)
 }
 
+// ErrorfWithSeverity reports a new error like Errorf with the severity like "warning". The severity
+// is set to Severity field of the error.
+func (r *RuleBase) ErrorfWithSeverity(pos *Pos, severity string, format string, args ...interface{}) {
+	err := errorfAt(pos, r.name, format, args...)
+	err.Severity = severity
+	r.errs = append(r.errs, err)
+}
+
+// errorfWithSuggestions reports a new error like Errorf. When some names in candidates are similar
+// to the wrong name, they are suggested in the error message and stored in the error.
+func (r *RuleBase) errorfWithSuggestions(pos *Pos, name string, candidates []string, format string, args ...interface{}) {
+	err := errorfAt(pos, r.name, format, args...)
+	if s := similarNames(name, candidates); len(s) > 0 {
+		err.Message += ". " + didYouMean(s)
+		err.Suggestions = s
+	}
+	r.errs = append(r.errs, err)
+}
+
 // Debug prints debug log to the output. The output is specified by the argument of EnableDebug method.
 // By default, no output is set so debug log is not printed.
 func (r *RuleBase) Debug(format string, args ...interface{}) {
@@ -96,13 +115,46 @@ func (r *RuleBase) Config() *Config {
 	return r.config
 }
 
-// Rule is an interface which all rule structs must meet.
+// Rule is an interface which all rule structs must meet. Embedding RuleBase struct implements all
+// methods of this interface. Override the Visit* methods of Pass interface to implement your own
+// checks. Your rules can be added to Linter with LinterOptions.AddRule.
 type Rule interface {
 	Pass
+	// Errs returns errors found by the rule. Errors are reported by RuleBase.Error,
+	// RuleBase.Errorf, and RuleBase.ErrorfWithSeverity methods.
 	Errs() []*Error
+	// Name returns the name of the rule. It is used as the kind of errors like "[expression]".
 	Name() string
+	// Description returns the description of the rule.
 	Description() string
+	// EnableDebug enables debug output from the rule. Linter calls this method when debug output is
+	// enabled.
 	EnableDebug(out io.Writer)
+	// SetConfig populates user configuration of actionlint to the rule. Linter calls this method
+	// before visiting a syntax tree when a configuration file is found.
 	SetConfig(cfg *Config)
+	// Config returns the user configuration set by SetConfig. It returns nil when no configuration
+	// was set.
 	Config() *Config
 }
+
+// ConcurrentRule is an interface for your own rules which can be run concurrently with other rules.
+// Rules added by LinterOptions.AddRule or LinterOptions.OnRulesCreated are run sequentially in the
+// same goroutine unless they implement this interface.
+type ConcurrentRule interface {
+	Rule
+	// Concurrent returns true when the rule can be run concurrently with other rules. It must not
+	// share mutable states with other rules without synchronization.
+	Concurrent() bool
+}
+
+// containsRule returns whether the rule is in the rules. Rules are compared in a loop instead of a
+// map since rules created by users may not be hashable.
+func containsRule(rules []Rule, rule Rule) bool {
+	for _, r := range rules {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
@@ -1,9 +1,9 @@
 package actionlint
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -23,6 +23,25 @@ var BrandingColors = map[string]struct{}{
 	"gray-dark": {},
 }
 
+// unsupportedFeatherIcons is a set of Feather icon names which are not allowed at branding.icon
+// in action.yaml.
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#exclusions
+var unsupportedFeatherIcons = map[string]struct{}{
+	"coffee":        {},
+	"columns":       {},
+	"divide-circle": {},
+	"divide-square": {},
+	"divide":        {},
+	"frown":         {},
+	"hexagon":       {},
+	"key":           {},
+	"meh":           {},
+	"mouse-pointer": {},
+	"smile":         {},
+	"tool":          {},
+	"x-octagon":     {},
+}
+
 // BrandingIcons is a set of icon names allowed at branding.icon in action.yaml.
 // https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#brandingicon
 var BrandingIcons = map[string]struct{}{
@@ -289,6 +308,15 @@ var BrandingIcons = map[string]struct{}{
 type RuleAction struct {
 	RuleBase
 	cache *LocalActionsCache
+	// privateActions is metadata of private actions declared at "actions" in config file.
+	privateActions privateActions
+	// filesMayBeCreated is true when some previous step in the job may create files in the
+	// workspace. For example, the step running a script may clone a repository containing actions.
+	filesMayBeCreated bool
+	// registry checks existence of images of Docker actions. It is nil when the check is disabled.
+	registry *dockerRegistry
+	// ctx is the context of requests to the registry. Canceling it aborts the requests.
+	ctx context.Context
 }
 
 // NewRuleAction creates new RuleAction instance.
@@ -302,8 +330,32 @@ func NewRuleAction(cache *LocalActionsCache) *RuleAction {
 	}
 }
 
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleAction) VisitJobPre(n *Job) error {
+	rule.filesMayBeCreated = false
+	return nil
+}
+
+// stepMayCreateFiles returns true when the step may create some files in the workspace. Checking
+// out the repository itself with actions/checkout does not create files other than the repository.
+func stepMayCreateFiles(s *Step) bool {
+	e, ok := s.Exec.(*ExecAction)
+	if !ok {
+		return true // The step runs a script
+	}
+	if e.Uses == nil || !strings.HasPrefix(strings.ToLower(e.Uses.Value), "actions/checkout@") {
+		return true
+	}
+	_, path := e.Inputs["path"]
+	_, repo := e.Inputs["repository"]
+	return path || repo
+}
+
 // VisitStep is callback when visiting Step node.
 func (rule *RuleAction) VisitStep(n *Step) error {
+	created := rule.filesMayBeCreated
+	rule.filesMayBeCreated = created || stepMayCreateFiles(n)
+
 	e, ok := n.Exec.(*ExecAction)
 	if !ok || e.Uses == nil {
 		return nil
@@ -318,7 +370,7 @@ func (rule *RuleAction) VisitStep(n *Step) error {
 
 	if strings.HasPrefix(spec, "./") {
 		// Relative to repository root
-		rule.checkLocalAction(spec, e)
+		rule.checkLocalAction(spec, e, created)
 		return nil
 	}
 
@@ -360,13 +412,24 @@ func (rule *RuleAction) checkRepoAction(spec string, exec *ExecAction) {
 		rule.invalidActionFormat(exec.Uses.Pos, spec, "owner and repo and ref should not be empty")
 	}
 
-	meta, ok := PopularActions[spec]
+	if meta := rule.privateActions.find(spec); meta != nil {
+		if meta.SkipInputs {
+			rule.Debug("This action skips to check inputs: %s", spec)
+			return
+		}
+		rule.checkAction(meta, exec, func(m *ActionMetadata) string {
+			return fmt.Sprintf("%q declared at \"actions\" in config", spec)
+		})
+		return
+	}
+
+	meta, ok := FindPopularAction(spec)
 	if !ok {
-		if _, ok := OutdatedPopularActionSpecs[spec]; ok {
+		if IsOutdatedPopularAction(spec) {
 			rule.Errorf(exec.Uses.Pos, "the runner of %q action is too old to run on GitHub Actions. update the action's version to fix this issue", spec)
 			return
 		}
-		rule.Debug("This action is not found in popular actions data set: %s", spec)
+		rule.checkRemoteAction(spec, exec)
 		return
 	}
 	if meta.SkipInputs {
@@ -379,6 +442,68 @@ func (rule *RuleAction) checkRepoAction(spec string, exec *ExecAction) {
 	})
 }
 
+// checkRemoteAction checks inputs of the action which is not in the popular actions data set with
+// its metadata fetched from the remote repository. It does nothing unless fetching remote actions
+// is enabled.
+func (rule *RuleAction) checkRemoteAction(spec string, exec *ExecAction) {
+	meta, _, err := rule.cache.FindMetadata(spec)
+	if err != nil {
+		rule.Error(exec.Uses.Pos, err.Error())
+		return
+	}
+	if meta == nil {
+		rule.Debug("This action is not found in popular actions data set: %s", spec)
+		return
+	}
+
+	rule.checkAction(meta, exec, func(m *ActionMetadata) string {
+		return fmt.Sprintf("%q defined at %q", m.Name, spec)
+	})
+}
+
+// actionBooleanInputValues is a set of values accepted by boolean inputs. Actions usually parse
+// boolean inputs with getBooleanInput() of @actions/core and it throws an error for other values.
+// https://github.com/actions/toolkit/blob/main/packages/core/src/core.ts
+var actionBooleanInputValues = []string{"true", "True", "TRUE", "false", "False", "FALSE"}
+
+// checkInputValue checks the value given to the input at "with:" matches the type of the input.
+// Since the official action metadata syntax does not have types of inputs, only inputs whose types
+// are declared with "type" key in local action metadata are checked. Types are never guessed from
+// default values because inputs such as "submodules" of actions/checkout accept values other than
+// booleans even if their default values are "false".
+func (rule *RuleAction) checkInputValue(meta *ActionMetadataInput, input *Input, action string) {
+	if meta.Type == "" || input.Value == nil || input.Value.ContainsExpression() {
+		return
+	}
+	v := strings.TrimSpace(input.Value.Value)
+	if v == "" {
+		return
+	}
+
+	switch meta.Type {
+	case "boolean":
+		if !contains(actionBooleanInputValues, v) {
+			rule.Errorf(
+				input.Value.Pos,
+				"input %q of action %s is a boolean input but the value %q is not a boolean. available values are \"true\" and \"false\"",
+				input.Name.Value,
+				action,
+				v,
+			)
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			rule.Errorf(
+				input.Value.Pos,
+				"input %q of action %s is a number input but the value %q is not a number",
+				input.Name.Value,
+				action,
+				v,
+			)
+		}
+	}
+}
+
 func (rule *RuleAction) invalidActionFormat(pos *Pos, spec string, why string) {
 	rule.Errorf(pos, "specifying action %q in invalid format because %s. available formats are \"{owner}/{repo}@{ref}\" or \"{owner}/{repo}/{path}@{ref}\"", spec, why)
 }
@@ -414,7 +539,7 @@ func (rule *RuleAction) checkRunsFileExists(file, dir, prop, name string, pos *P
 		return
 	}
 	p := filepath.Join(dir, f)
-	if _, err := os.Stat(p); errors.Is(err, os.ErrNotExist) {
+	if _, err := rule.cache.proj.fileSystem().Stat(p); errors.Is(err, os.ErrNotExist) {
 		rule.Errorf(pos, `file %q does not exist in %q. it is specified at %q key in "runs" section in %q action`, f, dir, prop, name)
 	}
 }
@@ -487,29 +612,35 @@ func (rule *RuleAction) checkLocalActionRuns(meta *ActionMetadata, pos *Pos) {
 
 // https://docs.github.com/en/actions/learn-github-actions/workflow-syntax-for-github-actions#example-using-the-github-packages-container-registry
 func (rule *RuleAction) checkDockerAction(uri string, exec *ExecAction) {
-	tag := ""
-	tagExists := false
-	if idx := strings.IndexRune(uri[len("docker://"):], ':'); idx != -1 {
-		idx += len("docker://")
-		if idx < len(uri) {
-			tag = uri[idx+1:]
-			uri = uri[:idx]
-			tagExists = true
-		}
+	ref, err := parseDockerImageRef(uri[len("docker://"):])
+	if err != nil {
+		rule.Errorf(exec.Uses.Pos, "Docker image reference %q of Docker action is invalid: %s", uri, err)
+		return
 	}
 
-	if _, err := url.Parse(uri); err != nil {
+	if ref.hasTag && ref.tag == "" {
+		rule.Errorf(exec.Uses.Pos, "tag of Docker action should not be empty: %q", "docker://"+ref.name())
+		return
+	}
+
+	if !ref.hasTag && ref.digest == "" {
 		rule.Errorf(
 			exec.Uses.Pos,
-			"URI for Docker container %q is invalid: %s (tag=%s)",
+			"tag of Docker action is missing in %q. \"latest\" tag is used implicitly and the image may change unexpectedly. specify a tag or a digest like \"%s:{tag}\"",
+			uri,
 			uri,
-			err.Error(),
-			tag,
 		)
 	}
 
-	if tagExists && tag == "" {
-		rule.Errorf(exec.Uses.Pos, "tag of Docker action should not be empty: %q", uri)
+	if rule.registry == nil {
+		return
+	}
+	ctx := rule.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if rule.registry.exists(ctx, ref) == dockerImageNotFound {
+		rule.Errorf(exec.Uses.Pos, "Docker image %q of Docker action does not exist in the registry. check the name, the tag, and the digest of the image", ref)
 	}
 }
 
@@ -546,14 +677,60 @@ func (rule *RuleAction) checkLocalActionMetadata(meta *ActionMetadata, action *E
 	rule.checkLocalActionRuns(meta, action.Uses.Pos)
 }
 
+// checkLocalActionDirExists reports the local action whose directory does not exist in the
+// repository. Note that the directory without action metadata is not reported since it may be an
+// uninitialized Git submodule (#25, #40). The directory whose parent directory does not exist is
+// not reported either since the whole path may be created while running the workflow.
+func (rule *RuleAction) checkLocalActionDirExists(spec string, action *ExecAction) {
+	proj := rule.cache.proj
+	if proj == nil {
+		return
+	}
+	root := proj.RootDir()
+	dir := filepath.Join(root, filepath.FromSlash(spec))
+	fsys := proj.fileSystem()
+	if _, err := fsys.Stat(dir); !errors.Is(err, os.ErrNotExist) {
+		return
+	}
+
+	// Suggest the directories which have similar names in the parent directory
+	parent, base := filepath.Split(filepath.Clean(dir))
+	es, err := fsys.ReadDir(parent)
+	if err != nil {
+		return
+	}
+	cs := []string{}
+	for _, e := range es {
+		if e.IsDir() {
+			cs = append(cs, e.Name())
+		}
+	}
+	ss := similarNames(base, cs)
+	for i, s := range ss {
+		if r, err := filepath.Rel(root, filepath.Join(parent, s)); err == nil {
+			ss[i] = "./" + filepath.ToSlash(r)
+		}
+	}
+
+	e := errorfAt(action.Uses.Pos, rule.name, "local action %q does not exist. its directory is not found in the repository", spec)
+	if len(ss) > 0 {
+		e.Message += ". " + didYouMean(ss)
+		e.Suggestions = ss
+	}
+	rule.errs = append(rule.errs, e)
+}
+
 // https://docs.github.com/en/actions/learn-github-actions/workflow-syntax-for-github-actions#example-using-action-in-the-same-repository-as-the-workflow
-func (rule *RuleAction) checkLocalAction(spec string, action *ExecAction) {
+func (rule *RuleAction) checkLocalAction(spec string, action *ExecAction, filesMayBeCreated bool) {
 	meta, cached, err := rule.cache.FindMetadata(spec)
 	if err != nil {
 		rule.Error(action.Uses.Pos, err.Error())
 		return
 	}
 	if meta == nil {
+		if !filesMayBeCreated {
+			rule.checkLocalActionDirExists(spec, action)
+		}
 		return
 	}
 
@@ -575,14 +752,18 @@ func (rule *RuleAction) checkAction(meta *ActionMetadata, exec *ExecAction, desc
 			for _, i := range meta.Inputs {
 				ns = append(ns, i.Name)
 			}
-			rule.Errorf(
+			rule.errorfWithSuggestions(
 				i.Name.Pos,
+				i.Name.Value,
+				ns,
 				"input %q is not defined in action %s. available inputs are %s",
 				i.Name.Value,
 				describe(meta),
 				sortedQuotes(ns),
 			)
+			continue
 		}
+		rule.checkInputValue(meta.Inputs[id], i, describe(meta))
 	}
 
 	// Check mandatory inputs are specified
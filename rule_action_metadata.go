@@ -0,0 +1,321 @@
+package actionlint
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var reActionInputID = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// Enumeration of values in description of input like "One of `foo`, `bar`, or `baz`"
+var reEnumInDescription = regexp.MustCompile("(?i)\\b(?:one of|(?:possible|allowed|valid|available|supported) values(?: are| is)?|either)\\s*:?\\s*((?:[`\"'][^`\"']+[`\"'](?:\\s*(?:,|/|\\||\\bor\\b|\\band\\b)\\s*)*)+)")
+var reQuotedValue = regexp.MustCompile("[`\"']([^`\"']+)[`\"']")
+
+// RuleActionMetadata is a rule to check action metadata files (action.yml) given to the linter
+// directly. It checks "runs" configuration depending on the type of action, declarations of inputs
+// and outputs, and branding. Unlike the checks by RuleAction, this rule reports errors at positions
+// in the action metadata file.
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions
+type RuleActionMetadata struct {
+	RuleBase
+	dir string
+	fs  fileSystem
+}
+
+// NewRuleActionMetadata creates a new RuleActionMetadata instance. The dir parameter is a path
+// to the directory where the action metadata file is put. Files referred from the metadata are
+// resolved from the directory. When it is empty, existence of the files is not checked.
+func NewRuleActionMetadata(dir string) *RuleActionMetadata {
+	return &RuleActionMetadata{
+		RuleBase: RuleBase{
+			name: "action-metadata",
+			desc: "Checks for action metadata files (action.yml) such as \"runs\" configuration, inputs, outputs, and branding",
+		},
+		dir: dir,
+		fs:  osFileSystem{},
+	}
+}
+
+// CheckAction checks the action metadata syntax tree.
+func (rule *RuleActionMetadata) CheckAction(a *Action) {
+	for _, i := range a.Inputs {
+		if !reActionInputID.MatchString(i.Name.Value) {
+			rule.Errorf(i.Name.Pos, "invalid input ID %q. input ID must start with a letter or _ and contain only alphanumeric characters, -, or _", i.Name.Value)
+		}
+		rule.checkInputDefault(i)
+	}
+
+	if a.Branding != nil {
+		rule.checkBrandingIcon(a.Branding.Icon)
+		rule.checkBrandingColor(a.Branding.Color)
+	}
+
+	r := a.Runs
+	if r == nil || r.Using == nil {
+		return // Missing sections were reported by parser
+	}
+
+	switch r.Using.Value {
+	case "docker":
+		rule.checkDockerRuns(r)
+		rule.checkOutputsWithoutValue(a, "Docker")
+	case "composite":
+		rule.checkCompositeRuns(r)
+		for _, o := range a.Outputs {
+			if o.Value == nil {
+				rule.Errorf(o.Name.Pos, "\"value\" is required at output %q since the action is a composite action", o.Name.Value)
+			}
+		}
+	case "node16", "node20":
+		rule.checkJavaScriptRuns(r)
+		rule.checkOutputsWithoutValue(a, "JavaScript")
+	default:
+		rule.Errorf(r.Using.Pos, `invalid runner name %q at runs.using. valid runners are "composite", "docker", "node16", and "node20". see https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#runs`, r.Using.Value)
+
+		// Probably invalid version of Node.js runner. Assume it is JavaScript action to find as many errors as possible
+		if strings.HasPrefix(r.Using.Value, "node") {
+			rule.checkJavaScriptRuns(r)
+		}
+	}
+}
+
+// enumInDescription returns the values enumerated in the description of input. It returns nil when
+// the description does not enumerate two or more values.
+func enumInDescription(desc string) []string {
+	for _, l := range strings.Split(desc, "\n") {
+		m := reEnumInDescription.FindStringSubmatch(l)
+		if m == nil {
+			continue
+		}
+		ms := reQuotedValue.FindAllStringSubmatch(m[1], -1)
+		if len(ms) < 2 {
+			continue
+		}
+		vs := make([]string, 0, len(ms))
+		for _, m := range ms {
+			vs = append(vs, m[1])
+		}
+		return vs
+	}
+	return nil
+}
+
+func (rule *RuleActionMetadata) checkInputDefault(i *ActionInput) {
+	d := i.Default
+	if d == nil {
+		return
+	}
+
+	// Runner does not check the required input when it has the default value
+	if r := i.Required; r != nil && r.Expression == nil && r.Value {
+		rule.Errorf(r.Pos, "input %q is required but it has the default value %q. the default value is used when the input is not given so \"required: true\" is misleading", i.Name.Value, d.Value)
+	}
+
+	if i.Description == nil || d.Value == "" || d.ContainsExpression() {
+		return
+	}
+	vs := enumInDescription(i.Description.Value)
+	if vs == nil {
+		return
+	}
+	for _, v := range vs {
+		if strings.EqualFold(v, d.Value) {
+			return
+		}
+	}
+	rule.Errorf(d.Pos, "default value %q of input %q is not one of the values enumerated in its description: %s", d.Value, i.Name.Value, quotes(vs))
+}
+
+// brandingHint returns the sentence to suggest the similar names to the incorrect value at
+// branding section and the suggested names.
+func brandingHint(v string, allowed map[string]struct{}) (string, []string) {
+	cs := make([]string, 0, len(allowed))
+	for c := range allowed {
+		cs = append(cs, c)
+	}
+	ss := similarNames(strings.ToLower(v), cs)
+	if len(ss) == 0 {
+		return "", nil
+	}
+	return " " + didYouMean(ss), ss
+}
+
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#brandingicon
+func (rule *RuleActionMetadata) checkBrandingIcon(i *String) {
+	if i == nil || i.Value == "" {
+		return
+	}
+	v := strings.ToLower(i.Value)
+	if _, ok := BrandingIcons[v]; ok {
+		return
+	}
+	if _, ok := unsupportedFeatherIcons[v]; ok {
+		rule.Errorf(i.Pos, "icon %q at branding.icon is a Feather icon but it is not supported by GitHub. see the official document to know the exhaustive list of supported icons: https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#brandingicon", i.Value)
+		return
+	}
+	hint, ss := brandingHint(v, BrandingIcons)
+	err := errorfAt(i.Pos, rule.name, "incorrect icon name %q at branding.icon.%s see the official document to know the exhaustive list of supported icons: https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#brandingicon", i.Value, hint)
+	err.Suggestions = ss
+	rule.errs = append(rule.errs, err)
+}
+
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#brandingcolor
+func (rule *RuleActionMetadata) checkBrandingColor(c *String) {
+	if c == nil || c.Value == "" {
+		return
+	}
+	v := strings.ToLower(c.Value)
+	if _, ok := BrandingColors[v]; ok {
+		return
+	}
+	hint, ss := brandingHint(v, BrandingColors)
+	err := errorfAt(c.Pos, rule.name, "incorrect color %q at branding.color.%s see the official document to know the exhaustive list of supported colors: https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#brandingcolor", c.Value, hint)
+	err.Suggestions = ss
+	rule.errs = append(rule.errs, err)
+}
+
+func (rule *RuleActionMetadata) checkOutputsWithoutValue(a *Action, ty string) {
+	for _, o := range a.Outputs {
+		if o.Value != nil {
+			rule.Errorf(o.Value.Pos, "\"value\" is not allowed at output %q because the action is a %s action. it is only available in composite actions", o.Name.Value, ty)
+		}
+	}
+}
+
+func (rule *RuleActionMetadata) missingRunsProp(r *ActionRuns, prop, ty string) {
+	rule.Errorf(r.Pos, "%q is required in \"runs\" section because the action is a %s action", prop, ty)
+}
+
+func (rule *RuleActionMetadata) checkInvalidRunsProps(r *ActionRuns, ty string, props []string) {
+	for _, prop := range props {
+		var pos *Pos
+		switch prop {
+		case "main":
+			if r.Main != nil {
+				pos = r.Main.Pos
+			}
+		case "pre":
+			if r.Pre != nil {
+				pos = r.Pre.Pos
+			}
+		case "pre-if":
+			if r.PreIf != nil {
+				pos = r.PreIf.Pos
+			}
+		case "post":
+			if r.Post != nil {
+				pos = r.Post.Pos
+			}
+		case "post-if":
+			if r.PostIf != nil {
+				pos = r.PostIf.Pos
+			}
+		case "steps":
+			if len(r.Steps) > 0 {
+				pos = r.Steps[0].Pos
+			}
+		case "image":
+			if r.Image != nil {
+				pos = r.Image.Pos
+			}
+		case "pre-entrypoint":
+			if r.PreEntrypoint != nil {
+				pos = r.PreEntrypoint.Pos
+			}
+		case "entrypoint":
+			if r.Entrypoint != nil {
+				pos = r.Entrypoint.Pos
+			}
+		case "post-entrypoint":
+			if r.PostEntrypoint != nil {
+				pos = r.PostEntrypoint.Pos
+			}
+		case "args":
+			if r.Args != nil {
+				pos = r.Pos
+				if len(r.Args) > 0 {
+					pos = r.Args[0].Pos
+				}
+			}
+		case "env":
+			if r.Env != nil {
+				pos = r.Pos
+			}
+		}
+		if pos != nil {
+			rule.Errorf(pos, "%q is not allowed in \"runs\" section because the action is a %s action", prop, ty)
+		}
+	}
+}
+
+func (rule *RuleActionMetadata) checkRunsFileExists(s *String, prop string) {
+	if s == nil || s.Value == "" || rule.dir == "" || s.ContainsExpression() {
+		return
+	}
+	f := filepath.FromSlash(s.Value)
+	if _, err := rule.fs.Stat(filepath.Join(rule.dir, f)); errors.Is(err, os.ErrNotExist) {
+		rule.Errorf(s.Pos, "file %q does not exist in %q. it is specified at %q key in \"runs\" section", f, rule.dir, prop)
+	}
+}
+
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#runs-for-docker-container-actions
+func (rule *RuleActionMetadata) checkDockerRuns(r *ActionRuns) {
+	if r.Image == nil {
+		rule.missingRunsProp(r, "image", "Docker")
+	} else if strings.HasPrefix(r.Image.Value, "docker://") {
+		if ref := strings.TrimPrefix(r.Image.Value, "docker://"); !r.Image.ContainsExpression() && !isValidDockerImageRef(ref) {
+			rule.Errorf(r.Image.Pos, "Docker image reference %q at \"image\" key is not well-formed. it must be in the form of \"docker://{name}:{tag}\" or \"docker://{name}@{digest}\" like \"docker://alpine:3.20\". note that the name must be in lower case", ref)
+		}
+	} else {
+		rule.checkRunsFileExists(r.Image, "image")
+		if filepath.Base(filepath.FromSlash(r.Image.Value)) != "Dockerfile" {
+			rule.Errorf(r.Image.Pos, "the local file %q referenced from \"image\" key must be named \"Dockerfile\"", r.Image.Value)
+		}
+	}
+	rule.checkInvalidRunsProps(r, "Docker", []string{"main", "pre", "pre-if", "post", "post-if", "steps"})
+}
+
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#runs-for-composite-actions
+func (rule *RuleActionMetadata) checkCompositeRuns(r *ActionRuns) {
+	if r.Steps == nil {
+		rule.missingRunsProp(r, "steps", "Composite")
+	}
+	rule.checkInvalidRunsProps(r, "Composite", []string{"main", "pre", "pre-if", "post", "post-if", "image", "pre-entrypoint", "entrypoint", "post-entrypoint", "args", "env"})
+
+	for _, s := range r.Steps {
+		if e, ok := s.Exec.(*ExecRun); ok && e.Shell == nil {
+			pos := s.Pos
+			if e.RunPos != nil {
+				pos = e.RunPos
+			}
+			rule.Error(pos, "\"shell\" is required for step running a script with \"run\" in composite action")
+		}
+		if s.TimeoutMinutes != nil {
+			rule.Error(s.TimeoutMinutes.Pos, "\"timeout-minutes\" is not available at step in composite action")
+		}
+	}
+}
+
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#runs-for-javascript-actions
+func (rule *RuleActionMetadata) checkJavaScriptRuns(r *ActionRuns) {
+	if r.Main == nil {
+		rule.missingRunsProp(r, "main", "JavaScript")
+	} else {
+		rule.checkRunsFileExists(r.Main, "main")
+	}
+
+	rule.checkRunsFileExists(r.Pre, "pre")
+	if r.Pre == nil && r.PreIf != nil {
+		rule.Error(r.PreIf.Pos, "\"pre\" is required when \"pre-if\" is specified in \"runs\" section")
+	}
+
+	rule.checkRunsFileExists(r.Post, "post")
+	if r.Post == nil && r.PostIf != nil {
+		rule.Error(r.PostIf.Pos, "\"post\" is required when \"post-if\" is specified in \"runs\" section")
+	}
+
+	rule.checkInvalidRunsProps(r, "JavaScript", []string{"steps", "image", "pre-entrypoint", "entrypoint", "post-entrypoint", "args", "env"})
+}
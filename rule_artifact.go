@@ -0,0 +1,151 @@
+package actionlint
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// https://docs.github.com/en/actions/learn-github-actions/usage-limits-billing-and-administration#artifact-and-log-retention-policy
+const defaultMaxArtifactRetentionDays = 90
+
+// artifactUpload is an upload of artifact with a literal name by actions/upload-artifact v4 or later.
+type artifactUpload struct {
+	name string
+	pos  *Pos
+	job  string
+}
+
+// RuleArtifact is a rule checker to check usage of artifact actions such as
+// actions/upload-artifact.
+type RuleArtifact struct {
+	RuleBase
+	uploads []*artifactUpload
+	// matrix is true when the current job runs multiple matrix combinations.
+	matrix bool
+	jobID  *String
+}
+
+// NewRuleArtifact creates new RuleArtifact instance.
+func NewRuleArtifact() *RuleArtifact {
+	return &RuleArtifact{
+		RuleBase: RuleBase{
+			name: "artifact",
+			desc: "Checks for retention days and names of artifacts uploaded by actions/upload-artifact",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleArtifact) VisitWorkflowPre(n *Workflow) error {
+	rule.uploads = nil
+	return nil
+}
+
+// VisitWorkflowPost is callback when visiting Workflow node after visiting its children.
+func (rule *RuleArtifact) VisitWorkflowPost(n *Workflow) error {
+	// Jobs are visited in random order. Sort uploads to report errors deterministically
+	sort.Slice(rule.uploads, func(i, j int) bool {
+		return rule.uploads[i].pos.IsBefore(rule.uploads[j].pos)
+	})
+	seen := map[string]*artifactUpload{}
+	for _, u := range rule.uploads {
+		first, ok := seen[u.name]
+		if !ok {
+			seen[u.name] = u
+			continue
+		}
+		rule.Errorf(
+			u.pos,
+			"artifact %q is uploaded by job %q but it is already uploaded at %s in job %q. uploading to the same artifact name fails since actions/upload-artifact v4. use a different name or set \"overwrite: true\"",
+			u.name,
+			u.job,
+			first.pos.String(),
+			first.job,
+		)
+	}
+	rule.uploads = nil
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleArtifact) VisitJobPre(n *Job) error {
+	rule.matrix = n.Strategy != nil && hasMultipleMatrixCombinations(n.Strategy.Matrix)
+	rule.jobID = n.ID
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleArtifact) VisitStep(n *Step) error {
+	e, ok := n.Exec.(*ExecAction)
+	if !ok || e.Uses == nil || !strings.HasPrefix(strings.ToLower(e.Uses.Value), "actions/upload-artifact@") {
+		return nil
+	}
+	if i, ok := e.Inputs["retention-days"]; ok && i.Value != nil {
+		rule.checkRetentionDays(i.Value)
+	}
+	rule.checkName(e, n)
+	return nil
+}
+
+// https://github.com/actions/upload-artifact/blob/main/docs/MIGRATION.md#multiple-uploads-to-the-same-named-artifact
+func (rule *RuleArtifact) checkName(e *ExecAction, n *Step) {
+	if actionMajorVersion(e.Uses.Value) < 4 {
+		return // Uploading to the same name was allowed before v4. Or the version is unknown
+	}
+	if o, ok := e.Inputs["overwrite"]; ok && o.Value != nil {
+		if o.Value.ContainsExpression() || strings.TrimSpace(o.Value.Value) == "true" {
+			return
+		}
+	}
+
+	name, pos := "artifact", e.Uses.Pos // Default name of artifact
+	if i, ok := e.Inputs["name"]; ok && i.Value != nil {
+		if i.Value.ContainsExpression() {
+			return // Name may be different for each matrix combination or run
+		}
+		name, pos = i.Value.Value, i.Value.Pos
+	}
+
+	if rule.matrix {
+		rule.Errorf(
+			pos,
+			"artifact %q is uploaded with the same name by all matrix combinations of this job. uploading to the same artifact name fails since actions/upload-artifact v4. include matrix values in the name like \"%s-${{ matrix.os }}\"",
+			name,
+			name,
+		)
+		return
+	}
+
+	job := ""
+	if rule.jobID != nil {
+		job = rule.jobID.Value
+	}
+	rule.uploads = append(rule.uploads, &artifactUpload{name, pos, job})
+}
+
+func hasMultipleMatrixCombinations(m *Matrix) bool {
+	n, ok := matrixCombinations(m)
+	return ok && n > 1
+}
+
+func (rule *RuleArtifact) checkRetentionDays(s *String) {
+	if s.ContainsExpression() {
+		return // Value is unknown statically
+	}
+
+	max := defaultMaxArtifactRetentionDays
+	if rule.config != nil && rule.config.MaxArtifactRetentionDays > 0 {
+		max = rule.config.MaxArtifactRetentionDays
+	}
+
+	v := strings.TrimSpace(s.Value)
+	d, err := strconv.Atoi(v)
+	if err != nil {
+		rule.Errorf(s.Pos, "value %q at \"retention-days\" input must be an integer in days between 1 and %d", v, max)
+		return
+	}
+	if d < 1 || d > max {
+		rule.Errorf(s.Pos, "value %d at \"retention-days\" input is out of range. it must be between 1 and %d days", d, max)
+	}
+}
@@ -0,0 +1,137 @@
+package actionlint
+
+import "strings"
+
+// Contexts and their properties which don't change across workflow runs. "*" means all properties.
+var cacheKeyStaticProps = map[string]map[string]struct{}{
+	"runner": {"os": {}, "arch": {}},
+	"github": {
+		"repository":       {},
+		"repository_owner": {},
+		"workflow":         {},
+		"job":              {},
+		"event_name":       {},
+	},
+	"matrix":   {"*": {}},
+	"strategy": {"*": {}},
+}
+
+// RuleCacheKey is a rule checker to detect keys of actions/cache which make the cache useless.
+// Since a cache is immutable once it is saved, a key which never changes keeps restoring the
+// outdated cache. This rule is disabled by default and enabled by "cache-key" in config file.
+// https://github.com/actions/cache#creating-a-cache-key
+type RuleCacheKey struct {
+	RuleBase
+}
+
+// NewRuleCacheKey creates new RuleCacheKey instance.
+func NewRuleCacheKey() *RuleCacheKey {
+	return &RuleCacheKey{
+		RuleBase: RuleBase{
+			name: "cache-key",
+			desc: "Checks for keys of actions/cache which make the cache useless",
+		},
+	}
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleCacheKey) VisitStep(n *Step) error {
+	e, ok := n.Exec.(*ExecAction)
+	if !ok || e.Uses == nil {
+		return nil
+	}
+
+	u := strings.ToLower(e.Uses.Value)
+	restore := false
+	switch {
+	case strings.HasPrefix(u, "actions/cache@"), strings.HasPrefix(u, "actions/cache/restore@"):
+		restore = true
+	case strings.HasPrefix(u, "actions/cache/save@"):
+	default:
+		return nil
+	}
+
+	i, ok := e.Inputs["key"]
+	if !ok || i.Value == nil {
+		return nil // Missing required input is reported by "action" rule
+	}
+	k := i.Value
+
+	if !k.ContainsExpression() {
+		rule.Errorf(
+			k.Pos,
+			"cache key %q is a constant string. the cache is never updated since a cache is immutable once it is saved. include a hash of files with hashFiles() in the key",
+			k.Value,
+		)
+	} else if isStaticCacheKey(k.Value) {
+		rule.Errorf(
+			k.Pos,
+			"cache key %q never changes across workflow runs. the cache is never updated since a cache is immutable once it is saved. include a hash of files with hashFiles() in the key",
+			k.Value,
+		)
+	}
+
+	if restore {
+		if _, ok := e.Inputs["restore-keys"]; !ok {
+			rule.Errorf(
+				e.Uses.Pos,
+				"\"restore-keys\" input is not set to %q action. nothing is restored when the key %q does not match exactly. set prefixes of the key to \"restore-keys\"",
+				e.Uses.Value,
+				k.Value,
+			)
+		}
+	}
+
+	return nil
+}
+
+// isStaticCacheKey returns true when all expressions in the key only refer values which don't
+// change across workflow runs. When some expression cannot be parsed, it returns false.
+func isStaticCacheKey(s string) bool {
+	for {
+		idx := strings.Index(s, "${{")
+		if idx == -1 {
+			return true
+		}
+		s = s[idx+3:]
+
+		l := NewExprLexer(s)
+		expr, err := NewExprParser().Parse(l)
+		if err != nil {
+			return false
+		}
+
+		static := true
+		VisitExprNode(expr, func(n, p ExprNode, entering bool) {
+			if !entering {
+				return
+			}
+			switch n := n.(type) {
+			case *FuncCallNode:
+				if strings.ToLower(n.Callee) == "hashfiles" {
+					static = false
+				}
+			case *VariableNode:
+				props, ok := cacheKeyStaticProps[strings.ToLower(n.Name)]
+				if !ok {
+					static = false
+					return
+				}
+				if _, ok := props["*"]; ok {
+					return
+				}
+				if d, ok := p.(*ObjectDerefNode); ok && d.Receiver == n {
+					if _, ok := props[strings.ToLower(d.Property)]; ok {
+						return
+					}
+				}
+				static = false
+			}
+		})
+		if !static {
+			return false
+		}
+
+		s = s[l.Offset():]
+	}
+}
@@ -0,0 +1,156 @@
+package actionlint
+
+import "strings"
+
+// Substrings of scripts at "run:" which use the history of the repository. They are matched with
+// the lowercased scripts.
+var checkoutHistoryCommands = []string{
+	"git log",
+	"git describe",
+	"git rev-list",
+	"git merge-base",
+	"git blame",
+	"git shortlog",
+	"git tag",
+	"git diff",
+	"git rebase",
+	"git merge",
+	"git cherry-pick",
+	"git fetch",
+	"git cliff",
+	"git-cliff",
+	"semantic-release",
+	"goreleaser",
+	"changeset",
+	"lerna",
+	"nx affected",
+	"setuptools_scm",
+	"setuptools-scm",
+	"gitversion",
+	"standard-version",
+	"conventional-changelog",
+	"commitlint",
+	"cz bump",
+	"sonar-scanner",
+	"gitleaks",
+	"trufflehog",
+}
+
+// Actions which use the history of the repository. They are matched with the lowercased
+// "{owner}/{repo}" of action specs.
+var checkoutHistoryActions = map[string]struct{}{
+	"anothrnick/github-tag-action":                    {},
+	"changesets/action":                               {},
+	"codfish/semantic-release-action":                 {},
+	"cycjimmy/semantic-release-action":                {},
+	"dorny/paths-filter":                              {},
+	"github/super-linter":                             {},
+	"gitleaks/gitleaks-action":                        {},
+	"gittools/actions":                                {},
+	"goreleaser/goreleaser-action":                    {},
+	"mathieudutour/github-tag-action":                 {},
+	"nrwl/nx-set-shas":                                {},
+	"orhun/git-cliff-action":                          {},
+	"paulhatch/semantic-version":                      {},
+	"python-semantic-release/python-semantic-release": {},
+	"sonarsource/sonarcloud-github-action":            {},
+	"sonarsource/sonarqube-scan-action":               {},
+	"super-linter/super-linter":                       {},
+	"tj-actions/changed-files":                        {},
+	"trufflesecurity/trufflehog":                      {},
+	"wagoid/commitlint-github-action":                 {},
+}
+
+// RuleCheckoutPerformance is a rule checker to detect slow usages of actions/checkout. Fetching the
+// entire history with "fetch-depth: 0" and cloning submodules recursively take long time in large
+// repositories. This rule is disabled by default and enabled by "checkout-performance" in config
+// file or "performance" preset.
+// https://github.com/actions/checkout#usage
+type RuleCheckoutPerformance struct {
+	RuleBase
+}
+
+// NewRuleCheckoutPerformance creates new RuleCheckoutPerformance instance.
+func NewRuleCheckoutPerformance() *RuleCheckoutPerformance {
+	return &RuleCheckoutPerformance{
+		RuleBase: RuleBase{
+			name: "checkout-performance",
+			desc: "Checks for slow usages of actions/checkout such as fetching the entire history which the job does not need",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleCheckoutPerformance) VisitJobPre(n *Job) error {
+	history := false
+	for _, s := range n.Steps {
+		if stepUsesGitHistory(s) {
+			history = true
+			break
+		}
+	}
+
+	for _, s := range n.Steps {
+		e, ok := s.Exec.(*ExecAction)
+		if !ok || e.Uses == nil || !strings.HasPrefix(strings.ToLower(e.Uses.Value), "actions/checkout@") {
+			continue
+		}
+
+		if i, ok := e.Inputs["fetch-depth"]; ok && !history && n.ID != nil {
+			if v := i.Value; v != nil && !v.ContainsExpression() && strings.TrimSpace(v.Value) == "0" {
+				rule.Errorf(
+					v.Pos,
+					"\"fetch-depth: 0\" of %q action fetches the entire history of the repository but no step in job %q seems to use the history. remove \"fetch-depth\" to fetch only the latest commit or set the number of commits which the job needs",
+					e.Uses.Value,
+					n.ID.Value,
+				)
+			}
+		}
+
+		if i, ok := e.Inputs["submodules"]; ok {
+			if v := i.Value; v != nil && !v.ContainsExpression() && strings.EqualFold(strings.TrimSpace(v.Value), "recursive") {
+				rule.Errorf(
+					v.Pos,
+					"\"submodules: recursive\" of %q action clones all nested submodules unconditionally. set \"submodules: true\" when only top-level submodules are necessary",
+					e.Uses.Value,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// stepUsesGitHistory returns true when the step seems to use the history of the repository. Local
+// actions are assumed to use the history since what they do is unknown.
+func stepUsesGitHistory(s *Step) bool {
+	switch e := s.Exec.(type) {
+	case *ExecRun:
+		if e.Run == nil {
+			return false
+		}
+		r := strings.ToLower(e.Run.Value)
+		for _, c := range checkoutHistoryCommands {
+			if strings.Contains(r, c) {
+				return true
+			}
+		}
+	case *ExecAction:
+		if e.Uses == nil {
+			return false
+		}
+		u := strings.ToLower(e.Uses.Value)
+		if strings.HasPrefix(u, "./") {
+			return true
+		}
+		u, _, _ = strings.Cut(u, "@")
+		if owner, rest, ok := strings.Cut(u, "/"); ok {
+			// Actions in sub-directories like "gittools/actions/gitversion/setup" are matched with their repositories
+			repo, _, _ := strings.Cut(rest, "/")
+			u = owner + "/" + repo
+		}
+		_, ok := checkoutHistoryActions[u]
+		return ok
+	}
+	return false
+}
@@ -0,0 +1,200 @@
+package actionlint
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// codeownersLocations is a list of directories where CODEOWNERS file is searched in order. GitHub
+// uses the first file found and ignores the others.
+var codeownersLocations = []string{".github", "", "docs"}
+
+// codeownersMaxSize is the maximum size of CODEOWNERS file. GitHub does not load the file larger
+// than this size.
+const codeownersMaxSize = 3 * 1024 * 1024
+
+var reCodeownersUser = regexp.MustCompile(`^@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,37}[a-zA-Z0-9])?$`)
+var reCodeownersTeam = regexp.MustCompile(`^@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,37}[a-zA-Z0-9])?/[a-zA-Z0-9_.-]+$`)
+var reCodeownersEmail = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// RuleCodeowners is a rule to check CODEOWNERS file.
+// https://docs.github.com/en/repositories/managing-your-repositorys-settings-and-features/customizing-your-repository/about-code-owners
+type RuleCodeowners struct {
+	RuleBase
+	path        string
+	root        string
+	ownerExists func(owner string) (bool, error)
+	fs          fileSystem
+}
+
+// NewRuleCodeowners creates a new RuleCodeowners instance. The path parameter is a file path of
+// the CODEOWNERS file. The root parameter is a path to the root directory of the repository. It is
+// used to check the file is not ignored due to other CODEOWNERS files. When it is empty, the check
+// is skipped. The ownerExists parameter is a function to look up the owner on GitHub. When it is
+// nil, existence of owners is not checked.
+func NewRuleCodeowners(path, root string, ownerExists func(string) (bool, error)) *RuleCodeowners {
+	return &RuleCodeowners{
+		RuleBase: RuleBase{
+			name: "codeowners",
+			desc: "Checks for CODEOWNERS file such as file patterns, owners, and rules which never take effect",
+		},
+		path:        path,
+		root:        root,
+		ownerExists: ownerExists,
+		fs:          osFileSystem{},
+	}
+}
+
+// isCodeownersFile returns true when the file path is CODEOWNERS file.
+func isCodeownersFile(path string) bool {
+	return filepath.Base(path) == "CODEOWNERS"
+}
+
+// CheckCodeowners checks the CODEOWNERS syntax tree. The size parameter is a size of the file in
+// bytes.
+func (rule *RuleCodeowners) CheckCodeowners(c *Codeowners, size int) {
+	if size > codeownersMaxSize {
+		rule.Errorf(&Pos{Line: 1, Col: 1}, "CODEOWNERS file is %d bytes. GitHub does not load CODEOWNERS file larger than 3 MB", size)
+	}
+	rule.checkLocation()
+
+	for i, r := range c.Rules {
+		if !rule.checkPattern(r.Pattern) {
+			continue
+		}
+		for _, o := range r.Owners {
+			rule.checkOwner(o)
+		}
+		rule.checkShadowed(r, c.Rules[i+1:])
+	}
+}
+
+// checkLocation checks the CODEOWNERS file is not ignored. GitHub only uses the first CODEOWNERS
+// file found in ".github", the repository root, and "docs" directories in order.
+func (rule *RuleCodeowners) checkLocation() {
+	if rule.root == "" || rule.path == "" {
+		return
+	}
+	rel, err := filepath.Rel(rule.root, filepath.Dir(rule.path))
+	if err != nil {
+		return
+	}
+	if rel == "." {
+		rel = ""
+	}
+	for _, d := range codeownersLocations {
+		if d == rel {
+			return
+		}
+		p := filepath.Join(rule.root, d, "CODEOWNERS")
+		if _, err := rule.fs.Stat(p); err == nil {
+			rule.Errorf(
+				&Pos{Line: 1, Col: 1},
+				"this CODEOWNERS file is ignored since %q takes precedence. GitHub only uses the first CODEOWNERS file found in \".github\", the repository root, and \"docs\" directories",
+				filepath.ToSlash(filepath.Join(d, "CODEOWNERS")),
+			)
+			return
+		}
+	}
+}
+
+// checkPattern checks the file pattern. Some gitignore syntax is not supported by CODEOWNERS. It
+// returns false when the line is not a rule.
+// https://docs.github.com/en/repositories/managing-your-repositorys-settings-and-features/customizing-your-repository/about-code-owners#syntax-exceptions
+func (rule *RuleCodeowners) checkPattern(p *String) bool {
+	v := p.Value
+	switch {
+	case strings.HasPrefix(v, `\#`):
+		rule.Errorf(p.Pos, "escaping \"#\" with \"\\\" in pattern \"%s\" is not supported in CODEOWNERS. the line is treated as a comment", v)
+		return false
+	case strings.HasPrefix(v, "!"):
+		rule.Errorf(p.Pos, "negating pattern with \"!\" in %q is not supported in CODEOWNERS", v)
+	case strings.ContainsAny(v, "[]"):
+		rule.Errorf(p.Pos, "character range with \"[ ]\" in pattern %q is not supported in CODEOWNERS", v)
+	}
+	return true
+}
+
+func (rule *RuleCodeowners) checkOwner(o *String) {
+	v := o.Value
+	if strings.HasPrefix(v, "@") {
+		if !reCodeownersUser.MatchString(v) && !reCodeownersTeam.MatchString(v) {
+			rule.Errorf(o.Pos, "invalid owner %q. owner must be a user name like \"@octocat\", a team name like \"@org/team\", or an email address", v)
+			return
+		}
+	} else if !reCodeownersEmail.MatchString(v) {
+		m := "invalid owner %q. owner must be a user name like \"@octocat\", a team name like \"@org/team\", or an email address"
+		if reCodeownersUser.MatchString("@"+v) || reCodeownersTeam.MatchString("@"+v) {
+			m += ". did you forget \"@\"?"
+		}
+		rule.Errorf(o.Pos, m, v)
+		return
+	}
+
+	if rule.ownerExists == nil || !strings.HasPrefix(v, "@") {
+		return
+	}
+	ok, err := rule.ownerExists(v)
+	if err != nil {
+		rule.Debug("Could not look up owner %s: %s", v, err)
+		return
+	}
+	if !ok {
+		if strings.Contains(v, "/") {
+			rule.Errorf(o.Pos, "team %q does not exist on GitHub or is not visible with the API token", v)
+		} else {
+			rule.Errorf(o.Pos, "user or organization %q does not exist on GitHub", v)
+		}
+	}
+}
+
+// isCatchAllCodeownersPattern returns true when the pattern matches all files in the repository.
+func isCatchAllCodeownersPattern(p string) bool {
+	return p == "*" || p == "**" || p == "/**" || p == "/**/*"
+}
+
+// codeownersDirOfPattern returns the directory which the pattern matches all files in, like
+// "/docs/" for "/docs/" or "/docs/**". It returns an empty string when the pattern is not such
+// pattern.
+func codeownersDirOfPattern(p string) string {
+	if !strings.HasPrefix(p, "/") {
+		return ""
+	}
+	d := strings.TrimSuffix(p, "**")
+	if !strings.HasSuffix(d, "/") || strings.ContainsAny(d, `*?\`) {
+		return ""
+	}
+	return d
+}
+
+// checkShadowed checks the rule takes effect. In CODEOWNERS, the last matching pattern takes
+// precedence. So a rule is never applied when a later rule matches all files the rule matches.
+func (rule *RuleCodeowners) checkShadowed(r *CodeownersRule, later []*CodeownersRule) {
+	p := r.Pattern.Value
+	for _, l := range later {
+		lp := l.Pattern.Value
+		var reason string
+		if strings.HasPrefix(lp, `\#`) {
+			continue // This line is a comment
+		} else if isCatchAllCodeownersPattern(lp) {
+			reason = "matches all files"
+		} else if lp == p {
+			reason = "has the same pattern"
+		} else if d := codeownersDirOfPattern(lp); d != "" && strings.HasPrefix(p, d) && p != d {
+			reason = fmt.Sprintf("matches all files in %q", d)
+		} else {
+			continue
+		}
+		rule.Errorf(
+			r.Pattern.Pos,
+			"rule for pattern %q never takes effect since the later rule for pattern %q at line %d %s. the last matching pattern takes precedence in CODEOWNERS",
+			p,
+			lp,
+			l.Pos.Line,
+			reason,
+		)
+		return
+	}
+}
@@ -0,0 +1,107 @@
+package actionlint
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	defaultComplexityMaxJobs     = 30
+	defaultComplexityMaxSteps    = 40
+	defaultComplexityMaxRunLines = 50
+)
+
+// RuleComplexity is a rule checker to report too large workflows, jobs, and scripts. They should be
+// split into reusable workflows or composite actions. This rule is disabled by default and enabled
+// by "complexity" in config file.
+type RuleComplexity struct {
+	RuleBase
+	maxJobs     int
+	maxSteps    int
+	maxRunLines int
+}
+
+func complexityThreshold(v, d int) int {
+	if v == 0 {
+		return d
+	}
+	return v
+}
+
+// NewRuleComplexity creates new RuleComplexity instance.
+func NewRuleComplexity(cfg *ComplexityConfig) *RuleComplexity {
+	if cfg == nil {
+		cfg = &ComplexityConfig{}
+	}
+	return &RuleComplexity{
+		RuleBase: RuleBase{
+			name: "complexity",
+			desc: "Checks for the number of jobs, steps, and lines of scripts exceeding configured thresholds",
+		},
+		maxJobs:     complexityThreshold(cfg.MaxJobs, defaultComplexityMaxJobs),
+		maxSteps:    complexityThreshold(cfg.MaxSteps, defaultComplexityMaxSteps),
+		maxRunLines: complexityThreshold(cfg.MaxRunLines, defaultComplexityMaxRunLines),
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleComplexity) VisitWorkflowPre(n *Workflow) error {
+	if rule.maxJobs < 0 || len(n.Jobs) <= rule.maxJobs {
+		return nil
+	}
+
+	jobs := make([]*Job, 0, len(n.Jobs))
+	for _, j := range n.Jobs {
+		jobs = append(jobs, j)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].Pos.IsBefore(jobs[j].Pos)
+	})
+
+	// Report at the first job exceeding the threshold
+	rule.Errorf(
+		jobs[rule.maxJobs].Pos,
+		"workflow has %d jobs, which exceeds the maximum number of jobs %d. consider splitting the workflow into reusable workflows",
+		len(jobs),
+		rule.maxJobs,
+	)
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleComplexity) VisitJobPre(n *Job) error {
+	if rule.maxSteps < 0 || len(n.Steps) <= rule.maxSteps {
+		return nil
+	}
+	rule.Errorf(
+		n.Steps[rule.maxSteps].Pos,
+		"job %q has %d steps, which exceeds the maximum number of steps %d. consider splitting the job into multiple jobs or moving steps into composite actions",
+		n.ID.Value,
+		len(n.Steps),
+		rule.maxSteps,
+	)
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleComplexity) VisitStep(n *Step) error {
+	if rule.maxRunLines < 0 {
+		return nil
+	}
+	e, ok := n.Exec.(*ExecRun)
+	if !ok || e.Run == nil {
+		return nil
+	}
+
+	l := strings.Count(strings.TrimRight(e.Run.Value, "\n"), "\n") + 1
+	if l <= rule.maxRunLines {
+		return nil
+	}
+	rule.Errorf(
+		e.Run.Pos,
+		"script at \"run:\" has %d lines, which exceeds the maximum number of lines %d. consider moving the script into a separate file or composite action",
+		l,
+		rule.maxRunLines,
+	)
+	return nil
+}
@@ -0,0 +1,128 @@
+package actionlint
+
+import "strings"
+
+// Properties of "github" context which have the same value across all pull requests.
+var concurrencyGroupConstantGitHubProps = map[string]struct{}{
+	"workflow":            {},
+	"workflow_ref":        {},
+	"repository":          {},
+	"repository_id":       {},
+	"repository_owner":    {},
+	"repository_owner_id": {},
+	"event_name":          {},
+	"server_url":          {},
+	"api_url":             {},
+	"graphql_url":         {},
+	"job":                 {},
+}
+
+// RuleConcurrency is a rule checker to detect "concurrency.group" which is accidentally shared by
+// all runs of workflows triggered by pull requests. Such a group serializes the runs of all pull
+// requests or cancels runs of other pull requests.
+// https://docs.github.com/en/actions/using-jobs/using-concurrency
+type RuleConcurrency struct {
+	RuleBase
+	// event is a name of the pull request event which triggers the current workflow. It is empty
+	// when the workflow is not triggered by pull requests.
+	event string
+}
+
+// NewRuleConcurrency creates new RuleConcurrency instance.
+func NewRuleConcurrency() *RuleConcurrency {
+	return &RuleConcurrency{
+		RuleBase: RuleBase{
+			name: "concurrency",
+			desc: "Checks for \"concurrency.group\" shared by all pull requests in workflows triggered by pull requests",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleConcurrency) VisitWorkflowPre(n *Workflow) error {
+	rule.event = ""
+	for _, e := range n.On {
+		if w, ok := e.(*WebhookEvent); ok {
+			if h := w.EventName(); h == "pull_request" || h == "pull_request_target" {
+				rule.event = h
+				break
+			}
+		}
+	}
+	rule.checkConcurrency(n.Concurrency)
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleConcurrency) VisitJobPre(n *Job) error {
+	rule.checkConcurrency(n.Concurrency)
+	return nil
+}
+
+func (rule *RuleConcurrency) checkConcurrency(c *Concurrency) {
+	if rule.event == "" || c == nil || c.Group == nil {
+		return
+	}
+	g := c.Group
+
+	if !g.ContainsExpression() {
+		rule.Errorf(
+			g.Pos,
+			"concurrency group %q is a constant string. all runs of this workflow triggered by %q event are serialized in the single group across all pull requests. include \"github.ref\" or \"github.head_ref\" in the group like \"${{ github.workflow }}-${{ github.ref }}\"",
+			g.Value,
+			rule.event,
+		)
+		return
+	}
+
+	if !isConcurrencyGroupSharedByPullRequests(g.Value) {
+		return
+	}
+	rule.Errorf(
+		g.Pos,
+		"concurrency group %q does not contain \"github.ref\" nor \"github.head_ref\". all runs of this workflow triggered by %q event share the same group across all pull requests. include \"github.ref\" or \"github.head_ref\" in the group like \"${{ github.workflow }}-${{ github.ref }}\"",
+		g.Value,
+		rule.event,
+	)
+}
+
+// isConcurrencyGroupSharedByPullRequests returns true when all expressions in the group refer only
+// "github" context properties which have the same value across all pull requests. When some
+// expression cannot be parsed, it returns false since the group is unknown.
+func isConcurrencyGroupSharedByPullRequests(s string) bool {
+	for {
+		idx := strings.Index(s, "${{")
+		if idx == -1 {
+			return true
+		}
+		s = s[idx+3:]
+
+		l := NewExprLexer(s)
+		expr, err := NewExprParser().Parse(l)
+		if err != nil {
+			return false
+		}
+
+		shared := true
+		VisitExprNode(expr, func(n, p ExprNode, entering bool) {
+			if !entering {
+				return
+			}
+			v, ok := n.(*VariableNode)
+			if !ok {
+				return
+			}
+			if d, ok := p.(*ObjectDerefNode); ok && d.Receiver == n && strings.ToLower(v.Name) == "github" {
+				if _, ok := concurrencyGroupConstantGitHubProps[strings.ToLower(d.Property)]; ok {
+					return
+				}
+			}
+			shared = false
+		})
+		if !shared {
+			return false
+		}
+
+		s = s[l.Offset():]
+	}
+}
@@ -0,0 +1,487 @@
+package actionlint
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	reContainerPortRange  = regexp.MustCompile(`^([0-9]+)(?:-([0-9]+))?$`)
+	reContainerVolumeName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]+$`)
+	reWindowsAbsPath      = regexp.MustCompile(`^[a-zA-Z]:[\\/]`)
+	reExpressionInOptions = regexp.MustCompile(`(?s)\$\{\{.*?\}\}`)
+)
+
+// dockerCreateFlags is a table from flags of `docker create` command to whether the flag takes
+// a value. Short flags are included without the leading "-".
+// https://docs.docker.com/reference/cli/docker/container/create/
+var dockerCreateFlags = map[string]bool{
+	"add-host":              true,
+	"annotation":            true,
+	"attach":                true,
+	"blkio-weight":          true,
+	"blkio-weight-device":   true,
+	"cap-add":               true,
+	"cap-drop":              true,
+	"cgroup-parent":         true,
+	"cgroupns":              true,
+	"cidfile":               true,
+	"cpu-count":             true,
+	"cpu-percent":           true,
+	"cpu-period":            true,
+	"cpu-quota":             true,
+	"cpu-rt-period":         true,
+	"cpu-rt-runtime":        true,
+	"cpu-shares":            true,
+	"cpus":                  true,
+	"cpuset-cpus":           true,
+	"cpuset-mems":           true,
+	"device":                true,
+	"device-cgroup-rule":    true,
+	"device-read-bps":       true,
+	"device-read-iops":      true,
+	"device-write-bps":      true,
+	"device-write-iops":     true,
+	"disable-content-trust": false,
+	"dns":                   true,
+	"dns-option":            true,
+	"dns-search":            true,
+	"domainname":            true,
+	"entrypoint":            true,
+	"env":                   true,
+	"env-file":              true,
+	"expose":                true,
+	"gpus":                  true,
+	"group-add":             true,
+	"health-cmd":            true,
+	"health-interval":       true,
+	"health-retries":        true,
+	"health-start-interval": true,
+	"health-start-period":   true,
+	"health-timeout":        true,
+	"help":                  false,
+	"hostname":              true,
+	"init":                  false,
+	"interactive":           false,
+	"io-maxbandwidth":       true,
+	"io-maxiops":            true,
+	"ip":                    true,
+	"ip6":                   true,
+	"ipc":                   true,
+	"isolation":             true,
+	"kernel-memory":         true,
+	"label":                 true,
+	"label-file":            true,
+	"link":                  true,
+	"link-local-ip":         true,
+	"log-driver":            true,
+	"log-opt":               true,
+	"mac-address":           true,
+	"memory":                true,
+	"memory-reservation":    true,
+	"memory-swap":           true,
+	"memory-swappiness":     true,
+	"mount":                 true,
+	"name":                  true,
+	"net":                   true,
+	"net-alias":             true,
+	"network":               true,
+	"network-alias":         true,
+	"no-healthcheck":        false,
+	"oom-kill-disable":      false,
+	"oom-score-adj":         true,
+	"pid":                   true,
+	"pids-limit":            true,
+	"platform":              true,
+	"privileged":            false,
+	"publish":               true,
+	"publish-all":           false,
+	"pull":                  true,
+	"quiet":                 false,
+	"read-only":             false,
+	"restart":               true,
+	"rm":                    false,
+	"runtime":               true,
+	"security-opt":          true,
+	"shm-size":              true,
+	"stop-signal":           true,
+	"stop-timeout":          true,
+	"storage-opt":           true,
+	"sysctl":                true,
+	"tmpfs":                 true,
+	"tty":                   false,
+	"ulimit":                true,
+	"user":                  true,
+	"userns":                true,
+	"uts":                   true,
+	"volume":                true,
+	"volume-driver":         true,
+	"volumes-from":          true,
+	"workdir":               true,
+	// Short flags
+	"a": true,
+	"c": true,
+	"e": true,
+	"h": true,
+	"i": false,
+	"l": true,
+	"m": true,
+	"p": true,
+	"P": false,
+	"q": false,
+	"t": false,
+	"u": true,
+	"v": true,
+	"w": true,
+}
+
+// dockerCreateFlagsUnsupported is a table from flags of `docker create` which are not supported on
+// GitHub Actions to the reasons. The runner configures the network of containers by itself.
+var dockerCreateFlagsUnsupported = map[string]string{
+	"network": "the network of the container is created by the runner",
+	"net":     "the network of the container is created by the runner",
+}
+
+// Mount options of volumes at "-v" option of `docker create`.
+// https://docs.docker.com/engine/storage/bind-mounts/
+var dockerVolumeModes = map[string]struct{}{
+	"ro":         {},
+	"rw":         {},
+	"z":          {},
+	"Z":          {},
+	"shared":     {},
+	"slave":      {},
+	"private":    {},
+	"rshared":    {},
+	"rslave":     {},
+	"rprivate":   {},
+	"nocopy":     {},
+	"consistent": {},
+	"cached":     {},
+	"delegated":  {},
+}
+
+// RuleContainer is a rule to check configurations of containers at "container:" and "services:".
+// It checks "ports", "volumes", and "options" are in the formats accepted by Docker.
+// https://docs.github.com/en/actions/using-workflows/workflow-syntax-for-github-actions#jobsjob_idservices
+type RuleContainer struct {
+	RuleBase
+}
+
+// NewRuleContainer creates new RuleContainer instance.
+func NewRuleContainer() *RuleContainer {
+	return &RuleContainer{
+		RuleBase: RuleBase{
+			name: "container",
+			desc: "Checks for ports, volumes, and options of containers at \"container:\" and \"services:\"",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleContainer) VisitJobPre(n *Job) error {
+	if n.Container != nil {
+		rule.checkContainer("\"container\" section", n.Container)
+	}
+	if n.Services != nil {
+		for _, s := range n.Services.Value {
+			rule.checkContainer(fmt.Sprintf("%q service", s.Name.Value), s.Container)
+		}
+	}
+	return nil
+}
+
+func (rule *RuleContainer) checkContainer(where string, n *Container) {
+	for _, p := range n.Ports {
+		rule.checkPort(where, p)
+	}
+	for _, v := range n.Volumes {
+		rule.checkVolume(where, v)
+	}
+	if n.Options != nil {
+		rule.checkOptions(where, n.Options)
+	}
+}
+
+// parsePortRange parses a port number like "8080" or a range of port numbers like "8000-8010".
+func parsePortRange(s string, min int) (int, int, error) {
+	m := reContainerPortRange.FindStringSubmatch(s)
+	if m == nil {
+		return 0, 0, fmt.Errorf("port %q is not a number nor a range of numbers like \"8000-8010\"", s)
+	}
+	start, err := strconv.Atoi(m[1])
+	if err != nil || start < min || 65535 < start {
+		return 0, 0, fmt.Errorf("port number %q must be in range of %d..65535", m[1], min)
+	}
+	if m[2] == "" {
+		return start, start, nil
+	}
+	end, err := strconv.Atoi(m[2])
+	if err != nil || end < min || 65535 < end {
+		return 0, 0, fmt.Errorf("port number %q must be in range of %d..65535", m[2], min)
+	}
+	if end < start {
+		return 0, 0, fmt.Errorf("start of port range %q is larger than its end", s)
+	}
+	return start, end, nil
+}
+
+// checkPortMapping validates the port mapping at "ports" like "127.0.0.1:8080:80/tcp". The format is
+// the same as "-p" option of `docker create`.
+// https://docs.docker.com/engine/network/#published-ports
+func checkPortMapping(s string) error {
+	if i := strings.LastIndexByte(s, '/'); i >= 0 {
+		switch proto := s[i+1:]; proto {
+		case "tcp", "udp", "sctp":
+		default:
+			return fmt.Errorf("protocol %q is invalid. available protocols are \"tcp\", \"udp\", and \"sctp\"", proto)
+		}
+		s = s[:i]
+	}
+
+	ip, hasIP := "", false
+	if strings.HasPrefix(s, "[") {
+		// IPv6 address like "[::1]:8080:80"
+		i := strings.IndexByte(s, ']')
+		if i < 0 {
+			return fmt.Errorf("\"]\" is missing in IPv6 address")
+		}
+		ip = s[1:i]
+		hasIP = true
+		s = s[i+1:]
+		if !strings.HasPrefix(s, ":") {
+			return fmt.Errorf("\":\" is missing after IP address %q", ip)
+		}
+		s = "_" + s // Dummy IP address to split the string into 3 parts
+	}
+
+	ss := strings.Split(s, ":")
+	if len(ss) > 3 {
+		return fmt.Errorf("too many \":\" separators")
+	}
+	if len(ss) == 3 {
+		if !hasIP {
+			ip = ss[0]
+		}
+		hasIP = true
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("IP address %q is invalid", ip)
+		}
+		ss = ss[1:]
+	}
+
+	cs, ce, err := parsePortRange(ss[len(ss)-1], 1)
+	if err != nil {
+		return fmt.Errorf("container %w", err)
+	}
+	if len(ss) == 1 || hasIP && ss[0] == "" {
+		return nil // Host port is omitted like "80" or "127.0.0.1::80"
+	}
+
+	hs, he, err := parsePortRange(ss[0], 0)
+	if err != nil {
+		return fmt.Errorf("host %w", err)
+	}
+	if cs != ce && he-hs != ce-cs {
+		return fmt.Errorf("size of host port range %q does not match size of container port range %q", ss[0], ss[1])
+	}
+	return nil
+}
+
+func (rule *RuleContainer) checkPort(where string, p *String) {
+	if p == nil || p.Value == "" || p.ContainsExpression() {
+		return
+	}
+	if err := checkPortMapping(p.Value); err != nil {
+		rule.Errorf(
+			p.Pos,
+			"port mapping %q at \"ports\" in %s is invalid: %s. it must be in the form of \"[[{ip}:]{host port}:]{container port}[/{protocol}]\" like \"8080:80\"",
+			p.Value,
+			where,
+			err,
+		)
+	}
+}
+
+// checkVolumeMount validates the volume at "volumes" like "my_volume:/data:ro". The format is the
+// same as "-v" option of `docker create`.
+// https://docs.docker.com/engine/storage/volumes/#syntax
+func checkVolumeMount(s string) error {
+	ss := strings.Split(s, ":")
+	if len(ss) > 3 {
+		return fmt.Errorf("too many \":\" separators")
+	}
+
+	src, dst := "", ss[0]
+	if len(ss) >= 2 {
+		src, dst = ss[0], ss[1]
+	}
+
+	if len(ss) >= 2 {
+		switch {
+		case src == "":
+			return fmt.Errorf("source is empty")
+		case strings.HasPrefix(src, "/"):
+			// Absolute path on host
+		case strings.HasPrefix(src, "."), strings.HasPrefix(src, "~"):
+			return fmt.Errorf("path %q on host must be absolute", src)
+		case !reContainerVolumeName.MatchString(src):
+			return fmt.Errorf("source %q is neither an absolute path on host nor a volume name. volume name must match the pattern %q", src, reContainerVolumeName.String())
+		}
+	}
+
+	if dst == "" {
+		return fmt.Errorf("destination path in container is empty")
+	}
+	if !strings.HasPrefix(dst, "/") {
+		return fmt.Errorf("destination path %q in container must be absolute", dst)
+	}
+	if dst == "/" {
+		return fmt.Errorf("destination path in container must not be \"/\"")
+	}
+
+	if len(ss) == 3 {
+		for _, m := range strings.Split(ss[2], ",") {
+			if _, ok := dockerVolumeModes[m]; !ok {
+				ms := make([]string, 0, len(dockerVolumeModes))
+				for m := range dockerVolumeModes {
+					ms = append(ms, m)
+				}
+				return fmt.Errorf("mode %q is invalid. available modes are %s", m, sortedQuotes(ms))
+			}
+		}
+	}
+	return nil
+}
+
+func (rule *RuleContainer) checkVolume(where string, v *String) {
+	if v == nil || v.Value == "" || v.ContainsExpression() {
+		return
+	}
+	if reWindowsAbsPath.MatchString(v.Value) || strings.ContainsRune(v.Value, '\\') {
+		return // Paths on Windows containers are not checked
+	}
+	if err := checkVolumeMount(v.Value); err != nil {
+		rule.Errorf(
+			v.Pos,
+			"volume %q at \"volumes\" in %s is invalid: %s. it must be in the form of \"[{source}:]{destination path}[:{mode}]\" like \"my_docker_volume:/volume_mount\"",
+			v.Value,
+			where,
+			err,
+		)
+	}
+}
+
+// splitShellWords splits the string into words in the same manner as shell. Quotes and escapes
+// with backslashes are handled.
+func splitShellWords(s string) ([]string, error) {
+	words := []string{}
+	var b strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			b.WriteRune(r)
+			escaped = false
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else if r == '\\' && quote == '"' {
+				escaped = true
+			} else {
+				b.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == '\\':
+			escaped = true
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inWord {
+				words = append(words, b.String())
+				b.Reset()
+				inWord = false
+			}
+		default:
+			b.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("quote %c is not closed", quote)
+	}
+	if inWord {
+		words = append(words, b.String())
+	}
+	return words, nil
+}
+
+func (rule *RuleContainer) checkOptions(where string, o *String) {
+	// Spaces in ${{ }} should not split words
+	words, err := splitShellWords(reExpressionInOptions.ReplaceAllString(o.Value, "${{}}"))
+	if err != nil {
+		rule.Errorf(o.Pos, "\"options\" in %s cannot be parsed: %s", where, err)
+		return
+	}
+
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+		if strings.Contains(w, "${{") {
+			continue // The value may be expanded to anything
+		}
+
+		var flags []string
+		hasValue := false
+		if strings.HasPrefix(w, "--") {
+			f := w[2:]
+			if j := strings.IndexByte(f, '='); j >= 0 {
+				f = f[:j]
+				hasValue = true
+			}
+			flags = []string{f}
+		} else if strings.HasPrefix(w, "-") && len(w) > 1 {
+			// Short flags can be combined like "-it"
+			for j, r := range w[1:] {
+				f := string(r)
+				flags = append(flags, f)
+				if dockerCreateFlags[f] {
+					hasValue = j < len(w)-2 // Rest of the word is the value like "-p8080:80"
+					break
+				}
+			}
+		} else {
+			rule.Errorf(o.Pos, "unexpected argument %q in \"options\" in %s. only flags of \"docker create\" command can be specified", w, where)
+			continue
+		}
+
+		for _, f := range flags {
+			name := "--" + f
+			if len(f) == 1 {
+				name = "-" + f
+			}
+			takesValue, ok := dockerCreateFlags[f]
+			if !ok {
+				rule.Errorf(o.Pos, "unknown flag %q in \"options\" in %s. see the document of \"docker create\" command for available flags: https://docs.docker.com/reference/cli/docker/container/create/", name, where)
+				if !hasValue && i+1 < len(words) && !strings.HasPrefix(words[i+1], "-") {
+					i++ // The next word is likely the value of the unknown flag
+				}
+				continue
+			}
+			if reason, ok := dockerCreateFlagsUnsupported[f]; ok {
+				rule.Errorf(o.Pos, "flag %q in \"options\" in %s is not supported on GitHub Actions since %s. see https://docs.github.com/en/actions/using-workflows/workflow-syntax-for-github-actions#jobsjob_idcontaineroptions", name, where, reason)
+			}
+			if takesValue && !hasValue {
+				if i+1 >= len(words) {
+					rule.Errorf(o.Pos, "flag %q in \"options\" in %s requires a value", name, where)
+				}
+				i++ // Skip the value
+			}
+		}
+	}
+}
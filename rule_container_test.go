@@ -0,0 +1,169 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleContainerCheckPortMapping(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  string
+	}{
+		{"80", ""},
+		{"8080:80", ""},
+		{"8080:80/tcp", ""},
+		{"53:53/udp", ""},
+		{"127.0.0.1:8080:80", ""},
+		{"127.0.0.1::80", ""},
+		{"[::1]:8080:80", ""},
+		{"[::1]::80/sctp", ""},
+		{"8000-8010:8000-8010", ""},
+		{"8000-8010:80", ""},
+		{"0:80", ""},
+		{"", `container port "" is not a number`},
+		{"http", `container port "http" is not a number`},
+		{"0", `container port number "0" must be in range of 1..65535`},
+		{"80:65536", `container port number "65536" must be in range`},
+		{"65536:80", `host port number "65536" must be in range of 0..65535`},
+		{":80", `host port "" is not a number`},
+		{"80/http", `protocol "http" is invalid`},
+		{"80/TCP", `protocol "TCP" is invalid`},
+		{"8010-8000:80", `start of port range "8010-8000" is larger than its end`},
+		{"80:8000-8010", `size of host port range "80" does not match`},
+		{"8000-8010:9000-9005", `size of host port range "8000-8010" does not match`},
+		{"localhost:8080:80", `IP address "localhost" is invalid`},
+		{"[::1:8080:80", `"]" is missing`},
+		{"[::1]8080:80", `":" is missing after IP address "::1"`},
+		{"1:2:3:4", `too many ":" separators`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			err := checkPortMapping(tc.input)
+			if tc.want == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Fatalf("error message %q does not contain %q", msg, tc.want)
+			}
+		})
+	}
+}
+
+func TestRuleContainerCheckVolumeMount(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  string
+	}{
+		{"/data", ""},
+		{"my_volume:/data", ""},
+		{"my-volume.1:/data:ro", ""},
+		{"/var/run/docker.sock:/var/run/docker.sock", ""},
+		{"/src:/dst:ro,z", ""},
+		{"data", `destination path "data" in container must be absolute`},
+		{"/", `destination path in container must not be "/"`},
+		{":/data", `source is empty`},
+		{"my_volume:", `destination path in container is empty`},
+		{"./data:/data", `path "./data" on host must be absolute`},
+		{"~/data:/data", `path "~/data" on host must be absolute`},
+		{"a:/data", `source "a" is neither an absolute path on host nor a volume name`},
+		{"my volume:/data", `source "my volume" is neither`},
+		{"/src:/dst:readonly", `mode "readonly" is invalid`},
+		{"/src:/dst:ro,", `mode "" is invalid`},
+		{"/a:/b:ro:rw", `too many ":" separators`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			err := checkVolumeMount(tc.input)
+			if tc.want == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Fatalf("error message %q does not contain %q", msg, tc.want)
+			}
+		})
+	}
+}
+
+func TestRuleContainerSplitShellWords(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  []string
+	}{
+		{"", []string{}},
+		{"  --rm  ", []string{"--rm"}},
+		{"--cpus 1\n--rm", []string{"--cpus", "1", "--rm"}},
+		{`--health-cmd "redis-cli ping"`, []string{"--health-cmd", "redis-cli ping"}},
+		{`--health-cmd 'pg_isready -U "postgres"'`, []string{"--health-cmd", `pg_isready -U "postgres"`}},
+		{`--label a\ b --label "x\"y"`, []string{"--label", "a b", "--label", `x"y`}},
+		{`--env FOO=""`, []string{"--env", "FOO="}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			have, err := splitShellWords(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Join(have, "\x00") != strings.Join(tc.want, "\x00") || len(have) != len(tc.want) {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+		})
+	}
+
+	if _, err := splitShellWords(`--health-cmd "redis-cli ping`); err == nil || !strings.Contains(err.Error(), "quote \" is not closed") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRuleContainerCheckOptions(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  []string
+	}{
+		{"--cpus 1 --memory=1g", nil},
+		{"-it --rm", nil},
+		{"-p8080:80 -e FOO=bar", nil},
+		{"--health-cmd ${{ matrix.cmd }} --health-retries 5", nil},
+		{"${{ matrix.options }} --rm", nil},
+		{"--network host", []string{`flag "--network" in "options" in "container" section is not supported`}},
+		{"--net=host", []string{`flag "--net" in "options" in "container" section is not supported`}},
+		{"--cpu 1 --rm", []string{`unknown flag "--cpu"`}},
+		{"-x", []string{`unknown flag "-x"`}},
+		{"-ix", []string{`unknown flag "-x"`}},
+		{"--rm redis-server", []string{`unexpected argument "redis-server"`}},
+		{"--rm --cpus", []string{`flag "--cpus" in "options" in "container" section requires a value`}},
+		{"--rm -e", []string{`flag "-e" in "options" in "container" section requires a value`}},
+		{`--health-cmd "redis-cli`, []string{`"options" in "container" section cannot be parsed: quote " is not closed`}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			r := NewRuleContainer()
+			r.checkOptions(`"container" section`, &String{Value: tc.input, Pos: &Pos{}})
+			errs := r.Errs()
+			if len(errs) != len(tc.want) {
+				t.Fatalf("wanted %d errors but got %v", len(tc.want), errs)
+			}
+			for i, want := range tc.want {
+				if msg := errs[i].Message; !strings.Contains(msg, want) {
+					t.Errorf("error message %q does not contain %q", msg, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package actionlint
+
+import (
+	"bytes"
+	"path"
+)
+
+// Comment to annotate intended usage of "continue-on-error: true".
+const continueOnErrorAllowComment = "actionlint: allow continue-on-error"
+
+// RuleContinueOnError is a rule checker to report "continue-on-error: true" on jobs and steps
+// matched by configured patterns, since it frequently masks real failures. Intended usage can be
+// annotated with a comment "# actionlint: allow continue-on-error" on the same line or the line
+// above. This rule is disabled by default and enabled by "continue-on-error" in config file.
+type RuleContinueOnError struct {
+	RuleBase
+	jobs  []string
+	steps []string
+	lines [][]byte
+}
+
+// NewRuleContinueOnError creates new RuleContinueOnError instance. The src parameter is a source of
+// the workflow to find comments annotating intended usage.
+func NewRuleContinueOnError(cfg *ContinueOnErrorConfig, src []byte) *RuleContinueOnError {
+	r := &RuleContinueOnError{
+		RuleBase: RuleBase{
+			name: "continue-on-error",
+			desc: "Checks for \"continue-on-error: true\" on jobs and steps matched by configured patterns",
+		},
+		lines: bytes.Split(src, []byte{'\n'}),
+	}
+	if cfg != nil {
+		r.jobs = cfg.Jobs
+		r.steps = cfg.Steps
+	}
+	return r
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleContinueOnError) VisitJobPre(n *Job) error {
+	if !rule.enabled(n.ContinueOnError) || n.ID == nil || !matchContinueOnErrorPatterns(rule.jobs, n.ID.Value) {
+		return nil
+	}
+	rule.Errorf(
+		n.ContinueOnError.Pos,
+		"\"continue-on-error: true\" is set to job %q. it may mask real failures. add a comment %q if this is intended",
+		n.ID.Value,
+		"# "+continueOnErrorAllowComment,
+	)
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleContinueOnError) VisitStep(n *Step) error {
+	if !rule.enabled(n.ContinueOnError) {
+		return nil
+	}
+	if rule.steps != nil && !(n.ID != nil && matchContinueOnErrorPatterns(rule.steps, n.ID.Value)) && !(n.Name != nil && matchContinueOnErrorPatterns(rule.steps, n.Name.Value)) {
+		return nil
+	}
+	rule.Errorf(
+		n.ContinueOnError.Pos,
+		"\"continue-on-error: true\" is set to step. it may mask real failures. add a comment %q if this is intended",
+		"# "+continueOnErrorAllowComment,
+	)
+	return nil
+}
+
+func (rule *RuleContinueOnError) enabled(b *Bool) bool {
+	if b == nil || b.Expression != nil || !b.Value {
+		return false
+	}
+	return !rule.allowed(b.Pos.Line)
+}
+
+// allowed returns true when the line or the line above contains the comment to allow the usage.
+func (rule *RuleContinueOnError) allowed(line int) bool {
+	for _, l := range []int{line, line - 1} {
+		if l < 1 || l > len(rule.lines) {
+			continue
+		}
+		s := rule.lines[l-1]
+		if i := bytes.IndexByte(s, '#'); i >= 0 && bytes.Contains(s[i:], []byte(continueOnErrorAllowComment)) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchContinueOnErrorPatterns(pats []string, s string) bool {
+	if pats == nil {
+		return true
+	}
+	for _, p := range pats {
+		if m, err := path.Match(p, s); err == nil && m {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,290 @@
+package actionlint
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/robfig/cron/v3"
+)
+
+// dependabotPackageEcosystems is a list of package managers supported by Dependabot.
+// https://docs.github.com/en/code-security/dependabot/dependabot-version-updates/configuration-options-for-the-dependabot.yml-file#package-ecosystem-
+var dependabotPackageEcosystems = []string{
+	"bun",
+	"bundler",
+	"cargo",
+	"composer",
+	"devcontainers",
+	"docker",
+	"docker-compose",
+	"dotnet-sdk",
+	"elm",
+	"github-actions",
+	"gitsubmodule",
+	"gomod",
+	"gradle",
+	"helm",
+	"maven",
+	"mix",
+	"npm",
+	"nuget",
+	"pip",
+	"pub",
+	"swift",
+	"terraform",
+	"uv",
+}
+
+// dependabotScheduleIntervals is a list of values of "interval" in "schedule" section.
+var dependabotScheduleIntervals = []string{"daily", "weekly", "monthly", "quarterly", "semiannually", "yearly", "cron"}
+
+// dependabotScheduleDays is a list of values of "day" in "schedule" section.
+var dependabotScheduleDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// dependabotRegistryTypes is a list of types of private registries.
+// https://docs.github.com/en/code-security/dependabot/working-with-dependabot/configuring-access-to-private-registries-for-dependabot
+var dependabotRegistryTypes = []string{
+	"cargo-registry",
+	"composer-repository",
+	"docker-registry",
+	"git",
+	"goproxy-server",
+	"helm-registry",
+	"hex-organization",
+	"hex-repository",
+	"maven-repository",
+	"npm-registry",
+	"nuget-feed",
+	"pub-repository",
+	"python-index",
+	"rubygems-server",
+	"terraform-registry",
+}
+
+var reDependabotScheduleTime = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// RuleDependabot is a rule to check Dependabot configuration file (.github/dependabot.yml).
+// https://docs.github.com/en/code-security/dependabot/dependabot-version-updates/configuration-options-for-the-dependabot.yml-file
+type RuleDependabot struct {
+	RuleBase
+	root string
+	fs   fileSystem
+}
+
+// NewRuleDependabot creates a new RuleDependabot instance. The root parameter is a path to the root
+// directory of the repository. Directories at "directory" are resolved from the root. When it is
+// empty, existence of the directories is not checked.
+func NewRuleDependabot(root string) *RuleDependabot {
+	return &RuleDependabot{
+		RuleBase: RuleBase{
+			name: "dependabot",
+			desc: "Checks for Dependabot configuration file (dependabot.yml) such as package ecosystems, directories, schedules, groups, and registries",
+		},
+		root: root,
+		fs:   osFileSystem{},
+	}
+}
+
+// isDependabotConfigFile returns true when the file path is Dependabot configuration file
+// ".github/dependabot.yml" or ".github/dependabot.yaml".
+func isDependabotConfigFile(path string) bool {
+	b := filepath.Base(path)
+	if b != "dependabot.yml" && b != "dependabot.yaml" {
+		return false
+	}
+	return filepath.Base(filepath.Dir(path)) == ".github"
+}
+
+// CheckDependabot checks the Dependabot configuration syntax tree.
+func (rule *RuleDependabot) CheckDependabot(d *Dependabot) {
+	if d.Version != nil && d.Version.Expression == nil && d.Version.Value != 2 {
+		rule.Errorf(d.Version.Pos, "\"version\" of Dependabot configuration must be 2 but got %d", d.Version.Value)
+	}
+
+	for _, r := range d.Registries {
+		if r.Type != nil && !contains(dependabotRegistryTypes, r.Type.Value) {
+			rule.errorfWithSuggestions(
+				r.Type.Pos,
+				r.Type.Value,
+				dependabotRegistryTypes,
+				"unknown type %q of registry %q. available types are %s",
+				r.Type.Value,
+				r.Name.Value,
+				sortedQuotes(dependabotRegistryTypes),
+			)
+		}
+	}
+
+	type updateKey struct {
+		ecosystem string
+		directory string
+		branch    string
+	}
+	seen := map[updateKey]*Pos{}
+	for _, u := range d.Updates {
+		rule.checkUpdate(u, d.Registries)
+
+		if u.PackageEcosystem == nil || u.Directory == nil {
+			continue
+		}
+		k := updateKey{u.PackageEcosystem.Value, strings.TrimSuffix(u.Directory.Value, "/"), ""}
+		if u.TargetBranch != nil {
+			k.branch = u.TargetBranch.Value
+		}
+		if p, ok := seen[k]; ok {
+			rule.Errorf(
+				u.Pos,
+				"update configuration for %q at directory %q is duplicated. previously defined at %s. combination of \"package-ecosystem\", \"directory\", and \"target-branch\" must be unique",
+				u.PackageEcosystem.Value,
+				u.Directory.Value,
+				p,
+			)
+			continue
+		}
+		seen[k] = u.Pos
+	}
+}
+
+func (rule *RuleDependabot) checkUpdate(u *DependabotUpdate, regs map[string]*DependabotRegistry) {
+	if e := u.PackageEcosystem; e != nil && !contains(dependabotPackageEcosystems, e.Value) {
+		rule.errorfWithSuggestions(
+			e.Pos,
+			e.Value,
+			dependabotPackageEcosystems,
+			"unknown package ecosystem %q. available package ecosystems are %s",
+			e.Value,
+			sortedQuotes(dependabotPackageEcosystems),
+		)
+	}
+
+	if u.Directory != nil && u.Directories != nil {
+		rule.Error(u.Pos, "\"directory\" and \"directories\" cannot be used at the same time in update configuration")
+	}
+	if u.Directory != nil {
+		rule.checkDirectory(u.Directory, false)
+	}
+	for _, d := range u.Directories {
+		rule.checkDirectory(d, true)
+	}
+
+	if u.Schedule != nil {
+		rule.checkSchedule(u.Schedule)
+	}
+
+	for _, g := range u.Groups {
+		rule.checkGroup(g)
+	}
+
+	for _, r := range u.Registries {
+		if r.Value == "*" {
+			continue
+		}
+		if len(regs) == 0 {
+			rule.Errorf(r.Pos, "registry %q is not defined since \"registries\" section is missing in Dependabot configuration", r.Value)
+			continue
+		}
+		if _, ok := regs[r.Value]; !ok {
+			ns := make([]string, 0, len(regs))
+			for _, r := range regs {
+				ns = append(ns, r.Name.Value)
+			}
+			rule.errorfWithSuggestions(
+				r.Pos,
+				r.Value,
+				ns,
+				"registry %q is not defined in \"registries\" section of Dependabot configuration. defined registries are %s",
+				r.Value,
+				sortedQuotes(ns),
+			)
+		}
+	}
+}
+
+func (rule *RuleDependabot) checkDirectory(dir *String, allowGlob bool) {
+	d := dir.Value
+	if !strings.HasPrefix(d, "/") {
+		rule.Errorf(dir.Pos, "directory %q must be an absolute path from the repository root such as %q", d, "/"+d)
+		return
+	}
+	if strings.ContainsAny(d, "*?[") {
+		if !allowGlob {
+			rule.Errorf(dir.Pos, "glob pattern %q is not available at \"directory\". use \"directories\" instead", d)
+		}
+		return
+	}
+	if rule.root == "" {
+		return
+	}
+	p := filepath.Join(rule.root, filepath.FromSlash(d))
+	if s, err := rule.fs.Stat(p); err != nil || !s.IsDir() {
+		rule.Errorf(dir.Pos, "directory %q does not exist in the repository", d)
+	}
+}
+
+func (rule *RuleDependabot) checkSchedule(s *DependabotSchedule) {
+	i := s.Interval
+	if i == nil {
+		return
+	}
+	if !contains(dependabotScheduleIntervals, i.Value) {
+		rule.errorfWithSuggestions(
+			i.Pos,
+			i.Value,
+			dependabotScheduleIntervals,
+			"unknown schedule interval %q. available intervals are %s",
+			i.Value,
+			sortedQuotes(dependabotScheduleIntervals),
+		)
+		return
+	}
+
+	if s.Day != nil {
+		if i.Value != "weekly" {
+			rule.Errorf(s.Day.Pos, "\"day\" is only available when schedule interval is \"weekly\" but it is %q", i.Value)
+		} else if d := strings.ToLower(s.Day.Value); !contains(dependabotScheduleDays, d) {
+			rule.errorfWithSuggestions(
+				s.Day.Pos,
+				d,
+				dependabotScheduleDays,
+				"unknown day %q of schedule. available days are %s",
+				s.Day.Value,
+				sortedQuotes(dependabotScheduleDays),
+			)
+		}
+	}
+
+	if s.Time != nil && !reDependabotScheduleTime.MatchString(s.Time.Value) {
+		rule.Errorf(s.Time.Pos, "time %q of schedule must be in \"hh:mm\" format such as \"09:00\"", s.Time.Value)
+	}
+
+	if i.Value == "cron" {
+		if s.Cronjob == nil {
+			rule.Error(s.Pos, "\"cronjob\" is required in \"schedule\" section when schedule interval is \"cron\"")
+			return
+		}
+		p := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+		if _, err := p.Parse(s.Cronjob.Value); err != nil {
+			rule.Errorf(s.Cronjob.Pos, "invalid CRON format %q at \"cronjob\": %s", s.Cronjob.Value, err.Error())
+		}
+	} else if s.Cronjob != nil {
+		rule.Errorf(s.Cronjob.Pos, "\"cronjob\" is only available when schedule interval is \"cron\" but it is %q", i.Value)
+	}
+}
+
+func (rule *RuleDependabot) checkGroup(g *DependabotGroup) {
+	checkValue := func(s *String, key string, values []string) {
+		if s != nil && !contains(values, s.Value) {
+			rule.Errorf(s.Pos, "value %q at %q of group %q must be one of %s", s.Value, key, g.Name.Value, sortedQuotes(values))
+		}
+	}
+	checkValue(g.AppliesTo, "applies-to", []string{"version-updates", "security-updates"})
+	checkValue(g.DependencyType, "dependency-type", []string{"development", "production"})
+	for _, t := range g.UpdateTypes {
+		checkValue(t, "update-types", []string{"major", "minor", "patch"})
+	}
+
+	if g.Patterns == nil && g.DependencyType == nil && g.UpdateTypes == nil {
+		rule.Errorf(g.Name.Pos, "group %q must have at least one of \"patterns\", \"dependency-type\", and \"update-types\" to select dependencies", g.Name.Value)
+	}
+}
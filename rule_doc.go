@@ -0,0 +1,80 @@
+package actionlint
+
+const checksDocURL = "https://github.com/rhysd/actionlint/blob/main/docs/checks.md"
+
+// ruleDocAnchors is a mapping from kinds of errors reported by built-in rules to the anchors of
+// their sections in docs/checks.md.
+var ruleDocAnchors = map[string]string{
+	"action":                  "check-action-format",
+	"action-metadata":         "action-metadata-syntax",
+	"artifact":                "artifact-name-collision",
+	"cache-key":               "cache-key",
+	"checkout-performance":    "checkout-performance",
+	"codeowners":              "codeowners",
+	"complexity":              "complexity",
+	"concurrency":             "concurrency-group",
+	"container":               "check-container-config",
+	"continue-on-error":       "continue-on-error",
+	"credentials":             "check-hardcoded-credentials",
+	"dependabot":              "dependabot",
+	"deprecated-commands":     "check-deprecated-workflow-commands",
+	"duplicate-steps":         "duplicate-steps",
+	"env-shadowing":           "env-shadowing",
+	"environment":             "environment-exists",
+	"environment-url":         "environment-url",
+	"env-var":                 "check-env-var-names",
+	"events":                  "check-webhook-events",
+	"expression":              "check-syntax-expression",
+	"flake8":                  "check-pyflakes-integ",
+	"gh-token":                "check-gh-token",
+	"github-enterprise":       "github-enterprise",
+	"github-script":           "check-github-script",
+	"glob":                    "check-glob-pattern",
+	"id":                      "check-job-step-ids",
+	"if-cond":                 "if-cond-always-true",
+	"implicit-conversion":     "implicit-conversion",
+	"issue-form":              "issue-forms",
+	"job-needs":               "check-job-deps",
+	"matrix":                  "check-matrix-values",
+	"naming-convention":       "naming-convention",
+	"path-exists":             "check-path-exists",
+	"permissions":             "permissions",
+	"pinned-runner":           "pinned-runner",
+	"psscriptanalyzer":        "check-psscriptanalyzer-integ",
+	"push-filters":            "push-filters",
+	"pyflakes":                "check-pyflakes-integ",
+	"recommend-concurrency":   "recommend-concurrency",
+	"redundant-cache":         "redundant-cache",
+	"redundant-permissions":   "redundant-permissions",
+	"release-notes":           "release-notes",
+	"require-environment":     "require-environment",
+	"require-step-names":      "require-step-names",
+	"require-timeout-minutes": "require-timeout-minutes",
+	"ruff":                    "check-pyflakes-integ",
+	"runner-group":            "runner-group-exists",
+	"runner-image":            "runner-image-deprecation",
+	"runner-label":            "check-runner-labels",
+	"runner-policy":           "runner-policy",
+	"schedule-dispatch":       "schedule-dispatch",
+	"script-checker":          "check-custom-shells",
+	"secrets-and-vars":        "secrets-vars-exist",
+	"shell-name":              "check-shell-names",
+	"shellcheck":              "check-shellcheck-integ",
+	"suggest-matrix":          "suggest-matrix",
+	"syntax-check":            "check-unexpected-keys",
+	"timeout-minutes":         "timeout-minutes-limits",
+	"workflow-call":           "check-reusable-workflows",
+	"workflow-name":           "duplicate-workflow-names",
+	"workflow-template":       "workflow-template",
+	"yaml-style":              "yaml-style",
+}
+
+// ruleDocURL returns the URL of the document of the rule which reports the kind of errors. It
+// returns an empty string for the rules which are not built-in such as custom rules and plugins.
+func ruleDocURL(kind string) string {
+	a, ok := ruleDocAnchors[kind]
+	if !ok {
+		return ""
+	}
+	return checksDocURL + "#" + a
+}
@@ -0,0 +1,31 @@
+package actionlint
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRuleDocAnchorsExist(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("docs", "checks.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := string(b)
+	for kind, a := range ruleDocAnchors {
+		if !strings.Contains(doc, `<a name="`+a+`">`) && !strings.Contains(doc, `<a name="#`+a+`">`) {
+			t.Errorf("anchor %q for rule %q does not exist in docs/checks.md", a, kind)
+		}
+	}
+}
+
+func TestRuleDocURL(t *testing.T) {
+	want := "https://github.com/rhysd/actionlint/blob/main/docs/checks.md#check-shellcheck-integ"
+	if u := ruleDocURL("shellcheck"); u != want {
+		t.Errorf("wanted %q but got %q", want, u)
+	}
+	if u := ruleDocURL("my-custom-rule"); u != "" {
+		t.Errorf("doc URL of custom rule should be empty but got %q", u)
+	}
+}
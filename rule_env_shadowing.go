@@ -0,0 +1,98 @@
+package actionlint
+
+// RuleEnvShadowing is a rule checker to detect environment variables shadowing the ones defined
+// at outer scopes. This rule is disabled by default and enabled by "env-shadowing" in config file.
+type RuleEnvShadowing struct {
+	RuleBase
+	workflowEnv *Env
+	jobEnv      *Env
+}
+
+// NewRuleEnvShadowing creates new RuleEnvShadowing instance.
+func NewRuleEnvShadowing() *RuleEnvShadowing {
+	return &RuleEnvShadowing{
+		RuleBase: RuleBase{
+			name: "env-shadowing",
+			desc: "Checks for environment variables shadowing or redundantly redefining the ones at outer scopes",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleEnvShadowing) VisitWorkflowPre(n *Workflow) error {
+	rule.workflowEnv = n.Env
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleEnvShadowing) VisitJobPre(n *Job) error {
+	rule.jobEnv = n.Env
+	if !hasEnvVars(n.Env) || !hasEnvVars(rule.workflowEnv) {
+		return nil
+	}
+
+	for k, v := range n.Env.Vars {
+		o, ok := rule.workflowEnv.Vars[k]
+		if !ok || v.Value.ContainsExpression() || v.Value.Value != o.Value.Value {
+			continue
+		}
+		rule.Errorf(
+			v.Name.Pos,
+			"environment variable %q is redefined with the same value %q as workflow-level \"env:\" defined at %s. this definition is redundant",
+			v.Name.Value,
+			v.Value.Value,
+			o.Name.Pos.String(),
+		)
+	}
+	return nil
+}
+
+// VisitJobPost is callback when visiting Job node after visiting its children.
+func (rule *RuleEnvShadowing) VisitJobPost(n *Job) error {
+	rule.jobEnv = nil
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleEnvShadowing) VisitStep(n *Step) error {
+	if !hasEnvVars(n.Env) {
+		return nil
+	}
+
+	for k, v := range n.Env.Vars {
+		o, where := rule.findOuterEnvVar(k)
+		if o == nil || v.Value.Value == o.Value.Value {
+			continue
+		}
+		rule.Errorf(
+			v.Name.Pos,
+			"environment variable %q at step-level \"env:\" shadows %s-level one defined at %s with a different value. the value %q is overridden with %q in this step",
+			v.Name.Value,
+			where,
+			o.Name.Pos.String(),
+			o.Value.Value,
+			v.Value.Value,
+		)
+	}
+	return nil
+}
+
+// findOuterEnvVar finds the nearest environment variable definition from the step scope.
+func (rule *RuleEnvShadowing) findOuterEnvVar(key string) (*EnvVar, string) {
+	if hasEnvVars(rule.jobEnv) {
+		if v, ok := rule.jobEnv.Vars[key]; ok {
+			return v, "job"
+		}
+	}
+	if hasEnvVars(rule.workflowEnv) {
+		if v, ok := rule.workflowEnv.Vars[key]; ok {
+			return v, "workflow"
+		}
+	}
+	return nil, ""
+}
+
+func hasEnvVars(env *Env) bool {
+	// When env is set by an expression, its variables are unknown statically
+	return env != nil && env.Expression == nil && len(env.Vars) > 0
+}
@@ -0,0 +1,39 @@
+package actionlint
+
+// RuleEnvironment is a rule to check deployment environments at "environment:" exist in the
+// repository. GitHub creates a new environment automatically when a job refers to an environment
+// which does not exist, so a typo in the name silently runs the job without protection rules and
+// secrets of the intended environment.
+// https://docs.github.com/en/actions/deployment/targeting-different-environments/using-environments-for-deployment
+type RuleEnvironment struct {
+	RuleBase
+	environments remoteNamesChecker
+}
+
+// NewRuleEnvironment creates a new RuleEnvironment instance. The slug parameter is "owner/repo" of
+// the repository where the workflow runs. The environments parameter is a function to fetch names
+// of the environments in the repository. Its second return value is false when the names could not
+// be fetched. In the case, this rule reports nothing.
+func NewRuleEnvironment(slug string, environments func(string) ([]string, bool, error)) *RuleEnvironment {
+	return &RuleEnvironment{
+		RuleBase: RuleBase{
+			name: "environment",
+			desc: "Checks for deployment environments at \"environment:\" which do not exist in the repository",
+		},
+		environments: remoteNamesChecker{
+			slug:     slug,
+			fetch:    environments,
+			what:     "environments",
+			notExist: "environment %q does not exist in repository %q. a new environment without protection rules and secrets is created on running the job",
+			empty:    "no environment is configured in the repository",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleEnvironment) VisitJobPre(n *Job) error {
+	if n.Environment == nil || n.Environment.Name == nil {
+		return nil
+	}
+	return rule.environments.check(&rule.RuleBase, n.Environment.Name)
+}
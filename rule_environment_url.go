@@ -0,0 +1,64 @@
+package actionlint
+
+import (
+	"net/url"
+	"strings"
+)
+
+// RuleEnvironmentURL is a rule to check URLs at "environment.url". The URL is shown in the
+// deployment of the environment. GitHub does not fail the workflow run on an invalid URL. It only
+// reports a warning deep in the run log and the URL is not shown.
+// https://docs.github.com/en/actions/writing-workflows/workflow-syntax-for-github-actions#jobsjob_idenvironment
+type RuleEnvironmentURL struct {
+	RuleBase
+}
+
+// NewRuleEnvironmentURL creates new RuleEnvironmentURL instance.
+func NewRuleEnvironmentURL() *RuleEnvironmentURL {
+	return &RuleEnvironmentURL{
+		RuleBase: RuleBase{
+			name: "environment-url",
+			desc: "Checks for invalid URLs at \"environment.url\"",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleEnvironmentURL) VisitJobPre(n *Job) error {
+	if n.Environment == nil || n.Environment.URL == nil {
+		return nil
+	}
+	u := n.Environment.URL
+	if u.Value == "" {
+		return nil
+	}
+
+	if i := strings.Index(u.Value, "${{"); i >= 0 {
+		// Only the scheme can be checked when the URL is built with expressions like
+		// "https://${{ steps.deploy.outputs.host }}/app"
+		p := strings.ToLower(u.Value[:i])
+		if strings.HasPrefix(p, "http://") || strings.HasPrefix(p, "https://") || strings.HasPrefix("http://", p) || strings.HasPrefix("https://", p) {
+			return nil
+		}
+		rule.Errorf(u.Pos, "URL %q at \"environment.url\" must start with \"http://\" or \"https://\"", u.Value)
+		return nil
+	}
+
+	rule.checkURL(u)
+	return nil
+}
+
+func (rule *RuleEnvironmentURL) checkURL(u *String) {
+	p, err := url.Parse(u.Value)
+	if err != nil {
+		rule.Errorf(u.Pos, "URL %q at \"environment.url\" cannot be parsed: %s", u.Value, err.(*url.Error).Err)
+		return
+	}
+	if p.Scheme != "http" && p.Scheme != "https" {
+		rule.Errorf(u.Pos, "URL %q at \"environment.url\" must be an absolute URL with \"http\" or \"https\" scheme like \"https://example.com\"", u.Value)
+		return
+	}
+	if p.Host == "" {
+		rule.Errorf(u.Pos, "host name is missing in URL %q at \"environment.url\"", u.Value)
+	}
+}
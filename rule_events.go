@@ -1,6 +1,7 @@
 package actionlint
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -14,6 +15,9 @@ import (
 // https://docs.github.com/en/actions/learn-github-actions/events-that-trigger-workflows
 type RuleEvents struct {
 	RuleBase
+	// workflowTemplate is true when the workflow is a workflow template. Placeholders such as
+	// "$cron-daily" are accepted.
+	workflowTemplate bool
 }
 
 // NewRuleEvents creates new RuleEvents instance.
@@ -40,10 +44,11 @@ func (rule *RuleEvents) checkEvent(event Event) {
 		for _, c := range e.Cron {
 			rule.checkCron(c)
 		}
+		rule.checkOverlappingCrons(e.Cron)
 	case *WorkflowDispatchEvent:
 		rule.checkWorkflowDispatchEvent(e)
 	case *RepositoryDispatchEvent:
-		// Nothing to do
+		rule.checkRepositoryDispatchEvent(e)
 	case *WorkflowCallEvent:
 		rule.checkWorkflowCallEvent(e)
 	case *WebhookEvent:
@@ -54,9 +59,36 @@ func (rule *RuleEvents) checkEvent(event Event) {
 }
 
 // https://docs.github.com/en/actions/learn-github-actions/workflow-syntax-for-github-actions#onschedule
+// checkRepositoryDispatchEvent checks the event types at "types:" are declared at
+// "repository-dispatch.types" in config file. The workflow is never triggered by unknown types.
+func (rule *RuleEvents) checkRepositoryDispatchEvent(event *RepositoryDispatchEvent) {
+	if rule.config == nil || rule.config.RepositoryDispatch == nil {
+		return
+	}
+	known := rule.config.RepositoryDispatch.Types
+	if len(known) == 0 {
+		return
+	}
+	for _, t := range event.Types {
+		if t.ContainsExpression() || contains(known, t.Value) {
+			continue
+		}
+		rule.errorfWithSuggestions(
+			t.Pos,
+			t.Value,
+			known,
+			"type %q of \"repository_dispatch\" event is not declared at \"repository-dispatch.types\" in config file. the workflow is never triggered by the type. declared types are %s",
+			t.Value,
+			sortedQuotes(known),
+		)
+	}
+}
+
 func (rule *RuleEvents) checkCron(spec *String) {
-	p := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	sched, err := p.Parse(spec.Value)
+	if rule.workflowTemplate && spec.Value == "$cron-daily" {
+		return
+	}
+	sched, err := parseCronSchedule(spec.Value) // Defined at cron.go
 	if err != nil {
 		rule.Errorf(spec.Pos, "invalid CRON format %q in schedule event: %s", spec.Value, err.Error())
 		return
@@ -74,6 +106,53 @@ func (rule *RuleEvents) checkCron(spec *String) {
 	}
 }
 
+// checkOverlappingCrons checks schedules which run at the same times as other schedules. They only
+// make duplicate runs of the workflow.
+func (rule *RuleEvents) checkOverlappingCrons(specs []*String) {
+	type parsed struct {
+		spec  *String
+		sched *cron.SpecSchedule
+	}
+	ps := make([]parsed, 0, len(specs))
+	for _, s := range specs {
+		if sched, err := parseCronSchedule(s.Value); err == nil { // Defined at cron.go
+			ps = append(ps, parsed{s, sched})
+		}
+	}
+
+	for i, p := range ps {
+		for j, q := range ps {
+			if i == j {
+				continue
+			}
+			if !cronScheduleCovers(q.sched, p.sched) { // Defined at cron.go
+				continue
+			}
+			if cronScheduleCovers(p.sched, q.sched) {
+				if j < i {
+					rule.Errorf(
+						p.spec.Pos,
+						"schedule %q runs at the same times as schedule %q at %s. the workflow runs twice at each time. remove one of them",
+						p.spec.Value,
+						q.spec.Value,
+						q.spec.Pos,
+					)
+					break
+				}
+				continue
+			}
+			rule.Errorf(
+				p.spec.Pos,
+				"all runs of schedule %q are also triggered by broader schedule %q at %s. the workflow runs twice at the overlapping times. remove the redundant schedule",
+				p.spec.Value,
+				q.spec.Value,
+				q.spec.Pos,
+			)
+			break
+		}
+	}
+}
+
 func (rule *RuleEvents) filterNotAvailable(pos *Pos, filter, hook string, available []string) {
 	e := "events"
 	if len(available) < 2 {
@@ -109,13 +188,22 @@ func (rule *RuleEvents) checkExclusiveFilters(filter, ignore *WebhookEventFilter
 	}
 }
 
+// nonWebhookEventNames is a list of event names which are not Webhook events. They are parsed as
+// dedicated events so they are not in AllWebhookTypes.
+var nonWebhookEventNames = []string{"schedule", "workflow_dispatch", "repository_dispatch", "workflow_call"}
+
 // https://docs.github.com/en/actions/learn-github-actions/events-that-trigger-workflows#webhook-events
 func (rule *RuleEvents) checkWebhookEvent(event *WebhookEvent) {
 	hook := event.Hook.Value
 
 	types, ok := AllWebhookTypes[hook]
 	if !ok {
-		rule.Errorf(event.Pos, "unknown Webhook event %q. see https://docs.github.com/en/actions/learn-github-actions/events-that-trigger-workflows#webhook-events for list of all Webhook event names", hook)
+		ns := make([]string, 0, len(AllWebhookTypes)+len(nonWebhookEventNames))
+		for n := range AllWebhookTypes {
+			ns = append(ns, n)
+		}
+		ns = append(ns, nonWebhookEventNames...)
+		rule.errorfWithSuggestions(event.Pos, hook, ns, "unknown Webhook event %q. see https://docs.github.com/en/actions/learn-github-actions/events-that-trigger-workflows#webhook-events for list of all Webhook event names", hook)
 		return
 	}
 
@@ -154,6 +242,234 @@ func (rule *RuleEvents) checkWebhookEvent(event *WebhookEvent) {
 		hook,
 		[]string{"push"},
 	)
+
+	if !rule.checkFilterNeverMatches(event.Branches, "branch", "branches-ignore", hook) {
+		rule.checkRedundantFilterPatterns(event.Branches, hook, true)
+	}
+	if !rule.checkFilterNeverMatches(event.Tags, "tag", "tags-ignore", hook) {
+		rule.checkRedundantFilterPatterns(event.Tags, hook, true)
+	}
+	if !rule.checkFilterNeverMatches(event.Paths, "file", "paths-ignore", hook) {
+		rule.checkRedundantFilterPatterns(event.Paths, hook, true)
+	}
+	rule.checkRedundantFilterPatterns(event.BranchesIgnore, hook, false)
+	rule.checkRedundantFilterPatterns(event.TagsIgnore, hook, false)
+	rule.checkRedundantFilterPatterns(event.PathsIgnore, hook, false)
+
+	// On push event, branches-ignore: ['**'] is used for running workflow only on pushing tags and
+	// vice versa
+	if hook != "push" || event.Tags == nil && event.TagsIgnore == nil {
+		rule.checkIgnoreFilterMatchesAll(event.BranchesIgnore, "branches", hook)
+	}
+	if hook != "push" || event.Branches == nil && event.BranchesIgnore == nil {
+		rule.checkIgnoreFilterMatchesAll(event.TagsIgnore, "tags", hook)
+	}
+	rule.checkIgnoreFilterMatchesAll(event.PathsIgnore, "files", hook)
+}
+
+// checkFilterNeverMatches checks the patterns in the filter such as "branches" can match something.
+// Patterns in the filter are evaluated in order and a pattern starting with "!" excludes strings
+// matched by the preceding patterns. It returns true when some error was reported.
+// https://docs.github.com/en/actions/using-workflows/workflow-syntax-for-github-actions#example-including-and-excluding-branches
+func (rule *RuleEvents) checkFilterNeverMatches(filter *WebhookEventFilter, what, ignore, hook string) bool {
+	if filter.IsEmpty() {
+		return false
+	}
+
+	vs := validFilterPatterns(filter)
+	if len(vs) == 0 {
+		return false
+	}
+
+	excluded := make([]string, 0, len(vs))
+	for i, v := range vs {
+		if strings.HasPrefix(v.Value, "!") {
+			continue
+		}
+		by := ""
+		for _, n := range vs[i+1:] {
+			if strings.HasPrefix(n.Value, "!") && globCovers(n.Value[1:], v.Value) { // Defined at glob.go
+				by = n.Value
+				break
+			}
+		}
+		if by == "" {
+			return false // This pattern can match something
+		}
+		excluded = append(excluded, fmt.Sprintf("%q is excluded by %q", v.Value, by))
+	}
+
+	if len(excluded) == 0 {
+		rule.Errorf(
+			filter.Name.Pos,
+			"all patterns in %q filter of %q event are negated with \"!\" so no %s matches the filter. at least one pattern without \"!\" is necessary. use %q filter to only exclude some patterns",
+			filter.Name.Value,
+			hook,
+			what,
+			ignore,
+		)
+		return true
+	}
+
+	rule.Errorf(
+		filter.Name.Pos,
+		"no %s matches %q filter of %q event since all patterns are excluded by the following negated patterns: %s",
+		what,
+		filter.Name.Value,
+		hook,
+		strings.Join(excluded, ", "),
+	)
+	return true
+}
+
+// checkRedundantFilterPatterns checks duplicate patterns and patterns which have no effect in the
+// filter. They make the filter hard to understand. When negatable is true, patterns starting with
+// "!" are analyzed as negations.
+func (rule *RuleEvents) checkRedundantFilterPatterns(filter *WebhookEventFilter, hook string, negatable bool) {
+	if filter.IsEmpty() {
+		return
+	}
+
+	vs := validFilterPatterns(filter)
+	for j, v := range vs {
+		neg := negatable && strings.HasPrefix(v.Value, "!")
+		pat := v.Value
+		if neg {
+			pat = pat[1:]
+		}
+
+		// Preceding patterns with the same polarity until a pattern with the opposite polarity
+		redundant := false
+		for i := j - 1; i >= 0; i-- {
+			u := vs[i].Value
+			if negatable && strings.HasPrefix(u, "!") != neg {
+				break
+			}
+			if u == v.Value {
+				rule.Errorf(
+					v.Pos,
+					"pattern %q in %q filter of %q event is duplicated. previously defined at %s",
+					v.Value,
+					filter.Name.Value,
+					hook,
+					vs[i].Pos,
+				)
+				redundant = true
+				break
+			}
+			if neg {
+				u = u[1:]
+			}
+			if globCovers(u, pat) { // Defined at glob.go
+				rule.Errorf(
+					v.Pos,
+					"pattern %q in %q filter of %q event is redundant since everything matched by it is already matched by the preceding pattern %q",
+					v.Value,
+					filter.Name.Value,
+					hook,
+					vs[i].Value,
+				)
+				redundant = true
+				break
+			}
+		}
+		if redundant || !negatable {
+			continue
+		}
+
+		if neg {
+			// A negated pattern excludes nothing when it does not overlap with any preceding pattern
+			overlap := false
+			for _, u := range vs[:j] {
+				if !strings.HasPrefix(u.Value, "!") && !globsDisjoint(u.Value, pat) { // Defined at glob.go
+					overlap = true
+					break
+				}
+			}
+			if !overlap {
+				rule.Errorf(
+					v.Pos,
+					"negated pattern %q in %q filter of %q event excludes nothing since no preceding pattern without \"!\" matches the same %s",
+					v.Value,
+					filter.Name.Value,
+					hook,
+					filterTarget(filter.Name.Value),
+				)
+			}
+			continue
+		}
+
+		// A pattern has no effect when it is always excluded by a following negated pattern
+		for _, n := range vs[j+1:] {
+			if strings.HasPrefix(n.Value, "!") && globCovers(n.Value[1:], pat) {
+				rule.Errorf(
+					v.Pos,
+					"pattern %q in %q filter of %q event has no effect since everything matched by it is excluded by the following negated pattern %q",
+					v.Value,
+					filter.Name.Value,
+					hook,
+					n.Value,
+				)
+				break
+			}
+		}
+	}
+}
+
+// validFilterPatterns returns patterns in the filter which are not empty and have no syntax error.
+// Invalid patterns are reported by "glob" rule and cannot be analyzed.
+func validFilterPatterns(filter *WebhookEventFilter) []*String {
+	path := strings.HasPrefix(filter.Name.Value, "paths")
+	vs := make([]*String, 0, len(filter.Values))
+	for _, v := range filter.Values {
+		p := strings.TrimPrefix(v.Value, "!")
+		if p == "" {
+			continue
+		}
+		var errs []InvalidGlobPattern
+		if path {
+			errs = ValidatePathGlob(p)
+		} else {
+			errs = ValidateRefGlob(p)
+		}
+		if len(errs) == 0 {
+			vs = append(vs, v)
+		}
+	}
+	return vs
+}
+
+// filterTarget returns what the patterns in the filter match such as "branches" for "branches" filter.
+func filterTarget(name string) string {
+	switch name {
+	case "branches", "branches-ignore":
+		return "branches"
+	case "tags", "tags-ignore":
+		return "tags"
+	default:
+		return "files"
+	}
+}
+
+// checkIgnoreFilterMatchesAll checks the patterns in the filter such as "branches-ignore" do not
+// ignore everything. When everything is ignored, the event never triggers the workflow.
+func (rule *RuleEvents) checkIgnoreFilterMatchesAll(filter *WebhookEventFilter, what, hook string) {
+	if filter.IsEmpty() {
+		return
+	}
+	for _, v := range filter.Values {
+		if v.Value != "" && globCovers(v.Value, "**") {
+			rule.Errorf(
+				v.Pos,
+				"pattern %q in %q filter matches all %s so %q event never triggers the workflow",
+				v.Value,
+				filter.Name.Value,
+				what,
+				hook,
+			)
+			return
+		}
+	}
 }
 
 func (rule *RuleEvents) checkTypes(hook *String, types []*String, expected []string) {
@@ -1,11 +1,15 @@
 package actionlint
 
 import (
+	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 //go:generate go run ./scripts/generate-availability ./availability.go
+//go:generate go run ./scripts/generate-webhook-payloads ./webhook_payloads.go
 
 type typedExpr struct {
 	ty  ExprType
@@ -19,16 +23,32 @@ type typedExpr struct {
 // - https://docs.github.com/en/actions/learn-github-actions/expressions
 type RuleExpression struct {
 	RuleBase
-	matrixTy         *ObjectType
-	stepsTy          *ObjectType
-	needsTy          *ObjectType
-	secretsTy        *ObjectType
-	inputsTy         *ObjectType
-	dispatchInputsTy *ObjectType
-	jobsTy           *ObjectType
-	workflow         *Workflow
-	localActions     *LocalActionsCache
-	localWorkflows   *LocalReusableWorkflowCache
+	matrixTy            *ObjectType
+	job                 *Job
+	stepsTy             *ObjectType
+	needsTy             *ObjectType
+	secretsTy           *ObjectType
+	inputsTy            *ObjectType
+	dispatchInputsTy    *ObjectType
+	eventTy             *ObjectType
+	jobsTy              *ObjectType
+	workflow            *Workflow
+	localActions        *LocalActionsCache
+	localWorkflows      *LocalReusableWorkflowCache
+	workspace           *hashFilesWorkspace
+	fromJSONTypes       map[string]ExprType
+	strictActionOutputs bool
+	privateActions      privateActions
+	explainer           *exprExplainer
+	action              *Action
+	continuedSteps      map[string]struct{}
+	// dispatchTypes is event types of "repository_dispatch" declared in config file.
+	dispatchTypes []string
+	// clientPayloadTy is the type of "github.event.client_payload" declared in config file.
+	clientPayloadTy ExprType
+	// dispatchActions is possible values of "github.event.action" in the workflow. When this value
+	// is nil, comparisons with the value are not checked.
+	dispatchActions []string
 }
 
 // NewRuleExpression creates new RuleExpression instance.
@@ -55,6 +75,12 @@ func NewRuleExpression(actionsCache *LocalActionsCache, workflowCache *LocalReus
 func (rule *RuleExpression) VisitWorkflowPre(n *Workflow) error {
 	rule.checkString(n.Name, "")
 
+	rule.eventTy = eventPayloadType(n.On)
+	rule.dispatchActions = nil
+	if rule.eventTy != nil {
+		rule.updateRepositoryDispatchEvent(n.On)
+	}
+
 	for _, e := range n.On {
 		switch e := e.(type) {
 		case *WebhookEvent:
@@ -168,10 +194,41 @@ func (rule *RuleExpression) VisitWorkflowPre(n *Workflow) error {
 	rule.checkDefaults(n.Defaults, "")
 	rule.checkConcurrency(n.Concurrency, "concurrency")
 
+	if rule.action != nil {
+		rule.checkActionPre(rule.action)
+	}
+
 	rule.workflow = n
 	return nil
 }
 
+// checkActionPre checks expressions in the action metadata which are evaluated before running the
+// action. It also sets the type of "inputs" context from inputs of the action. All inputs of actions
+// are strings.
+func (rule *RuleExpression) checkActionPre(a *Action) {
+	ity := NewEmptyStrictObjectType()
+	for id, i := range a.Inputs {
+		rule.checkString(i.Default, "")
+		ity.Props[id] = StringType{}
+	}
+	rule.inputsTy = ity
+
+	if r := a.Runs; r != nil {
+		// "post-if" is evaluated after all steps of the job which runs the action. The steps are unknown.
+		// "steps" context at "pre-if" is reported as unavailable context
+		rule.stepsTy = NewEmptyObjectType()
+		rule.checkIfCondition(r.PreIf, "runs.pre-if")
+		rule.checkIfCondition(r.PostIf, "runs.post-if")
+		rule.stepsTy = nil
+		rule.checkString(r.Image, "")
+		rule.checkString(r.PreEntrypoint, "")
+		rule.checkString(r.Entrypoint, "")
+		rule.checkString(r.PostEntrypoint, "")
+		rule.checkStrings(r.Args, "")
+		rule.checkEnv(r.Env, "")
+	}
+}
+
 // VisitWorkflowPost is callback when visiting Workflow node after visiting its children
 func (rule *RuleExpression) VisitWorkflowPost(n *Workflow) error {
 	if e, ok := n.FindWorkflowCallEvent(); ok {
@@ -183,6 +240,11 @@ func (rule *RuleExpression) VisitWorkflowPost(n *Workflow) error {
 
 // VisitJobPre is callback when visiting Job node before visiting its children.
 func (rule *RuleExpression) VisitJobPre(n *Job) error {
+	rule.job = n
+	if rule.explainer != nil {
+		rule.explainer.job = n
+	}
+
 	// Type of needs must be resolved before resolving type of matrix because `needs` context can
 	// be used in matrix configuration.
 	rule.needsTy = rule.calcNeedsType(n)
@@ -206,9 +268,17 @@ func (rule *RuleExpression) VisitJobPre(n *Job) error {
 	if n.RunsOn != nil {
 		if n.RunsOn.LabelsExpr != nil {
 			if ty := rule.checkOneExpression(n.RunsOn.LabelsExpr, "runner label at \"runs-on\" section", "jobs.<job_id>.runs-on"); ty != nil {
-				switch ty.(type) {
+				switch ty := ty.(type) {
 				case *ArrayType, StringType, AnyType:
 					// OK
+				case *ObjectType:
+					// Object form like `runs-on: ${{ fromJSON('{"group":"g","labels":["l"]}') }}` is
+					// only allowed for the whole "runs-on" section
+					if n.RunsOn.Group == nil {
+						rule.checkRunsOnObjectType(ty, n.RunsOn.LabelsExpr.Pos)
+						break
+					}
+					rule.Errorf(n.RunsOn.LabelsExpr.Pos, "type of expression at \"runs-on.labels\" must be string or array but found type %q", ty.String())
 				default:
 					rule.Errorf(n.RunsOn.LabelsExpr.Pos, "type of expression at \"runs-on\" must be string or array but found type %q", ty.String())
 				}
@@ -218,7 +288,19 @@ func (rule *RuleExpression) VisitJobPre(n *Job) error {
 				rule.checkString(l, "jobs.<job_id>.runs-on")
 			}
 		}
-		rule.checkString(n.RunsOn.Group, "jobs.<job_id>.runs-on")
+		if g := n.RunsOn.Group; g != nil {
+			ts := rule.checkString(g, "jobs.<job_id>.runs-on")
+			if len(ts) == 1 && g.IsExpressionAssigned() {
+				switch ts[0].ty.(type) {
+				case StringType, AnyType:
+					// OK
+				case *ObjectType, *ArrayType, NullType:
+					// Already reported by checkTemplateEvaluatedType
+				default:
+					rule.Errorf(&ts[0].pos, "type of expression at \"runs-on.group\" must be string but found type %q", ts[0].ty.String())
+				}
+			}
+		}
 	}
 
 	rule.checkConcurrency(n.Concurrency, "jobs.<job_id>.concurrency")
@@ -248,6 +330,10 @@ func (rule *RuleExpression) VisitJobPre(n *Job) error {
 	rule.checkWorkflowCall(n.WorkflowCall)
 
 	rule.stepsTy = NewEmptyStrictObjectType()
+	rule.continuedSteps = map[string]struct{}{}
+	if rule.explainer != nil {
+		rule.explainer.steps = map[string]*Step{}
+	}
 
 	return nil
 }
@@ -262,10 +348,18 @@ func (rule *RuleExpression) VisitJobPost(n *Job) error {
 	for _, output := range n.Outputs {
 		rule.checkString(output.Value, "jobs.<job_id>.outputs.<output_id>")
 	}
+	if rule.action != nil {
+		// Outputs of composite action are evaluated after all its steps are run
+		for _, o := range rule.action.Outputs {
+			rule.checkString(o.Value, "jobs.<job_id>.outputs.<output_id>")
+		}
+	}
 
 	rule.matrixTy = nil
 	rule.stepsTy = nil
+	rule.continuedSteps = nil
 	rule.needsTy = nil
+	rule.job = nil
 
 	return nil
 }
@@ -275,10 +369,11 @@ func (rule *RuleExpression) VisitStep(n *Step) error {
 	rule.checkString(n.Name, "jobs.<job_id>.steps.name")
 	rule.checkIfCondition(n.If, "jobs.<job_id>.steps.if")
 
-	var spec *String
+	var spec, script *String
 	switch e := n.Exec.(type) {
 	case *ExecRun:
 		rule.checkScriptString(e.Run, "jobs.<job_id>.steps.run")
+		script = e.Run
 		rule.checkString(e.Shell, "")
 		rule.checkString(e.WorkingDirectory, "jobs.<job_id>.steps.working-directory")
 	case *ExecAction:
@@ -290,8 +385,11 @@ func (rule *RuleExpression) VisitStep(n *Step) error {
 				rule.checkString(i.Value, "jobs.<job_id>.steps.with")
 			}
 		}
-		rule.checkString(e.Entrypoint, "")
-		rule.checkString(e.Args, "")
+		rule.checkString(e.Entrypoint, "jobs.<job_id>.steps.with")
+		rule.checkString(e.Args, "jobs.<job_id>.steps.with")
+		if rule.isDockerAction(e.Uses) {
+			rule.checkUntrustedInputsInDockerArgs(e.Args)
+		}
 		spec = e.Uses
 	}
 
@@ -306,17 +404,97 @@ func (rule *RuleExpression) VisitStep(n *Step) error {
 		}
 		// Step ID is case insensitive
 		id := strings.ToLower(n.ID.Value)
+		outputs := rule.getActionOutputsType(spec)
+		if script != nil && rule.action != nil {
+			// Outputs of composite action are usually set by scripts in its steps. Type them to
+			// check the wiring of outputs of the composite action
+			outputs = typeOfScriptOutputs(script.Value)
+		}
 		rule.stepsTy.Props[id] = NewStrictObjectType(map[string]ExprType{
-			"outputs":    rule.getActionOutputsType(spec),
+			"outputs":    outputs,
 			"conclusion": StringType{},
 			"outcome":    StringType{},
 		})
+		if c := n.ContinueOnError; c != nil && c.Expression == nil && c.Value && rule.continuedSteps != nil {
+			rule.continuedSteps[id] = struct{}{}
+		}
+		if rule.explainer != nil {
+			rule.explainer.steps[id] = n
+		}
 	}
 
 	return nil
 }
 
 // Get type of `outputs.<output name>`
+// isDockerAction returns true when the action at "uses:" is known to run a Docker container. Inputs
+// "args" and "entrypoint" are passed to the container only for Docker actions.
+func (rule *RuleExpression) isDockerAction(spec *String) bool {
+	if spec == nil {
+		return false
+	}
+	if strings.HasPrefix(spec.Value, "docker://") {
+		return true
+	}
+	if strings.HasPrefix(spec.Value, "./") {
+		// Error on reading the metadata is reported by getActionOutputsType
+		meta, _, err := rule.localActions.FindMetadata(spec.Value)
+		return err == nil && meta != nil && meta.Runs.Using == "docker"
+	}
+	return false
+}
+
+// checkUntrustedInputsInDockerArgs reports untrusted inputs interpolated into "args" of Docker
+// action without double quotes. The runner splits "args" into arguments with whitespaces so the
+// untrusted input can inject arbitrary arguments to the container. When the entrypoint of the
+// container is a shell, it causes script injection.
+// https://docs.github.com/en/actions/learn-github-actions/workflow-syntax-for-github-actions#jobsjob_idstepswithargs
+func (rule *RuleExpression) checkUntrustedInputsInDockerArgs(args *String) {
+	if args == nil || !args.ContainsExpression() {
+		return
+	}
+
+	line, col := args.Pos.Line, args.Pos.Col
+	if args.Quoted {
+		col++
+	}
+	s := args.Value
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' {
+			quoted = !quoted
+			continue
+		}
+		if !strings.HasPrefix(s[i:], "${{") {
+			continue
+		}
+
+		start := i + 3 // 3 means removing "${{"
+		l := NewExprLexer(s[start:])
+		expr, err := NewExprParser().Parse(l)
+		if err != nil {
+			return // Syntax error is reported by checkString
+		}
+		i = start + l.Offset() - 1
+
+		if quoted {
+			continue
+		}
+
+		u := NewUntrustedInputChecker(BuiltinUntrustedInputs)
+		u.usage = "\"args\" of Docker action without quotes since it may inject arbitrary arguments to the container"
+		VisitExprNode(expr, func(n, _ ExprNode, entering bool) {
+			if !entering {
+				u.OnVisitNodeLeave(n)
+			}
+		})
+		u.OnVisitEnd()
+		for _, err := range u.Errs() {
+			rule.exprError(err, line, col+start)
+		}
+	}
+}
+
 func (rule *RuleExpression) getActionOutputsType(spec *String) *ObjectType {
 	if spec == nil {
 		return NewMapObjectType(StringType{})
@@ -341,15 +519,75 @@ func (rule *RuleExpression) getActionOutputsType(spec *String) *ObjectType {
 		return NewEmptyObjectType()
 	}
 
+	if meta := rule.privateActions.find(spec.Value); meta != nil {
+		return typeOfActionOutputs(meta)
+	}
+
 	// When the action run at this step is a popular action, we know what outputs are set by it.
 	// Set the output names to `steps.{step_id}.outputs.{name}`.
-	if meta, ok := PopularActions[spec.Value]; ok {
+	if meta, ok := FindPopularAction(spec.Value); ok {
 		return typeOfActionOutputs(meta)
 	}
 
+	if rule.strictActionOutputs {
+		if _, meta := popularActionOfSameMajorVersion(spec.Value); meta != nil {
+			return typeOfActionOutputs(meta)
+		}
+	}
+
 	return NewMapObjectType(StringType{})
 }
 
+var reScriptOutput = regexp.MustCompile(`\becho\s+(?:-[a-zA-Z]+\s+)?["']?([a-zA-Z_][a-zA-Z0-9_-]*)(?:=|<<)[^>]*>>\s*["']?\$\{?GITHUB_OUTPUT\b\}?`)
+
+// typeOfScriptOutputs returns the type of outputs of the step which runs the script. Outputs of
+// the step are typed strictly only when all writes to $GITHUB_OUTPUT in the script are in the form
+// of `echo "name=value" >> "$GITHUB_OUTPUT"`. Otherwise the step may set any outputs.
+func typeOfScriptOutputs(script string) *ObjectType {
+	props := map[string]ExprType{}
+	for _, l := range strings.Split(script, "\n") {
+		n := strings.Count(l, "GITHUB_OUTPUT")
+		if n == 0 {
+			continue
+		}
+		ms := reScriptOutput.FindAllStringSubmatch(l, -1)
+		if len(ms) != n {
+			return NewMapObjectType(StringType{}) // Outputs are written in an unknown way
+		}
+		for _, m := range ms {
+			props[strings.ToLower(m[1])] = StringType{} // Output name is case insensitive
+		}
+	}
+	if len(props) == 0 {
+		// The outputs may be written by other programs run by the script
+		return NewMapObjectType(StringType{})
+	}
+	return NewStrictObjectType(props)
+}
+
+var reSemverRef = regexp.MustCompile(`^v?(\d+)(?:\.\d+){0,2}$`)
+
+// popularActionOfSameMajorVersion finds the popular action whose major version is the same as the
+// action spec like "actions/cache@v4.1.2". It returns the spec of the popular action and its
+// metadata. When such action is not found, it returns nil metadata.
+func popularActionOfSameMajorVersion(spec string) (string, *ActionMetadata) {
+	i := strings.LastIndexByte(spec, '@')
+	if i < 0 {
+		return "", nil
+	}
+	m := reSemverRef.FindStringSubmatch(spec[i+1:])
+	if m == nil {
+		return "", nil
+	}
+	for _, v := range []string{"v" + m[1], "v" + m[1] + ".x"} {
+		s := spec[:i+1] + v
+		if meta, ok := FindPopularAction(s); ok {
+			return s, meta
+		}
+	}
+	return "", nil
+}
+
 func (rule *RuleExpression) getWorkflowCallOutputsType(call *WorkflowCall) *ObjectType {
 	if call.Uses == nil {
 		return NewMapObjectType(StringType{})
@@ -619,6 +857,10 @@ func (rule *RuleExpression) checkIfCondition(str *String, workflowKey string) {
 		if len(ts) == 1 {
 			if str.IsExpressionAssigned() {
 				condTy = ts[0].ty
+				src := strings.TrimPrefix(strings.TrimSpace(str.Value), "${{")
+				if expr, err := NewExprParser().Parse(NewExprLexer(src)); err == nil {
+					rule.checkDispatchInputCondition(expr, str.Pos)
+				}
 			}
 		}
 	} else {
@@ -632,9 +874,11 @@ func (rule *RuleExpression) checkIfCondition(str *String, workflowKey string) {
 			return
 		}
 
-		if ty, ok := rule.checkSemanticsOfExprNode(expr, line, col, false, workflowKey); ok {
+		rule.checkPrecedence(expr, src, line, col)
+		if ty, ok := rule.checkSemanticsOfExprNode(expr, src, line, col, false, workflowKey); ok {
 			condTy = ty
 		}
+		rule.checkDispatchInputCondition(expr, str.Pos)
 	}
 
 	if condTy != nil && !(BoolType{}).Assignable(condTy) {
@@ -642,6 +886,60 @@ func (rule *RuleExpression) checkIfCondition(str *String, workflowKey string) {
 	}
 }
 
+// checkDispatchInputCondition checks `github.event.inputs.<name>` of boolean input is not used as
+// condition directly. The value is a string 'true' or 'false' and both are truthy since they are
+// not empty.
+func (rule *RuleExpression) checkDispatchInputCondition(expr ExprNode, pos *Pos) {
+	if rule.dispatchInputsTy == nil {
+		return
+	}
+	switch n := expr.(type) {
+	case *NotOpNode:
+		rule.checkDispatchInputCondition(n.Operand, pos)
+	case *LogicalOpNode:
+		rule.checkDispatchInputCondition(n.Left, pos)
+		rule.checkDispatchInputCondition(n.Right, pos)
+	default:
+		name, ok := dispatchInputName(n)
+		if !ok {
+			return
+		}
+		if _, ok := rule.dispatchInputsTy.Props[name].(BoolType); !ok {
+			return
+		}
+		rule.Errorf(
+			pos,
+			"\"github.event.inputs.%s\" is always a string even if the input is boolean type. the condition does not depend on the input value since both 'true' and 'false' are truthy non-empty strings. use \"inputs.%[1]s\" which is typed as bool instead",
+			name,
+		)
+	}
+}
+
+// checkRunsOnObjectType checks the type of the object evaluated at "runs-on" section. The object
+// must have "group" and/or "labels" properties.
+// https://docs.github.com/en/actions/using-jobs/choosing-the-runner-for-a-job#choosing-runners-in-a-group
+func (rule *RuleExpression) checkRunsOnObjectType(ty *ObjectType, pos *Pos) {
+	for _, name := range sortedKeys(ty.Props) {
+		t := ty.Props[name]
+		switch name {
+		case "group":
+			switch t.(type) {
+			case StringType, AnyType:
+			default:
+				rule.Errorf(pos, "type of \"group\" property in object at \"runs-on\" must be string but found type %q", t.String())
+			}
+		case "labels":
+			switch t.(type) {
+			case *ArrayType, StringType, AnyType:
+			default:
+				rule.Errorf(pos, "type of \"labels\" property in object at \"runs-on\" must be string or array but found type %q", t.String())
+			}
+		default:
+			rule.Errorf(pos, "object at \"runs-on\" can only have \"group\" and \"labels\" properties but found unexpected property %q in type %q", name, ty.String())
+		}
+	}
+}
+
 func (rule *RuleExpression) checkTemplateEvaluatedType(ts []typedExpr) {
 	for _, t := range ts {
 		switch t.ty.(type) {
@@ -717,6 +1015,7 @@ func (rule *RuleExpression) checkExprsIn(s string, pos *Pos, quoted, checkUntrus
 	if quoted {
 		col++ // when the string is quoted like 'foo' or "foo", column should be incremented
 	}
+	src := s
 	offset := 0
 	ts := []typedExpr{}
 	for {
@@ -728,7 +1027,7 @@ func (rule *RuleExpression) checkExprsIn(s string, pos *Pos, quoted, checkUntrus
 		start := idx + 3 // 3 means removing "${{"
 		s = s[start:]
 		offset += start
-		col := col + offset
+		col := col + utf8.RuneCountInString(src[:offset]) // Column is counted in characters
 
 		ty, offsetAfter, ok := rule.checkSemantics(s, line, col, checkUntrusted, workflowKey)
 		if !ok {
@@ -748,20 +1047,350 @@ func (rule *RuleExpression) checkExprsIn(s string, pos *Pos, quoted, checkUntrus
 
 func (rule *RuleExpression) exprError(err *ExprError, lineBase, colBase int) {
 	pos := convertExprLineColToPos(err.Line, err.Column, lineBase, colBase)
-	rule.Error(pos, err.Message)
+	e := errorAt(pos, rule.name, err.Message)
+	e.Suggestions = err.Suggestions
+	rule.errs = append(rule.errs, e)
+}
+
+// checkMatrixWithoutStrategy reports accesses to "matrix" context like `matrix.os` in the job which
+// has no "strategy.matrix" section. "matrix" context is always an empty object in the job so the
+// accesses are evaluated to empty strings. It returns true when some access was reported.
+func (rule *RuleExpression) checkMatrixWithoutStrategy(expr ExprNode, src string, line, col int, workflowKey string) bool {
+	if workflowKey != "" {
+		// When "matrix" context is not available at the key, it is reported by the semantics checker
+		if ctx, _ := WorkflowKeyAvailability(workflowKey); !contains(ctx, "matrix") {
+			return false
+		}
+	}
+
+	var tokens []*Token
+	found := false
+	VisitExprNode(expr, func(n, _ ExprNode, entering bool) {
+		if !entering {
+			return
+		}
+		var recv ExprNode
+		switch n := n.(type) {
+		case *ObjectDerefNode:
+			recv = n.Receiver
+		case *IndexAccessNode:
+			recv = n.Operand
+		case *ArrayDerefNode:
+			recv = n.Receiver
+		default:
+			return
+		}
+		if v, ok := recv.(*VariableNode); !ok || v.Name != "matrix" {
+			return
+		}
+
+		if tokens == nil {
+			ts, _, err := LexExpression(src)
+			if err != nil {
+				return
+			}
+			tokens = ts
+		}
+
+		msg := "%q is accessed but job %q has no \"strategy.matrix\" section. \"matrix\" context is always an empty object in the job and the access is evaluated to an empty value"
+		if rule.isReusableWorkflow() {
+			msg += ". matrix values of the caller job are not passed to the reusable workflow. pass them via \"with:\" of the caller job and use \"inputs\" context instead"
+		}
+		t := n.Token()
+		pos := convertExprLineColToPos(t.Line, t.Column, line, col)
+		rule.Errorf(pos, msg, exprNodeSource(n, tokens, src), rule.job.ID.Value)
+		found = true
+	})
+	return found
+}
+
+// checkStepsInOtherJobs reports accesses to "steps" context like `steps.foo.outputs.bar` where the
+// step "foo" is not defined in the current job but in other jobs of the workflow. "steps" context
+// only contains the steps of the current job so outputs of the step must be passed via outputs of
+// the job and "needs" context. It returns the step IDs of the reported accesses.
+func (rule *RuleExpression) checkStepsInOtherJobs(expr ExprNode, src string, line, col int, workflowKey string) []string {
+	if workflowKey != "" {
+		// When "steps" context is not available at the key, it is reported by the semantics checker
+		if ctx, _ := WorkflowKeyAvailability(workflowKey); !contains(ctx, "steps") {
+			return nil
+		}
+	}
+
+	var tokens []*Token
+	var found []string
+	VisitExprNode(expr, func(n, p ExprNode, entering bool) {
+		if !entering || !isExprAccessNode(n) || (p != nil && isExprAccessNode(p) && exprAccessReceiver(p) == n) {
+			// Check only the outermost node of the access chain like `steps.foo.outputs.bar`
+			return
+		}
+		r, path := exprAccessPath(n)
+		if v, ok := r.(*VariableNode); !ok || v.Name != "steps" || len(path) == 0 || path[0] == "*" {
+			return
+		}
+		id := strings.ToLower(path[0])
+		if _, ok := rule.stepsTy.Props[id]; ok || rule.hasStepInJob(rule.job, id) {
+			// The step is defined in the current job. Accesses to the steps which are not run yet
+			// are reported by the semantics checker
+			return
+		}
+
+		jobs := []string{}
+		for _, j := range rule.workflow.Jobs {
+			if j != rule.job && j.ID != nil && rule.hasStepInJob(j, id) {
+				jobs = append(jobs, j.ID.Value)
+			}
+		}
+		if len(jobs) == 0 {
+			return
+		}
+
+		if tokens == nil {
+			ts, _, err := LexExpression(src)
+			if err != nil {
+				return
+			}
+			tokens = ts
+		}
+
+		t := n.Token()
+		pos := convertExprLineColToPos(t.Line, t.Column, line, col)
+		rule.Errorf(pos, "%s", rule.stepInOtherJobMessage(path, exprNodeSource(n, tokens, src), jobs))
+		found = append(found, id)
+	})
+	return found
 }
 
-func (rule *RuleExpression) checkSemanticsOfExprNode(expr ExprNode, line, col int, checkUntrusted bool, workflowKey string) (ExprType, bool) {
+func (rule *RuleExpression) stepInOtherJobMessage(path []string, src string, jobs []string) string {
+	id := strings.ToLower(path[0])
+	cur := rule.job.ID.Value
+	if len(jobs) > 1 {
+		return fmt.Sprintf(
+			"step %q accessed at %q is not defined in job %q but in other jobs %s. \"steps\" context only contains steps of the current job. set the step output to \"outputs:\" of the job and access it via \"needs.<job_id>.outputs.<name>\" instead",
+			id,
+			src,
+			cur,
+			sortedQuotes(jobs),
+		)
+	}
+
+	job := jobs[0]
+	name := "<name>"
+	if len(path) >= 3 && path[1] == "outputs" && path[2] != "*" {
+		name = path[2]
+	}
+
+	needs := ""
+	if !rule.needsJob(job) {
+		needs = fmt.Sprintf(" after adding %q to \"needs:\" of job %q", job, cur)
+	}
+
+	var out string
+	if j, ok := rule.workflow.Jobs[strings.ToLower(job)]; ok && name != "<name>" {
+		ref := fmt.Sprintf("steps.%s.outputs.%s", id, name)
+		for _, o := range j.Outputs {
+			if o.Name != nil && o.Value != nil && strings.Contains(strings.ToLower(o.Value.Value), ref) {
+				out = o.Name.Value
+				break
+			}
+		}
+	}
+
+	if out != "" {
+		return fmt.Sprintf(
+			"step %q accessed at %q is not defined in job %q but in job %q. \"steps\" context only contains steps of the current job. the step output is already set to output %q of job %q. use \"needs.%s.outputs.%s\" instead%s",
+			id,
+			src,
+			cur,
+			job,
+			out,
+			job,
+			job,
+			out,
+			needs,
+		)
+	}
+	return fmt.Sprintf(
+		"step %q accessed at %q is not defined in job %q but in job %q. \"steps\" context only contains steps of the current job. set the step output to \"outputs:\" of job %q like \"%s: ${{ steps.%s.outputs.%s }}\" and use \"needs.%s.outputs.%s\" instead%s",
+		id,
+		src,
+		cur,
+		job,
+		job,
+		name,
+		id,
+		name,
+		job,
+		name,
+		needs,
+	)
+}
+
+func (rule *RuleExpression) hasStepInJob(j *Job, id string) bool {
+	for _, s := range j.Steps {
+		if s.ID != nil && strings.EqualFold(s.ID.Value, id) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *RuleExpression) needsJob(id string) bool {
+	for _, n := range rule.job.Needs {
+		if strings.EqualFold(n.Value, id) {
+			return true
+		}
+	}
+	return false
+}
+
+func isExprAccessNode(n ExprNode) bool {
+	switch n.(type) {
+	case *ObjectDerefNode, *IndexAccessNode, *ArrayDerefNode:
+		return true
+	default:
+		return false
+	}
+}
+
+func exprAccessReceiver(n ExprNode) ExprNode {
+	switch n := n.(type) {
+	case *ObjectDerefNode:
+		return n.Receiver
+	case *IndexAccessNode:
+		return n.Operand
+	case *ArrayDerefNode:
+		return n.Receiver
+	default:
+		return nil
+	}
+}
+
+// checkConclusionOfContinuedSteps reports comparisons like `steps.foo.conclusion == 'failure'` where
+// the step "foo" has "continue-on-error: true". Conclusion of such step is "success" even if the
+// step failed so the comparison is always evaluated to the same value.
+func (rule *RuleExpression) checkConclusionOfContinuedSteps(expr ExprNode, src string, line, col int) {
+	var tokens []*Token
+	VisitExprNode(expr, func(n, _ ExprNode, entering bool) {
+		if !entering {
+			return
+		}
+		op, ok := n.(*CompareOpNode)
+		if !ok || (op.Kind != CompareOpNodeKindEq && op.Kind != CompareOpNodeKindNotEq) {
+			return
+		}
+		prop, lit := op.Left, op.Right
+		if _, ok := prop.(*StringNode); ok {
+			prop, lit = lit, prop
+		}
+		s, ok := lit.(*StringNode)
+		if !ok || !strings.EqualFold(s.Value, "failure") {
+			return
+		}
+		r, path := exprAccessPath(prop)
+		if v, ok := r.(*VariableNode); !ok || v.Name != "steps" || len(path) != 2 || path[1] != "conclusion" {
+			return
+		}
+		id := strings.ToLower(path[0])
+		if _, ok := rule.continuedSteps[id]; !ok {
+			return
+		}
+
+		if tokens == nil {
+			ts, _, err := LexExpression(src)
+			if err != nil {
+				return
+			}
+			tokens = ts
+		}
+		t := op.Token()
+		pos := convertExprLineColToPos(t.Line, t.Column, line, col)
+		rule.Errorf(
+			pos,
+			"conclusion of step %q is never \"failure\" since the step has \"continue-on-error: true\" so %q is always evaluated to the same value. use \"steps.%s.outcome\" to check the result of the step before \"continue-on-error\" is applied",
+			path[0],
+			exprNodeSource(op, tokens, src),
+			path[0],
+		)
+	})
+}
+
+// checkDeprecatedActionInputs reports accesses to the inputs which have "deprecationMessage" in the
+// steps and the outputs of the composite action. Users of the action are warned not to use such
+// inputs so the action itself should not depend on them.
+func (rule *RuleExpression) checkDeprecatedActionInputs(expr ExprNode, line, col int) {
+	VisitExprNode(expr, func(n, _ ExprNode, entering bool) {
+		if !entering {
+			return
+		}
+		var recv ExprNode
+		var id string
+		switch n := n.(type) {
+		case *ObjectDerefNode:
+			recv, id = n.Receiver, n.Property
+		case *IndexAccessNode:
+			s, ok := n.Index.(*StringNode)
+			if !ok {
+				return
+			}
+			recv, id = n.Operand, strings.ToLower(s.Value)
+		default:
+			return
+		}
+		if v, ok := recv.(*VariableNode); !ok || v.Name != "inputs" {
+			return
+		}
+		i, ok := rule.action.Inputs[id]
+		if !ok || i.DeprecationMessage == nil {
+			return
+		}
+		t := n.Token()
+		pos := convertExprLineColToPos(t.Line, t.Column, line, col)
+		rule.Errorf(pos, "input %q is deprecated but it is still used in the action. the deprecation message is %q", i.Name.Value, i.DeprecationMessage.Value)
+	})
+}
+
+// isReusableWorkflow returns true when the workflow being checked is triggered by "workflow_call".
+func (rule *RuleExpression) isReusableWorkflow() bool {
+	if rule.workflow == nil {
+		return false
+	}
+	for _, e := range rule.workflow.On {
+		if _, ok := e.(*WorkflowCallEvent); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *RuleExpression) checkSemanticsOfExprNode(expr ExprNode, src string, line, col int, checkUntrusted bool, workflowKey string) (ExprType, bool) {
 	var v []string
 	if rule.config != nil {
 		v = rule.config.ConfigVariables
 	}
 	c := NewExprSemanticsChecker(checkUntrusted, v)
+	c.workspace = rule.workspace
+	c.fromJSONTypes = rule.fromJSONTypes
 	if rule.matrixTy != nil {
 		c.UpdateMatrix(rule.matrixTy)
+	} else if rule.action != nil {
+		// Matrix of the job which runs the action is unknown
+		c.UpdateMatrix(NewEmptyObjectType())
+	} else if rule.job != nil && rule.checkMatrixWithoutStrategy(expr, src, line, col, workflowKey) {
+		// Avoid reporting the same accesses as undefined properties again
+		c.UpdateMatrix(NewEmptyObjectType())
 	}
 	if rule.stepsTy != nil {
-		c.UpdateSteps(rule.stepsTy)
+		ty := rule.stepsTy
+		if rule.action == nil && rule.job != nil && rule.workflow != nil && ty.IsStrict() {
+			if ids := rule.checkStepsInOtherJobs(expr, src, line, col, workflowKey); len(ids) > 0 {
+				// Avoid reporting the same accesses as undefined properties again
+				ty = ty.DeepCopy().(*ObjectType)
+				for _, id := range ids {
+					ty.Props[id] = NewEmptyObjectType()
+				}
+			}
+		}
+		c.UpdateSteps(ty)
 	}
 	if rule.needsTy != nil {
 		c.UpdateNeeds(rule.needsTy)
@@ -772,14 +1401,25 @@ func (rule *RuleExpression) checkSemanticsOfExprNode(expr ExprNode, line, col in
 	if rule.inputsTy != nil {
 		c.UpdateInputs(rule.inputsTy)
 	}
+	if rule.eventTy != nil {
+		c.UpdateEvent(rule.eventTy)
+	}
 	if rule.dispatchInputsTy != nil {
 		c.UpdateDispatchInputs(rule.dispatchInputsTy)
 	}
+	if rule.dispatchActions != nil {
+		c.SetRepositoryDispatchTypes(rule.dispatchActions)
+	}
 	if rule.jobsTy != nil {
 		c.UpdateJobs(rule.jobsTy)
 	}
 	if workflowKey != "" {
-		ctx, sp := WorkflowKeyAvailability(workflowKey)
+		var ctx, sp []string
+		if rule.action != nil {
+			ctx, sp = actionKeyAvailability(workflowKey)
+		} else {
+			ctx, sp = WorkflowKeyAvailability(workflowKey)
+		}
 		if len(ctx) == 0 {
 			rule.Debug("No context avaiability was found for workflow key %q", workflowKey)
 		}
@@ -787,14 +1427,75 @@ func (rule *RuleExpression) checkSemanticsOfExprNode(expr ExprNode, line, col in
 		c.SetSpecialFunctionAvailability(sp)
 	}
 
+	// Find the node to explain its type when it is requested by -explain-at
+	var target ExprNode
+	var tokens []*Token
+	if rule.explainer != nil {
+		if ts, _, err := LexExpression(src); err == nil {
+			tokens = ts
+			target = rule.explainer.nodeAt(expr, ts, line, col)
+		}
+		if target != nil {
+			c.nodeTypes = map[ExprNode]ExprType{}
+		}
+	}
+
 	ty, errs := c.Check(expr)
 	for _, err := range errs {
 		rule.exprError(err, line, col)
 	}
+	if len(rule.continuedSteps) > 0 {
+		rule.checkConclusionOfContinuedSteps(expr, src, line, col)
+	}
+	if rule.action != nil && rule.job != nil {
+		rule.checkDeprecatedActionInputs(expr, line, col)
+	}
+
+	if target != nil {
+		t, ok := c.nodeTypes[target]
+		if !ok {
+			t = AnyType{}
+		}
+		rule.explainer.result = &exprExplanation{
+			expr:    exprNodeSource(expr, tokens, src),
+			node:    exprNodeSource(target, tokens, src),
+			ty:      t,
+			sources: rule.explainSources(target),
+		}
+	}
 
 	return ty, len(errs) == 0
 }
 
+// contextsInAction removes the contexts which are not available in action metadata from the contexts
+// available at some workflow key. Composite actions cannot access secrets directly and they don't
+// know the dependencies of the job which runs them.
+func contextsInAction(ctx []string) []string {
+	ret := make([]string, 0, len(ctx))
+	for _, c := range ctx {
+		if c != "secrets" && c != "needs" {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+// actionKeyAvailability returns contexts and special functions availability of the key in action
+// metadata. "runs.pre-if" and "runs.post-if" are keys only in action metadata. Other keys are
+// workflow keys. "steps" context is not available at "runs.pre-if" since no step has run yet.
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#runspre-if
+func actionKeyAvailability(key string) ([]string, []string) {
+	switch key {
+	case "runs.pre-if":
+		return []string{"env", "github", "inputs", "job", "matrix", "runner", "strategy", "vars"}, []string{"always", "cancelled", "failure", "success"}
+	case "runs.post-if":
+		return []string{"env", "github", "inputs", "job", "matrix", "runner", "steps", "strategy", "vars"}, []string{"always", "cancelled", "failure", "success"}
+	default:
+		ctx, sp := WorkflowKeyAvailability(key)
+		return contextsInAction(ctx), sp
+	}
+}
+
 func (rule *RuleExpression) checkSemantics(src string, line, col int, checkUntrusted bool, workflowKey string) (ExprType, int, bool) {
 	l := NewExprLexer(src)
 	p := NewExprParser()
@@ -803,10 +1504,26 @@ func (rule *RuleExpression) checkSemantics(src string, line, col int, checkUntru
 		rule.exprError(err, line, col)
 		return nil, l.Offset(), false
 	}
-	t, ok := rule.checkSemanticsOfExprNode(expr, line, col, checkUntrusted, workflowKey)
+	rule.checkPrecedence(expr, src, line, col)
+	t, ok := rule.checkSemanticsOfExprNode(expr, src, line, col, checkUntrusted, workflowKey)
 	return t, l.Offset(), ok
 }
 
+func (rule *RuleExpression) checkPrecedence(expr ExprNode, src string, line, col int) {
+	ts, _, err := LexExpression(src)
+	if err != nil {
+		return // Unreachable since the expression was already parsed
+	}
+	for _, err := range newExprPrecedenceChecker(ts).check(expr) {
+		if err.severity == "" {
+			rule.exprError(err.ExprError, line, col)
+			continue
+		}
+		pos := convertExprLineColToPos(err.Line, err.Column, line, col)
+		rule.ErrorfWithSeverity(pos, err.severity, "%s", err.Message)
+	}
+}
+
 func (rule *RuleExpression) calcNeedsType(job *Job) *ObjectType {
 	// https://docs.github.com/en/actions/learn-github-actions/contexts#needs-context
 	o := NewEmptyStrictObjectType()
@@ -853,15 +1570,30 @@ func (rule *RuleExpression) checkMatrixExpression(expr *String) *ObjectType {
 	if ty == nil {
 		return NewEmptyObjectType()
 	}
-	matTy, ok := ty.(*ObjectType)
+	o, ok := ty.(*ObjectType)
 	if !ok {
 		return NewEmptyObjectType()
 	}
 
+	// Copy the object type since the type of expression may be shared (e.g. "github.event.inputs")
+	matTy := &ObjectType{Props: make(map[string]ExprType, len(o.Props)), Mapped: o.Mapped}
+	for n, p := range o.Props {
+		matTy.Props[n] = p
+	}
+
+	incTy, hasInc := matTy.Props["include"]
+	delete(matTy.Props, "include")
+	delete(matTy.Props, "exclude")
+
+	// Each row of matrix is an array of values. The type of matrix value is its element type
+	for n, p := range matTy.Props {
+		if a, ok := p.(*ArrayType); ok {
+			matTy.Props[n] = a.Elem
+		}
+	}
+
 	// Consider properties in include section elements since 'include' section adds matrix values
-	incTy, ok := matTy.Props["include"]
-	if ok {
-		delete(matTy.Props, "include")
+	if hasInc {
 		if a, ok := incTy.(*ArrayType); ok {
 			if o, ok := a.Elem.(*ObjectType); ok {
 				for n, p := range o.Props {
@@ -876,8 +1608,6 @@ func (rule *RuleExpression) checkMatrixExpression(expr *String) *ObjectType {
 		}
 	}
 
-	delete(matTy.Props, "exclude")
-
 	return matTy
 }
 
@@ -1073,3 +1803,68 @@ func typeOfActionOutputs(meta *ActionMetadata) *ObjectType {
 	}
 	return NewStrictObjectType(props)
 }
+
+// updateRepositoryDispatchEvent updates the type of "github.event" and possible values of
+// "github.event.action" when the workflow is triggered by "repository_dispatch" event. The value of
+// "github.event.action" is the event type. The types are the ones at "types:" of the event or the
+// ones declared in config file.
+func (rule *RuleExpression) updateRepositoryDispatchEvent(events []Event) {
+	var dispatch *RepositoryDispatchEvent
+	other := false // Whether "github.event.action" is also set by other events
+	for _, e := range events {
+		if d, ok := e.(*RepositoryDispatchEvent); ok {
+			dispatch = d
+		} else if _, ok := webhookPayloadProps[e.EventName()]["action"]; ok {
+			other = true
+		}
+	}
+	if dispatch == nil {
+		return
+	}
+
+	if rule.clientPayloadTy != nil {
+		rule.eventTy.Props["client_payload"] = rule.clientPayloadTy
+	}
+
+	if other {
+		return
+	}
+	ts := make([]string, 0, len(dispatch.Types))
+	for _, t := range dispatch.Types {
+		if t.ContainsExpression() {
+			return
+		}
+		ts = append(ts, t.Value)
+	}
+	if len(ts) == 0 {
+		ts = rule.dispatchTypes
+	}
+	if len(ts) > 0 {
+		rule.dispatchActions = ts
+	}
+}
+
+// eventPayloadType returns the type of "github.event" for the events which trigger the workflow.
+// When the workflow is triggered by multiple events, the type is the union of their payloads.
+// It returns nil when some payload is unknown such as "workflow_call" event whose payload is the
+// one of the caller workflow.
+func eventPayloadType(events []Event) *ObjectType {
+	if len(events) == 0 {
+		return nil
+	}
+	props := map[string]ExprType{}
+	for _, e := range events {
+		ps, ok := webhookPayloadProps[e.EventName()]
+		if !ok {
+			return nil
+		}
+		for n, t := range ps {
+			if p, ok := props[n]; ok {
+				props[n] = p.Merge(t)
+			} else {
+				props[n] = t
+			}
+		}
+	}
+	return NewStrictObjectType(props)
+}
@@ -0,0 +1,202 @@
+package actionlint
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Environment variables to authenticate `gh` command.
+// https://cli.github.com/manual/gh_help_environment
+var ghTokenEnvVars = []string{"GH_TOKEN", "GITHUB_TOKEN", "GH_ENTERPRISE_TOKEN", "GITHUB_ENTERPRISE_TOKEN"}
+
+var (
+	// `gh` command at the position of command like `gh pr list` or `foo | gh issue create`
+	reGHCommand = regexp.MustCompile(`(?:^|[;&|(` + "`" + `]|\$\(|\b(?:then|do|else|sudo|xargs|exec|time)\s)\s*gh\s+(-{0,2}[a-z][a-z-]*)`)
+	// `git push` command at the position of command
+	reGitPushCommand = regexp.MustCompile(`(?:^|[;&|(` + "`" + `]|\$\(|\b(?:then|do|else|sudo|exec|time)\s)\s*git\s+push\b([^;&|#\n]*)`)
+	// Assignment of the token like `export GH_TOKEN=...` or `echo "GH_TOKEN=..." >> "$GITHUB_ENV"`
+	reGHTokenAssign = regexp.MustCompile(`\b(?:GH_TOKEN|GITHUB_TOKEN|GH_ENTERPRISE_TOKEN|GITHUB_ENTERPRISE_TOKEN)=`)
+	// Configurations of Git credentials in scripts
+	reGitCredentialsSetup = regexp.MustCompile(`\bgh\s+auth\s+setup-git\b|x-access-token|\bgit\s+remote\s+set-url\b|extraheader|insteadOf|\bcredential\.|git-credential`)
+)
+
+// Subcommands of `gh` which do not require authentication
+var ghSubcommandsWithoutAuth = map[string]struct{}{
+	"help":       {},
+	"version":    {},
+	"--version":  {},
+	"--help":     {},
+	"-h":         {},
+	"completion": {},
+	"config":     {},
+	"alias":      {},
+	"auth":       {},
+}
+
+// RuleGHToken is a rule to check scripts at "run:" which will fail due to lack of credentials.
+// `gh` command requires a token in GH_TOKEN or GITHUB_TOKEN environment variable on GitHub
+// Actions, and `git push` requires credentials persisted by actions/checkout.
+type RuleGHToken struct {
+	RuleBase
+	lines       []string
+	workflowEnv *Env
+	jobEnv      *Env
+	// tokenInGitHubEnv is true when some previous step in the job may set the token to $GITHUB_ENV.
+	tokenInGitHubEnv bool
+	// ghLoggedIn is true when some previous step in the job runs `gh auth login`.
+	ghLoggedIn bool
+	// noCredentials is the position of "persist-credentials: false" of actions/checkout in previous
+	// steps of the job. It is nil when the credentials are persisted.
+	noCredentials *Pos
+	// gitCredentialsSetup is true when some previous step in the job configures Git credentials.
+	gitCredentialsSetup bool
+}
+
+// NewRuleGHToken creates new RuleGHToken instance. The src parameter is the source of the workflow
+// file to convert positions in scripts into positions in the workflow file. It can be nil.
+func NewRuleGHToken(src []byte) *RuleGHToken {
+	var lines []string
+	if src != nil {
+		lines = strings.Split(string(src), "\n")
+	}
+	return &RuleGHToken{
+		RuleBase: RuleBase{
+			name: "gh-token",
+			desc: "Checks for \"gh\" and \"git push\" commands in scripts run without credentials",
+		},
+		lines: lines,
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleGHToken) VisitWorkflowPre(n *Workflow) error {
+	rule.workflowEnv = n.Env
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleGHToken) VisitJobPre(n *Job) error {
+	rule.jobEnv = n.Env
+	rule.tokenInGitHubEnv = false
+	rule.ghLoggedIn = false
+	rule.noCredentials = nil
+	rule.gitCredentialsSetup = false
+	return nil
+}
+
+// envMayHaveGHToken returns true when the token for `gh` may be set in the env. Env defined with
+// an expression like `env: ${{ fromJSON(...) }}` may have any variables.
+func envMayHaveGHToken(env *Env) bool {
+	if env == nil {
+		return false
+	}
+	if env.Expression != nil {
+		return true
+	}
+	for _, v := range env.Vars {
+		for _, n := range ghTokenEnvVars {
+			if strings.EqualFold(v.Name.Value, n) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleGHToken) VisitStep(n *Step) error {
+	switch e := n.Exec.(type) {
+	case *ExecAction:
+		if e.Uses != nil && strings.HasPrefix(strings.ToLower(e.Uses.Value), "actions/checkout@") {
+			if i, ok := e.Inputs["persist-credentials"]; ok && i.Value != nil && i.Value.Value == "false" {
+				rule.noCredentials = i.Value.Pos
+			} else {
+				rule.noCredentials = nil
+			}
+		}
+	case *ExecRun:
+		if e.Run != nil {
+			rule.checkScript(e.Run, n)
+		}
+	}
+	return nil
+}
+
+func (rule *RuleGHToken) checkScript(run *String, step *Step) {
+	script := run.Value
+	assigned := reGHTokenAssign.MatchString(script)
+	if assigned && strings.Contains(script, "GITHUB_ENV") {
+		rule.tokenInGitHubEnv = true
+	}
+	credentials := reGitCredentialsSetup.MatchString(script)
+
+	hasToken := assigned ||
+		rule.tokenInGitHubEnv ||
+		rule.ghLoggedIn ||
+		envMayHaveGHToken(step.Env) ||
+		envMayHaveGHToken(rule.jobEnv) ||
+		envMayHaveGHToken(rule.workflowEnv)
+	checkGitPush := rule.noCredentials != nil && !rule.gitCredentialsSetup && !credentials
+
+	mapPos := scriptPosMapper(rule.lines, run, run.Pos) // Defined at rule_pyflakes.go
+	loggedIn := false
+	for i, line := range strings.Split(script, "\n") {
+		if j := strings.Index(line, "#"); j >= 0 && (j == 0 || line[j-1] == ' ' || line[j-1] == '\t') {
+			line = line[:j] // Remove comment
+		}
+
+		if !hasToken && !loggedIn {
+			for _, m := range reGHCommand.FindAllStringSubmatchIndex(line, -1) {
+				sub := line[m[2]:m[3]]
+				if sub == "auth" && strings.HasPrefix(strings.TrimSpace(line[m[3]:]), "login") {
+					loggedIn = true // `gh auth login` authenticates the following commands
+				}
+				if _, ok := ghSubcommandsWithoutAuth[sub]; ok {
+					continue
+				}
+				col := strings.LastIndex(line[:m[2]], "gh") + 1
+				rule.Errorf(
+					mapPos(i+1, col),
+					"\"gh %s\" command will fail with an authentication error since none of %s environment variables is set. set a token like \"GH_TOKEN: ${{ github.token }}\" at \"env:\" of the step",
+					sub,
+					quotes(ghTokenEnvVars),
+				)
+				break // Report only one error per line
+			}
+		}
+
+		if checkGitPush {
+			for _, m := range reGitPushCommand.FindAllStringSubmatchIndex(line, -1) {
+				if !isGitPushToOrigin(line[m[2]:m[3]]) {
+					continue
+				}
+				col := strings.LastIndex(line[:m[2]], "git") + 1
+				rule.Errorf(
+					mapPos(i+1, col),
+					"\"git push\" command will fail with an authentication error since credentials are not persisted by actions/checkout due to \"persist-credentials: false\" at %s. configure credentials for the remote before pushing",
+					rule.noCredentials,
+				)
+				break
+			}
+		}
+	}
+
+	if loggedIn {
+		rule.ghLoggedIn = true
+	}
+	if credentials {
+		rule.gitCredentialsSetup = true
+	}
+}
+
+// isGitPushToOrigin returns true when the arguments of `git push` push to "origin" remote. When no
+// remote is specified, the default remote "origin" is used.
+func isGitPushToOrigin(args string) bool {
+	for _, a := range strings.Fields(args) {
+		if strings.HasPrefix(a, "-") {
+			continue
+		}
+		return a == "origin" || a == `"origin"` || a == "'origin'"
+	}
+	return true
+}
@@ -0,0 +1,217 @@
+package actionlint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run ./scripts/generate-ghes-compatibility ./ghes_compatibility.go
+
+// gitHubEnterpriseCompatibility is a compatibility of a feature or an action with GitHub Enterprise
+// Server versions.
+type gitHubEnterpriseCompatibility struct {
+	// since is the GHES version where it is available first. An empty string means that it is not
+	// available on any version.
+	since string
+	// desc is a description of the feature or a reason why the action is not available.
+	desc string
+}
+
+type gitHubEnterpriseVersion struct {
+	major int
+	minor int
+}
+
+func (v gitHubEnterpriseVersion) less(other gitHubEnterpriseVersion) bool {
+	if v.major != other.major {
+		return v.major < other.major
+	}
+	return v.minor < other.minor
+}
+
+// parseGitHubEnterpriseVersion parses GHES version in "MAJOR.MINOR" format like "3.12".
+func parseGitHubEnterpriseVersion(s string) (gitHubEnterpriseVersion, bool) {
+	ma, mi, ok := strings.Cut(s, ".")
+	if !ok {
+		return gitHubEnterpriseVersion{}, false
+	}
+	major, err := strconv.ParseUint(ma, 10, 32)
+	if err != nil {
+		return gitHubEnterpriseVersion{}, false
+	}
+	minor, err := strconv.ParseUint(mi, 10, 32)
+	if err != nil {
+		return gitHubEnterpriseVersion{}, false
+	}
+	return gitHubEnterpriseVersion{int(major), int(minor)}, true
+}
+
+// RuleGitHubEnterprise is a rule checker to detect features which are disabled on GitHub Enterprise
+// Server. When the version of the instance is configured, features and actions which are not
+// available on the version are also detected. This rule is enabled when "github-enterprise" is
+// configured in config file.
+type RuleGitHubEnterprise struct {
+	RuleBase
+	ghes    *GitHubEnterpriseConfig
+	version *gitHubEnterpriseVersion
+}
+
+// NewRuleGitHubEnterprise creates new RuleGitHubEnterprise instance.
+func NewRuleGitHubEnterprise(cfg *GitHubEnterpriseConfig) *RuleGitHubEnterprise {
+	r := &RuleGitHubEnterprise{
+		RuleBase: RuleBase{
+			name: "github-enterprise",
+			desc: "Checks for features which are not available on GitHub Enterprise Server",
+		},
+		ghes: cfg,
+	}
+	if v, ok := parseGitHubEnterpriseVersion(cfg.Version); ok {
+		r.version = &v
+	}
+	return r
+}
+
+// checkFeature checks the feature is available on the instance. It returns true when some error
+// was reported.
+func (rule *RuleGitHubEnterprise) checkFeature(pos *Pos, feature string) bool {
+	if rule.ghes.featureDisabled(feature) {
+		rule.Errorf(
+			pos,
+			"%s is not available on GitHub Enterprise Server since %q feature is disabled at \"github-enterprise.disabled-features\" in config",
+			gitHubEnterpriseFeatures[feature],
+			feature,
+		)
+		return true
+	}
+
+	c, ok := gitHubEnterpriseFeatureCompatibilities[feature]
+	if !ok || rule.available(c) {
+		return false
+	}
+	rule.Errorf(
+		pos,
+		"%s is not available on GitHub Enterprise Server %s configured at \"github-enterprise.version\" in config%s",
+		c.desc,
+		rule.ghes.Version,
+		availableSince(c),
+	)
+	return true
+}
+
+func (rule *RuleGitHubEnterprise) checkAction(uses *String) {
+	if uses.ContainsExpression() || strings.HasPrefix(uses.Value, "./") || strings.HasPrefix(uses.Value, "docker://") {
+		return
+	}
+	spec, ref, ok := strings.Cut(strings.ToLower(uses.Value), "@")
+	if !ok {
+		return
+	}
+	ss := strings.SplitN(spec, "/", 3)
+	if len(ss) < 2 {
+		return
+	}
+	name := ss[0] + "/" + ss[1]
+	major, _, _ := strings.Cut(ref, ".")
+
+	c, ok := gitHubEnterpriseActionCompatibilities[name+"@"+major]
+	if !ok {
+		c, ok = gitHubEnterpriseActionCompatibilities[name]
+	}
+	if !ok || rule.available(c) {
+		return
+	}
+	rule.Errorf(
+		uses.Pos,
+		"action %q is not available on GitHub Enterprise Server %s configured at \"github-enterprise.version\" in config: %s%s",
+		uses.Value,
+		rule.ghes.Version,
+		c.desc,
+		availableSince(c),
+	)
+}
+
+// available returns true when the compatibility is satisfied by the configured version. It always
+// returns true when the version is not configured.
+func (rule *RuleGitHubEnterprise) available(c *gitHubEnterpriseCompatibility) bool {
+	if rule.version == nil {
+		return true
+	}
+	if c.since == "" {
+		return false
+	}
+	v, ok := parseGitHubEnterpriseVersion(c.since)
+	return !ok || !rule.version.less(v)
+}
+
+func availableSince(c *gitHubEnterpriseCompatibility) string {
+	if c.since == "" {
+		return ". it is not available on any version of GitHub Enterprise Server"
+	}
+	return fmt.Sprintf(". it is available since GitHub Enterprise Server %s", c.since)
+}
+
+func (rule *RuleGitHubEnterprise) checkPermissions(p *Permissions) {
+	if p == nil {
+		return
+	}
+	if s, ok := p.Scopes["id-token"]; ok && s.Value != nil && s.Value.Value == "write" {
+		rule.checkFeature(s.Name.Pos, "oidc")
+	}
+	if s, ok := p.Scopes["attestations"]; ok && s.Value != nil && s.Value.Value != "none" {
+		rule.checkFeature(s.Name.Pos, "attestations-permission")
+	}
+	if p.All != nil && p.All.Value == "write-all" {
+		rule.checkFeature(p.All.Pos, "oidc")
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleGitHubEnterprise) VisitWorkflowPre(n *Workflow) error {
+	rule.checkPermissions(n.Permissions)
+	if n.Concurrency != nil {
+		rule.checkFeature(n.Concurrency.Pos, "concurrency")
+	}
+	if n.RunName != nil {
+		rule.checkFeature(n.RunName.Pos, "run-name")
+	}
+	for _, e := range n.On {
+		if w, ok := e.(*WebhookEvent); ok && w.EventName() == "merge_group" {
+			rule.checkFeature(w.Pos, "merge-group-event")
+		}
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleGitHubEnterprise) VisitJobPre(n *Job) error {
+	rule.checkPermissions(n.Permissions)
+	if n.Concurrency != nil {
+		rule.checkFeature(n.Concurrency.Pos, "concurrency")
+	}
+	if n.Environment != nil {
+		rule.checkFeature(n.Environment.Pos, "environments")
+	}
+	if n.WorkflowCall != nil && n.WorkflowCall.Uses != nil {
+		if !rule.checkFeature(n.WorkflowCall.Uses.Pos, "reusable-workflows") && n.WorkflowCall.InheritSecrets {
+			rule.checkFeature(n.WorkflowCall.Uses.Pos, "secrets-inherit")
+		}
+	}
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleGitHubEnterprise) VisitStep(n *Step) error {
+	e, ok := n.Exec.(*ExecAction)
+	if !ok || e.Uses == nil {
+		return nil
+	}
+	u := strings.ToLower(e.Uses.Value)
+	if strings.HasPrefix(u, "actions/cache@") || strings.HasPrefix(u, "actions/cache/") {
+		if rule.checkFeature(e.Uses.Pos, "cache") {
+			return nil
+		}
+	}
+	rule.checkAction(e.Uses)
+	return nil
+}
@@ -0,0 +1,550 @@
+package actionlint
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// githubScriptBindings is a list of variables which are provided to the script by actions/github-script.
+// https://github.com/actions/github-script#actionsgithub-script
+var githubScriptBindings = []string{
+	"github",
+	"context",
+	"core",
+	"glob",
+	"io",
+	"exec",
+	"fetch",
+	"require",
+	"__original_require__",
+}
+
+// jsGlobals is a set of global variables and keywords in JavaScript on Node.js. The key is a name
+// and the value is unused.
+var jsGlobals = map[string]struct{}{
+	// Keywords which can be followed by '.' like `this.foo` or `import.meta`
+	"this":      {},
+	"super":     {},
+	"new":       {},
+	"import":    {},
+	"arguments": {},
+	// JavaScript standard built-in objects
+	"globalThis":           {},
+	"Infinity":             {},
+	"NaN":                  {},
+	"undefined":            {},
+	"Object":               {},
+	"Function":             {},
+	"Boolean":              {},
+	"Symbol":               {},
+	"Error":                {},
+	"AggregateError":       {},
+	"EvalError":            {},
+	"RangeError":           {},
+	"ReferenceError":       {},
+	"SyntaxError":          {},
+	"TypeError":            {},
+	"URIError":             {},
+	"Number":               {},
+	"BigInt":               {},
+	"Math":                 {},
+	"Date":                 {},
+	"String":               {},
+	"RegExp":               {},
+	"Array":                {},
+	"Int8Array":            {},
+	"Uint8Array":           {},
+	"Uint8ClampedArray":    {},
+	"Int16Array":           {},
+	"Uint16Array":          {},
+	"Int32Array":           {},
+	"Uint32Array":          {},
+	"Float32Array":         {},
+	"Float64Array":         {},
+	"BigInt64Array":        {},
+	"BigUint64Array":       {},
+	"Map":                  {},
+	"Set":                  {},
+	"WeakMap":              {},
+	"WeakSet":              {},
+	"WeakRef":              {},
+	"FinalizationRegistry": {},
+	"ArrayBuffer":          {},
+	"SharedArrayBuffer":    {},
+	"DataView":             {},
+	"Atomics":              {},
+	"JSON":                 {},
+	"Promise":              {},
+	"Reflect":              {},
+	"Proxy":                {},
+	"Intl":                 {},
+	// Globals of Node.js
+	"console":         {},
+	"process":         {},
+	"Buffer":          {},
+	"module":          {},
+	"exports":         {},
+	"crypto":          {},
+	"performance":     {},
+	"URL":             {},
+	"URLSearchParams": {},
+	"TextEncoder":     {},
+	"TextDecoder":     {},
+	"AbortController": {},
+	"AbortSignal":     {},
+	"Headers":         {},
+	"Request":         {},
+	"Response":        {},
+	"FormData":        {},
+	"Blob":            {},
+	"WebAssembly":     {},
+}
+
+// jsRegexpAfterKeywords is a set of keywords after which '/' starts a regular expression literal.
+var jsRegexpAfterKeywords = map[string]struct{}{
+	"return":     {},
+	"typeof":     {},
+	"instanceof": {},
+	"in":         {},
+	"of":         {},
+	"new":        {},
+	"delete":     {},
+	"void":       {},
+	"throw":      {},
+	"case":       {},
+	"do":         {},
+	"else":       {},
+	"yield":      {},
+	"await":      {},
+}
+
+type jsTokenKind int
+
+const (
+	jsTokenIdent jsTokenKind = iota
+	jsTokenPunct
+	jsTokenLiteral
+)
+
+type jsToken struct {
+	kind jsTokenKind
+	text string
+	line int
+	col  int
+}
+
+type jsError struct {
+	line int
+	col  int
+	msg  string
+}
+
+// jsLexer is a small lexer of JavaScript. It does not parse JavaScript source but detects lexical
+// errors such as unterminated string literals and unbalanced brackets.
+type jsLexer struct {
+	src    string
+	off    int
+	line   int
+	col    int
+	tokens []*jsToken
+	// brackets is a stack of open brackets. "${" is the start of substitution in template literal.
+	brackets []*jsToken
+}
+
+func (l *jsLexer) peek() rune {
+	if l.off >= len(l.src) {
+		return -1
+	}
+	r, _ := utf8.DecodeRuneInString(l.src[l.off:])
+	return r
+}
+
+func (l *jsLexer) peekAt(i int) byte {
+	if l.off+i >= len(l.src) {
+		return 0
+	}
+	return l.src[l.off+i]
+}
+
+func (l *jsLexer) eat() rune {
+	r, w := utf8.DecodeRuneInString(l.src[l.off:])
+	l.off += w
+	if r == '\n' {
+		l.line++
+		l.col = 1
+	} else {
+		l.col++
+	}
+	return r
+}
+
+func (l *jsLexer) errorf(line, col int, format string, args ...interface{}) *jsError {
+	return &jsError{line, col, fmt.Sprintf(format, args...)}
+}
+
+func (l *jsLexer) regexpAllowed() bool {
+	if len(l.tokens) == 0 {
+		return true
+	}
+	t := l.tokens[len(l.tokens)-1]
+	switch t.kind {
+	case jsTokenIdent:
+		_, ok := jsRegexpAfterKeywords[t.text]
+		return ok
+	case jsTokenLiteral:
+		return false
+	default:
+		// Note: "++" and "--" before '/' are usually postfix operators like `i++ / 2`
+		return t.text != ")" && t.text != "]" && t.text != "++" && t.text != "--"
+	}
+}
+
+func isJSIdentStart(r rune) bool {
+	return r == '$' || r == '_' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || r > unicode.MaxASCII && unicode.IsLetter(r)
+}
+
+func isJSIdentPart(r rune) bool {
+	return isJSIdentStart(r) || '0' <= r && r <= '9' || r > unicode.MaxASCII && (unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Mc, r))
+}
+
+func (l *jsLexer) lexString(q rune, line, col int) *jsError {
+	for {
+		switch l.peek() {
+		case -1, '\n':
+			return l.errorf(line, col, "unterminated string literal")
+		case '\\':
+			l.eat()
+			if l.peek() != -1 {
+				l.eat() // Escaped character including line continuation
+			}
+		case q:
+			l.eat()
+			return nil
+		default:
+			l.eat()
+		}
+	}
+}
+
+// lexTemplate lexes characters in template literal until the end of the literal or "${". It
+// returns true when "${" is found.
+func (l *jsLexer) lexTemplate(line, col int) (bool, *jsError) {
+	for {
+		switch l.peek() {
+		case -1:
+			return false, l.errorf(line, col, "unterminated template literal")
+		case '\\':
+			l.eat()
+			if l.peek() != -1 {
+				l.eat()
+			}
+		case '`':
+			l.eat()
+			return false, nil
+		case '$':
+			l.eat()
+			if l.peek() == '{' {
+				l.eat()
+				return true, nil
+			}
+		default:
+			l.eat()
+		}
+	}
+}
+
+func (l *jsLexer) lexRegexp(line, col int) *jsError {
+	class := false
+	for {
+		switch l.peek() {
+		case -1, '\n':
+			return l.errorf(line, col, "unterminated regular expression literal")
+		case '\\':
+			l.eat()
+			if r := l.peek(); r != -1 && r != '\n' {
+				l.eat()
+			}
+		case '[':
+			l.eat()
+			class = true
+		case ']':
+			l.eat()
+			class = false
+		case '/':
+			l.eat()
+			if !class {
+				for isJSIdentPart(l.peek()) {
+					l.eat() // Flags
+				}
+				return nil
+			}
+		default:
+			l.eat()
+		}
+	}
+}
+
+func (l *jsLexer) open(t *jsToken) {
+	l.brackets = append(l.brackets, t)
+}
+
+func (l *jsLexer) close(r rune, line, col int) (*jsToken, *jsError) {
+	if len(l.brackets) == 0 {
+		return nil, l.errorf(line, col, "unexpected %q", r)
+	}
+	o := l.brackets[len(l.brackets)-1]
+	want := map[string]rune{"(": ')', "[": ']', "{": '}', "${": '}'}[o.text]
+	if r != want {
+		return nil, l.errorf(line, col, "unexpected %q. %q at %d:%d is not closed", r, o.text, o.line, o.col)
+	}
+	l.brackets = l.brackets[:len(l.brackets)-1]
+	return o, nil
+}
+
+func (l *jsLexer) lex() *jsError {
+	if strings.HasPrefix(l.src, "#!") {
+		for r := l.peek(); r != -1 && r != '\n'; r = l.peek() {
+			l.eat() // Skip shebang
+		}
+	}
+
+	for {
+		r := l.peek()
+		if r == -1 {
+			break
+		}
+		line, col := l.line, l.col
+		start := l.off
+
+		switch {
+		case unicode.IsSpace(r) || r == '\uFEFF':
+			l.eat()
+		case r == '/' && l.peekAt(1) == '/':
+			for r := l.peek(); r != -1 && r != '\n'; r = l.peek() {
+				l.eat()
+			}
+		case r == '/' && l.peekAt(1) == '*':
+			l.eat()
+			l.eat()
+			for {
+				if l.peek() == -1 {
+					return l.errorf(line, col, "unterminated comment")
+				}
+				if l.eat() == '*' && l.peek() == '/' {
+					l.eat()
+					break
+				}
+			}
+		case r == '/' && l.regexpAllowed():
+			l.eat()
+			if err := l.lexRegexp(line, col); err != nil {
+				return err
+			}
+			l.tokens = append(l.tokens, &jsToken{jsTokenLiteral, l.src[start:l.off], line, col})
+		case r == '"' || r == '\'':
+			l.eat()
+			if err := l.lexString(r, line, col); err != nil {
+				return err
+			}
+			l.tokens = append(l.tokens, &jsToken{jsTokenLiteral, l.src[start:l.off], line, col})
+		case r == '`':
+			l.eat()
+			subst, err := l.lexTemplate(line, col)
+			if err != nil {
+				return err
+			}
+			l.tokens = append(l.tokens, &jsToken{jsTokenLiteral, l.src[start:l.off], line, col})
+			if subst {
+				l.open(&jsToken{jsTokenPunct, "${", line, col})
+			}
+		case '0' <= r && r <= '9' || r == '.' && '0' <= l.peekAt(1) && l.peekAt(1) <= '9':
+			for {
+				p := l.peek()
+				if p == '.' || p == '_' || '0' <= p && p <= '9' || 'a' <= p && p <= 'z' || 'A' <= p && p <= 'Z' {
+					l.eat()
+					continue
+				}
+				if (p == '+' || p == '-') && strings.ContainsAny(l.src[l.off-1:l.off], "eE") && !strings.HasPrefix(l.src[start:], "0x") && !strings.HasPrefix(l.src[start:], "0X") {
+					l.eat() // Exponent like 1e+10
+					continue
+				}
+				break
+			}
+			l.tokens = append(l.tokens, &jsToken{jsTokenLiteral, l.src[start:l.off], line, col})
+		case isJSIdentStart(r) || r == '\\':
+			l.eat()
+			for p := l.peek(); isJSIdentPart(p) || p == '\\'; p = l.peek() {
+				l.eat()
+			}
+			l.tokens = append(l.tokens, &jsToken{jsTokenIdent, l.src[start:l.off], line, col})
+		case r == '#':
+			l.eat()
+			if !isJSIdentStart(l.peek()) {
+				return l.errorf(line, col, "unexpected character %q", r)
+			}
+			for isJSIdentPart(l.peek()) {
+				l.eat()
+			}
+			// Private name like #foo is not a reference to variable
+			l.tokens = append(l.tokens, &jsToken{jsTokenLiteral, l.src[start:l.off], line, col})
+		case r == '(' || r == '[' || r == '{':
+			l.eat()
+			t := &jsToken{jsTokenPunct, string(r), line, col}
+			l.open(t)
+			l.tokens = append(l.tokens, t)
+		case r == ')' || r == ']' || r == '}':
+			l.eat()
+			o, err := l.close(r, line, col)
+			if err != nil {
+				return err
+			}
+			if o.text != "${" {
+				l.tokens = append(l.tokens, &jsToken{jsTokenPunct, string(r), line, col})
+				break
+			}
+			// End of substitution in template literal. Continue lexing the template literal
+			subst, err := l.lexTemplate(o.line, o.col)
+			if err != nil {
+				return err
+			}
+			l.tokens = append(l.tokens, &jsToken{jsTokenLiteral, l.src[start:l.off], line, col})
+			if subst {
+				l.open(o)
+			}
+		case r == '?' && l.peekAt(1) == '.' && !('0' <= l.peekAt(2) && l.peekAt(2) <= '9'):
+			l.eat()
+			l.eat()
+			l.tokens = append(l.tokens, &jsToken{jsTokenPunct, "?.", line, col})
+		case (r == '+' || r == '-') && l.peekAt(1) == byte(r):
+			l.eat()
+			l.eat()
+			l.tokens = append(l.tokens, &jsToken{jsTokenPunct, l.src[start:l.off], line, col})
+		case strings.ContainsRune("+-*/%=<>!&|^~?:;,.", r):
+			l.eat()
+			l.tokens = append(l.tokens, &jsToken{jsTokenPunct, string(r), line, col})
+		default:
+			l.eat()
+			return l.errorf(line, col, "unexpected character %q", r)
+		}
+	}
+
+	if len(l.brackets) > 0 {
+		o := l.brackets[len(l.brackets)-1]
+		if o.text == "${" {
+			return l.errorf(o.line, o.col, "unterminated template literal")
+		}
+		return l.errorf(o.line, o.col, "%q is not closed", o.text)
+	}
+
+	return nil
+}
+
+// lexJavaScript splits the JavaScript source into tokens. When the source has a lexical error, it
+// returns the error as 2nd return value.
+func lexJavaScript(src string) ([]*jsToken, *jsError) {
+	l := &jsLexer{src: src, line: 1, col: 1}
+	if err := l.lex(); err != nil {
+		return nil, err
+	}
+	return l.tokens, nil
+}
+
+// jsUndefinedVariables finds variables which are used as objects of property accesses like `foo.bar`
+// but never defined in the source. To avoid false positives without parsing the source, a variable
+// is considered as defined when it appears at any other place such as `const foo = ...`,
+// `(foo) => ...`, or `{ foo }`.
+func jsUndefinedVariables(tokens []*jsToken, bindings []string) []*jsToken {
+	defined := map[string]struct{}{}
+	for _, b := range bindings {
+		defined[b] = struct{}{}
+	}
+	for n := range jsGlobals {
+		defined[n] = struct{}{}
+	}
+
+	candidates := []*jsToken{}
+	for i, t := range tokens {
+		if t.kind != jsTokenIdent {
+			continue
+		}
+		if i > 0 && tokens[i-1].kind == jsTokenPunct && (tokens[i-1].text == "." || tokens[i-1].text == "?.") {
+			continue // Property name
+		}
+		if i+1 < len(tokens) && tokens[i+1].kind == jsTokenPunct && (tokens[i+1].text == "." || tokens[i+1].text == "?.") {
+			candidates = append(candidates, t)
+		} else {
+			defined[t.text] = struct{}{}
+		}
+	}
+
+	undefined := []*jsToken{}
+	for _, t := range candidates {
+		if strings.Trim(t.text, "_") == "" {
+			continue // Placeholder of ${{ }}
+		}
+		if _, ok := defined[t.text]; !ok {
+			defined[t.text] = struct{}{} // Report the same variable only once
+			undefined = append(undefined, t)
+		}
+	}
+	return undefined
+}
+
+// RuleGitHubScript is a rule to check JavaScript source at "script" input of actions/github-script.
+// https://github.com/actions/github-script
+type RuleGitHubScript struct {
+	RuleBase
+	lines []string
+}
+
+// NewRuleGitHubScript creates new RuleGitHubScript instance. The src parameter is the source of the
+// workflow file to convert positions in scripts into positions in the workflow file. It can be nil.
+func NewRuleGitHubScript(src []byte) *RuleGitHubScript {
+	var lines []string
+	if src != nil {
+		lines = strings.Split(string(src), "\n")
+	}
+	return &RuleGitHubScript{
+		RuleBase: RuleBase{
+			name: "github-script",
+			desc: "Checks for JavaScript source at \"script\" input of actions/github-script",
+		},
+		lines: lines,
+	}
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleGitHubScript) VisitStep(n *Step) error {
+	e, ok := n.Exec.(*ExecAction)
+	if !ok || e.Uses == nil || !strings.HasPrefix(e.Uses.Value, "actions/github-script@") {
+		return nil
+	}
+	i, ok := e.Inputs["script"]
+	if !ok || i.Value == nil {
+		return nil
+	}
+
+	src := sanitizeExpressionsInScript(i.Value.Value)           // Defined at rule_shellcheck.go
+	mapPos := scriptPosMapper(rule.lines, i.Value, i.Value.Pos) // Defined at rule_pyflakes.go
+
+	tokens, err := lexJavaScript(src)
+	if err != nil {
+		rule.Errorf(mapPos(err.line, err.col), "syntax error in script of actions/github-script at %d:%d: %s", err.line, err.col, err.msg)
+		return nil
+	}
+
+	for _, t := range jsUndefinedVariables(tokens, githubScriptBindings) {
+		rule.Errorf(
+			mapPos(t.line, t.col),
+			"variable %q at %d:%d in script of actions/github-script is not defined. variables provided by actions/github-script are %s",
+			t.text,
+			t.line,
+			t.col,
+			quotes(githubScriptBindings),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleGitHubScriptLexValidScripts(t *testing.T) {
+	tests := []struct {
+		what string
+		src  string
+	}{
+		{"empty", ""},
+		{"property access", "console.log(context.repo.owner)"},
+		{"strings", `const s = 'it\'s' + "\"quoted\"" + 'line\
+continuation'`},
+		{"template literal", "const s = `hello ${context.actor}!`"},
+		{"nested template literal", "const s = `a ${`b ${ {c: 1}.c }`} d`"},
+		{"template literal with multiple lines", "const s = `\nfoo\n${1 + 2}\nbar\n`"},
+		{"regular expression", "const m = /^v(\\d+)\\/[}/]/gi.exec(s)"},
+		{"regular expression after return", "function f() { return /[/]/.test(s) }"},
+		{"regular expression after paren", "if (/foo/.test(s)) {}"},
+		{"division", "const x = (a + b) / 2 / c"},
+		{"division after postfix increment", "const x = i++ / 2"},
+		{"division after property", "const x = a.length / 2"},
+		{"line comment", "// it's a comment with {\nconst x = 1"},
+		{"block comment", "/* it's a comment\n with ( */ const x = 1"},
+		{"numbers", "const x = 1e+10 + 0x1F + 1_000 + .5 + 1.5e-3 + 10n"},
+		{"optional chaining", "const x = a?.b?.[0] ?? (b ? .5 : 1)"},
+		{"private field", "class A { #x = 1; get() { return this.#x } }"},
+		{"shebang", "#!/usr/bin/env node\nconsole.log(1)"},
+		{"non-ASCII identifier", "const 変数 = 1; console.log(変数)"},
+		{"top-level await", "const { data } = await github.rest.pulls.get({ owner: 'o', repo: 'r', pull_number: 1 })"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			if _, err := lexJavaScript(tc.src); err != nil {
+				t.Fatalf("unexpected error at %d:%d: %s", err.line, err.col, err.msg)
+			}
+		})
+	}
+}
+
+func TestRuleGitHubScriptLexInvalidScripts(t *testing.T) {
+	tests := []struct {
+		what      string
+		src       string
+		line, col int
+		msg       string
+	}{
+		{"unterminated string", "const s = 'foo\nconsole.log(s)", 1, 11, "unterminated string literal"},
+		{"unterminated template literal", "const s = `foo ${a}\nbar", 1, 11, "unterminated template literal"},
+		{"unterminated substitution", "const s = `foo ${a", 1, 11, "unterminated template literal"},
+		{"unterminated comment", "/* foo\nbar", 1, 1, "unterminated comment"},
+		{"unterminated regular expression", "const r = /foo\n", 1, 11, "unterminated regular expression literal"},
+		{"unclosed bracket", "if (a) {\n  console.log(a)\n", 1, 8, `"{" is not closed`},
+		{"unexpected bracket", "console.log(a))", 1, 15, `unexpected ')'`},
+		{"mismatched bracket", "foo(a]", 1, 6, `unexpected ']'. "(" at 1:4 is not closed`},
+		{"unexpected character", "const a = @foo", 1, 11, `unexpected character '@'`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := lexJavaScript(tc.src)
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if err.line != tc.line || err.col != tc.col || err.msg != tc.msg {
+				t.Fatalf("wanted %d:%d: %s but got %d:%d: %s", tc.line, tc.col, tc.msg, err.line, err.col, err.msg)
+			}
+		})
+	}
+}
+
+func TestRuleGitHubScriptUndefinedVariables(t *testing.T) {
+	tests := []struct {
+		what string
+		src  string
+		want []string
+	}{
+		{"bindings", "core.setOutput('x', context.repo.owner); await github.rest.issues.get({})", nil},
+		{"globals", "console.log(JSON.stringify(process.env)); Math.max(1, 2)", nil},
+		{"declared variables", "const fs = require('fs'); let {data} = x; fs.readFileSync(data.path)", nil},
+		{"parameters", "items.map((item) => item.name); function f(a, b) { return a.b }", []string{"items"}},
+		{"properties", "context.payload.octokit.foo", nil},
+		{"undefined variable", "octokit.rest.issues.get({})", []string{"octokit"}},
+		{"optional chaining", "pr?.number", []string{"pr"}},
+		{"reported once", "foo.a; foo.b; foo.c", []string{"foo"}},
+		{"placeholder", "________________.foo", nil},
+		{"in template literal", "`${actions.x}`", []string{"actions"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			tokens, err := lexJavaScript(tc.src)
+			if err != nil {
+				t.Fatalf("unexpected error at %d:%d: %s", err.line, err.col, err.msg)
+			}
+			have := []string{}
+			for _, t := range jsUndefinedVariables(tokens, githubScriptBindings) {
+				have = append(have, t.text)
+			}
+			if strings.Join(have, ",") != strings.Join(tc.want, ",") {
+				t.Fatalf("wanted %v but got %v", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestRuleGitHubScriptCheckScriptInput(t *testing.T) {
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/github-script@v7
+        with:
+          script: |
+            const title = '${{ github.event.head_commit.message }}'
+            console.log(title
+      - uses: actions/github-script@v7
+        with:
+          script: octokit.rest.issues.list()
+      - uses: actions/github-script@v7
+        with:
+          script: >
+            console.log(pr.number)
+      - uses: actions/checkout@v4
+        with:
+          script: console.log(
+      - run: echo hello
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	r := NewRuleGitHubScript([]byte(src))
+	v := NewVisitor()
+	v.AddPass(r)
+	if err := v.Visit(w); err != nil {
+		t.Fatal(err)
+	}
+	errs = r.Errs()
+
+	want := []struct {
+		line, col int
+		msg       string
+	}{
+		{10, 24, `syntax error in script of actions/github-script at 2:12: "(" is not closed`},
+		{13, 19, `variable "octokit" at 1:1 in script of actions/github-script is not defined`},
+		{16, 19, `variable "pr" at 1:13 in script of actions/github-script is not defined`},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("wanted %d errors but got %d: %v", len(want), len(errs), errs)
+	}
+	for i, w := range want {
+		e := errs[i]
+		if e.Line != w.line || e.Column != w.col || !strings.HasPrefix(e.Message, w.msg) {
+			t.Errorf("wanted %d:%d: %s but got %d:%d: %s", w.line, w.col, w.msg, e.Line, e.Column, e.Message)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package actionlint
+
+import (
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RuleImplicitConversion is a rule checker to detect unquoted YAML scalars which are implicitly
+// converted to other values. For example, "python-version: 3.10" is parsed as number 3.1 and the
+// action receives "3.1". It checks matrix values, environment variables, inputs at "with:", and
+// default values of inputs.
+type RuleImplicitConversion struct {
+	RuleBase
+}
+
+// NewRuleImplicitConversion creates new RuleImplicitConversion instance.
+func NewRuleImplicitConversion() *RuleImplicitConversion {
+	return &RuleImplicitConversion{
+		RuleBase: RuleBase{
+			name: "implicit-conversion",
+			desc: "Checks for unquoted values like 3.10 which are implicitly converted to other values by YAML parser",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleImplicitConversion) VisitWorkflowPre(n *Workflow) error {
+	rule.checkEnv(n.Env)
+	for _, e := range n.On {
+		switch e := e.(type) {
+		case *WorkflowDispatchEvent:
+			for _, i := range e.Inputs {
+				if i.Type != WorkflowDispatchEventInputTypeBoolean && i.Type != WorkflowDispatchEventInputTypeNumber && i.Name != nil {
+					rule.checkString(i.Default, "default value of input "+strconv.Quote(i.Name.Value))
+				}
+			}
+		case *WorkflowCallEvent:
+			for _, i := range e.Inputs {
+				if i.Type == WorkflowCallEventInputTypeString && i.Name != nil {
+					rule.checkString(i.Default, "default value of input "+strconv.Quote(i.Name.Value))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleImplicitConversion) VisitJobPre(n *Job) error {
+	rule.checkEnv(n.Env)
+	if n.Strategy == nil || n.Strategy.Matrix == nil {
+		return nil
+	}
+	m := n.Strategy.Matrix
+	for _, r := range m.Rows {
+		for _, v := range r.Values {
+			rule.checkRawYAML(v, r.Name.Value)
+		}
+	}
+	for _, cs := range []*MatrixCombinations{m.Include, m.Exclude} {
+		if cs == nil {
+			continue
+		}
+		for _, c := range cs.Combinations {
+			for _, a := range c.Assigns {
+				rule.checkRawYAML(a.Value, a.Key.Value)
+			}
+		}
+	}
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleImplicitConversion) VisitStep(n *Step) error {
+	rule.checkEnv(n.Env)
+	if e, ok := n.Exec.(*ExecAction); ok {
+		for _, i := range e.Inputs {
+			rule.checkString(i.Value, "input "+strconv.Quote(i.Name.Value)+" at \"with:\"")
+		}
+	}
+	return nil
+}
+
+func (rule *RuleImplicitConversion) checkEnv(e *Env) {
+	if e == nil {
+		return
+	}
+	for _, v := range e.Vars {
+		rule.checkString(v.Value, "environment variable "+strconv.Quote(v.Name.Value))
+	}
+}
+
+func (rule *RuleImplicitConversion) checkRawYAML(v RawYAMLValue, key string) {
+	switch v := v.(type) {
+	case *RawYAMLString:
+		if !v.Quoted {
+			rule.checkScalar(v.Value, v.Pos(), "matrix value of "+strconv.Quote(key))
+		}
+	case *RawYAMLArray:
+		for _, e := range v.Elems {
+			rule.checkRawYAML(e, key)
+		}
+	case *RawYAMLObject:
+		for k, p := range v.Props {
+			rule.checkRawYAML(p, k)
+		}
+	}
+}
+
+func (rule *RuleImplicitConversion) checkString(s *String, what string) {
+	if s != nil && !s.Quoted {
+		rule.checkScalar(s.Value, s.Pos, what)
+	}
+}
+
+func (rule *RuleImplicitConversion) checkScalar(v string, pos *Pos, what string) {
+	if v == "" || ContainsExpression(v) {
+		return
+	}
+
+	if _, ok := yamlTruthyValues[strings.ToLower(v)]; ok && !strings.EqualFold(v, "true") && !strings.EqualFold(v, "false") {
+		if c := rule.config; c != nil && c.YAMLStyle != nil && c.YAMLStyle.Truthy {
+			return // Already reported by "yaml-style" rule
+		}
+		rule.ErrorfWithSeverity(
+			pos,
+			"warning",
+			"%s is %s which may be parsed as boolean by some YAML parsers. quote the value like '%s' if it is a string",
+			what,
+			v,
+			v,
+		)
+		return
+	}
+
+	c, ok := convertedYAMLNumber(v)
+	if !ok || c == v {
+		return
+	}
+	rule.ErrorfWithSeverity(
+		pos,
+		"warning",
+		"%s is %s which is parsed as number and it is converted to string %q. quote the value like '%s' to keep it as-is",
+		what,
+		v,
+		c,
+		v,
+	)
+}
+
+// convertedYAMLNumber returns the string representation of the number when the plain scalar is
+// parsed as number. The second return value is false when the scalar is not a number.
+func convertedYAMLNumber(v string) (string, bool) {
+	var n yaml.Node
+	if err := yaml.Unmarshal([]byte(v), &n); err != nil || len(n.Content) != 1 {
+		return "", false
+	}
+	c := n.Content[0]
+	switch c.Tag {
+	case "!!int":
+		var i int64
+		if err := c.Decode(&i); err != nil {
+			return "", false
+		}
+		return strconv.FormatInt(i, 10), true
+	case "!!float":
+		var f float64
+		if err := c.Decode(&f); err != nil {
+			return "", false
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,225 @@
+package actionlint
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// issueFormElementAttributes is a map from types of body elements in issue forms to their
+// available attributes.
+// https://docs.github.com/en/communities/using-templates-to-encourage-useful-issues-and-pull-requests/syntax-for-githubs-form-schema
+var issueFormElementAttributes = map[string][]string{
+	"markdown":   {"value"},
+	"textarea":   {"label", "description", "placeholder", "value", "render"},
+	"input":      {"label", "description", "placeholder", "value"},
+	"dropdown":   {"label", "description", "multiple", "options", "default"},
+	"checkboxes": {"label", "description", "options"},
+}
+
+var issueFormElementTypes = []string{"markdown", "textarea", "input", "dropdown", "checkboxes"}
+
+var reIssueFormElementID = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+var reIssueFormProject = regexp.MustCompile(`^[a-zA-Z0-9_.-]+/[0-9]+$`)
+
+// RuleIssueForm is a rule to check issue forms in ".github/ISSUE_TEMPLATE" directory.
+// https://docs.github.com/en/communities/using-templates-to-encourage-useful-issues-and-pull-requests/syntax-for-issue-forms
+type RuleIssueForm struct {
+	RuleBase
+}
+
+// NewRuleIssueForm creates a new RuleIssueForm instance.
+func NewRuleIssueForm() *RuleIssueForm {
+	return &RuleIssueForm{
+		RuleBase: RuleBase{
+			name: "issue-form",
+			desc: "Checks for issue forms in \".github/ISSUE_TEMPLATE\" directory such as types and attributes of body elements",
+		},
+	}
+}
+
+// isIssueFormFile returns true when the file path is an issue form in ".github/ISSUE_TEMPLATE"
+// directory. "config.yml" in the directory is a configuration of template chooser, not an issue form.
+func isIssueFormFile(path string) bool {
+	if !strings.HasSuffix(path, ".yml") && !strings.HasSuffix(path, ".yaml") {
+		return false
+	}
+	b := filepath.Base(path)
+	if b == "config.yml" || b == "config.yaml" {
+		return false
+	}
+	d := filepath.Dir(path)
+	return filepath.Base(d) == "ISSUE_TEMPLATE" && filepath.Base(filepath.Dir(d)) == ".github"
+}
+
+// CheckIssueForm checks the issue form syntax tree.
+func (rule *RuleIssueForm) CheckIssueForm(f *IssueForm) {
+	rule.checkUniqueStrings(f.Labels, "label")
+	rule.checkUniqueStrings(f.Assignees, "assignee")
+	for _, p := range f.Projects {
+		if !reIssueFormProject.MatchString(p.Value) {
+			rule.Errorf(p.Pos, "project %q in issue form must be in \"{owner}/{project number}\" format such as \"octo-org/44\"", p.Value)
+		}
+	}
+
+	if f.Body == nil {
+		return
+	}
+
+	ids := map[string]*Pos{}
+	labels := map[string]*Pos{}
+	hasInput := false
+	for _, e := range f.Body {
+		if e.Type == nil {
+			continue
+		}
+		t := e.Type.Value
+		if _, ok := issueFormElementAttributes[t]; !ok {
+			rule.errorfWithSuggestions(
+				e.Type.Pos,
+				t,
+				issueFormElementTypes,
+				"unknown type %q of element in \"body\" section. available types are %s",
+				t,
+				sortedQuotes(issueFormElementTypes),
+			)
+			continue
+		}
+		if t != "markdown" {
+			hasInput = true
+		}
+
+		rule.checkElement(e)
+
+		if e.ID != nil {
+			if !reIssueFormElementID.MatchString(e.ID.Value) {
+				rule.Errorf(e.ID.Pos, "ID %q of element in \"body\" section can only contain alphanumeric characters, \"-\", and \"_\"", e.ID.Value)
+			} else if p, ok := ids[e.ID.Value]; ok {
+				rule.Errorf(e.ID.Pos, "ID %q of element in \"body\" section is duplicated. previously defined at %s", e.ID.Value, p)
+			} else {
+				ids[e.ID.Value] = e.ID.Pos
+			}
+		}
+
+		if a := e.Attributes; a != nil && a.Label != nil && t != "markdown" {
+			if p, ok := labels[a.Label.Value]; ok {
+				rule.Errorf(a.Label.Pos, "label %q of element in \"body\" section is duplicated. previously defined at %s", a.Label.Value, p)
+			} else {
+				labels[a.Label.Value] = a.Label.Pos
+			}
+		}
+	}
+
+	if !hasInput && len(f.Body) > 0 {
+		rule.Error(f.Body[0].Pos, "\"body\" section of issue form must contain at least one element other than \"markdown\"")
+	}
+}
+
+func (rule *RuleIssueForm) checkUniqueStrings(ss []*String, what string) {
+	seen := make(map[string]*Pos, len(ss))
+	for _, s := range ss {
+		v := strings.TrimSpace(s.Value)
+		if v == "" {
+			rule.Errorf(s.Pos, "%s in issue form must not be empty", what)
+			continue
+		}
+		if p, ok := seen[v]; ok {
+			rule.Errorf(s.Pos, "%s %q in issue form is duplicated. previously defined at %s", what, v, p)
+			continue
+		}
+		seen[v] = s.Pos
+	}
+}
+
+func (rule *RuleIssueForm) checkElement(e *IssueFormElement) {
+	t := e.Type.Value
+
+	if t == "markdown" {
+		if e.ID != nil {
+			rule.Error(e.ID.Pos, "\"id\" is not available for \"markdown\" element")
+		}
+	}
+	if e.Validations != nil && (t == "markdown" || t == "checkboxes") {
+		rule.Errorf(e.Validations, "\"validations\" is not available for %q element", t)
+	}
+
+	a := e.Attributes
+	if a == nil {
+		rule.Errorf(e.Pos, "\"attributes\" is required in %q element", t)
+		return
+	}
+
+	avail := issueFormElementAttributes[t]
+	for _, k := range a.Keys {
+		if !contains(avail, k.Value) {
+			rule.Errorf(
+				k.Pos,
+				"attribute %q is not available for %q element. available attributes are %s",
+				k.Value,
+				t,
+				sortedQuotes(avail),
+			)
+		}
+	}
+
+	if t == "markdown" {
+		if a.Value == nil {
+			rule.Error(a.Pos, "\"value\" attribute is required for \"markdown\" element")
+		}
+		return
+	}
+
+	if a.Label == nil {
+		rule.Errorf(a.Pos, "\"label\" attribute is required for %q element", t)
+	}
+
+	switch t {
+	case "dropdown":
+		rule.checkDropdownOptions(a)
+	case "checkboxes":
+		if a.Options == nil {
+			rule.Error(a.Pos, "\"options\" attribute is required for \"checkboxes\" element")
+		}
+		for _, o := range a.Options {
+			if !o.Mapping {
+				rule.Error(o.Pos, "option of \"checkboxes\" element must be a mapping with \"label\" key")
+			}
+		}
+	}
+}
+
+func (rule *RuleIssueForm) checkDropdownOptions(a *IssueFormAttributes) {
+	if a.Options == nil {
+		rule.Error(a.Pos, "\"options\" attribute is required for \"dropdown\" element")
+		return
+	}
+
+	seen := make(map[string]*Pos, len(a.Options))
+	for _, o := range a.Options {
+		if o.Mapping {
+			rule.Error(o.Pos, "option of \"dropdown\" element must be a string")
+			continue
+		}
+		if o.Label == nil {
+			continue
+		}
+		v := strings.TrimSpace(o.Label.Value)
+		if v == "" {
+			rule.Error(o.Label.Pos, "option of \"dropdown\" element must not be empty")
+			continue
+		}
+		if strings.EqualFold(v, "none") {
+			rule.Errorf(o.Label.Pos, "option %q of \"dropdown\" element is reserved by GitHub. it is used when the dropdown is not required and nothing is selected", v)
+			continue
+		}
+		if p, ok := seen[v]; ok {
+			rule.Errorf(o.Label.Pos, "option %q of \"dropdown\" element is duplicated. previously defined at %s", v, p)
+			continue
+		}
+		seen[v] = o.Label.Pos
+	}
+
+	if d := a.Default; d != nil && d.Expression == nil && (d.Value < 0 || d.Value >= len(a.Options)) {
+		rule.Errorf(d.Pos, "\"default\" attribute of \"dropdown\" element must be an index of options in range from 0 to %d but got %d", len(a.Options)-1, d.Value)
+	}
+}
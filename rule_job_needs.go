@@ -96,7 +96,11 @@ func (rule *RuleJobNeeds) VisitWorkflowPost(n *Workflow) error {
 		for _, dep := range node.needs {
 			n, ok := rule.nodes[dep]
 			if !ok {
-				rule.Errorf(node.pos, "job %q needs job %q which does not exist in this workflow", id, dep)
+				ids := make([]string, 0, len(rule.nodes))
+				for id := range rule.nodes {
+					ids = append(ids, id)
+				}
+				rule.errorfWithSuggestions(node.pos, dep, ids, "job %q needs job %q which does not exist in this workflow", id, dep)
 				valid = false
 				continue
 			}
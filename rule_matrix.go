@@ -185,8 +185,10 @@ func (rule *RuleMatrix) checkExclude(m *Matrix) {
 				for k := range rows {
 					ss = append(ss, k)
 				}
-				rule.Errorf(
+				rule.errorfWithSuggestions(
 					a.Key.Pos,
+					k,
+					ss,
 					"%q in \"exclude\" section does not exist in matrix. available matrix configurations are %s",
 					k,
 					sortedQuotes(ss),
@@ -0,0 +1,88 @@
+package actionlint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// RuleNamingConvention is a rule checker to enforce naming conventions of job IDs, step IDs, and
+// job output names with regular expressions. This rule is disabled by default and enabled by
+// "naming-convention" in config file.
+type RuleNamingConvention struct {
+	RuleBase
+	jobID  *regexp.Regexp
+	stepID *regexp.Regexp
+	output *regexp.Regexp
+}
+
+func compileNamingConvention(pat, key string) (*regexp.Regexp, error) {
+	if pat == "" {
+		return nil, nil
+	}
+	r, err := regexp.Compile(pat)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression %q at \"naming-convention.%s\" in config: %w", pat, key, err)
+	}
+	return r, nil
+}
+
+// NewRuleNamingConvention creates new RuleNamingConvention instance. It returns an error when some
+// pattern in the configuration is not a valid regular expression.
+func NewRuleNamingConvention(cfg *NamingConventionConfig) (*RuleNamingConvention, error) {
+	r := &RuleNamingConvention{
+		RuleBase: RuleBase{
+			name: "naming-convention",
+			desc: "Checks for job IDs, step IDs, and job output names following naming conventions",
+		},
+	}
+	if cfg == nil {
+		return r, nil
+	}
+
+	var err error
+	if r.jobID, err = compileNamingConvention(cfg.JobID, "job-id"); err != nil {
+		return nil, err
+	}
+	if r.stepID, err = compileNamingConvention(cfg.StepID, "step-id"); err != nil {
+		return nil, err
+	}
+	if r.output, err = compileNamingConvention(cfg.Output, "output"); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleNamingConvention) VisitJobPre(n *Job) error {
+	if rule.jobID != nil && n.ID != nil && !rule.jobID.MatchString(n.ID.Value) {
+		rule.Errorf(n.ID.Pos, "job ID %q does not match naming convention %q", n.ID.Value, rule.jobID.String())
+	}
+
+	if rule.output == nil || len(n.Outputs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(n.Outputs))
+	for k := range n.Outputs {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		o := n.Outputs[k].Name
+		if !rule.output.MatchString(o.Value) {
+			rule.Errorf(o.Pos, "output name %q of job %q does not match naming convention %q", o.Value, n.ID.Value, rule.output.String())
+		}
+	}
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleNamingConvention) VisitStep(n *Step) error {
+	if rule.stepID == nil || n.ID == nil || n.ID.ContainsExpression() {
+		return nil
+	}
+	if !rule.stepID.MatchString(n.ID.Value) {
+		rule.Errorf(n.ID.Pos, "step ID %q does not match naming convention %q", n.ID.Value, rule.stepID.String())
+	}
+	return nil
+}
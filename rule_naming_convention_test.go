@@ -0,0 +1,17 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleNamingConventionInvalidPattern(t *testing.T) {
+	_, err := NewRuleNamingConvention(&NamingConventionConfig{StepID: "("})
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	want := `invalid regular expression "(" at "naming-convention.step-id" in config`
+	if msg := err.Error(); !strings.Contains(msg, want) {
+		t.Fatalf("error message %q does not contain %q", msg, want)
+	}
+}
@@ -0,0 +1,298 @@
+package actionlint
+
+import (
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	// Characters which terminate a word of path in shell scripts
+	pathWordChars = `[^\s;&|<>'"$` + "`" + `()*?\[\]{}~]`
+	// Prefix of command position like the start of line or after "|"
+	commandPosPrefix = `(?:^|[;&|(` + "`" + `]|\$\(|\b(?:then|do|else|sudo|exec|time)\s)\s*`
+)
+
+var (
+	// Scripts run directly like `./scripts/build.sh`
+	reRunLocalScript = regexp.MustCompile(commandPosPrefix + `(\.\.?/` + pathWordChars + `+)(?:\s|$|[;&|)])`)
+	// Scripts run by interpreters like `bash scripts/build.sh` or `source ./env.sh`
+	reRunScriptByInterpreter = regexp.MustCompile(commandPosPrefix + `(?:bash|sh|zsh|dash|ksh|pwsh|python3?|node|ruby|perl|source|\.)\s+(?:-[a-zA-Z-]+\s+)*(` + pathWordChars + `+)(?:\s|$|[;&|)])`)
+	// Commands to change the current directory
+	reChangeDir = regexp.MustCompile(`\b(?:cd|pushd|Set-Location|chdir)\b`)
+	// File extensions of scripts run by interpreters
+	reScriptFileExt = regexp.MustCompile(`\.(?:sh|bash|zsh|ps1|py|js|mjs|cjs|ts|rb|pl)$`)
+)
+
+// RulePathExists is a rule to check that paths referenced by workflows exist in the repository.
+// It checks "working-directory", scripts run in "run:" like `./scripts/build.sh`, and glob patterns
+// in "paths" and "paths-ignore" filters. This rule is enabled by "verify-paths" in config file.
+type RulePathExists struct {
+	RuleBase
+	root        string
+	workspace   *hashFilesWorkspace
+	lines       []string
+	workflowRun *DefaultsRun
+	jobRun      *DefaultsRun
+	// created is true when some previous step in the job may create files in the workspace.
+	created bool
+	// scripts is a concatenation of scripts run by the previous steps in the job.
+	scripts  strings.Builder
+	reported map[*String]struct{}
+	fs       fileSystem
+}
+
+// NewRulePathExists creates new RulePathExists instance. The root parameter is a path to the root
+// directory of the repository. The src parameter is the source of the workflow file to convert
+// positions in scripts into positions in the workflow file. It can be nil.
+func NewRulePathExists(root string, src []byte) *RulePathExists {
+	var lines []string
+	if src != nil {
+		lines = strings.Split(string(src), "\n")
+	}
+	return &RulePathExists{
+		RuleBase: RuleBase{
+			name: "path-exists",
+			desc: "Checks for paths referenced at \"working-directory\", \"run:\", and \"paths\" filters exist in the repository",
+		},
+		root:      root,
+		workspace: newHashFilesWorkspace(root), // Defined at hash_files.go
+		lines:     lines,
+		reported:  map[*String]struct{}{},
+		fs:        osFileSystem{},
+	}
+}
+
+// setFileSystem sets the file system where the paths are checked.
+func (rule *RulePathExists) setFileSystem(fsys fileSystem) {
+	rule.fs = fsys
+	rule.workspace.fs = fsys
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RulePathExists) VisitWorkflowPre(n *Workflow) error {
+	if n.Defaults != nil {
+		rule.workflowRun = n.Defaults.Run
+	}
+	for _, e := range n.On {
+		if w, ok := e.(*WebhookEvent); ok {
+			rule.checkPathFilter(w.Paths, w.Hook.Value)
+			rule.checkPathFilter(w.PathsIgnore, w.Hook.Value)
+		}
+	}
+	return nil
+}
+
+func (rule *RulePathExists) checkPathFilter(filter *WebhookEventFilter, event string) {
+	if filter == nil {
+		return
+	}
+	for _, v := range filter.Values {
+		pat := v.Value
+		neg := strings.HasPrefix(pat, "!")
+		if neg {
+			pat = pat[1:]
+		}
+		if pat == "" || len(ValidatePathGlob(pat)) > 0 {
+			continue // Invalid patterns are reported by "glob" rule
+		}
+		re, err := compileFilterGlob(pat) // Defined at glob.go
+		if err != nil || rule.globMatchesSomePath(re) {
+			continue
+		}
+
+		var effect string
+		switch {
+		case neg:
+			effect = "the negation excludes no file"
+		case filter.Name.Value == "paths":
+			effect = "it never triggers the workflow"
+		default:
+			effect = "it ignores no change"
+		}
+		rule.Errorf(
+			v.Pos,
+			"glob pattern %q in %q filter of %q event matches no file in the repository so %s. check the pattern is not mistyped",
+			v.Value,
+			filter.Name.Value,
+			event,
+			effect,
+		)
+	}
+}
+
+// globMatchesSomePath returns true when the pattern matches some file or directory in the repository.
+func (rule *RulePathExists) globMatchesSomePath(re *regexp.Regexp) bool {
+	for _, f := range rule.workspace.list() {
+		for {
+			if re.MatchString(f) {
+				return true
+			}
+			i := strings.LastIndexByte(f, '/')
+			if i < 0 {
+				break
+			}
+			f = f[:i] // Check the parent directory
+		}
+	}
+	return false
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RulePathExists) VisitJobPre(n *Job) error {
+	rule.jobRun = nil
+	if n.Defaults != nil {
+		rule.jobRun = n.Defaults.Run
+	}
+	rule.created = false
+	rule.scripts.Reset()
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RulePathExists) VisitStep(n *Step) error {
+	switch e := n.Exec.(type) {
+	case *ExecAction:
+		// Actions like actions/download-artifact may create files
+		rule.created = rule.created || stepMayCreateFiles(n) // Defined at rule_action.go
+	case *ExecRun:
+		rule.checkRun(e)
+		if e.Run != nil {
+			rule.scripts.WriteString(e.Run.Value)
+			rule.scripts.WriteByte('\n')
+		}
+	}
+	return nil
+}
+
+func (rule *RulePathExists) exists(p string) bool {
+	_, err := rule.fs.Stat(filepath.Join(rule.root, filepath.FromSlash(p)))
+	return err == nil
+}
+
+// pathMentionedIn returns true when the file name appears in the text. It means the file may be
+// created by a previous script like `curl -o install.sh ...`.
+func pathMentionedIn(text, p string) bool {
+	b := path.Base(p)
+	for {
+		i := strings.Index(text, b)
+		if i < 0 {
+			return false
+		}
+		end := i + len(b)
+		if (i == 0 || !isPathWordChar(text[i-1])) && (end == len(text) || !isPathWordChar(text[end])) {
+			return true
+		}
+		text = text[end:]
+	}
+}
+
+// isPathWordChar returns true when the character can be a part of file name.
+func isPathWordChar(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_' || c == '-' || c == '.'
+}
+
+func (rule *RulePathExists) checkRun(e *ExecRun) {
+	wd := e.WorkingDirectory
+	if wd == nil && rule.jobRun != nil {
+		wd = rule.jobRun.WorkingDirectory
+	}
+	if wd == nil && rule.workflowRun != nil {
+		wd = rule.workflowRun.WorkingDirectory
+	}
+
+	dir := "."
+	if wd != nil {
+		if wd.ContainsExpression() || isAbsPath(wd.Value) || strings.HasPrefix(wd.Value, "~") {
+			return // Only paths relative to the workspace can be checked
+		}
+		dir = path.Clean(filepath.ToSlash(wd.Value))
+		if dir == ".." || strings.HasPrefix(dir, "../") {
+			return // Outside the workspace
+		}
+		if _, ok := rule.reported[wd]; ok {
+			return
+		}
+		if !rule.created && !pathMentionedIn(rule.scripts.String(), dir) && !rule.exists(dir) {
+			rule.Errorf(
+				wd.Pos,
+				"working directory %q does not exist in the repository. check the path or create the directory before this step",
+				wd.Value,
+			)
+			rule.reported[wd] = struct{}{}
+			return
+		}
+	}
+
+	if e.Run == nil || !rule.isShellScript(e) {
+		return
+	}
+
+	mapPos := scriptPosMapper(rule.lines, e.Run, e.RunPos) // Defined at rule_pyflakes.go
+	prev := rule.scripts.String()
+	offset := 0
+	for i, line := range strings.Split(e.Run.Value, "\n") {
+		start := offset
+		offset += len(line) + 1
+		if j := strings.Index(line, "#"); j >= 0 && (j == 0 || line[j-1] == ' ' || line[j-1] == '\t') {
+			line = line[:j] // Remove comment
+		}
+
+		ms := reRunLocalScript.FindAllStringSubmatchIndex(line, -1)
+		for _, m := range reRunScriptByInterpreter.FindAllStringSubmatchIndex(line, -1) {
+			p := line[m[2]:m[3]]
+			if strings.Contains(p, "/") || reScriptFileExt.MatchString(p) {
+				ms = append(ms, m)
+			}
+		}
+
+		for _, m := range ms {
+			before := e.Run.Value[:start+m[2]]
+			if reChangeDir.MatchString(before) {
+				return // The working directory was changed in the script
+			}
+			p := line[m[2]:m[3]]
+			resolved := path.Join(dir, p)
+			if resolved == ".." || strings.HasPrefix(resolved, "../") {
+				continue
+			}
+			// The file may be created by a previous step or in the same script before running it
+			if rule.created || pathMentionedIn(prev, resolved) || pathMentionedIn(before, resolved) || rule.exists(resolved) {
+				continue
+			}
+			rule.Errorf(
+				mapPos(i+1, m[2]+1),
+				"script file %q run at \"run:\" does not exist in the repository. the path is resolved to %q from the workspace. check the path or create the file before this step",
+				p,
+				resolved,
+			)
+		}
+	}
+}
+
+// isShellScript returns true when the script at "run:" is run by a shell which can run other
+// scripts such as bash and pwsh.
+func (rule *RulePathExists) isShellScript(e *ExecRun) bool {
+	sh := e.Shell
+	if sh == nil && rule.jobRun != nil {
+		sh = rule.jobRun.Shell
+	}
+	if sh == nil && rule.workflowRun != nil {
+		sh = rule.workflowRun.Shell
+	}
+	if sh == nil {
+		return true // Default shell is bash or pwsh
+	}
+	switch strings.Fields(sh.Value + " _")[0] {
+	case "bash", "sh", "pwsh", "powershell":
+		return true
+	default:
+		return false
+	}
+}
+
+// isAbsPath returns true when the path is absolute on Unix or Windows.
+func isAbsPath(p string) bool {
+	return strings.HasPrefix(p, "/") || strings.HasPrefix(p, `\`) || len(p) >= 3 && p[1] == ':' && (p[2] == '/' || p[2] == '\\')
+}
@@ -1,21 +1,14 @@
 package actionlint
 
-var allPermissionScopes = map[string]struct{}{
-	"actions":             {},
-	"attestations":        {},
-	"checks":              {},
-	"contents":            {},
-	"deployments":         {},
-	"id-token":            {},
-	"issues":              {},
-	"discussions":         {},
-	"packages":            {},
-	"pages":               {},
-	"pull-requests":       {},
-	"repository-projects": {},
-	"security-events":     {},
-	"statuses":            {},
-}
+import (
+	"strconv"
+	"strings"
+)
+
+//go:generate go run ./scripts/generate-permissions ./permission_scopes.go
+
+// defaultPermissionLevels is access levels for permission scopes which are not known.
+var defaultPermissionLevels = []string{"read", "write", "none"}
 
 // RulePermissions is a rule checker to check permission configurations in a workflow.
 // https://docs.github.com/en/actions/security-guides/automatic-token-authentication#permissions-for-the-github_token
@@ -62,18 +55,26 @@ func (rule *RulePermissions) checkPermissions(p *Permissions) {
 
 	for _, p := range p.Scopes {
 		n := p.Name.Value // Permission names are case-sensitive
-		if _, ok := allPermissionScopes[n]; !ok {
-			ss := make([]string, 0, len(allPermissionScopes))
-			for s := range allPermissionScopes {
-				ss = append(ss, s)
-			}
-			rule.Errorf(p.Name.Pos, "unknown permission scope %q. all available permission scopes are %s", n, sortedQuotes(ss))
+		levels, ok := allPermissionScopes[n]
+		if !ok {
+			ss := sortedKeys(allPermissionScopes)
+			rule.errorfWithSuggestions(p.Name.Pos, n, ss, "unknown permission scope %q. all available permission scopes are %s", n, sortedQuotes(ss))
+			levels = defaultPermissionLevels
 		}
-		switch p.Value.Value {
-		case "read", "write", "none":
-			// OK
-		default:
-			rule.Errorf(p.Value.Pos, "%q is invalid for permission of scope %q. available values are \"read\", \"write\" or \"none\"", p.Value.Value, n)
+		if !contains(levels, p.Value.Value) {
+			rule.Errorf(p.Value.Pos, "%q is invalid for permission of scope %q. available values are %s", p.Value.Value, n, quotesOr(levels))
 		}
 	}
 }
+
+// quotesOr quotes the strings and joins them like `"a", "b" or "c"`.
+func quotesOr(ss []string) string {
+	if len(ss) == 1 {
+		return strconv.Quote(ss[0])
+	}
+	qs := make([]string, 0, len(ss)-1)
+	for _, s := range ss[:len(ss)-1] {
+		qs = append(qs, strconv.Quote(s))
+	}
+	return strings.Join(qs, ", ") + " or " + strconv.Quote(ss[len(ss)-1])
+}
@@ -0,0 +1,87 @@
+package actionlint
+
+import "strings"
+
+// Pinned runner labels suggested instead of "-latest" labels. They are the images which the
+// "-latest" labels currently point to.
+var pinnedRunnerLabels = map[string]string{
+	"ubuntu-latest":       "ubuntu-24.04",
+	"windows-latest":      "windows-2022",
+	"macos-latest":        "macos-14",
+	"macos-latest-large":  "macos-14-large",
+	"macos-latest-xl":     "macos-14-xl",
+	"macos-latest-xlarge": "macos-14-xlarge",
+}
+
+// RulePinnedRunner is a rule checker to detect "-latest" runner labels such as "ubuntu-latest".
+// The images of "-latest" labels are migrated to new versions occasionally, so pinned images are
+// necessary for reproducibility. This rule is disabled by default and enabled by "pinned-runner"
+// in config file.
+type RulePinnedRunner struct {
+	RuleBase
+}
+
+// NewRulePinnedRunner creates new RulePinnedRunner instance.
+func NewRulePinnedRunner() *RulePinnedRunner {
+	return &RulePinnedRunner{
+		RuleBase: RuleBase{
+			name: "pinned-runner",
+			desc: "Checks for \"-latest\" runner labels which are not pinned to specific images",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RulePinnedRunner) VisitJobPre(n *Job) error {
+	if n.RunsOn == nil {
+		return nil
+	}
+
+	var m *Matrix
+	if n.Strategy != nil {
+		m = n.Strategy.Matrix
+	}
+
+	labels := n.RunsOn.Labels
+	if n.RunsOn.LabelsExpr != nil {
+		labels = []*String{n.RunsOn.LabelsExpr}
+	}
+
+	if hasSelfHostedRunnerLabel(labels) {
+		return nil // Labels of self-hosted runners are not related to runner images
+	}
+
+	for _, l := range labels {
+		if !l.ContainsExpression() {
+			rule.checkLabel(l)
+			continue
+		}
+		for _, l := range runnerLabelsInMatrix(l, m) {
+			rule.checkLabel(l)
+		}
+	}
+
+	return nil
+}
+
+func (rule *RulePinnedRunner) checkLabel(label *String) {
+	l := strings.ToLower(label.Value)
+	if !strings.Contains(l, "-latest") || !contains(allGitHubHostedRunnerLabels, l) {
+		return
+	}
+
+	if p, ok := pinnedRunnerLabels[l]; ok {
+		rule.Errorf(
+			label.Pos,
+			"runner label %q is not pinned to specific image. the image is migrated to new version occasionally. use pinned label such as %q for reproducibility",
+			label.Value,
+			p,
+		)
+		return
+	}
+	rule.Errorf(
+		label.Pos,
+		"runner label %q is not pinned to specific image. the image is migrated to new version occasionally. use pinned label for reproducibility",
+		label.Value,
+	)
+}
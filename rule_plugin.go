@@ -0,0 +1,149 @@
+package actionlint
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// pluginInput is a JSON object passed to stdin of a plugin command.
+type pluginInput struct {
+	// Version is the version of this object. See pluginProtocolVersion.
+	Version int `json:"version"`
+	// Path is a file path of the workflow.
+	Path string `json:"path"`
+	// Source is the source of the workflow file.
+	Source string `json:"source"`
+	// Workflow is the syntax tree of the workflow. See plugin_ast.go.
+	Workflow *pluginWorkflow `json:"workflow"`
+}
+
+// pluginError is an error reported by a plugin command via stdout.
+type pluginError struct {
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Message  string `json:"message"`
+	Severity string `json:"severity"`
+}
+
+// pluginDefaultTimeout is the maximum duration of running a plugin for one workflow file when
+// "timeout" is not set in the config.
+const pluginDefaultTimeout = 60 * time.Second
+
+// RulePlugin is a rule which runs an external rule plugin configured at "plugins" in config file.
+// The plugin command or WebAssembly module receives the syntax tree of the workflow as JSON from
+// stdin and reports errors as JSON to stdout.
+type RulePlugin struct {
+	RuleBase
+	cmd  *externalCommand
+	args []string
+	path string
+	src  []byte
+}
+
+// NewRulePlugin creates new RulePlugin instance. The path parameter is the file path of the
+// workflow and the src parameter is its source. They are passed to the plugin. When the plugin
+// command is not found in system, it returns an error. When the plugin is a WebAssembly module, it
+// is run in this process without access to files. It returns an error when the module cannot be
+// loaded or actionlint was built without WebAssembly runtime.
+func NewRulePlugin(cfg *PluginConfig, proc *concurrentProcess, path string, src []byte) (*RulePlugin, error) {
+	var cmd *externalCommand
+	var args []string
+	if cfg.WASM != "" {
+		name, b, err := cfg.loadWASM()
+		if err != nil {
+			return nil, fmt.Errorf("WebAssembly module of plugin %q could not be read: %w", cfg.Name, err)
+		}
+		cmd, err = proc.newWASMCommandRunner(name, b, false)
+		if err != nil {
+			return nil, fmt.Errorf("WebAssembly module of plugin %q is not available: %w", cfg.Name, err)
+		}
+	} else {
+		c, err := proc.newCommandRunner(cfg.Command[0], false)
+		if err != nil {
+			return nil, fmt.Errorf("command of plugin %q is not available: %w", cfg.Name, err)
+		}
+		cmd = c
+		args = cfg.Command[1:]
+	}
+	cmd.timeout = pluginDefaultTimeout
+	if cfg.Timeout > 0 {
+		cmd.timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+
+	return &RulePlugin{
+		RuleBase: RuleBase{
+			name: cfg.Name,
+			desc: fmt.Sprintf("External rule plugin %q configured in config file", cfg.Name),
+		},
+		cmd:  cmd,
+		args: args,
+		path: path,
+		src:  src,
+	}, nil
+}
+
+// loadWASM reads the WebAssembly module of the plugin and returns its name and content. The module
+// is read only once since the same config is shared by all workflow files.
+func (c *PluginConfig) loadWASM() (string, []byte, error) {
+	c.wasmOnce.Do(func() {
+		p := c.WASM
+		if c.dir != "" && !filepath.IsAbs(p) {
+			p = filepath.Join(c.dir, p)
+		}
+		b, err := os.ReadFile(p)
+		if err != nil {
+			c.wasmErr = err
+			return
+		}
+		// Include the digest in the name so that the module is compiled again when it is modified
+		c.wasmName = fmt.Sprintf("%s@%x", p, sha256.Sum256(b))
+		c.wasmBin = b
+	})
+	return c.wasmName, c.wasmBin, c.wasmErr
+}
+
+// VisitWorkflowPost is callback when visiting Workflow node after visiting its children. The plugin
+// is run here with the entire syntax tree of the workflow.
+func (rule *RulePlugin) VisitWorkflowPost(n *Workflow) error {
+	stdin, err := json.Marshal(&pluginInput{pluginProtocolVersion, rule.path, string(rule.src), newPluginWorkflow(n)})
+	if err != nil {
+		return fmt.Errorf("could not encode syntax tree of workflow %q into JSON for plugin %q: %w", rule.path, rule.name, err)
+	}
+	rule.Debug("Running plugin %s with %s for workflow %s", rule.cmd.exe, rule.args, rule.path)
+
+	rule.cmd.run(rule.args, string(stdin), func(stdout []byte, err error) error {
+		if err != nil {
+			rule.Debug("Command %s failed: %v", rule.cmd.exe, err)
+			return fmt.Errorf("plugin %q did not run successfully while checking %q: %w", rule.name, rule.path, err)
+		}
+		if len(strings.TrimSpace(string(stdout))) == 0 {
+			return nil
+		}
+
+		errs := []pluginError{}
+		if err := json.Unmarshal(stdout, &errs); err != nil {
+			return fmt.Errorf("could not parse JSON output from plugin %q while checking %q: %w: stdout=%q", rule.name, rule.path, err, stdout)
+		}
+		// Only one process runs for this rule so synchronization is not necessary
+		for _, e := range errs {
+			p := &Pos{Line: e.Line, Col: e.Column}
+			if p.Line <= 0 {
+				p.Line = 1
+			}
+			if p.Col <= 0 {
+				p.Col = 1
+			}
+			err := errorfAt(p, rule.name, "%s", strings.TrimSuffix(strings.TrimSpace(e.Message), "."))
+			err.Severity = e.Severity
+			rule.errs = append(rule.errs, err)
+		}
+		return nil
+	})
+
+	return rule.cmd.wait()
+}
@@ -0,0 +1,271 @@
+package actionlint
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testFakePlugin creates a fake plugin command which saves stdin to a file and outputs the given
+// string.
+func testFakePlugin(t *testing.T, output string) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake plugin command is a shell script")
+	}
+	dir := t.TempDir()
+	exe := filepath.Join(dir, "policy")
+	out := filepath.Join(dir, "output.json")
+	if err := os.WriteFile(out, []byte(output), 0644); err != nil {
+		t.Fatal(err)
+	}
+	script := "#!/bin/sh\ncat > '" + filepath.Join(dir, "stdin") + "'\ncat '" + out + "'\n"
+	if err := os.WriteFile(exe, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return exe
+}
+
+func testRunRulePlugin(t *testing.T, exe string) (*RulePlugin, []*Error, error) {
+	src := "on: push\njobs:\n  test:\n    runs-on: self-hosted\n    steps:\n      - run: echo hello\n"
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	r, err := NewRulePlugin(&PluginConfig{Name: "org-policy", Command: []string{exe, "--strict"}}, newConcurrentProcess(1), "test.yaml", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := NewVisitor()
+	v.AddPass(r)
+	err = v.Visit(w)
+	return r, r.Errs(), err
+}
+
+func TestRulePluginReportErrors(t *testing.T) {
+	exe := testFakePlugin(t, `[{"line":4,"column":14,"message":"self-hosted runners are not allowed.","severity":"error"},{"message":"no timeout"}]`)
+	r, errs, err := testRunRulePlugin(t, exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Name() != "org-policy" {
+		t.Fatalf("unexpected rule name %q", r.Name())
+	}
+
+	want := []Error{
+		{Message: "self-hosted runners are not allowed", Line: 4, Column: 14, Kind: "org-policy", Severity: "error"},
+		{Message: "no timeout", Line: 1, Column: 1, Kind: "org-policy"},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("wanted %d errors but got %d: %v", len(want), len(errs), errs)
+	}
+	for i, w := range want {
+		e := errs[i]
+		if e.Message != w.Message || e.Line != w.Line || e.Column != w.Column || e.Kind != w.Kind || e.Severity != w.Severity {
+			t.Errorf("wanted %#v but got %#v", w, *e)
+		}
+	}
+
+	b, err := os.ReadFile(filepath.Join(filepath.Dir(exe), "stdin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var input struct {
+		Version  int    `json:"version"`
+		Path     string `json:"path"`
+		Source   string `json:"source"`
+		Workflow struct {
+			Jobs map[string]struct {
+				RunsOn struct {
+					Labels []struct {
+						Value  string `json:"value"`
+						Line   int    `json:"line"`
+						Column int    `json:"column"`
+					} `json:"labels"`
+				} `json:"runs_on"`
+			} `json:"jobs"`
+		} `json:"workflow"`
+	}
+	if err := json.Unmarshal(b, &input); err != nil {
+		t.Fatalf("input to plugin is not JSON: %v: %q", err, b)
+	}
+	if input.Version != 1 || input.Path != "test.yaml" || !strings.HasPrefix(input.Source, "on: push\n") {
+		t.Fatalf("unexpected version, path, or source in input: %q", b)
+	}
+	ls := input.Workflow.Jobs["test"].RunsOn.Labels
+	if len(ls) != 1 || ls[0].Value != "self-hosted" || ls[0].Line != 4 || ls[0].Column != 14 {
+		t.Fatalf("unexpected syntax tree in input: %q", b)
+	}
+}
+
+func TestRulePluginTimeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake plugin command is a shell script")
+	}
+	exe := filepath.Join(t.TempDir(), "policy")
+	if err := os.WriteFile(exe, []byte("#!/bin/sh\nexec sleep 10\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewRulePlugin(&PluginConfig{Name: "org-policy", Command: []string{exe}}, newConcurrentProcess(1), "test.yaml", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.cmd.timeout != pluginDefaultTimeout {
+		t.Fatalf("default timeout is not set: %s", r.cmd.timeout)
+	}
+	r, err = NewRulePlugin(&PluginConfig{Name: "org-policy", Command: []string{exe}, Timeout: 3}, newConcurrentProcess(1), "test.yaml", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.cmd.timeout != 3*time.Second {
+		t.Fatalf("timeout in config is not set: %s", r.cmd.timeout)
+	}
+
+	r.cmd.timeout = 100 * time.Millisecond
+	start := time.Now()
+	err = r.VisitWorkflowPost(&Workflow{})
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	if !strings.Contains(err.Error(), "did not finish within 100ms") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Fatalf("plugin was not killed on timeout: %s", d)
+	}
+}
+
+func TestRulePluginNoError(t *testing.T) {
+	exe := testFakePlugin(t, "")
+	_, errs, err := testRunRulePlugin(t, exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+}
+
+func TestRulePluginInvalidOutput(t *testing.T) {
+	exe := testFakePlugin(t, "Traceback (most recent call last):")
+	_, _, err := testRunRulePlugin(t, exe)
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	if !strings.Contains(err.Error(), `could not parse JSON output from plugin "org-policy"`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRulePluginCommandNotFound(t *testing.T) {
+	_, err := NewRulePlugin(&PluginConfig{Name: "org-policy", Command: []string{"this-command-does-not-exist"}}, newConcurrentProcess(1), "test.yaml", nil)
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	if !strings.Contains(err.Error(), `command of plugin "org-policy" is not available`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRulePluginWASMModule(t *testing.T) {
+	saved := newWASMCommand
+	defer func() { newWASMCommand = saved }()
+
+	var stdin string
+	newWASMCommand = func(name string, bin []byte, mount bool) (cmdFunc, error) {
+		if string(bin) != "\x00asm" {
+			t.Errorf("unexpected module %q", bin)
+		}
+		if mount {
+			t.Error("files should not be mounted for plugin")
+		}
+		return func(ctx context.Context, args []string, in string) ([]byte, error) {
+			if len(args) > 0 {
+				t.Errorf("no argument should be passed but got %v", args)
+			}
+			stdin = in
+			return []byte(`[{"line":4,"column":14,"message":"self-hosted runners are not allowed"}]`), nil
+		}, nil
+	}
+
+	// The relative path of the module is resolved from the directory of the config file
+	dir := t.TempDir()
+	wasm := filepath.Join(dir, "policy.wasm")
+	if err := os.WriteFile(wasm, []byte("\x00asm"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	conf := filepath.Join(dir, "actionlint.yaml")
+	if err := os.WriteFile(conf, []byte("plugins:\n  - name: org-policy\n    wasm: policy.wasm\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := ReadConfigFile(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := "on: push\njobs:\n  test:\n    runs-on: self-hosted\n    steps:\n      - run: echo hello\n"
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	r, err := NewRulePlugin(cfg.Plugins[0], newConcurrentProcess(1), "test.yaml", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The module is read only once per config
+	if err := os.Remove(wasm); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewRulePlugin(cfg.Plugins[0], newConcurrentProcess(1), "other.yaml", []byte(src)); err != nil {
+		t.Fatal(err)
+	}
+	v := NewVisitor()
+	v.AddPass(r)
+	if err := v.Visit(w); err != nil {
+		t.Fatal(err)
+	}
+
+	errs = r.Errs()
+	if len(errs) != 1 || errs[0].Message != "self-hosted runners are not allowed" || errs[0].Line != 4 || errs[0].Column != 14 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if !strings.Contains(stdin, `"path":"test.yaml"`) {
+		t.Fatalf("unexpected input to plugin: %q", stdin)
+	}
+}
+
+func TestRulePluginWASMModuleNotAvailable(t *testing.T) {
+	wasm := filepath.Join(t.TempDir(), "policy.wasm")
+	testCases := []struct {
+		what string
+		file bool
+		want string
+	}{
+		{"file not found", false, `WebAssembly module of plugin "org-policy" could not be read`},
+		{"no runtime", true, `built without "wazero" build tag`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			if tc.file {
+				if newWASMCommand != nil {
+					t.Skip("actionlint is built with WebAssembly runtime")
+				}
+				if err := os.WriteFile(wasm, []byte("\x00asm"), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			_, err := NewRulePlugin(&PluginConfig{Name: "org-policy", WASM: wasm}, newConcurrentProcess(1), "test.yaml", nil)
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
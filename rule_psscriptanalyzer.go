@@ -0,0 +1,226 @@
+package actionlint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// psscriptanalyzerCommand is a PowerShell script to run PSScriptAnalyzer. It reads a JSON array of
+// scripts from stdin and outputs a JSON array of found issues with the indices of the scripts.
+const psscriptanalyzerCommand = `$ErrorActionPreference = 'Stop'
+$scripts = @([Console]::In.ReadToEnd() | ConvertFrom-Json)
+$results = for ($i = 0; $i -lt $scripts.Count; $i++) {
+  Invoke-ScriptAnalyzer -ScriptDefinition $scripts[$i] | ForEach-Object {
+    [pscustomobject]@{ Index = $i; RuleName = $_.RuleName; Severity = $_.Severity.ToString(); Line = $_.Line; Column = $_.Column; Message = $_.Message }
+  }
+}
+ConvertTo-Json -InputObject @($results) -Compress`
+
+// psscriptanalyzerAvailableCommand is a PowerShell script to check PSScriptAnalyzer module is
+// installed.
+const psscriptanalyzerAvailableCommand = `if (Get-Module -ListAvailable -Name PSScriptAnalyzer) { 'yes' }`
+
+type psscriptanalyzerError struct {
+	Index    int    `json:"Index"`
+	RuleName string `json:"RuleName"`
+	Severity string `json:"Severity"`
+	Line     int    `json:"Line"`
+	Column   int    `json:"Column"`
+	Message  string `json:"Message"`
+}
+
+// psscriptanalyzerAvailability checks PSScriptAnalyzer module is available only once since
+// starting PowerShell takes time.
+type psscriptanalyzerAvailability struct {
+	once sync.Once
+	ok   bool
+}
+
+func (a *psscriptanalyzerAvailability) check(exe string) bool {
+	a.once.Do(func() {
+		c := &cmdExecution{exe, []string{"-NoProfile", "-NonInteractive", "-Command", psscriptanalyzerAvailableCommand}, "", false, nil, 0}
+		// The result is shared by all lint runs so it must not depend on the context of one run
+		out, err := c.run(context.Background())
+		a.ok = err == nil && strings.TrimSpace(string(out)) == "yes"
+	})
+	return a.ok
+}
+
+func isPowerShell(shell string) bool {
+	return shell == "pwsh" || shell == "powershell" || strings.HasPrefix(shell, "pwsh ") || strings.HasPrefix(shell, "powershell ")
+}
+
+// RulePSScriptAnalyzer is a rule to check PowerShell scripts at 'run:' using PSScriptAnalyzer.
+// https://github.com/PowerShell/PSScriptAnalyzer
+type RulePSScriptAnalyzer struct {
+	RuleBase
+	cmd           *externalCommand
+	lines         []string
+	available     *psscriptanalyzerAvailability
+	scripts       []string
+	mapPos        []func(int, int) *Pos
+	workflowShell string
+	jobShell      string
+	runnerShell   string
+	mu            sync.Mutex
+}
+
+func newRulePSScriptAnalyzer(cmd *externalCommand, src []byte, available *psscriptanalyzerAvailability) *RulePSScriptAnalyzer {
+	var lines []string
+	if src != nil {
+		lines = strings.Split(string(src), "\n")
+	}
+	return &RulePSScriptAnalyzer{
+		RuleBase: RuleBase{
+			name: "psscriptanalyzer",
+			desc: "Checks for PowerShell script when \"shell: pwsh\" or \"shell: powershell\" is configured using PSScriptAnalyzer",
+		},
+		cmd:       cmd,
+		lines:     lines,
+		available: available,
+	}
+}
+
+// NewRulePSScriptAnalyzer creates new RulePSScriptAnalyzer instance. The executable parameter is a
+// command name or a file path of PowerShell such as "pwsh" where PSScriptAnalyzer module is
+// installed. The src parameter is the source of the workflow file to convert positions in scripts
+// into positions in the workflow file. It can be nil. When the given executable is not found in
+// system, it returns an error. When PSScriptAnalyzer module is not installed, no script is checked.
+func NewRulePSScriptAnalyzer(executable string, proc *concurrentProcess, src []byte) (*RulePSScriptAnalyzer, error) {
+	cmd, err := proc.newCommandRunner(executable, false)
+	if err != nil {
+		return nil, err
+	}
+	return newRulePSScriptAnalyzer(cmd, src, &psscriptanalyzerAvailability{}), nil
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RulePSScriptAnalyzer) VisitWorkflowPre(n *Workflow) error {
+	if n.Defaults != nil && n.Defaults.Run != nil && n.Defaults.Run.Shell != nil {
+		rule.workflowShell = n.Defaults.Run.Shell.Value
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RulePSScriptAnalyzer) VisitJobPre(n *Job) error {
+	if n.Defaults != nil && n.Defaults.Run != nil && n.Defaults.Run.Shell != nil {
+		rule.jobShell = n.Defaults.Run.Shell.Value
+	}
+	if n.RunsOn != nil {
+		for _, label := range n.RunsOn.Labels {
+			l := strings.ToLower(label.Value)
+			// Default shell on Windows is PowerShell.
+			if l == "windows" || strings.HasPrefix(l, "windows-") {
+				rule.runnerShell = "pwsh"
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// VisitJobPost is callback when visiting Job node after visiting its children.
+func (rule *RulePSScriptAnalyzer) VisitJobPost(n *Job) error {
+	rule.jobShell = ""
+	rule.runnerShell = ""
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RulePSScriptAnalyzer) VisitStep(n *Step) error {
+	run, ok := n.Exec.(*ExecRun)
+	if !ok || run.Run == nil || !isPowerShell(rule.getShellName(run)) {
+		return nil
+	}
+	src := sanitizeExpressionsInScript(run.Run.Value) // Defined at rule_shellcheck.go
+	rule.Debug("%s: Add PowerShell script to be checked by PSScriptAnalyzer:\n%s", run.RunPos, src)
+	rule.scripts = append(rule.scripts, src)
+	rule.mapPos = append(rule.mapPos, scriptPosMapper(rule.lines, run.Run, run.RunPos)) // Defined at rule_pyflakes.go
+	return nil
+}
+
+func (rule *RulePSScriptAnalyzer) getShellName(exec *ExecRun) string {
+	if exec.Shell != nil {
+		return exec.Shell.Value
+	}
+	if rule.jobShell != "" {
+		return rule.jobShell
+	}
+	if rule.workflowShell != "" {
+		return rule.workflowShell
+	}
+	return rule.runnerShell
+}
+
+// VisitWorkflowPost is callback when visiting Workflow node after visiting its children. All
+// PowerShell scripts in the workflow are checked by one process here since starting PowerShell
+// takes time.
+func (rule *RulePSScriptAnalyzer) VisitWorkflowPost(n *Workflow) error {
+	rule.workflowShell = ""
+	if len(rule.scripts) == 0 {
+		return nil
+	}
+	if rule.available != nil && !rule.available.check(rule.cmd.exe) {
+		rule.Debug("Skipped checking %d scripts since PSScriptAnalyzer module is not installed in %s", len(rule.scripts), rule.cmd.exe)
+		return nil
+	}
+
+	scripts, mapPos := rule.scripts, rule.mapPos
+	rule.scripts, rule.mapPos = nil, nil
+	stdin, err := json.Marshal(scripts)
+	if err != nil {
+		return fmt.Errorf("could not encode scripts into JSON to run PSScriptAnalyzer: %w", err)
+	}
+
+	args := []string{"-NoProfile", "-NonInteractive", "-Command", psscriptanalyzerCommand}
+	rule.cmd.run(args, string(stdin), func(stdout []byte, err error) error {
+		if err != nil {
+			rule.Debug("Command %s failed: %v", rule.cmd.exe, err)
+			return fmt.Errorf("`%s` did not run PSScriptAnalyzer successfully while checking %d scripts: %w", rule.cmd.exe, len(scripts), err)
+		}
+		return rule.parseErrors(stdout, mapPos)
+	})
+
+	return rule.cmd.wait()
+}
+
+func (rule *RulePSScriptAnalyzer) parseErrors(stdout []byte, mapPos []func(int, int) *Pos) error {
+	errs := []psscriptanalyzerError{}
+	if err := json.Unmarshal(stdout, &errs); err != nil {
+		return fmt.Errorf("could not parse JSON output from PSScriptAnalyzer: %w: stdout=%q", err, stdout)
+	}
+
+	rule.mu.Lock()
+	defer rule.mu.Unlock()
+	for _, e := range errs {
+		if e.Index < 0 || e.Index >= len(mapPos) {
+			rule.Debug("Ignored error for unknown script #%d reported by PSScriptAnalyzer: %s", e.Index, e.Message)
+			continue
+		}
+		col := e.Column
+		if col <= 0 {
+			col = 1
+		}
+		msg := strings.TrimSuffix(strings.TrimSpace(e.Message), ".") // Trim period aligning style of error message
+		err := errorfAt(mapPos[e.Index](e.Line, col), rule.name, "PSScriptAnalyzer reported issue in this script: %s:%s:%d:%d: %s", e.RuleName, e.Severity, e.Line, e.Column, msg)
+		err.Severity = psscriptanalyzerSeverity(e.Severity)
+		rule.errs = append(rule.errs, err)
+	}
+	return nil
+}
+
+// psscriptanalyzerSeverity converts severity of PSScriptAnalyzer into severity of errors.
+func psscriptanalyzerSeverity(s string) string {
+	switch s {
+	case "Warning":
+		return "warning"
+	case "Information":
+		return "info"
+	default: // "Error" and "ParseError"
+		return "error"
+	}
+}
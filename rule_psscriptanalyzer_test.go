@@ -0,0 +1,122 @@
+package actionlint
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testPSScriptAnalyzerWorkflow = `on: push
+jobs:
+  windows:
+    runs-on: windows-latest
+    steps:
+      - run: |
+          $files = ls
+          Write-Host $files
+      - run: echo hello
+        shell: bash
+      - run: gci ${{ github.workspace }}
+        shell: powershell
+  linux:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo hello
+`
+
+// testFakePwsh creates a fake pwsh command which reports PSAvoidUsingCmdletAliases in the first and
+// the second scripts. When available is false, it behaves as if PSScriptAnalyzer is not installed.
+func testFakePwsh(t *testing.T, available bool) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake pwsh command is a shell script")
+	}
+	dir := t.TempDir()
+	exe := filepath.Join(dir, "pwsh")
+	yes := ""
+	if available {
+		yes = "echo yes"
+	}
+	script := `#!/bin/sh
+case "$*" in
+  *Get-Module*) ` + yes + `;;
+  *)
+    cat > '` + filepath.Join(dir, "stdin") + `'
+    echo '[{"Index":0,"RuleName":"PSAvoidUsingCmdletAliases","Severity":"Warning","Line":1,"Column":10,"Message":"'"'ls'"' is an alias of '"'Get-ChildItem'"'."},{"Index":1,"RuleName":"PSAvoidUsingCmdletAliases","Severity":"Information","Line":1,"Column":1,"Message":"'"'gci'"' is an alias of '"'Get-ChildItem'"'."}]'
+    ;;
+esac
+`
+	if err := os.WriteFile(exe, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return exe
+}
+
+func testRunRulePSScriptAnalyzer(t *testing.T, exe string) (*RulePSScriptAnalyzer, []*Error) {
+	w, errs := Parse([]byte(testPSScriptAnalyzerWorkflow))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	r, err := NewRulePSScriptAnalyzer(exe, newConcurrentProcess(1), []byte(testPSScriptAnalyzerWorkflow))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := NewVisitor()
+	v.AddPass(r)
+	if err := v.Visit(w); err != nil {
+		t.Fatal(err)
+	}
+	return r, r.Errs()
+}
+
+func TestRulePSScriptAnalyzerCheckPowerShellScripts(t *testing.T) {
+	exe := testFakePwsh(t, true)
+	_, errs := testRunRulePSScriptAnalyzer(t, exe)
+
+	type result struct {
+		Line, Column int
+		Severity     string
+		Message      string
+	}
+	have := []result{}
+	for _, e := range errs {
+		have = append(have, result{e.Line, e.Column, e.Severity, e.Message})
+	}
+	want := []result{
+		{7, 20, "warning", "PSScriptAnalyzer reported issue in this script: PSAvoidUsingCmdletAliases:Warning:1:10: 'ls' is an alias of 'Get-ChildItem'"},
+		{11, 14, "info", "PSScriptAnalyzer reported issue in this script: PSAvoidUsingCmdletAliases:Information:1:1: 'gci' is an alias of 'Get-ChildItem'"},
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatal(diff)
+	}
+
+	b, err := os.ReadFile(filepath.Join(filepath.Dir(exe), "stdin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdin := `["$files = ls\nWrite-Host $files\n","gci _______________________"]`
+	if string(b) != stdin {
+		t.Fatalf("unexpected scripts passed to PSScriptAnalyzer: %q", b)
+	}
+}
+
+func TestRulePSScriptAnalyzerModuleNotInstalled(t *testing.T) {
+	exe := testFakePwsh(t, false)
+	_, errs := testRunRulePSScriptAnalyzer(t, exe)
+	if len(errs) > 0 {
+		t.Fatalf("no error should be reported when PSScriptAnalyzer is not installed: %v", errs)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(exe), "stdin")); err == nil {
+		t.Fatal("PSScriptAnalyzer should not run when it is not installed")
+	}
+}
+
+func TestRulePSScriptAnalyzerParseOutputError(t *testing.T) {
+	r := newRulePSScriptAnalyzer(&externalCommand{}, nil, nil)
+	err := r.parseErrors([]byte("Invoke-ScriptAnalyzer: not found"), nil)
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+}
@@ -0,0 +1,38 @@
+package actionlint
+
+// RulePushFilters is a rule checker to detect "push" event without any filter. Such workflow is
+// triggered by pushes to every branch including topic branches and wastes runner minutes in
+// repositories with many branches. This rule is disabled by default and enabled by "push-filters"
+// in config file.
+// https://docs.github.com/en/actions/using-workflows/workflow-syntax-for-github-actions#onpushbranchestagsbranches-ignoretags-ignore
+type RulePushFilters struct {
+	RuleBase
+}
+
+// NewRulePushFilters creates new RulePushFilters instance.
+func NewRulePushFilters() *RulePushFilters {
+	return &RulePushFilters{
+		RuleBase: RuleBase{
+			name: "push-filters",
+			desc: "Checks for \"push\" event without branch, tag, and path filters",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RulePushFilters) VisitWorkflowPre(n *Workflow) error {
+	for _, e := range n.On {
+		w, ok := e.(*WebhookEvent)
+		if !ok || w.Hook.Value != "push" {
+			continue
+		}
+		if w.Branches != nil || w.BranchesIgnore != nil || w.Tags != nil || w.TagsIgnore != nil || w.Paths != nil || w.PathsIgnore != nil {
+			continue
+		}
+		rule.Errorf(
+			w.Pos,
+			"\"push\" event has no \"branches\", \"branches-ignore\", \"tags\", \"tags-ignore\", \"paths\", nor \"paths-ignore\" filter. the workflow is triggered by pushes to every branch including topic branches. add filters such as \"branches: [main]\" to limit the trigger",
+		)
+	}
+	return nil
+}
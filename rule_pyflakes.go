@@ -2,7 +2,9 @@ package actionlint
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 )
@@ -25,23 +27,76 @@ func getShellIsPythonKind(shell *String) shellIsPythonKind {
 	return shellIsPythonKindNotPython
 }
 
-// RulePyflakes is a rule to check Python scripts at 'run:' using pyflakes.
+// pythonChecker is a definition of a Python checker command which reads a script from stdin.
+type pythonChecker struct {
+	name string
+	desc string
+	// pre and post are command line arguments put before and after the arguments given by users.
+	pre  []string
+	post []string
+	// combineOutput is true when the checker reports some errors to stderr.
+	combineOutput bool
+	// prefix is a prefix of lines of errors like "<stdin>:" in the output. When this value is
+	// empty, the output is JSON.
+	prefix string
+}
+
+// Python checkers available at "python-checker" in config file.
+var pythonCheckers = map[string]*pythonChecker{
+	"pyflakes": {
+		name: "pyflakes",
+		desc: "Checks for Python script when \"shell: python\" is configured using Pyflakes",
+		// Combine output because pyflakes outputs lint errors to stdout and outputs syntax errors to stderr. (#411)
+		combineOutput: true,
+		prefix:        "<stdin>:",
+	},
+	"flake8": {
+		name:          "flake8",
+		desc:          "Checks for Python script when \"shell: python\" is configured using flake8",
+		post:          []string{"-"},
+		combineOutput: true,
+		prefix:        "stdin:",
+	},
+	"ruff": {
+		name: "ruff",
+		desc: "Checks for Python script when \"shell: python\" is configured using ruff",
+		pre:  []string{"check", "--output-format=json", "--no-cache"},
+		post: []string{"-"},
+	},
+}
+
+// RulePyflakes is a rule to check Python scripts at 'run:' using pyflakes. Other Python checkers
+// flake8 and ruff are also available with "python-checker" in config file.
 // https://github.com/PyCQA/pyflakes
 type RulePyflakes struct {
 	RuleBase
 	cmd                   *externalCommand
+	checker               *pythonChecker
+	args                  []string
+	lines                 []string
 	workflowShellIsPython shellIsPythonKind
 	jobShellIsPython      shellIsPythonKind
 	mu                    sync.Mutex
 }
 
 func newRulePyflakes(cmd *externalCommand) *RulePyflakes {
+	return newRulePythonChecker(cmd, pythonCheckers["pyflakes"], nil, nil)
+}
+
+func newRulePythonChecker(cmd *externalCommand, checker *pythonChecker, args []string, src []byte) *RulePyflakes {
+	var lines []string
+	if src != nil {
+		lines = strings.Split(string(src), "\n")
+	}
 	return &RulePyflakes{
 		RuleBase: RuleBase{
-			name: "pyflakes",
-			desc: "Checks for Python script when \"shell: python\" is configured using Pyflakes",
+			name: checker.name,
+			desc: checker.desc,
 		},
 		cmd:                   cmd,
+		checker:               checker,
+		args:                  args,
+		lines:                 lines,
 		workflowShellIsPython: shellIsPythonKindUnspecified,
 		jobShellIsPython:      shellIsPythonKindUnspecified,
 	}
@@ -51,12 +106,39 @@ func newRulePyflakes(cmd *externalCommand) *RulePyflakes {
 // or relative/absolute file path. When the given executable is not found in system, it returns
 // an error.
 func NewRulePyflakes(executable string, proc *concurrentProcess) (*RulePyflakes, error) {
-	// Combine output because pyflakes outputs lint errors to stdout and outputs syntax errors to stderr. (#411)
-	cmd, err := proc.newCommandRunner(executable, true)
+	return NewRulePythonChecker(nil, executable, proc, nil)
+}
+
+// NewRulePythonChecker creates new RulePyflakes instance which runs the Python checker configured
+// by the cfg parameter. When cfg is nil, pyflakes is run. The executable parameter is used when no
+// executable is configured. The src parameter is the source of the workflow file. It is used for
+// converting positions in scripts into positions in the workflow file. It can be nil. When the
+// given executable is not found in system, it returns an error.
+func NewRulePythonChecker(cfg *PythonCheckerConfig, executable string, proc *concurrentProcess, src []byte) (*RulePyflakes, error) {
+	checker := pythonCheckers["pyflakes"]
+	var args []string
+	if cfg != nil {
+		if cfg.Name != "" {
+			c, ok := pythonCheckers[cfg.Name]
+			if !ok {
+				return nil, fmt.Errorf("unknown Python checker %q", cfg.Name)
+			}
+			checker = c
+			if cfg.Name != "pyflakes" {
+				executable = cfg.Name
+			}
+		}
+		if cfg.Executable != "" {
+			executable = cfg.Executable
+		}
+		args = cfg.Args
+	}
+
+	cmd, err := proc.newCommandRunner(executable, checker.combineOutput)
 	if err != nil {
 		return nil, err
 	}
-	return newRulePyflakes(cmd), nil
+	return newRulePythonChecker(cmd, checker, args, src), nil
 }
 
 // VisitJobPre is callback when visiting Job node before visiting its children.
@@ -98,7 +180,7 @@ func (rule *RulePyflakes) VisitStep(n *Step) error {
 		return nil
 	}
 
-	rule.runPyflakes(run.Run.Value, run.RunPos)
+	rule.runPyflakes(run)
 	return nil
 }
 
@@ -114,11 +196,62 @@ func (rule *RulePyflakes) isPythonShell(r *ExecRun) bool {
 	return rule.workflowShellIsPython == shellIsPythonKindPython
 }
 
-func (rule *RulePyflakes) runPyflakes(src string, pos *Pos) {
-	src = sanitizeExpressionsInScript(src) // Defined at rule_shellcheck.go
-	rule.Debug("%s: Running %s for Python script:\n%s", pos, rule.cmd.exe, src)
+// scriptPosMapper returns a function to convert a line and a column in the script into a position
+// in the workflow source. Both of them are 1-based. A script can be mapped only when it is a literal
+// block scalar with "|" or a single-line plain scalar. Otherwise, and when the source is not
+// available, the function returns the fallback position such as the position of "run:".
+func scriptPosMapper(lines []string, script *String, fallback *Pos) func(line, col int) *Pos {
+	fb := func(int, int) *Pos { return fallback }
+	if lines == nil || script == nil || script.Pos == nil {
+		return fb
+	}
+	l, c := script.Pos.Line, script.Pos.Col
+	if l <= 0 || l > len(lines) || c <= 0 || c > len(lines[l-1]) {
+		return fb
+	}
 
-	rule.cmd.run([]string{}, src, func(stdout []byte, err error) error {
+	if !strings.HasPrefix(lines[l-1][c-1:], "|") {
+		if script.Quoted || strings.Contains(script.Value, "\n") {
+			return fb
+		}
+		return func(line, col int) *Pos {
+			if line != 1 {
+				return fallback
+			}
+			return &Pos{Line: l, Col: c + col - 1}
+		}
+	}
+
+	// Indentation of literal block is determined by its first non-empty line
+	indent := -1
+	for _, s := range lines[l:] {
+		if t := strings.TrimLeft(s, " "); t != "" && t != "\r" {
+			indent = len(s) - len(t)
+			break
+		}
+	}
+	if indent < 0 {
+		return fb
+	}
+	return func(line, col int) *Pos {
+		if line <= 0 || l+line > len(lines) || col <= 0 {
+			return fallback
+		}
+		return &Pos{Line: l + line, Col: indent + col}
+	}
+}
+
+func (rule *RulePyflakes) runPyflakes(run *ExecRun) {
+	src := sanitizeExpressionsInScript(run.Run.Value) // Defined at rule_shellcheck.go
+	pos := run.RunPos
+	args := make([]string, 0, len(rule.checker.pre)+len(rule.args)+len(rule.checker.post))
+	args = append(args, rule.checker.pre...)
+	args = append(args, rule.args...)
+	args = append(args, rule.checker.post...)
+	rule.Debug("%s: Running %s with %s for Python script:\n%s", pos, rule.cmd.exe, args, src)
+	mapPos := scriptPosMapper(rule.lines, run.Run, run.RunPos)
+
+	rule.cmd.run(args, src, func(stdout []byte, err error) error {
 		if err != nil {
 			rule.Debug("Command %s failed: %v", rule.cmd.exe, err)
 			return fmt.Errorf("`%s` did not run successfully while checking script at %s: %w", rule.cmd.exe, pos, err)
@@ -127,8 +260,11 @@ func (rule *RulePyflakes) runPyflakes(src string, pos *Pos) {
 			return nil
 		}
 
+		if rule.checker.prefix == "" {
+			return rule.parseJSONErrors(stdout, pos, mapPos)
+		}
 		for len(stdout) > 0 {
-			if stdout, err = rule.parseNextError(stdout, pos); err != nil {
+			if stdout, err = rule.parseNextError(stdout, pos, mapPos); err != nil {
 				return err
 			}
 		}
@@ -136,11 +272,32 @@ func (rule *RulePyflakes) runPyflakes(src string, pos *Pos) {
 	})
 }
 
-func (rule *RulePyflakes) parseNextError(stdout []byte, pos *Pos) ([]byte, error) {
+// report reports the error with message like "1:7: undefined name 'foo'" at the position in the
+// workflow source converted from the line and the column at the head of the message.
+func (rule *RulePyflakes) report(msg string, mapPos func(int, int) *Pos) {
+	line, col := 0, 0
+	if ss := strings.SplitN(msg, ":", 3); len(ss) >= 2 {
+		line, _ = strconv.Atoi(ss[0])
+		if len(ss) == 3 {
+			col, _ = strconv.Atoi(ss[1])
+		}
+	}
+	if col <= 0 {
+		col = 1 // Old pyflakes does not report column
+	}
+
+	// This method needs to be thread-safe since concurrentProcess.run calls its callback in a different goroutine.
+	rule.mu.Lock()
+	rule.Errorf(mapPos(line, col), "%s reported issue in this script: %s", rule.name, msg)
+	rule.mu.Unlock()
+}
+
+func (rule *RulePyflakes) parseNextError(stdout []byte, pos *Pos, mapPos func(int, int) *Pos) ([]byte, error) {
 	b := stdout
+	prefix := []byte(rule.checker.prefix)
 
 	// Search the start of error message.
-	idx := bytes.Index(b, []byte("<stdin>:"))
+	idx := bytes.Index(b, prefix)
 	if idx == -1 {
 		// Syntax errors from pyflake consist of multiple lines. Skip subsequent lines. (#411)
 		// ```
@@ -150,11 +307,11 @@ func (rule *RulePyflakes) parseNextError(stdout []byte, pos *Pos) ([]byte, error
 		// ```
 		return nil, nil
 	}
-	b = b[idx+len("<stdin>:"):]
+	b = b[idx+len(prefix):]
 
 	idx = bytes.IndexByte(b, '\n')
 	if idx == -1 {
-		return nil, fmt.Errorf(`error message from pyflakes does not end with \n nor \r\n while checking script at %s. output: %q`, pos, stdout)
+		return nil, fmt.Errorf(`error message from %s does not end with \n nor \r\n while checking script at %s. output: %q`, rule.name, pos, stdout)
 	}
 
 	msg := b[:idx]
@@ -163,10 +320,31 @@ func (rule *RulePyflakes) parseNextError(stdout []byte, pos *Pos) ([]byte, error
 	}
 	b = b[idx+1:]
 
-	// This method needs to be thread-safe since concurrentProcess.run calls its callback in a different goroutine.
-	rule.mu.Lock()
-	rule.Errorf(pos, "pyflakes reported issue in this script: %s", msg)
-	rule.mu.Unlock()
+	rule.report(string(msg), mapPos)
 
 	return b, nil
 }
+
+type ruffError struct {
+	Code     *string `json:"code"` // null for syntax errors
+	Message  string  `json:"message"`
+	Location struct {
+		Row    int `json:"row"`
+		Column int `json:"column"`
+	} `json:"location"`
+}
+
+func (rule *RulePyflakes) parseJSONErrors(stdout []byte, pos *Pos, mapPos func(int, int) *Pos) error {
+	errs := []ruffError{}
+	if err := json.Unmarshal(stdout, &errs); err != nil {
+		return fmt.Errorf("could not parse JSON output from %s while checking script at %s: %w: stdout=%q", rule.name, pos, err, stdout)
+	}
+	for _, e := range errs {
+		msg := e.Message
+		if e.Code != nil && *e.Code != "" {
+			msg = *e.Code + " " + msg
+		}
+		rule.report(fmt.Sprintf("%d:%d: %s", e.Location.Row, e.Location.Column, msg), mapPos)
+	}
+	return nil
+}
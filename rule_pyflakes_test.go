@@ -1,8 +1,13 @@
 package actionlint
 
 import (
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestRulePyflakesDetectPythonShell(t *testing.T) {
@@ -157,7 +162,7 @@ func TestRulePyflakesParsePyflakesOutputOK(t *testing.T) {
 			stdout := []byte(tc.input)
 			pos := &Pos{Line: 1, Col: 2}
 			for len(stdout) > 0 {
-				o, err := r.parseNextError(stdout, pos)
+				o, err := r.parseNextError(stdout, pos, func(int, int) *Pos { return pos })
 				if err != nil {
 					t.Fatalf("Parse error %q while reading input %q", err, stdout)
 				}
@@ -185,7 +190,7 @@ func TestRulePyflakesParsePyflakesOutputOK(t *testing.T) {
 
 func TestRulePyflakesParsePyflakesOutputError(t *testing.T) {
 	r := newRulePyflakes(&externalCommand{})
-	_, err := r.parseNextError([]byte("<stdin>:1:7: undefined name 'foo'"), &Pos{})
+	_, err := r.parseNextError([]byte("<stdin>:1:7: undefined name 'foo'"), &Pos{}, func(int, int) *Pos { return &Pos{} })
 	if err == nil {
 		t.Fatal("Error did not happen")
 	}
@@ -195,3 +200,163 @@ func TestRulePyflakesParsePyflakesOutputError(t *testing.T) {
 		t.Fatalf("Error %q does not contain expected message %q", have, want)
 	}
 }
+
+func TestRulePyflakesParseOutputOfOtherCheckers(t *testing.T) {
+	tests := []struct {
+		checker string
+		input   string
+		want    []string
+		col     int // Column of the first error in the script
+	}{
+		{
+			checker: "flake8",
+			input: "stdin:1:1: F401 'os' imported but unused\n" +
+				"stdin:2:7: F821 undefined name 'foo'\n",
+			want: []string{
+				"flake8 reported issue in this script: 1:1: F401 'os' imported but unused",
+				"flake8 reported issue in this script: 2:7: F821 undefined name 'foo'",
+			},
+			col: 1,
+		},
+		{
+			checker: "ruff",
+			input: `[{"code":"F401","message":"` + "`os`" + ` imported but unused","location":{"row":1,"column":8}},` +
+				`{"code":null,"message":"SyntaxError: Expected ')', found newline","location":{"row":3,"column":7}}]`,
+			want: []string{
+				"ruff reported issue in this script: 1:8: F401 `os` imported but unused",
+				"ruff reported issue in this script: 3:7: SyntaxError: Expected ')', found newline",
+			},
+			col: 8,
+		},
+		{
+			checker: "ruff",
+			input:   "[]",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.checker, func(t *testing.T) {
+			r := newRulePythonChecker(&externalCommand{}, pythonCheckers[tc.checker], nil, nil)
+			stdout := []byte(tc.input)
+			pos := &Pos{Line: 1, Col: 2}
+			mapPos := func(l, c int) *Pos { return &Pos{Line: l + 10, Col: c + 4} }
+			if r.checker.prefix == "" {
+				if err := r.parseJSONErrors(stdout, pos, mapPos); err != nil {
+					t.Fatal(err)
+				}
+			} else {
+				for len(stdout) > 0 {
+					o, err := r.parseNextError(stdout, pos, mapPos)
+					if err != nil {
+						t.Fatal(err)
+					}
+					stdout = o
+				}
+			}
+
+			have := r.Errs()
+			if len(have) != len(tc.want) {
+				t.Fatalf("wanted %d errors but got %v", len(tc.want), have)
+			}
+			for i, want := range tc.want {
+				if have[i].Message != want {
+					t.Errorf("wanted message %q but got %q", want, have[i].Message)
+				}
+				if have[i].Kind != tc.checker {
+					t.Errorf("wanted kind %q but got %q", tc.checker, have[i].Kind)
+				}
+			}
+			if len(have) > 0 && (have[0].Line != 11 || have[0].Column != tc.col+4) {
+				t.Errorf("position was not converted: %v", have[0])
+			}
+		})
+	}
+}
+
+func TestRulePyflakesParseRuffOutputError(t *testing.T) {
+	r := newRulePythonChecker(&externalCommand{}, pythonCheckers["ruff"], nil, nil)
+	err := r.parseJSONErrors([]byte("error: invalid"), &Pos{}, func(int, int) *Pos { return &Pos{} })
+	if err == nil || !strings.Contains(err.Error(), "could not parse JSON output from ruff") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRulePyflakesScriptPosMapper(t *testing.T) {
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - shell: python
+        run: |
+
+          import os
+          print(foo)
+      - shell: python
+        run: print(foo)
+      - shell: python
+        run: 'print(foo)'
+      - shell: python
+        run: >
+          print(foo)
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	lines := strings.Split(src, "\n")
+	steps := w.Jobs["test"].Steps
+	tests := []struct {
+		what      string
+		step      int
+		line, col int
+		want      Pos
+	}{
+		{"literal block", 0, 3, 7, Pos{Line: 10, Col: 17}},
+		{"first line of literal block", 0, 2, 1, Pos{Line: 9, Col: 11}},
+		{"plain scalar", 1, 1, 7, Pos{Line: 12, Col: 20}},
+		{"quoted scalar falls back to run:", 2, 1, 7, Pos{Line: 14, Col: 9}},
+		{"folded block falls back to run:", 3, 1, 7, Pos{Line: 16, Col: 9}},
+		{"line out of script falls back to run:", 0, 100, 1, Pos{Line: 7, Col: 9}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			run := steps[tc.step].Exec.(*ExecRun)
+			have := scriptPosMapper(lines, run.Run, run.RunPos)(tc.line, tc.col)
+			if *have != tc.want {
+				t.Fatalf("wanted %v but got %v", tc.want, *have)
+			}
+		})
+	}
+
+	run := steps[0].Exec.(*ExecRun)
+	if have := scriptPosMapper(nil, run.Run, run.RunPos)(3, 7); *have != *run.RunPos {
+		t.Fatalf("position should fall back to run: without source but got %v", *have)
+	}
+}
+
+func TestRulePyflakesNewRulePythonChecker(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake checker command is a shell script")
+	}
+	exe := filepath.Join(t.TempDir(), "my-ruff")
+	if err := os.WriteFile(exe, []byte("#!/bin/sh\necho '[]'\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	proc := newConcurrentProcess(1)
+	r, err := NewRulePythonChecker(&PythonCheckerConfig{Name: "ruff", Executable: exe, Args: []string{"--select", "E,F"}}, "pyflakes", proc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Name() != "ruff" || r.cmd.exe != exe {
+		t.Fatalf("unexpected rule %q with executable %q", r.Name(), r.cmd.exe)
+	}
+	if diff := cmp.Diff([]string{"--select", "E,F"}, r.args); diff != "" {
+		t.Fatal(diff)
+	}
+
+	if _, err := NewRulePythonChecker(&PythonCheckerConfig{Name: "pylint"}, "pyflakes", proc, nil); err == nil {
+		t.Fatal("error did not occur for unknown checker")
+	}
+}
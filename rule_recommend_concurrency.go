@@ -0,0 +1,87 @@
+package actionlint
+
+import "strings"
+
+// RuleRecommendConcurrency is a rule checker to recommend "concurrency:" with "cancel-in-progress: true"
+// for workflows triggered by events like "pull_request". Without it, pushing new commits to a pull
+// request does not stop the runs for outdated commits and they waste runner minutes. This rule is
+// disabled by default and enabled by "recommend-concurrency" in config file.
+type RuleRecommendConcurrency struct {
+	RuleBase
+	events []string
+}
+
+// NewRuleRecommendConcurrency creates new RuleRecommendConcurrency instance.
+func NewRuleRecommendConcurrency(cfg *RecommendConcurrencyConfig) *RuleRecommendConcurrency {
+	events := []string{"pull_request"}
+	if cfg != nil && len(cfg.Events) > 0 {
+		events = make([]string, 0, len(cfg.Events))
+		for _, e := range cfg.Events {
+			events = append(events, strings.ToLower(e))
+		}
+	}
+	return &RuleRecommendConcurrency{
+		RuleBase: RuleBase{
+			name: "recommend-concurrency",
+			desc: "Recommends \"concurrency:\" with \"cancel-in-progress: true\" for workflows triggered by events like \"pull_request\"",
+		},
+		events: events,
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleRecommendConcurrency) VisitWorkflowPre(n *Workflow) error {
+	var hook *String
+	for _, e := range n.On {
+		if w, ok := e.(*WebhookEvent); ok && contains(rule.events, w.EventName()) {
+			hook = w.Hook
+			break
+		}
+	}
+	if hook == nil {
+		return nil
+	}
+
+	c := n.Concurrency
+	if c == nil {
+		if allJobsSetConcurrency(n.Jobs) {
+			return nil
+		}
+		rule.Errorf(
+			hook.Pos,
+			"workflow triggered by %q event does not set \"concurrency:\". consider canceling redundant runs with \"concurrency: { group: ${{ github.workflow }}-${{ github.ref }}, cancel-in-progress: true }\"",
+			hook.Value,
+		)
+		return nil
+	}
+
+	if c.CancelInProgress == nil {
+		rule.Errorf(
+			c.Pos,
+			"\"cancel-in-progress\" is not set at \"concurrency:\" of workflow triggered by %q event. consider setting \"cancel-in-progress: true\" to cancel redundant runs",
+			hook.Value,
+		)
+		return nil
+	}
+
+	if c.CancelInProgress.Expression == nil && !c.CancelInProgress.Value {
+		rule.Errorf(
+			c.CancelInProgress.Pos,
+			"\"cancel-in-progress\" is false at \"concurrency:\" of workflow triggered by %q event. consider setting \"cancel-in-progress: true\" to cancel redundant runs",
+			hook.Value,
+		)
+	}
+	return nil
+}
+
+func allJobsSetConcurrency(jobs map[string]*Job) bool {
+	if len(jobs) == 0 {
+		return false
+	}
+	for _, j := range jobs {
+		if j.Concurrency == nil {
+			return false
+		}
+	}
+	return true
+}
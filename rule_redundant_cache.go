@@ -0,0 +1,157 @@
+package actionlint
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Directories cached by setup-* actions for each package manager.
+var setupActionCacheDirs = map[string][]string{
+	"npm":    {"~/.npm"},
+	"yarn":   {"~/.cache/yarn", "~/.yarn/cache", ".yarn/cache", "~/.yarn/berry/cache"},
+	"pnpm":   {"~/.pnpm-store", "~/.local/share/pnpm/store"},
+	"pip":    {"~/.cache/pip"},
+	"pipenv": {"~/.cache/pipenv", "~/.local/share/virtualenvs"},
+	"poetry": {"~/.cache/pypoetry"},
+	"go":     {"~/go/pkg/mod", "~/.cache/go-build"},
+}
+
+// setupActionCache is a package manager cache enabled by setup-* action.
+type setupActionCache struct {
+	manager string
+	uses    *String
+	input   string
+}
+
+// RuleRedundantCache is a rule checker to detect actions/cache which caches the same directory as
+// built-in caching of setup-* actions like actions/setup-node in the same job. Such double caching
+// wastes the cache storage of the repository.
+type RuleRedundantCache struct {
+	RuleBase
+}
+
+// NewRuleRedundantCache creates new RuleRedundantCache instance.
+func NewRuleRedundantCache() *RuleRedundantCache {
+	return &RuleRedundantCache{
+		RuleBase: RuleBase{
+			name: "redundant-cache",
+			desc: "Checks for actions/cache caching the same directory as setup-* actions' built-in caching",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleRedundantCache) VisitJobPre(n *Job) error {
+	caches := map[string]*setupActionCache{}
+	for _, s := range n.Steps {
+		if e, ok := s.Exec.(*ExecAction); ok {
+			if c := builtinCacheOfSetupAction(e); c != nil {
+				if _, ok := caches[c.manager]; !ok {
+					caches[c.manager] = c
+				}
+			}
+		}
+	}
+	if len(caches) == 0 {
+		return nil
+	}
+
+	for _, s := range n.Steps {
+		e, ok := s.Exec.(*ExecAction)
+		if !ok || e.Uses == nil || !isCacheAction(e.Uses.Value) {
+			continue
+		}
+		i, ok := e.Inputs["path"]
+		if !ok || i.Value == nil {
+			continue
+		}
+		for _, p := range strings.Split(i.Value.Value, "\n") {
+			p = normalizeCachePath(p)
+			if p == "" {
+				continue
+			}
+			for _, c := range caches {
+				if !contains(setupActionCacheDirs[c.manager], p) {
+					continue
+				}
+				rule.Errorf(
+					i.Value.Pos,
+					"path %q is already cached by built-in caching of %q action at %s (%s). caching the same directory twice wastes cache storage. remove this step or disable the built-in caching",
+					p,
+					c.uses.Value,
+					c.uses.Pos.String(),
+					c.input,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+func isCacheAction(uses string) bool {
+	u := strings.ToLower(uses)
+	return strings.HasPrefix(u, "actions/cache@") || strings.HasPrefix(u, "actions/cache/save@")
+}
+
+func normalizeCachePath(p string) string {
+	p = strings.TrimSpace(p)
+	if strings.HasPrefix(p, "$HOME/") {
+		p = "~/" + p[len("$HOME/"):]
+	}
+	return strings.TrimRight(p, "/")
+}
+
+// actionMajorVersion returns major version of the action's ref like "v4" or "v4.1.0". It returns -1
+// when the ref is not a version.
+func actionMajorVersion(uses string) int {
+	idx := strings.IndexByte(uses, '@')
+	if idx == -1 {
+		return -1
+	}
+	v := strings.TrimPrefix(uses[idx+1:], "v")
+	if dot := strings.IndexByte(v, '.'); dot != -1 {
+		v = v[:dot]
+	}
+	m, err := strconv.Atoi(v)
+	if err != nil {
+		return -1
+	}
+	return m
+}
+
+func builtinCacheOfSetupAction(e *ExecAction) *setupActionCache {
+	if e.Uses == nil {
+		return nil
+	}
+	u := strings.ToLower(e.Uses.Value)
+	input := func(name string) (string, bool) {
+		i, ok := e.Inputs[name]
+		if !ok || i.Value == nil || i.Value.ContainsExpression() {
+			return "", false
+		}
+		return strings.TrimSpace(i.Value.Value), true
+	}
+
+	switch {
+	case strings.HasPrefix(u, "actions/setup-node@"), strings.HasPrefix(u, "actions/setup-python@"):
+		m, ok := input("cache")
+		if !ok || m == "" {
+			return nil
+		}
+		return &setupActionCache{m, e.Uses, "\"cache: " + m + "\" input"}
+	case strings.HasPrefix(u, "actions/setup-go@"):
+		// Caching is enabled by default since v4
+		if v, ok := input("cache"); ok {
+			if v != "true" {
+				return nil
+			}
+			return &setupActionCache{"go", e.Uses, "\"cache: true\" input"}
+		}
+		if actionMajorVersion(u) < 4 {
+			return nil
+		}
+		return &setupActionCache{"go", e.Uses, "enabled by default since v4"}
+	default:
+		return nil
+	}
+}
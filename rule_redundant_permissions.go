@@ -0,0 +1,111 @@
+package actionlint
+
+import "sort"
+
+// Levels of permission values. A larger level grants more.
+var permissionLevels = map[string]int{
+	"none":  0,
+	"read":  1,
+	"write": 2,
+}
+
+// RuleRedundantPermissions is a rule checker to detect redundant "permissions:" declarations.
+// Job-level "permissions:" which is identical to workflow-level one is redundant since the job
+// inherits the workflow-level permissions. And scopes which are granted at both levels where the
+// job-level grant is a superset of the workflow-level one are reported so that reviewers can
+// notice the job-level escalation. This rule is disabled by default and enabled by
+// "redundant-permissions" in config file.
+type RuleRedundantPermissions struct {
+	RuleBase
+	workflow *Permissions
+}
+
+// NewRuleRedundantPermissions creates new RuleRedundantPermissions instance.
+func NewRuleRedundantPermissions() *RuleRedundantPermissions {
+	return &RuleRedundantPermissions{
+		RuleBase: RuleBase{
+			name: "redundant-permissions",
+			desc: "Checks for job-level \"permissions:\" redundantly repeating workflow-level one",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleRedundantPermissions) VisitWorkflowPre(n *Workflow) error {
+	rule.workflow = n.Permissions
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleRedundantPermissions) VisitJobPre(n *Job) error {
+	w, j := rule.workflow, n.Permissions
+	if w == nil || j == nil {
+		return nil
+	}
+
+	if samePermissions(w, j) {
+		id := ""
+		if n.ID != nil {
+			id = n.ID.Value
+		}
+		rule.Errorf(
+			j.Pos,
+			"\"permissions:\" of job %q is identical to workflow-level \"permissions:\" at %s. remove it since the job inherits the workflow-level permissions",
+			id,
+			w.Pos.String(),
+		)
+		return nil
+	}
+
+	if w.All != nil || j.All != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(j.Scopes))
+	for name := range j.Scopes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		js := j.Scopes[name]
+		ws, ok := w.Scopes[name]
+		if !ok || js.Value == nil || ws.Value == nil {
+			continue
+		}
+		jl, ok := permissionLevels[js.Value.Value]
+		if !ok {
+			continue
+		}
+		wl, ok := permissionLevels[ws.Value.Value]
+		if !ok || jl <= wl {
+			continue
+		}
+		rule.Errorf(
+			js.Name.Pos,
+			"permission scope %q is granted %q at job-level which is a superset of %q granted at workflow-level at %s. the workflow-level grant is overridden in this job",
+			js.Name.Value,
+			js.Value.Value,
+			ws.Value.Value,
+			ws.Name.Pos.String(),
+		)
+	}
+
+	return nil
+}
+
+func samePermissions(l, r *Permissions) bool {
+	if l.All != nil || r.All != nil {
+		return l.All != nil && r.All != nil && l.All.Value == r.All.Value && !l.All.ContainsExpression()
+	}
+	if len(l.Scopes) != len(r.Scopes) {
+		return false
+	}
+	for name, ls := range l.Scopes {
+		rs, ok := r.Scopes[name]
+		if !ok || ls.Value == nil || rs.Value == nil || ls.Value.Value != rs.Value.Value || ls.Value.ContainsExpression() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,137 @@
+package actionlint
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// RuleReleaseNotes is a rule to check configuration file of automatically generated release notes
+// (.github/release.yml).
+// https://docs.github.com/en/repositories/releasing-projects-on-github/automatically-generated-release-notes
+type RuleReleaseNotes struct {
+	RuleBase
+}
+
+// NewRuleReleaseNotes creates a new RuleReleaseNotes instance.
+func NewRuleReleaseNotes() *RuleReleaseNotes {
+	return &RuleReleaseNotes{
+		RuleBase: RuleBase{
+			name: "release-notes",
+			desc: "Checks for configuration file of automatically generated release notes (release.yml) such as categories and labels",
+		},
+	}
+}
+
+// isReleaseNotesConfigFile returns true when the file path is configuration file of automatically
+// generated release notes ".github/release.yml" or ".github/release.yaml".
+func isReleaseNotesConfigFile(path string) bool {
+	b := filepath.Base(path)
+	if b != "release.yml" && b != "release.yaml" {
+		return false
+	}
+	return filepath.Base(filepath.Dir(path)) == ".github"
+}
+
+// CheckReleaseNotes checks the release notes configuration syntax tree.
+func (rule *RuleReleaseNotes) CheckReleaseNotes(r *ReleaseNotes) {
+	excluded := map[string]struct{}{}
+	if r.Exclude != nil {
+		rule.checkExclude(r.Exclude)
+		for _, l := range r.Exclude.Labels {
+			excluded[l.Value] = struct{}{}
+		}
+	}
+
+	titles := map[string]*Pos{}
+	type labelAt struct {
+		category *ReleaseNotesCategory
+		pos      *Pos
+	}
+	labels := map[string]labelAt{}
+	var catchAll *ReleaseNotesCategory
+	for _, c := range r.Categories {
+		if c.Title != nil {
+			if p, ok := titles[c.Title.Value]; ok {
+				rule.Errorf(c.Title.Pos, "title %q of category is duplicated. previously defined at %s", c.Title.Value, p)
+			} else {
+				titles[c.Title.Value] = c.Title.Pos
+			}
+		}
+
+		if catchAll != nil {
+			rule.Errorf(
+				c.Pos,
+				"category %s is always empty since the previous category %s has label \"*\", which matches all pull requests not matched by previous categories",
+				releaseNotesCategoryName(c),
+				releaseNotesCategoryName(catchAll),
+			)
+		}
+
+		if c.Exclude != nil {
+			rule.checkExclude(c.Exclude)
+		}
+
+		rule.checkUniqueStrings(c.Labels, "label", "category "+releaseNotesCategoryName(c))
+		for _, l := range c.Labels {
+			if l.Value == "*" {
+				if len(c.Labels) > 1 {
+					rule.Errorf(l.Pos, "label \"*\" matches all pull requests. other labels in category %s are redundant", releaseNotesCategoryName(c))
+				}
+				if catchAll == nil {
+					catchAll = c
+				}
+				continue
+			}
+			if _, ok := excluded[l.Value]; ok {
+				rule.Errorf(l.Pos, "pull requests with label %q are never shown in category %s since the label is excluded at \"changelog.exclude.labels\"", l.Value, releaseNotesCategoryName(c))
+				continue
+			}
+			if prev, ok := labels[l.Value]; ok {
+				if prev.category != c { // Duplicates in the same category were already reported
+					rule.Errorf(
+						l.Pos,
+						"pull requests with label %q are never shown in category %s since they are categorized into the previous category %s. the label was used at %s",
+						l.Value,
+						releaseNotesCategoryName(c),
+						releaseNotesCategoryName(prev.category),
+						prev.pos,
+					)
+				}
+				continue
+			}
+			labels[l.Value] = labelAt{c, l.Pos}
+		}
+	}
+}
+
+func releaseNotesCategoryName(c *ReleaseNotesCategory) string {
+	if c.Title == nil {
+		return "at " + c.Pos.String()
+	}
+	return `"` + c.Title.Value + `"`
+}
+
+func (rule *RuleReleaseNotes) checkExclude(e *ReleaseNotesExclude) {
+	rule.checkUniqueStrings(e.Labels, "label", "\"exclude\" section")
+	rule.checkUniqueStrings(e.Authors, "author", "\"exclude\" section")
+	for _, a := range e.Authors {
+		if strings.HasPrefix(a.Value, "@") {
+			rule.Errorf(a.Pos, "author %q in \"exclude\" section must be a user name without \"@\" like %q", a.Value, strings.TrimPrefix(a.Value, "@"))
+		}
+	}
+}
+
+func (rule *RuleReleaseNotes) checkUniqueStrings(ss []*String, what, where string) {
+	seen := make(map[string]*Pos, len(ss))
+	for _, s := range ss {
+		if strings.TrimSpace(s.Value) == "" {
+			rule.Errorf(s.Pos, "%s in %s must not be empty", what, where)
+			continue
+		}
+		if p, ok := seen[s.Value]; ok {
+			rule.Errorf(s.Pos, "%s %q in %s is duplicated. previously defined at %s", what, s.Value, where, p)
+			continue
+		}
+		seen[s.Value] = s.Pos
+	}
+}
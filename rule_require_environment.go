@@ -0,0 +1,152 @@
+package actionlint
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+var defaultRequireEnvironmentJobs = []string{"*deploy*", "*release*", "*publish*"}
+
+var reBranchRef = regexp.MustCompile(`refs/heads/([^'"\s)]+)`)
+
+// RuleRequireEnvironment is a rule checker to require "environment:" on jobs which look like
+// deploying to production. Protection rules and required reviewers of an environment are applied
+// only to jobs which refer the environment, so forgetting the key silently bypasses them. Deploy-like
+// jobs are detected heuristically by patterns of their IDs or names and production branches. This
+// rule is disabled by default and enabled by "require-environment" in config file.
+// https://docs.github.com/en/actions/deployment/targeting-different-environments/using-environments-for-deployment
+type RuleRequireEnvironment struct {
+	RuleBase
+	jobs     []string
+	branches []string
+	// onBranches is true when the workflow is triggered by "push" event to production branches.
+	onBranches bool
+}
+
+func validateRequireEnvironmentPatterns(pats []string, key string) error {
+	for _, p := range pats {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid glob pattern %q at \"require-environment.%s\" in config: %w", p, key, err)
+		}
+	}
+	return nil
+}
+
+// NewRuleRequireEnvironment creates new RuleRequireEnvironment instance. It returns an error when
+// some pattern in the configuration is not a valid glob.
+func NewRuleRequireEnvironment(cfg *RequireEnvironmentConfig) (*RuleRequireEnvironment, error) {
+	if err := validateRequireEnvironmentPatterns(cfg.Jobs, "jobs"); err != nil {
+		return nil, err
+	}
+	if err := validateRequireEnvironmentPatterns(cfg.Branches, "branches"); err != nil {
+		return nil, err
+	}
+	jobs := cfg.Jobs
+	if len(jobs) == 0 {
+		jobs = defaultRequireEnvironmentJobs
+	}
+	return &RuleRequireEnvironment{
+		RuleBase: RuleBase{
+			name: "require-environment",
+			desc: "Checks for \"environment:\" missing in jobs which deploy to production",
+		},
+		jobs:     jobs,
+		branches: cfg.Branches,
+	}, nil
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleRequireEnvironment) VisitWorkflowPre(n *Workflow) error {
+	rule.onBranches = false
+	for _, e := range n.On {
+		w, ok := e.(*WebhookEvent)
+		if !ok || w.Hook.Value != "push" {
+			continue
+		}
+		if w.Branches.IsEmpty() && w.Tags.IsEmpty() {
+			// Triggered by pushes to all branches
+			rule.onBranches = true
+			break
+		}
+		if w.Branches.IsEmpty() {
+			continue
+		}
+		for _, b := range w.Branches.Values {
+			if rule.isProductionBranch(b.Value) {
+				rule.onBranches = true
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleRequireEnvironment) VisitJobPre(n *Job) error {
+	// "environment:" is not available on jobs calling reusable workflows
+	if n.Environment != nil || n.WorkflowCall != nil || n.ID == nil {
+		return nil
+	}
+
+	pat, ok := rule.matchJob(n)
+	if !ok {
+		return nil
+	}
+	if len(rule.branches) > 0 && !rule.onBranches && !rule.conditionOnBranches(n.If) {
+		return nil
+	}
+
+	rule.Errorf(
+		n.Pos,
+		"job %q looks like deploying to production since it matches pattern %q at \"require-environment.jobs\" in config, but \"environment:\" is not set. protection rules and required reviewers of the environment are bypassed",
+		n.ID.Value,
+		pat,
+	)
+	return nil
+}
+
+func (rule *RuleRequireEnvironment) matchJob(n *Job) (string, bool) {
+	names := []string{strings.ToLower(n.ID.Value)}
+	if n.Name != nil && !n.Name.ContainsExpression() {
+		names = append(names, strings.ToLower(n.Name.Value))
+	}
+	for _, p := range rule.jobs {
+		lp := strings.ToLower(p)
+		for _, name := range names {
+			if m, err := path.Match(lp, name); err == nil && m {
+				return p, true
+			}
+		}
+	}
+	return "", false
+}
+
+// conditionOnBranches returns true when the condition compares "github.ref" with some production
+// branch like `github.ref == 'refs/heads/main'`.
+func (rule *RuleRequireEnvironment) conditionOnBranches(cond *String) bool {
+	if cond == nil {
+		return false
+	}
+	for _, m := range reBranchRef.FindAllStringSubmatch(cond.Value, -1) {
+		if rule.isProductionBranch(m[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// isProductionBranch returns true when the branch name or the branch filter pattern matches some
+// pattern of production branches.
+func (rule *RuleRequireEnvironment) isProductionBranch(branch string) bool {
+	for _, p := range rule.branches {
+		if p == branch {
+			return true
+		}
+		if m, err := path.Match(p, branch); err == nil && m {
+			return true
+		}
+	}
+	return false
+}
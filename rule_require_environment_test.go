@@ -0,0 +1,17 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleRequireEnvironmentInvalidPattern(t *testing.T) {
+	_, err := NewRuleRequireEnvironment(&RequireEnvironmentConfig{Branches: []string{"release/["}})
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	want := `invalid glob pattern "release/[" at "require-environment.branches" in config`
+	if msg := err.Error(); !strings.Contains(msg, want) {
+		t.Fatalf("error message %q does not contain %q", msg, want)
+	}
+}
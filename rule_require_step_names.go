@@ -0,0 +1,61 @@
+package actionlint
+
+import "strings"
+
+// RuleRequireStepNames is a rule checker to require "name:" on steps. Steps without names are
+// shown with their scripts or action names in CI logs and they are hard to read. This rule is
+// disabled by default and enabled by "require-step-names" in config file.
+type RuleRequireStepNames struct {
+	RuleBase
+	runOnly     bool
+	minRunLines int
+}
+
+// NewRuleRequireStepNames creates new RuleRequireStepNames instance.
+func NewRuleRequireStepNames(cfg *RequireStepNamesConfig) *RuleRequireStepNames {
+	r := &RuleRequireStepNames{
+		RuleBase: RuleBase{
+			name: "require-step-names",
+			desc: "Checks that \"name:\" is set to steps",
+		},
+	}
+	if cfg != nil {
+		r.runOnly = cfg.RunOnly || cfg.MinRunLines > 0
+		r.minRunLines = cfg.MinRunLines
+	}
+	return r
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleRequireStepNames) VisitStep(n *Step) error {
+	if n.Name != nil {
+		return nil
+	}
+
+	e, ok := n.Exec.(*ExecRun)
+	if !ok {
+		if !rule.runOnly {
+			rule.Errorf(n.Pos, "\"name:\" is not set to step. name the step to make CI logs readable")
+		}
+		return nil
+	}
+
+	if rule.minRunLines > 0 {
+		if e.Run == nil {
+			return nil
+		}
+		l := strings.Count(strings.TrimRight(e.Run.Value, "\n"), "\n") + 1
+		if l < rule.minRunLines {
+			return nil
+		}
+		rule.Errorf(
+			n.Pos,
+			"\"name:\" is not set to step running script with %d lines at \"run:\". name the step to make CI logs readable",
+			l,
+		)
+		return nil
+	}
+
+	rule.Errorf(n.Pos, "\"name:\" is not set to step running script at \"run:\". name the step to make CI logs readable")
+	return nil
+}
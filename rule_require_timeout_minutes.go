@@ -0,0 +1,47 @@
+package actionlint
+
+// RuleRequireTimeoutMinutes is a rule checker to require "timeout-minutes" on jobs and optionally
+// on steps. The default timeout of a job is 360 minutes and a hanging job wastes runner minutes
+// until the timeout. This rule is disabled by default and enabled by "require-timeout-minutes" in
+// config file.
+type RuleRequireTimeoutMinutes struct {
+	RuleBase
+	steps bool
+}
+
+// NewRuleRequireTimeoutMinutes creates new RuleRequireTimeoutMinutes instance.
+func NewRuleRequireTimeoutMinutes(cfg *RequireTimeoutMinutesConfig) *RuleRequireTimeoutMinutes {
+	return &RuleRequireTimeoutMinutes{
+		RuleBase: RuleBase{
+			name: "require-timeout-minutes",
+			desc: "Checks that \"timeout-minutes\" is set to jobs and optionally to steps",
+		},
+		steps: cfg != nil && cfg.Steps,
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleRequireTimeoutMinutes) VisitJobPre(n *Job) error {
+	// "timeout-minutes" is not available on a job calling a reusable workflow
+	if n.WorkflowCall != nil || n.TimeoutMinutes != nil {
+		return nil
+	}
+	rule.Errorf(
+		n.Pos,
+		"\"timeout-minutes\" is not set to job %q. the default timeout is 360 minutes and a hanging job wastes runner minutes until the timeout",
+		n.ID.Value,
+	)
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleRequireTimeoutMinutes) VisitStep(n *Step) error {
+	if !rule.steps || n.TimeoutMinutes != nil {
+		return nil
+	}
+	if _, ok := n.Exec.(*ExecRun); !ok {
+		return nil
+	}
+	rule.Errorf(n.Pos, "\"timeout-minutes\" is not set to step running shell script with \"run:\"")
+	return nil
+}
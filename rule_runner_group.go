@@ -0,0 +1,38 @@
+package actionlint
+
+// RuleRunnerGroup is a rule to check runner groups at "runs-on.group" exist in the organization
+// and are available to the repository. A job specifying a runner group which does not exist waits
+// for a runner until it times out.
+// https://docs.github.com/en/actions/using-jobs/choosing-the-runner-for-a-job#choosing-runners-in-a-group
+type RuleRunnerGroup struct {
+	RuleBase
+	groups remoteNamesChecker
+}
+
+// NewRuleRunnerGroup creates a new RuleRunnerGroup instance. The slug parameter is "owner/repo" of
+// the repository where the workflow runs. The groups parameter is a function to fetch names of the
+// runner groups available to the repository. Its second return value is false when the names could
+// not be fetched. In the case, this rule reports nothing.
+func NewRuleRunnerGroup(slug string, groups func(string) ([]string, bool, error)) *RuleRunnerGroup {
+	return &RuleRunnerGroup{
+		RuleBase: RuleBase{
+			name: "runner-group",
+			desc: "Checks for runner groups at \"runs-on.group\" which are not available to the repository",
+		},
+		groups: remoteNamesChecker{
+			slug:     slug,
+			fetch:    groups,
+			what:     "runner groups",
+			notExist: "runner group %q does not exist or is not available to repository %q. the job waits for a runner until it times out",
+			empty:    "no runner group is available to the repository",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleRunnerGroup) VisitJobPre(n *Job) error {
+	if n.RunsOn == nil || n.RunsOn.Group == nil {
+		return nil
+	}
+	return rule.groups.check(&rule.RuleBase, n.RunsOn.Group)
+}
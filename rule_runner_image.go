@@ -0,0 +1,159 @@
+package actionlint
+
+import "strings"
+
+//go:generate go run ./scripts/generate-runner-images ./runner_images.go
+
+// runnerImageDeprecation is a schedule of deprecation of runner image. Dates are in "YYYY-MM-DD"
+// format so that they can be compared as strings.
+type runnerImageDeprecation struct {
+	deprecation string
+	removal     string
+	alternative string
+}
+
+// runnerLabelMigration is a migration of "-latest" runner label from one image to another.
+type runnerLabelMigration struct {
+	label string
+	from  string
+	to    string
+	start string
+	end   string
+}
+
+// RuleRunnerImage is a rule checker to detect runner images which are deprecated or scheduled for
+// removal, and "-latest" runner labels which are being migrated to new images. Removed images are
+// reported as errors. Deprecations and migrations which are still in progress are reported as
+// warnings since the jobs still run.
+// https://github.com/actions/runner-images
+type RuleRunnerImage struct {
+	RuleBase
+	// today is the date when the schedules are evaluated in "YYYY-MM-DD" format. It is the date of
+	// the dataset by default so that results are reproducible regardless of when actionlint runs.
+	today string
+}
+
+// NewRuleRunnerImage creates new RuleRunnerImage instance.
+func NewRuleRunnerImage() *RuleRunnerImage {
+	return &RuleRunnerImage{
+		RuleBase: RuleBase{
+			name: "runner-image",
+			desc: "Checks for runner images which are deprecated or scheduled for removal in \"runs-on:\"",
+		},
+		today: runnerImagesUpdated,
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleRunnerImage) VisitJobPre(n *Job) error {
+	if n.RunsOn == nil {
+		return nil
+	}
+	if rule.config != nil && rule.config.GitHubEnterprise != nil {
+		return nil // The schedules of runner images on GitHub.com are not applied to GHES
+	}
+
+	var m *Matrix
+	if n.Strategy != nil {
+		m = n.Strategy.Matrix
+	}
+
+	labels := n.RunsOn.Labels
+	if n.RunsOn.LabelsExpr != nil {
+		labels = []*String{n.RunsOn.LabelsExpr}
+	}
+
+	if hasSelfHostedRunnerLabel(labels) {
+		return nil // Labels of self-hosted runners are not related to runner images
+	}
+
+	for _, l := range labels {
+		if !l.ContainsExpression() {
+			rule.checkLabel(l)
+			continue
+		}
+		for _, l := range runnerLabelsInMatrix(l, m) {
+			rule.checkLabel(l)
+		}
+	}
+
+	return nil
+}
+
+func (rule *RuleRunnerImage) checkLabel(label *String) {
+	l := strings.ToLower(label.Value)
+
+	if d, ok := runnerImageDeprecations[l]; ok {
+		switch {
+		case rule.today >= d.removal:
+			rule.Errorf(
+				label.Pos,
+				"runner image %q was removed on %s. jobs on the image no longer run. use %q or other image instead. see https://github.com/actions/runner-images",
+				label.Value,
+				d.removal,
+				d.alternative,
+			)
+		case rule.today >= d.deprecation:
+			rule.ErrorfWithSeverity(
+				label.Pos,
+				"warning",
+				"runner image %q is deprecated since %s and will be removed on %s. use %q or other image instead. see https://github.com/actions/runner-images",
+				label.Value,
+				d.deprecation,
+				d.removal,
+				d.alternative,
+			)
+		default:
+			rule.ErrorfWithSeverity(
+				label.Pos,
+				"warning",
+				"runner image %q is scheduled to be deprecated on %s and removed on %s. use %q or other image instead. see https://github.com/actions/runner-images",
+				label.Value,
+				d.deprecation,
+				d.removal,
+				d.alternative,
+			)
+		}
+		return
+	}
+
+	for _, m := range runnerLabelMigrations {
+		if m.label != l || rule.today >= m.end {
+			continue
+		}
+		if rule.today >= m.start {
+			rule.ErrorfWithSeverity(
+				label.Pos,
+				"warning",
+				"runner label %q is being migrated from %q to %q until %s. the job may run on either image. use %q explicitly to keep using the current image. see https://github.com/actions/runner-images",
+				label.Value,
+				m.from,
+				m.to,
+				m.end,
+				m.from,
+			)
+		} else {
+			rule.ErrorfWithSeverity(
+				label.Pos,
+				"warning",
+				"runner label %q will be migrated from %q to %q from %s to %s. verify the job on %q or use %q explicitly to keep using the current image. see https://github.com/actions/runner-images",
+				label.Value,
+				m.from,
+				m.to,
+				m.start,
+				m.end,
+				m.to,
+				m.from,
+			)
+		}
+	}
+}
+
+func hasSelfHostedRunnerLabel(labels []*String) bool {
+	for _, l := range labels {
+		if strings.EqualFold(l.Value, "self-hosted") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleRunnerImageSchedule(t *testing.T) {
+	tests := []struct {
+		label    string
+		today    string
+		want     string
+		severity string
+	}{
+		{"ubuntu-20.04", "2025-01-31", `runner image "ubuntu-20.04" is scheduled to be deprecated on 2025-02-01 and removed on 2025-04-15`, "warning"},
+		{"ubuntu-20.04", "2025-02-01", `runner image "ubuntu-20.04" is deprecated since 2025-02-01 and will be removed on 2025-04-15`, "warning"},
+		{"ubuntu-20.04", "2025-04-15", `runner image "ubuntu-20.04" was removed on 2025-04-15`, ""},
+		{"ubuntu-latest", "2024-12-01", `runner label "ubuntu-latest" will be migrated from "ubuntu-22.04" to "ubuntu-24.04" from 2024-12-05 to 2025-01-17`, "warning"},
+		{"ubuntu-latest", "2024-12-05", `runner label "ubuntu-latest" is being migrated from "ubuntu-22.04" to "ubuntu-24.04" until 2025-01-17`, "warning"},
+		{"ubuntu-latest", "2025-01-17", "", ""},
+		{"ubuntu-24.04", "2025-01-01", "", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.label+" at "+tc.today, func(t *testing.T) {
+			r := NewRuleRunnerImage()
+			r.today = tc.today
+			j := &Job{
+				RunsOn: &Runner{
+					Labels: []*String{{Value: tc.label, Pos: &Pos{}}},
+				},
+			}
+			if err := r.VisitJobPre(j); err != nil {
+				t.Fatal(err)
+			}
+			errs := r.Errs()
+			if tc.want == "" {
+				if len(errs) > 0 {
+					t.Fatalf("wanted no error but got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("wanted one error but got %v", errs)
+			}
+			if msg := errs[0].Message; !strings.Contains(msg, tc.want) {
+				t.Fatalf("error message %q does not contain %q", msg, tc.want)
+			}
+			if errs[0].Severity != tc.severity {
+				t.Fatalf("severity should be %q but got %q", tc.severity, errs[0].Severity)
+			}
+		})
+	}
+}
+
+func TestRuleRunnerImageTodayIsDatasetDate(t *testing.T) {
+	if r := NewRuleRunnerImage(); r.today != runnerImagesUpdated {
+		t.Fatalf("schedules should be evaluated at the date of the dataset %q but got %q", runnerImagesUpdated, r.today)
+	}
+}
@@ -126,6 +126,14 @@ type RuleRunnerLabel struct {
 	// all past compatibility values here for better error message. If accumulating all compatibility
 	// values into one integer, we can no longer know what labels are conflicting.
 	compats map[runnerOSCompat]*String
+	// group is the runner group at "runs-on.group" defined in "self-hosted-runner.groups" config.
+	// When it is not nil, labels are checked against the labels of the runners in the group.
+	group *runnerGroup
+}
+
+type runnerGroup struct {
+	name   string
+	labels []string
 }
 
 // NewRuleRunnerLabel creates new RuleRunnerLabel instance.
@@ -150,6 +158,11 @@ func (rule *RuleRunnerLabel) VisitJobPre(n *Job) error {
 		m = n.Strategy.Matrix
 	}
 
+	rule.group = nil
+	if g := n.RunsOn.Group; g != nil {
+		rule.group = rule.checkGroup(g)
+	}
+
 	if len(n.RunsOn.Labels) == 1 {
 		rule.checkLabel(n.RunsOn.Labels[0], m)
 		return nil
@@ -165,13 +178,14 @@ func (rule *RuleRunnerLabel) VisitJobPre(n *Job) error {
 	}
 
 	rule.compats = nil // reset
+	rule.group = nil
 	return nil
 }
 
 // https://docs.github.com/en/actions/using-github-hosted-runners/about-github-hosted-runners
 func (rule *RuleRunnerLabel) checkLabelAndConflict(l *String, m *Matrix) {
 	if l.ContainsExpression() {
-		ss := rule.tryToGetLabelsInMatrix(l, m)
+		ss := runnerLabelsInMatrix(l, m)
 		cs := make([]runnerOSCompat, 0, len(ss))
 		for _, s := range ss {
 			comp := rule.verifyRunnerLabel(s)
@@ -187,7 +201,7 @@ func (rule *RuleRunnerLabel) checkLabelAndConflict(l *String, m *Matrix) {
 
 func (rule *RuleRunnerLabel) checkLabel(l *String, m *Matrix) {
 	if l.ContainsExpression() {
-		ss := rule.tryToGetLabelsInMatrix(l, m)
+		ss := runnerLabelsInMatrix(l, m)
 		for _, s := range ss {
 			rule.verifyRunnerLabel(s)
 		}
@@ -197,9 +211,79 @@ func (rule *RuleRunnerLabel) checkLabel(l *String, m *Matrix) {
 	rule.verifyRunnerLabel(l)
 }
 
+// checkGroup checks the runner group at "runs-on.group" is defined in "self-hosted-runner.groups"
+// config and returns the group. It returns nil when the group cannot be checked.
+func (rule *RuleRunnerLabel) checkGroup(g *String) *runnerGroup {
+	if rule.config == nil || len(rule.config.SelfHostedRunner.Groups) == 0 || g.Value == "" || g.ContainsExpression() {
+		return nil
+	}
+	groups := rule.config.SelfHostedRunner.Groups
+	names := make([]string, 0, len(groups))
+	for n, ls := range groups {
+		// Names of runner groups are case-insensitive
+		if strings.EqualFold(n, g.Value) {
+			return &runnerGroup{n, ls}
+		}
+		names = append(names, n)
+	}
+	rule.errorfWithSuggestions(
+		g.Pos,
+		g.Value,
+		names,
+		"runner group %q is not defined in \"self-hosted-runner.groups\" in actionlint.yaml config file. defined groups are %s",
+		g.Value,
+		sortedQuotes(names),
+	)
+	return nil
+}
+
+// verifyGroupLabel checks the label is available on the runners in the runner group.
+func (rule *RuleRunnerLabel) verifyGroupLabel(label *String) runnerOSCompat {
+	l := label.Value
+	for _, p := range rule.group.labels {
+		m, err := path.Match(p, l)
+		if err != nil {
+			rule.Errorf(label.Pos, "label pattern %q of runner group %q is an invalid glob. kindly check \"self-hosted-runner.groups\" in actionlint.yaml config file: %v", p, rule.group.name, err)
+			return compatInvalid
+		}
+		if m {
+			if c, ok := defaultRunnerOSCompats[strings.ToLower(l)]; ok {
+				return c
+			}
+			return compatInvalid
+		}
+	}
+	avail := "no label is defined for the group"
+	if len(rule.group.labels) > 0 {
+		avail = "available labels are " + quotes(rule.group.labels)
+	}
+	rule.errorfWithSuggestions(
+		label.Pos,
+		l,
+		rule.group.labels,
+		"label %q is not available on runners in group %q. %s. if the label is added to the runners, add it to \"self-hosted-runner.groups\" in actionlint.yaml config file",
+		l,
+		rule.group.name,
+		avail,
+	)
+	return compatInvalid
+}
+
 func (rule *RuleRunnerLabel) verifyRunnerLabel(label *String) runnerOSCompat {
+	if rule.group != nil {
+		return rule.verifyGroupLabel(label)
+	}
+
 	l := label.Value
 	if c, ok := defaultRunnerOSCompats[strings.ToLower(l)]; ok {
+		if ghes := rule.gitHubEnterprise(); ghes != nil && contains(allGitHubHostedRunnerLabels, strings.ToLower(l)) && !containsFold(ghes.HostedLabels, l) {
+			rule.Errorf(
+				label.Pos,
+				"label %q is a label of GitHub-hosted runner which is not available on GitHub Enterprise Server. if the runner is available, add the label to \"github-enterprise.hosted-labels\" in actionlint.yaml config file",
+				l,
+			)
+			return compatInvalid
+		}
 		return c
 	}
 
@@ -221,12 +305,20 @@ func (rule *RuleRunnerLabel) verifyRunnerLabel(label *String) runnerOSCompat {
 		}
 	}
 
-	rule.Errorf(
+	hosted := rule.hostedLabels()
+	candidates := make([]string, 0, len(hosted)+len(selfHostedRunnerPresetOtherLabels)+len(selfHostedRunnerPresetOSLabels)+len(known))
+	candidates = append(candidates, hosted...)
+	candidates = append(candidates, selfHostedRunnerPresetOtherLabels...)
+	candidates = append(candidates, selfHostedRunnerPresetOSLabels...)
+	candidates = append(candidates, known...)
+	rule.errorfWithSuggestions(
 		label.Pos,
+		l,
+		candidates,
 		"label %q is unknown. available labels are %s. if it is a custom label for self-hosted runner, set list of labels in actionlint.yaml config file",
 		label.Value,
 		quotesAll(
-			allGitHubHostedRunnerLabels,
+			hosted,
 			selfHostedRunnerPresetOtherLabels,
 			selfHostedRunnerPresetOSLabels,
 			known,
@@ -236,7 +328,9 @@ func (rule *RuleRunnerLabel) verifyRunnerLabel(label *String) runnerOSCompat {
 	return compatInvalid
 }
 
-func (rule *RuleRunnerLabel) tryToGetLabelsInMatrix(label *String, m *Matrix) []*String {
+// runnerLabelsInMatrix returns values of the matrix row when the label is in the form of
+// "${{ matrix.xxx }}". Values containing expressions are ignored.
+func runnerLabelsInMatrix(label *String, m *Matrix) []*String {
 	if m == nil {
 		return nil
 	}
@@ -333,5 +427,35 @@ func (rule *RuleRunnerLabel) getKnownLabels() []string {
 	if rule.config == nil {
 		return nil
 	}
+	if ghes := rule.config.GitHubEnterprise; ghes != nil && len(ghes.HostedLabels) > 0 {
+		// Hosted runners on GHES may have custom labels like larger runners
+		ls := make([]string, 0, len(rule.config.SelfHostedRunner.Labels)+len(ghes.HostedLabels))
+		ls = append(ls, rule.config.SelfHostedRunner.Labels...)
+		return append(ls, ghes.HostedLabels...)
+	}
 	return rule.config.SelfHostedRunner.Labels
 }
+
+func (rule *RuleRunnerLabel) gitHubEnterprise() *GitHubEnterpriseConfig {
+	if rule.config == nil {
+		return nil
+	}
+	return rule.config.GitHubEnterprise
+}
+
+// hostedLabels returns labels of GitHub-hosted runners which are available.
+func (rule *RuleRunnerLabel) hostedLabels() []string {
+	if ghes := rule.gitHubEnterprise(); ghes != nil {
+		return ghes.HostedLabels
+	}
+	return allGitHubHostedRunnerLabels
+}
+
+func containsFold(heystack []string, needle string) bool {
+	for _, s := range heystack {
+		if strings.EqualFold(s, needle) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package actionlint
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+type runnerPolicyRequirement struct {
+	job    string
+	labels []string
+}
+
+// RuleRunnerPolicy is a rule checker to enforce the policy of runner labels in "runs-on:". The
+// policy can allow or forbid labels (e.g. forbidding "macos-*" for cost) and require labels for
+// some jobs (e.g. requiring self-hosted runners for deployment jobs). Labels given via matrix
+// values are also checked. This rule is disabled by default and enabled by "runner-policy" in
+// config file.
+type RuleRunnerPolicy struct {
+	RuleBase
+	allowed   []string
+	forbidden []string
+	required  []runnerPolicyRequirement
+}
+
+func validateRunnerPolicyPatterns(pats []string, key string) error {
+	for _, p := range pats {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid glob pattern %q at \"runner-policy.%s\" in config: %w", p, key, err)
+		}
+	}
+	return nil
+}
+
+// NewRuleRunnerPolicy creates new RuleRunnerPolicy instance. It returns an error when some pattern
+// in the configuration is not a valid glob.
+func NewRuleRunnerPolicy(cfg *RunnerPolicyConfig) (*RuleRunnerPolicy, error) {
+	r := &RuleRunnerPolicy{
+		RuleBase: RuleBase{
+			name: "runner-policy",
+			desc: "Checks for runner labels in \"runs-on:\" following the policy in config",
+		},
+	}
+	if cfg == nil {
+		return r, nil
+	}
+
+	if err := validateRunnerPolicyPatterns(cfg.Allowed, "allowed"); err != nil {
+		return nil, err
+	}
+	if err := validateRunnerPolicyPatterns(cfg.Forbidden, "forbidden"); err != nil {
+		return nil, err
+	}
+	r.allowed = cfg.Allowed
+	r.forbidden = cfg.Forbidden
+
+	jobs := make([]string, 0, len(cfg.Required))
+	for j := range cfg.Required {
+		jobs = append(jobs, j)
+	}
+	sort.Strings(jobs)
+	for _, j := range jobs {
+		if err := validateRunnerPolicyPatterns([]string{j}, "required"); err != nil {
+			return nil, err
+		}
+		r.required = append(r.required, runnerPolicyRequirement{j, cfg.Required[j]})
+	}
+
+	return r, nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleRunnerPolicy) VisitJobPre(n *Job) error {
+	if n.RunsOn == nil {
+		return nil
+	}
+
+	var m *Matrix
+	if n.Strategy != nil {
+		m = n.Strategy.Matrix
+	}
+
+	labels := n.RunsOn.Labels
+	if n.RunsOn.LabelsExpr != nil {
+		labels = []*String{n.RunsOn.LabelsExpr}
+	}
+
+	// Labels which are statically known. Labels in matrix are expanded
+	static := []*String{}
+	expanded := [][]*String{}
+	unknown := false
+	for _, l := range labels {
+		if !l.ContainsExpression() {
+			static = append(static, l)
+			continue
+		}
+		ls := runnerLabelsInMatrix(l, m)
+		if len(ls) == 0 {
+			unknown = true
+			continue
+		}
+		expanded = append(expanded, ls)
+	}
+
+	for _, l := range static {
+		rule.checkLabel(l)
+	}
+	for _, ls := range expanded {
+		for _, l := range ls {
+			rule.checkLabel(l)
+		}
+	}
+
+	if n.ID == nil || unknown {
+		return nil
+	}
+	pos := n.Pos
+	if len(labels) > 0 {
+		pos = labels[0].Pos
+	}
+	for _, req := range rule.required {
+		if !matchRunnerLabel(req.job, n.ID.Value) {
+			continue
+		}
+		for _, want := range req.labels {
+			if !hasRunnerLabel(want, static, expanded) {
+				rule.Errorf(
+					pos,
+					"job %q must run on runner with label %q required by pattern %q at \"runner-policy.required\" in config",
+					n.ID.Value,
+					want,
+					req.job,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (rule *RuleRunnerPolicy) checkLabel(l *String) {
+	for _, p := range rule.forbidden {
+		if matchRunnerLabel(p, l.Value) {
+			rule.Errorf(l.Pos, "runner label %q is forbidden by pattern %q at \"runner-policy.forbidden\" in config", l.Value, p)
+			return
+		}
+	}
+
+	if rule.allowed == nil {
+		return
+	}
+	for _, p := range rule.allowed {
+		if matchRunnerLabel(p, l.Value) {
+			return
+		}
+	}
+	rule.Errorf(
+		l.Pos,
+		"runner label %q is not allowed by \"runner-policy.allowed\" in config. allowed patterns are %s",
+		l.Value,
+		quotes(rule.allowed),
+	)
+}
+
+// hasRunnerLabel returns true when the label is in the static labels or all expanded values of
+// some label in matrix match the label.
+func hasRunnerLabel(want string, static []*String, expanded [][]*String) bool {
+	for _, l := range static {
+		if strings.EqualFold(l.Value, want) {
+			return true
+		}
+	}
+Expanded:
+	for _, ls := range expanded {
+		for _, l := range ls {
+			if !strings.EqualFold(l.Value, want) {
+				continue Expanded
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Runner labels are case-insensitive.
+func matchRunnerLabel(pat, label string) bool {
+	m, err := path.Match(strings.ToLower(pat), strings.ToLower(label))
+	return err == nil && m
+}
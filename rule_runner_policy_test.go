@@ -0,0 +1,17 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleRunnerPolicyInvalidPattern(t *testing.T) {
+	_, err := NewRuleRunnerPolicy(&RunnerPolicyConfig{Required: map[string][]string{"deploy-[": {"self-hosted"}}})
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	want := `invalid glob pattern "deploy-[" at "runner-policy.required" in config`
+	if msg := err.Error(); !strings.Contains(msg, want) {
+		t.Fatalf("error message %q does not contain %q", msg, want)
+	}
+}
@@ -0,0 +1,40 @@
+package actionlint
+
+// RuleScheduleDispatch is a rule checker to detect workflows triggered only by "schedule" event.
+// Such workflow cannot be run manually when a scheduled run fails until the next schedule. This
+// rule suggests adding "workflow_dispatch" event. This rule is disabled by default and enabled by
+// "schedule-dispatch" in config file.
+type RuleScheduleDispatch struct {
+	RuleBase
+}
+
+// NewRuleScheduleDispatch creates new RuleScheduleDispatch instance.
+func NewRuleScheduleDispatch() *RuleScheduleDispatch {
+	return &RuleScheduleDispatch{
+		RuleBase: RuleBase{
+			name: "schedule-dispatch",
+			desc: "Checks for workflows triggered only by \"schedule\" event without \"workflow_dispatch\" event",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleScheduleDispatch) VisitWorkflowPre(n *Workflow) error {
+	var sched *ScheduledEvent
+	for _, e := range n.On {
+		s, ok := e.(*ScheduledEvent)
+		if !ok {
+			return nil // Triggered by other events
+		}
+		sched = s
+	}
+	if sched == nil {
+		return nil
+	}
+
+	rule.Errorf(
+		sched.Pos,
+		"workflow is triggered only by \"schedule\" event. add \"workflow_dispatch\" event to \"on:\" so that the workflow can be run manually when a scheduled run fails",
+	)
+	return nil
+}
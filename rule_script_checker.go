@@ -0,0 +1,174 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// scriptCheckerInput is a JSON object passed to stdin of a script checker command.
+type scriptCheckerInput struct {
+	Shell    string `json:"shell"`
+	Language string `json:"language"`
+	Script   string `json:"script"`
+}
+
+// scriptCheckerError is an error reported by a script checker command via stdout.
+type scriptCheckerError struct {
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Message  string `json:"message"`
+	Severity string `json:"severity"`
+}
+
+type scriptChecker struct {
+	config *CustomShellConfig
+	name   string
+	cmd    *externalCommand
+}
+
+// RuleScriptChecker is a rule to check scripts at "run:" which are run by custom shells like
+// "shell: deno run {0}" using external checker commands configured at "custom-shells" in config file.
+type RuleScriptChecker struct {
+	RuleBase
+	checkers      map[string]*scriptChecker
+	lines         []string
+	workflowShell string
+	jobShell      string
+	mu            sync.Mutex
+}
+
+// NewRuleScriptChecker creates new RuleScriptChecker instance. The shells parameter is the custom
+// shells configured in config file. The src parameter is the source of the workflow file to convert
+// positions in scripts into positions in the workflow file. It can be nil. When some checker
+// command is not found in system, it returns an error.
+func NewRuleScriptChecker(shells []*CustomShellConfig, proc *concurrentProcess, src []byte) (*RuleScriptChecker, error) {
+	checkers := make(map[string]*scriptChecker, len(shells))
+	for _, sh := range shells {
+		cmd, err := proc.newCommandRunner(sh.Checker[0], false)
+		if err != nil {
+			return nil, fmt.Errorf("checker of shell %q is not available: %w", sh.Shell, err)
+		}
+		checkers[normalizeShell(sh.Shell)] = &scriptChecker{sh, filepath.Base(sh.Checker[0]), cmd}
+	}
+
+	var lines []string
+	if src != nil {
+		lines = strings.Split(string(src), "\n")
+	}
+
+	return &RuleScriptChecker{
+		RuleBase: RuleBase{
+			name: "script-checker",
+			desc: "Checks for scripts at \"run:\" run by custom shells configured at \"custom-shells\" in config file using external checkers",
+		},
+		checkers: checkers,
+		lines:    lines,
+	}, nil
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleScriptChecker) VisitWorkflowPre(n *Workflow) error {
+	if n.Defaults != nil && n.Defaults.Run != nil && n.Defaults.Run.Shell != nil {
+		rule.workflowShell = n.Defaults.Run.Shell.Value
+	}
+	return nil
+}
+
+// VisitWorkflowPost is callback when visiting Workflow node after visiting its children.
+func (rule *RuleScriptChecker) VisitWorkflowPost(n *Workflow) error {
+	rule.workflowShell = ""
+	var err error
+	for _, c := range rule.checkers {
+		// Wait until all processes running for this rule
+		if e := c.cmd.wait(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleScriptChecker) VisitJobPre(n *Job) error {
+	if n.Defaults != nil && n.Defaults.Run != nil && n.Defaults.Run.Shell != nil {
+		rule.jobShell = n.Defaults.Run.Shell.Value
+	}
+	return nil
+}
+
+// VisitJobPost is callback when visiting Job node after visiting its children.
+func (rule *RuleScriptChecker) VisitJobPost(n *Job) error {
+	rule.jobShell = ""
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleScriptChecker) VisitStep(n *Step) error {
+	run, ok := n.Exec.(*ExecRun)
+	if !ok || run.Run == nil {
+		return nil
+	}
+	c, ok := rule.checkers[normalizeShell(rule.getShellName(run))]
+	if !ok {
+		return nil
+	}
+	rule.runChecker(c, run)
+	return nil
+}
+
+func (rule *RuleScriptChecker) getShellName(exec *ExecRun) string {
+	if exec.Shell != nil {
+		return exec.Shell.Value
+	}
+	if rule.jobShell != "" {
+		return rule.jobShell
+	}
+	return rule.workflowShell
+}
+
+func (rule *RuleScriptChecker) runChecker(c *scriptChecker, run *ExecRun) {
+	pos := run.RunPos
+	stdin, err := json.Marshal(&scriptCheckerInput{
+		Shell:    c.config.Shell,
+		Language: c.config.Language,
+		Script:   sanitizeExpressionsInScript(run.Run.Value), // Defined at rule_shellcheck.go
+	})
+	if err != nil {
+		panic(err) // Encoding strings into JSON never fails
+	}
+	args := c.config.Checker[1:]
+	rule.Debug("%s: Running %s with %s for %s script:\n%s", pos, c.cmd.exe, args, c.config.Language, run.Run.Value)
+	mapPos := scriptPosMapper(rule.lines, run.Run, pos) // Defined at rule_pyflakes.go
+
+	c.cmd.run(args, string(stdin), func(stdout []byte, err error) error {
+		if err != nil {
+			rule.Debug("Command %s failed: %v", c.cmd.exe, err)
+			return fmt.Errorf("`%s` did not run successfully while checking %s script at %s: %w", c.cmd.exe, c.config.Language, pos, err)
+		}
+		if len(strings.TrimSpace(string(stdout))) == 0 {
+			return nil
+		}
+
+		errs := []scriptCheckerError{}
+		if err := json.Unmarshal(stdout, &errs); err != nil {
+			return fmt.Errorf("could not parse JSON output from %s while checking %s script at %s: %w: stdout=%q", c.cmd.exe, c.config.Language, pos, err, stdout)
+		}
+
+		// This callback is called in a different goroutine
+		rule.mu.Lock()
+		defer rule.mu.Unlock()
+		for _, e := range errs {
+			col := e.Column
+			if col <= 0 {
+				col = 1
+			}
+			msg := strings.TrimSuffix(strings.TrimSpace(e.Message), ".") // Trim period aligning style of error message
+			err := errorfAt(mapPos(e.Line, col), rule.name, "%s reported issue in this %s script: %d:%d: %s", c.name, c.config.Language, e.Line, e.Column, msg)
+			err.Severity = e.Severity
+			rule.errs = append(rule.errs, err)
+		}
+		return nil
+	})
+}
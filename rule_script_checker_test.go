@@ -0,0 +1,132 @@
+package actionlint
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// testFakeScriptChecker creates a fake checker command which saves stdin to a file and outputs the
+// given string.
+func testFakeScriptChecker(t *testing.T, output string) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake checker command is a shell script")
+	}
+	dir := t.TempDir()
+	exe := filepath.Join(dir, "check-deno")
+	out := filepath.Join(dir, "output.json")
+	if err := os.WriteFile(out, []byte(output), 0644); err != nil {
+		t.Fatal(err)
+	}
+	script := "#!/bin/sh\ncat > '" + filepath.Join(dir, "stdin") + "'\ncat '" + out + "'\n"
+	if err := os.WriteFile(exe, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return exe
+}
+
+func TestRuleScriptCheckerCheckScripts(t *testing.T) {
+	exe := testFakeScriptChecker(t, `[{"line":2,"column":7,"message":"'x' is never used.","severity":"warning"},{"line":0,"column":0,"message":"type error"}]`)
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: |
+          console.log('${{ github.sha }}')
+          const x = 1
+        shell: deno  run {0}
+      - run: echo hello
+  other:
+    runs-on: ubuntu-latest
+    defaults:
+      run:
+        shell: bash
+    steps:
+      - run: console.log(1)
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	shells := []*CustomShellConfig{
+		{Shell: "deno run {0}", Language: "typescript", Checker: []string{exe, "--json"}},
+	}
+	r, err := NewRuleScriptChecker(shells, newConcurrentProcess(1), []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := NewVisitor()
+	v.AddPass(r)
+	if err := v.Visit(w); err != nil {
+		t.Fatal(err)
+	}
+	errs = r.Errs()
+
+	want := []struct {
+		line, col int
+		severity  string
+		msg       string
+	}{
+		{8, 17, "warning", "check-deno reported issue in this typescript script: 2:7: 'x' is never used"},
+		{6, 9, "", "check-deno reported issue in this typescript script: 0:0: type error"},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("wanted %d errors but got %d: %v", len(want), len(errs), errs)
+	}
+	for i, w := range want {
+		e := errs[i]
+		if e.Line != w.line || e.Column != w.col || e.Severity != w.severity || e.Message != w.msg {
+			t.Errorf("wanted %d:%d: %s (%q) but got %d:%d: %s (%q)", w.line, w.col, w.msg, w.severity, e.Line, e.Column, e.Message, e.Severity)
+		}
+	}
+
+	b, err := os.ReadFile(filepath.Join(filepath.Dir(exe), "stdin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdin := `{"shell":"deno run {0}","language":"typescript","script":"console.log('_________________')\nconst x = 1\n"}`
+	if string(b) != stdin {
+		t.Fatalf("unexpected input to checker: %q", b)
+	}
+}
+
+func TestRuleScriptCheckerInvalidOutput(t *testing.T) {
+	exe := testFakeScriptChecker(t, "error: something went wrong")
+	src := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: console.log(1)\n        shell: deno run {0}\n"
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	shells := []*CustomShellConfig{
+		{Shell: "deno run {0}", Language: "typescript", Checker: []string{exe}},
+	}
+	r, err := NewRuleScriptChecker(shells, newConcurrentProcess(1), []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := NewVisitor()
+	v.AddPass(r)
+	err = v.Visit(w)
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	if !strings.Contains(err.Error(), "could not parse JSON output from") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRuleScriptCheckerCommandNotFound(t *testing.T) {
+	shells := []*CustomShellConfig{
+		{Shell: "deno run {0}", Language: "typescript", Checker: []string{"this-command-does-not-exist"}},
+	}
+	_, err := NewRuleScriptChecker(shells, newConcurrentProcess(1), nil)
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	if !strings.Contains(err.Error(), `checker of shell "deno run {0}" is not available`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
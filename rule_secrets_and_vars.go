@@ -0,0 +1,131 @@
+package actionlint
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// builtinSecrets is a set of secrets which are always available without setting them.
+var builtinSecrets = map[string]struct{}{
+	"GITHUB_TOKEN":         {},
+	"ACTIONS_STEP_DEBUG":   {},
+	"ACTIONS_RUNNER_DEBUG": {},
+}
+
+// RuleSecretsAndVars is a rule to check secrets at `secrets.X` and configuration variables at
+// `vars.Y` are set in the repository. Secrets and variables which are not set are evaluated to
+// empty strings silently, so renaming them breaks deployments at runtime.
+// https://docs.github.com/en/actions/security-guides/using-secrets-in-github-actions
+// https://docs.github.com/en/actions/learn-github-actions/variables
+type RuleSecretsAndVars struct {
+	RuleBase
+	src     []byte
+	slug    string
+	secrets func(slug, env string) ([]string, bool, error)
+	vars    func(slug, env string) ([]string, bool, error)
+}
+
+// NewRuleSecretsAndVars creates a new RuleSecretsAndVars instance. The src parameter is the source
+// of the workflow. The slug parameter is "owner/repo" of the repository where the workflow runs.
+// The secrets and vars parameters are functions to fetch names of secrets and variables available
+// in the repository and the environment. The environment is empty when the job has no environment.
+// Their second return values are false when the names could not be fetched. In the case, the
+// references are not checked.
+func NewRuleSecretsAndVars(src []byte, slug string, secrets, vars func(string, string) ([]string, bool, error)) *RuleSecretsAndVars {
+	return &RuleSecretsAndVars{
+		RuleBase: RuleBase{
+			name: "secrets-and-vars",
+			desc: "Checks for secrets and configuration variables which are not set in the repository",
+		},
+		src:     src,
+		slug:    slug,
+		secrets: secrets,
+		vars:    vars,
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleSecretsAndVars) VisitWorkflowPre(n *Workflow) error {
+	for _, e := range n.On {
+		if _, ok := e.(*WorkflowCallEvent); ok {
+			// Secrets and variables are passed from the caller workflow in another repository
+			return nil
+		}
+	}
+
+	// Lines from the key of a job until the key of the next job are in the job
+	type jobEnv struct {
+		line int
+		env  string
+	}
+	envs := make([]jobEnv, 0, len(n.Jobs))
+	for _, j := range n.Jobs {
+		if j.ID == nil {
+			continue
+		}
+		e := ""
+		if j.Environment != nil && j.Environment.Name != nil {
+			e = j.Environment.Name.Value
+		}
+		envs = append(envs, jobEnv{j.ID.Pos.Line, e})
+	}
+	sort.Slice(envs, func(i, j int) bool { return envs[i].line < envs[j].line })
+
+	inv := NewContextsInventory()
+	inv.Add("", rule.src, n)
+	for _, r := range inv.References() {
+		if r.Name == "*" {
+			continue
+		}
+		var list func(string, string) ([]string, bool, error)
+		switch r.Kind {
+		case "secret":
+			if _, ok := builtinSecrets[r.Name]; ok {
+				continue
+			}
+			list = rule.secrets
+		case "variable":
+			list = rule.vars
+		default:
+			continue
+		}
+
+		for _, l := range r.Locations {
+			env := ""
+			if i := sort.Search(len(envs), func(i int) bool { return envs[i].line > l.Line }) - 1; i >= 0 {
+				env = envs[i].env
+			}
+			if strings.Contains(env, "${{") {
+				continue // The environment is not known statically
+			}
+			names, ok, err := list(rule.slug, env)
+			if err != nil {
+				return err
+			}
+			if !ok || containsFold(names, r.Name) {
+				continue
+			}
+			rule.report(r.Kind, r.Name, env, names, &Pos{Line: l.Line, Col: l.Column})
+		}
+	}
+	return nil
+}
+
+func (rule *RuleSecretsAndVars) report(kind, name, env string, names []string, pos *Pos) {
+	where := "repository " + strconv.Quote(rule.slug) + " and its organization"
+	if env != "" {
+		where += " or environment " + strconv.Quote(env)
+	}
+	noun := "configuration variable"
+	if kind == "secret" {
+		noun = "secret"
+	}
+	err := errorfAt(pos, rule.name, "%s %q is not set in %s. it is evaluated to an empty string", noun, name, where)
+	err.Severity = "warning"
+	if s := similarNames(name, names); len(s) > 0 {
+		err.Message += ". " + didYouMean(s)
+		err.Suggestions = s
+	}
+	rule.errs = append(rule.errs, err)
+}
@@ -0,0 +1,141 @@
+package actionlint
+
+import (
+	"errors"
+	"testing"
+)
+
+func testRuleSecretsAndVarsRun(t *testing.T, src string, secrets, vars func(string, string) ([]string, bool, error)) []*Error {
+	t.Helper()
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	r := NewRuleSecretsAndVars([]byte(src), "owner/repo", secrets, vars)
+	if err := r.VisitWorkflowPre(w); err != nil {
+		t.Fatal(err)
+	}
+	return r.Errs()
+}
+
+func TestRuleSecretsAndVarsCheckNames(t *testing.T) {
+	src := `on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo "$A $B $C $D"
+        env:
+          A: ${{ secrets.REPO_SECRET }}
+          B: ${{ secrets.github_token }}
+          C: ${{ secrets.PROD_SECRET }}
+          D: ${{ vars.REPO_VAR }}
+  deploy:
+    runs-on: ubuntu-latest
+    environment: production
+    steps:
+      - run: echo "$A $B $C"
+        env:
+          A: ${{ secrets.PROD_SECRET }}
+          B: ${{ secrets.PROD_SECRT }}
+          C: ${{ vars.repo_var }}
+  dynamic:
+    runs-on: ubuntu-latest
+    environment: ${{ github.ref_name }}
+    steps:
+      - run: echo "$A $B"
+        env:
+          A: ${{ secrets.UNKNOWN }}
+          B: ${{ toJSON(secrets) }}
+`
+	secrets := func(slug, env string) ([]string, bool, error) {
+		if slug != "owner/repo" {
+			t.Errorf("unexpected slug %q", slug)
+		}
+		if env == "production" {
+			return []string{"REPO_SECRET", "PROD_SECRET"}, true, nil
+		}
+		if env != "" {
+			t.Errorf("unexpected environment %q", env)
+		}
+		return []string{"REPO_SECRET"}, true, nil
+	}
+	vars := func(slug, env string) ([]string, bool, error) {
+		return []string{"REPO_VAR"}, true, nil
+	}
+
+	errs := testRuleSecretsAndVarsRun(t, src, secrets, vars)
+	want := []string{
+		`secret "PROD_SECRET" is not set in repository "owner/repo" and its organization. it is evaluated to an empty string`,
+		`secret "PROD_SECRT" is not set in repository "owner/repo" and its organization or environment "production". it is evaluated to an empty string. did you mean "PROD_SECRET"?`,
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("wanted %d errors but got %d: %v", len(want), len(errs), errs)
+	}
+	for i, err := range errs {
+		if err.Message != want[i] {
+			t.Errorf("wanted %q but got %q", want[i], err.Message)
+		}
+		if err.Kind != "secrets-and-vars" || err.Severity != "warning" {
+			t.Errorf("unexpected kind or severity: %v", err)
+		}
+	}
+	if errs[0].Line != 10 || errs[1].Line != 19 {
+		t.Errorf("unexpected positions: %d, %d", errs[0].Line, errs[1].Line)
+	}
+}
+
+func TestRuleSecretsAndVarsSkipReusableWorkflow(t *testing.T) {
+	src := `on: workflow_call
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ secrets.FOO }} ${{ vars.BAR }}
+`
+	list := func(string, string) ([]string, bool, error) {
+		t.Error("names should not be fetched for reusable workflow")
+		return nil, false, nil
+	}
+	if errs := testRuleSecretsAndVarsRun(t, src, list, list); len(errs) > 0 {
+		t.Fatalf("no error should be reported: %v", errs)
+	}
+}
+
+func TestRuleSecretsAndVarsNamesNotAvailable(t *testing.T) {
+	src := `on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ secrets.FOO }} ${{ vars.BAR }}
+`
+	list := func(string, string) ([]string, bool, error) {
+		return nil, false, nil
+	}
+	if errs := testRuleSecretsAndVarsRun(t, src, list, list); len(errs) > 0 {
+		t.Fatalf("no error should be reported: %v", errs)
+	}
+}
+
+func TestRuleSecretsAndVarsFetchError(t *testing.T) {
+	src := `on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ vars.BAR }}
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	want := errors.New("dummy error")
+	list := func(string, string) ([]string, bool, error) {
+		return nil, false, want
+	}
+	r := NewRuleSecretsAndVars([]byte(src), "owner/repo", list, list)
+	if err := r.VisitWorkflowPre(w); !errors.Is(err, want) {
+		t.Fatalf("error should be returned: %v", err)
+	}
+}
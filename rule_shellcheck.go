@@ -3,11 +3,18 @@ package actionlint
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 )
 
+// shellcheckBatchSize is the maximum number of scripts checked by one shellcheck process. Scripts
+// are passed as files so this value also bounds length of the command line.
+const shellcheckBatchSize = 64
+
 type shellcheckError struct {
+	File    string `json:"file"`
 	Line    int    `json:"line"`
 	Column  int    `json:"column"`
 	Level   string `json:"level"`
@@ -15,6 +22,11 @@ type shellcheckError struct {
 	Message string `json:"message"`
 }
 
+type shellcheckScript struct {
+	src string
+	pos *Pos
+}
+
 // RuleShellcheck is a rule to check shell scripts at 'run:' using shellcheck.
 // https://github.com/koalaman/shellcheck
 type RuleShellcheck struct {
@@ -23,6 +35,9 @@ type RuleShellcheck struct {
 	workflowShell string
 	jobShell      string
 	runnerShell   string
+	jobDialect    string
+	extraArgs     []string
+	scripts       map[string][]*shellcheckScript
 	mu            sync.Mutex
 }
 
@@ -36,16 +51,29 @@ func newRuleShellcheck(cmd *externalCommand) *RuleShellcheck {
 		workflowShell: "",
 		jobShell:      "",
 		runnerShell:   "",
+		scripts:       map[string][]*shellcheckScript{},
 	}
 }
 
+// embeddedShellcheckWASM is shellcheck built for WASI and embedded in the executable. It is nil
+// unless actionlint is built with "wazero" and "shellcheck_embed" build tags. See
+// shellcheck_embed.go.
+var embeddedShellcheckWASM []byte
+
 // NewRuleShellcheck creates new RuleShellcheck instance. The executable argument can be command
 // name or relative/absolute file path. When the given executable is not found in system, it returns
-// an error as 2nd return value.
+// an error as 2nd return value. When the executable is the default "shellcheck" and it is not
+// found but shellcheck is embedded in the executable, the embedded one is used instead.
 func NewRuleShellcheck(executable string, proc *concurrentProcess) (*RuleShellcheck, error) {
 	cmd, err := proc.newCommandRunner(executable, false)
 	if err != nil {
-		return nil, err
+		if executable != "shellcheck" || len(embeddedShellcheckWASM) == 0 {
+			return nil, err
+		}
+		cmd, err = proc.newWASMCommandRunner("shellcheck.wasm", embeddedShellcheckWASM, true)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return newRuleShellcheck(cmd), nil
 }
@@ -57,12 +85,16 @@ func (rule *RuleShellcheck) VisitStep(n *Step) error {
 		return nil
 	}
 
-	rule.runShellcheck(run.Run.Value, rule.getShellName(run), run.RunPos)
+	rule.addScript(run.Run.Value, rule.getShellName(run), run.RunPos)
 	return nil
 }
 
 // VisitJobPre is callback when visiting Job node before visiting its children.
 func (rule *RuleShellcheck) VisitJobPre(n *Job) error {
+	if c := rule.config; c != nil && c.Shellcheck != nil && n.ID != nil {
+		rule.jobDialect = c.Shellcheck.shellOf(n.ID.Value)
+	}
+
 	if n.Defaults != nil && n.Defaults.Run != nil && n.Defaults.Run.Shell != nil {
 		rule.jobShell = n.Defaults.Run.Shell.Value
 	}
@@ -86,6 +118,7 @@ func (rule *RuleShellcheck) VisitJobPre(n *Job) error {
 func (rule *RuleShellcheck) VisitJobPost(n *Job) error {
 	rule.jobShell = ""
 	rule.runnerShell = ""
+	rule.jobDialect = ""
 	return nil
 }
 
@@ -97,9 +130,40 @@ func (rule *RuleShellcheck) VisitWorkflowPre(n *Workflow) error {
 	return nil
 }
 
-// VisitWorkflowPost is callback when visiting Workflow node after visiting its children.
+// VisitWorkflowPost is callback when visiting Workflow node after visiting its children. Scripts
+// collected while visiting the workflow are checked here.
 func (rule *RuleShellcheck) VisitWorkflowPost(n *Workflow) error {
 	rule.workflowShell = ""
+
+	// Temporary directories of scripts are removed after all processes finish. They cannot be removed
+	// in callbacks of the processes since the callbacks are not called when the context is canceled.
+	dirs := []string{}
+	defer func() {
+		for _, d := range dirs {
+			os.RemoveAll(d)
+		}
+	}()
+
+	// Spawning one process per script is slow on workflows which have many steps. Instead, scripts
+	// for the same shell are checked by one process in batch.
+	for _, sh := range shellcheckDialects {
+		ss := rule.scripts[sh]
+		for len(ss) > 0 {
+			n := len(ss)
+			if n > shellcheckBatchSize {
+				n = shellcheckBatchSize
+			}
+			d, err := rule.runShellcheck(sh, ss[:n])
+			if err != nil {
+				rule.cmd.wait()
+				return err
+			}
+			dirs = append(dirs, d)
+			ss = ss[n:]
+		}
+	}
+	rule.scripts = map[string][]*shellcheckScript{}
+
 	return rule.cmd.wait() // Wait until all processes running for this rule
 }
 
@@ -160,7 +224,29 @@ func sanitizeExpressionsInScript(src string) string {
 	}
 }
 
-func (rule *RuleShellcheck) runShellcheck(src, shell string, pos *Pos) {
+// args returns command line arguments of shellcheck. Options in config file and options given by
+// users are added after the default options so that they can override the defaults.
+func (rule *RuleShellcheck) args(sh string) []string {
+	// Reasons to exclude the rules:
+	//
+	// - SC1091: File not found. Scripts are for CI environment. Not suitable for checking this in current local
+	//           environment
+	// - SC2194: The word is constant. This sometimes happens at constants by replacing ${{ }} with underscores.
+	//           For example, `if ${{ matrix.foo }}; then ...` -> `if _________________; then ...`
+	// - SC2050: The expression is constant. This sometimes happens at `if` condition by replacing ${{ }} with
+	//           underscores (#45). For example, `if [ "${{ matrix.foo }}" = "x" ]` -> `if [ "_________________" = "x" ]`
+	// - SC2154: The var is referenced but not assigned. Script at `run:` can refer variables defined in `env:` section
+	//           so this rule can cause false positives (#53).
+	// - SC2157: Argument to -z is always false due to literal strings. When the argument of -z is replaced from ${{ }},
+	//           this can happen. For example, `if [ -z ${{ env.FOO }} ]` -> `if [ -z ______________ ]` (#113).
+	args := []string{"--norc", "-f", "json", "-x", "--shell", sh, "-e", "SC1091,SC2194,SC2050,SC2154,SC2157"}
+	if c := rule.config; c != nil && c.Shellcheck != nil {
+		args = append(args, c.Shellcheck.args()...)
+	}
+	return append(args, rule.extraArgs...)
+}
+
+func (rule *RuleShellcheck) addScript(src, shell string, pos *Pos) {
 	var sh string
 	if shell == "bash" || shell == "sh" {
 		sh = shell
@@ -171,24 +257,23 @@ func (rule *RuleShellcheck) runShellcheck(src, shell string, pos *Pos) {
 	} else {
 		return // Skip checking this shell script since shellcheck doesn't support it
 	}
+	if rule.jobDialect != "" {
+		sh = rule.jobDialect // The shell is overridden by "shellcheck.shells" in config
+	}
 
 	src = sanitizeExpressionsInScript(src)
-	rule.Debug("%s: Run shellcheck for %s script:\n%s", pos, sh, src)
+	rule.Debug("%s: Add %s script to be checked by shellcheck:\n%s", pos, sh, src)
+	rule.scripts[sh] = append(rule.scripts[sh], &shellcheckScript{src, pos})
+}
 
-	// Reasons to exclude the rules:
-	//
-	// - SC1091: File not found. Scripts are for CI environment. Not suitable for checking this in current local
-	//           environment
-	// - SC2194: The word is constant. This sometimes happens at constants by replacing ${{ }} with underscores.
-	//           For example, `if ${{ matrix.foo }}; then ...` -> `if _________________; then ...`
-	// - SC2050: The expression is constant. This sometimes happens at `if` condition by replacing ${{ }} with
-	//           underscores (#45). For example, `if [ "${{ matrix.foo }}" = "x" ]` -> `if [ "_________________" = "x" ]`
-	// - SC2154: The var is referenced but not assigned. Script at `run:` can refer variables defined in `env:` section
-	//           so this rule can cause false positives (#53).
-	// - SC2157: Argument to -z is always false due to literal strings. When the argument of -z is replaced from ${{ }},
-	//           this can happen. For example, `if [ -z ${{ env.FOO }} ]` -> `if [ -z ______________ ]` (#113).
-	args := []string{"--norc", "-f", "json", "-x", "--shell", sh, "-e", "SC1091,SC2194,SC2050,SC2154,SC2157", "-"}
-	rule.Debug("%s: Running %s command with %s", pos, rule.cmd.exe, args)
+// runShellcheck checks the scripts for the shell by one shellcheck process. The scripts are written
+// to temporary files since shellcheck can read only one script from stdin. It returns the temporary
+// directory of the files. The caller must remove it after the process finishes.
+func (rule *RuleShellcheck) runShellcheck(sh string, scripts []*shellcheckScript) (string, error) {
+	dir, err := os.MkdirTemp("", "actionlint-shellcheck-")
+	if err != nil {
+		return "", fmt.Errorf("could not create temporary directory to run shellcheck: %w", err)
+	}
 
 	// Use same options to run shell process described at document
 	// https://docs.github.com/en/actions/learn-github-actions/workflow-syntax-for-github-actions#using-a-specific-shell
@@ -196,12 +281,24 @@ func (rule *RuleShellcheck) runShellcheck(src, shell string, pos *Pos) {
 	if sh == "bash" {
 		setup = "set -eo pipefail"
 	}
-	script := fmt.Sprintf("%s\n%s\n", setup, src)
 
-	rule.cmd.run(args, script, func(stdout []byte, err error) error {
+	args := rule.args(sh)
+	files := make(map[string]*Pos, len(scripts))
+	for i, s := range scripts {
+		f := filepath.Join(dir, fmt.Sprintf("%d.sh", i))
+		if err := os.WriteFile(f, []byte(fmt.Sprintf("%s\n%s\n", setup, s.src)), 0600); err != nil {
+			os.RemoveAll(dir)
+			return "", fmt.Errorf("could not write script at %s to temporary file to run shellcheck: %w", s.pos, err)
+		}
+		args = append(args, f)
+		files[f] = s.pos
+	}
+	rule.Debug("Running %s command with %s for %d %s scripts", rule.cmd.exe, args, len(scripts), sh)
+
+	rule.cmd.run(args, "", func(stdout []byte, err error) error {
 		if err != nil {
 			rule.Debug("Command %s %s failed: %v", rule.cmd.exe, args, err)
-			return fmt.Errorf("`%s %s` did not run successfully while checking script at %s: %w", rule.cmd.exe, strings.Join(args, " "), pos, err)
+			return fmt.Errorf("`%s %s` did not run successfully while checking %d scripts at %s: %w", rule.cmd.exe, strings.Join(args, " "), len(scripts), scripts[0].pos, err)
 		}
 
 		errs := []shellcheckError{}
@@ -222,12 +319,20 @@ func (rule *RuleShellcheck) runShellcheck(src, shell string, pos *Pos) {
 		// Instead, actionlint shows position of 'run:' as position of error. And separately show
 		// location in script which is reported by shellcheck in error message.
 		for _, err := range errs {
+			pos, ok := files[err.File]
+			if !ok {
+				rule.Debug("Ignored error for unknown file %q reported by shellcheck: %s", err.File, err.Message)
+				continue
+			}
 			// Consider the first line is setup for running shell which was implicitly added for better check
 			line := err.Line - 1
 			msg := strings.TrimSuffix(err.Message, ".") // Trim period aligning style of error message
-			rule.Errorf(pos, "shellcheck reported issue in this script: SC%d:%s:%d:%d: %s", err.Code, err.Level, line, err.Column, msg)
+			e := errorfAt(pos, rule.name, "shellcheck reported issue in this script: SC%d:%s:%d:%d: %s", err.Code, err.Level, line, err.Column, msg)
+			e.Severity = err.Level
+			rule.errs = append(rule.errs, e)
 		}
 
 		return nil
 	})
+	return dir, nil
 }
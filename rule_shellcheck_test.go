@@ -1,8 +1,17 @@
 package actionlint
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestRuleShellcheckSanitizeExpressionsInScript(t *testing.T) {
@@ -197,3 +206,216 @@ func TestRuleShellcheckDetectShell(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleShellcheckArgsFromConfig(t *testing.T) {
+	r := newRuleShellcheck(&externalCommand{})
+	r.SetConfig(&Config{
+		Shellcheck: &ShellcheckConfig{
+			Exclude:  []string{"SC2086", "SC2129"},
+			Enable:   []string{"require-variable-braces"},
+			Severity: "warning",
+		},
+	})
+	r.extraArgs = []string{"-o", "all"}
+
+	want := []string{
+		"--norc", "-f", "json", "-x", "--shell", "sh", "-e", "SC1091,SC2194,SC2050,SC2154,SC2157",
+		"-e", "SC2086,SC2129", "-o", "require-variable-braces", "-S", "warning",
+		"-o", "all",
+	}
+	if diff := cmp.Diff(want, r.args("sh")); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestRuleShellcheckShellDialectOfJob(t *testing.T) {
+	c := &ShellcheckConfig{
+		Shells: map[string]string{
+			"alpine-*":    "dash",
+			"alpine-test": "ksh",
+			"Legacy":      "sh",
+		},
+	}
+	testCases := []struct {
+		job  string
+		want string
+	}{
+		{"alpine-build", "dash"},
+		{"alpine-test", "ksh"},
+		{"legacy", "sh"},
+		{"ubuntu", ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.job, func(t *testing.T) {
+			r := newRuleShellcheck(&externalCommand{})
+			r.SetConfig(&Config{Shellcheck: c})
+			r.VisitJobPre(&Job{ID: &String{Value: tc.job}})
+			if r.jobDialect != tc.want {
+				t.Fatalf("wanted dialect %q but got %q", tc.want, r.jobDialect)
+			}
+			r.VisitJobPost(&Job{})
+			if r.jobDialect != "" {
+				t.Fatalf("dialect was not reset: %q", r.jobDialect)
+			}
+		})
+	}
+}
+
+func TestRuleShellcheckReportSeverity(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake shellcheck command is a shell script")
+	}
+	dir := t.TempDir()
+	exe := filepath.Join(dir, "shellcheck")
+	// Report errors for the last file in arguments
+	out := `[{"file":"'"$f"'","line":2,"column":6,"level":"warning","code":2034,"message":"foo appears unused."},` +
+		`{"file":"'"$f"'","line":3,"column":6,"level":"style","code":2006,"message":"Use $(...) notation instead of legacy backticks."}]`
+	script := "#!/bin/sh\necho \"$@\" > '" + filepath.Join(dir, "args") + "'\nfor f; do :; done\necho '" + out + "'\n"
+	if err := os.WriteFile(exe, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewRuleShellcheck(exe, newConcurrentProcess(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.SetConfig(&Config{Shellcheck: &ShellcheckConfig{Shells: map[string]string{"test": "dash"}}})
+
+	pos := &Pos{Line: 5, Col: 9}
+	r.VisitWorkflowPre(&Workflow{})
+	r.VisitJobPre(&Job{ID: &String{Value: "test"}})
+	r.VisitStep(&Step{Exec: &ExecRun{Run: &String{Value: "foo=1\necho `date`"}, RunPos: pos}})
+	r.VisitJobPost(&Job{})
+	if err := r.VisitWorkflowPost(&Workflow{}); err != nil {
+		t.Fatal(err)
+	}
+
+	errs := r.Errs()
+	if len(errs) != 2 {
+		t.Fatalf("wanted 2 errors but got %v", errs)
+	}
+	for i, want := range []string{"warning", "style"} {
+		if errs[i].Severity != want {
+			t.Errorf("wanted severity %q but got %q: %v", want, errs[i].Severity, errs[i])
+		}
+		if !strings.Contains(errs[i].Message, ":"+want+":") {
+			t.Errorf("level %q is not in message: %q", want, errs[i].Message)
+		}
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "args"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args := string(b); !strings.Contains(args, "--shell dash") {
+		t.Fatalf("shell dialect was not overridden: %q", args)
+	}
+}
+
+func TestRuleShellcheckCheckScriptsInBatch(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake shellcheck command is a shell script")
+	}
+	dir := t.TempDir()
+	exe := filepath.Join(dir, "shellcheck")
+	log := filepath.Join(dir, "log")
+	// Report one error for each script file in arguments
+	script := `#!/bin/sh
+echo "$@" >> '` + log + `'
+sep=''
+printf '['
+for f; do
+  case "$f" in
+    *.sh) printf '%s{"file":"%s","line":2,"column":1,"level":"info","code":2086,"message":"Double quote."}' "$sep" "$f"; sep=',';;
+  esac
+done
+printf ']'
+`
+	if err := os.WriteFile(exe, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewRuleShellcheck(exe, newConcurrentProcess(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		shell string
+		pos   *Pos
+	}{
+		{"bash", &Pos{Line: 3, Col: 9}},
+		{"sh", &Pos{Line: 5, Col: 9}},
+		{"", &Pos{Line: 7, Col: 9}},
+		{"pwsh", &Pos{Line: 9, Col: 9}},
+		{"sh -e {0}", &Pos{Line: 11, Col: 9}},
+	}
+	r.VisitWorkflowPre(&Workflow{})
+	r.VisitJobPre(&Job{})
+	for _, s := range steps {
+		e := &ExecRun{Run: &String{Value: "echo $FOO"}, RunPos: s.pos}
+		if s.shell != "" {
+			e.Shell = &String{Value: s.shell}
+		}
+		r.VisitStep(&Step{Exec: e})
+	}
+	r.VisitJobPost(&Job{})
+	if err := r.VisitWorkflowPost(&Workflow{}); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(b), "\n"); n != 2 {
+		t.Fatalf("shellcheck should run once for each shell but it ran %d times:\n%s", n, b)
+	}
+
+	lines := []int{}
+	for _, err := range r.Errs() {
+		lines = append(lines, err.Line)
+	}
+	sort.Ints(lines)
+	if diff := cmp.Diff([]int{3, 5, 7, 11}, lines); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestRuleShellcheckRemoveTemporaryFilesOnCancel(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake shellcheck command is a shell script")
+	}
+	dir := t.TempDir()
+	exe := filepath.Join(dir, "shellcheck")
+	if err := os.WriteFile(exe, []byte("#!/bin/sh\nprintf '[]'\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r, err := NewRuleShellcheck(exe, newConcurrentProcessWithContext(ctx, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r.VisitWorkflowPre(&Workflow{})
+	r.VisitJobPre(&Job{})
+	for _, sh := range []string{"bash", "sh"} {
+		r.VisitStep(&Step{Exec: &ExecRun{Run: &String{Value: "echo $FOO"}, Shell: &String{Value: sh}, RunPos: &Pos{}}})
+	}
+	r.VisitJobPost(&Job{})
+	if err := r.VisitWorkflowPost(&Workflow{}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("wanted cancellation error but got %v", err)
+	}
+
+	entries, err := os.ReadDir(tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		t.Errorf("temporary file was not removed: %s", e.Name())
+	}
+}
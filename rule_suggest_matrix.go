@@ -0,0 +1,173 @@
+package actionlint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Maximum number of different values between two jobs to suggest a matrix.
+const suggestMatrixMaxDiffs = 3
+
+// jobShapeField is one field of job which is compared to detect near-duplicate jobs.
+type jobShapeField struct {
+	key   string
+	value string
+}
+
+// RuleSuggestMatrix is a rule checker to detect sibling jobs whose configurations are identical
+// except for a few literal values such as versions or OS. Such jobs can be merged into one job
+// with matrix. This rule is disabled by default and enabled by "suggest-matrix" in config file.
+// https://docs.github.com/en/actions/using-jobs/using-a-matrix-for-your-jobs
+type RuleSuggestMatrix struct {
+	RuleBase
+}
+
+// NewRuleSuggestMatrix creates new RuleSuggestMatrix instance.
+func NewRuleSuggestMatrix() *RuleSuggestMatrix {
+	return &RuleSuggestMatrix{
+		RuleBase: RuleBase{
+			name: "suggest-matrix",
+			desc: "Suggests matrix for sibling jobs which are identical except for a few values",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleSuggestMatrix) VisitWorkflowPre(n *Workflow) error {
+	jobs := make([]*Job, 0, len(n.Jobs))
+	for _, j := range n.Jobs {
+		// Jobs already using matrix and jobs calling reusable workflows are not target
+		if len(j.Steps) == 0 || j.WorkflowCall != nil || (j.Strategy != nil && j.Strategy.Matrix != nil) {
+			continue
+		}
+		jobs = append(jobs, j)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].Pos.IsBefore(jobs[j].Pos)
+	})
+
+	shapes := make([][]jobShapeField, 0, len(jobs))
+	for _, j := range jobs {
+		shapes = append(shapes, jobShape(j))
+	}
+
+	for i := 1; i < len(jobs); i++ {
+		for p := 0; p < i; p++ {
+			diffs, ok := diffJobShapes(shapes[p], shapes[i])
+			if !ok || len(diffs) == 0 || len(diffs) > suggestMatrixMaxDiffs {
+				continue
+			}
+			rule.Errorf(
+				jobs[i].Pos,
+				"job %q is identical to job %q at %s except for %s. consider merging them into one job with matrix",
+				jobs[i].ID.Value,
+				jobs[p].ID.Value,
+				jobs[p].Pos.String(),
+				strings.Join(diffs, ", "),
+			)
+			break // Report each job only once
+		}
+	}
+	return nil
+}
+
+func appendShapeString(fs []jobShapeField, k string, s *String) []jobShapeField {
+	if s == nil {
+		return fs
+	}
+	return append(fs, jobShapeField{k, s.Value})
+}
+
+func appendShapeEnv(fs []jobShapeField, prefix string, e *Env) []jobShapeField {
+	if e == nil {
+		return fs
+	}
+	if e.Expression != nil {
+		return appendShapeString(fs, prefix, e.Expression)
+	}
+	ks := make([]string, 0, len(e.Vars))
+	for k := range e.Vars {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	for _, k := range ks {
+		fs = appendShapeString(fs, prefix+"."+k, e.Vars[k].Value)
+	}
+	return fs
+}
+
+// appendStepShape appends fields of the step to the job shape. Keys of the fields are prefixed
+// with the given prefix.
+func appendStepShape(fs []jobShapeField, prefix string, s *Step) []jobShapeField {
+	fs = appendShapeString(fs, prefix+"name", s.Name)
+	fs = appendShapeString(fs, prefix+"if", s.If)
+	fs = appendShapeEnv(fs, prefix+"env", s.Env)
+	switch e := s.Exec.(type) {
+	case *ExecRun:
+		fs = appendShapeString(fs, prefix+"run", e.Run)
+		fs = appendShapeString(fs, prefix+"shell", e.Shell)
+		fs = appendShapeString(fs, prefix+"working-directory", e.WorkingDirectory)
+	case *ExecAction:
+		fs = appendShapeString(fs, prefix+"uses", e.Uses)
+		ks := make([]string, 0, len(e.Inputs))
+		for k := range e.Inputs {
+			ks = append(ks, k)
+		}
+		sort.Strings(ks)
+		for _, k := range ks {
+			fs = appendShapeString(fs, prefix+"with."+k, e.Inputs[k].Value)
+		}
+	}
+	return fs
+}
+
+func jobShape(j *Job) []jobShapeField {
+	fs := []jobShapeField{}
+
+	if j.RunsOn != nil {
+		if j.RunsOn.LabelsExpr != nil {
+			fs = appendShapeString(fs, "runs-on", j.RunsOn.LabelsExpr)
+		} else {
+			ls := make([]string, 0, len(j.RunsOn.Labels))
+			for _, l := range j.RunsOn.Labels {
+				ls = append(ls, l.Value)
+			}
+			fs = append(fs, jobShapeField{"runs-on", strings.Join(ls, ", ")})
+		}
+		fs = appendShapeString(fs, "runs-on.group", j.RunsOn.Group)
+	}
+	if len(j.Needs) > 0 {
+		ns := make([]string, 0, len(j.Needs))
+		for _, n := range j.Needs {
+			ns = append(ns, n.Value)
+		}
+		sort.Strings(ns)
+		fs = append(fs, jobShapeField{"needs", strings.Join(ns, ", ")})
+	}
+	fs = appendShapeString(fs, "if", j.If)
+	fs = appendShapeEnv(fs, "env", j.Env)
+
+	for i, s := range j.Steps {
+		fs = appendStepShape(fs, fmt.Sprintf("steps[%d].", i), s)
+	}
+	return fs
+}
+
+// diffJobShapes compares two job shapes. It returns false as the second return value when the
+// structures of the jobs are different. Otherwise it returns descriptions of the different values.
+func diffJobShapes(a, b []jobShapeField) ([]string, bool) {
+	if len(a) != len(b) {
+		return nil, false
+	}
+	diffs := []string{}
+	for i := range a {
+		if a[i].key != b[i].key {
+			return nil, false
+		}
+		if a[i].value != b[i].value {
+			diffs = append(diffs, fmt.Sprintf("%q (%q vs %q)", a[i].key, a[i].value, b[i].value))
+		}
+	}
+	return diffs, true
+}
@@ -50,6 +50,30 @@ func TestRuleBaseErrorfAndErrs(t *testing.T) {
 	}
 }
 
+func TestRuleBaseErrorfWithSuggestions(t *testing.T) {
+	r := NewRuleBase("dummy name", "dummy description")
+	r.errorfWithSuggestions(&Pos{Line: 1, Col: 2}, "buidl", []string{"build", "test", "lint"}, "job %q does not exist", "buidl")
+	r.errorfWithSuggestions(&Pos{Line: 3, Col: 4}, "deploy", []string{"build", "test", "lint"}, "job %q does not exist", "deploy")
+	want := []*Error{
+		{
+			Message:     `job "buidl" does not exist. did you mean "build"?`,
+			Line:        1,
+			Column:      2,
+			Kind:        "dummy name",
+			Suggestions: []string{"build"},
+		},
+		{
+			Message: `job "deploy" does not exist`,
+			Line:    3,
+			Column:  4,
+			Kind:    "dummy name",
+		},
+	}
+	if diff := cmp.Diff(r.Errs(), want); diff != "" {
+		t.Error("unexpected errors from Errs() method:", diff)
+	}
+}
+
 func TestRuleBaseDebugOutput(t *testing.T) {
 	r := NewRuleBase("dummy-name", "")
 	r.Debug("this %s output", "is not")
@@ -0,0 +1,113 @@
+package actionlint
+
+import "strings"
+
+const (
+	// https://docs.github.com/en/actions/learn-github-actions/usage-limits-billing-and-administration#usage-limits
+	maxGitHubHostedJobTimeoutMinutes = 360.0
+	// https://docs.github.com/en/actions/hosting-your-own-runners/managing-self-hosted-runners/about-self-hosted-runners#usage-limits
+	maxSelfHostedJobTimeoutMinutes = 5 * 24 * 60.0
+	defaultJobTimeoutMinutes       = 360.0
+)
+
+// RuleTimeoutMinutes is a rule checker to check values at "timeout-minutes" against the limits of
+// job execution time of runners.
+type RuleTimeoutMinutes struct {
+	RuleBase
+	// jobTimeout is an effective timeout of the current job. It is zero when the timeout is unknown
+	// statically.
+	jobTimeout float64
+	// jobTimeoutPos is a position of "timeout-minutes" of the current job. It is nil when the job
+	// does not set it.
+	jobTimeoutPos *Pos
+}
+
+// NewRuleTimeoutMinutes creates new RuleTimeoutMinutes instance.
+func NewRuleTimeoutMinutes() *RuleTimeoutMinutes {
+	return &RuleTimeoutMinutes{
+		RuleBase: RuleBase{
+			name: "timeout-minutes",
+			desc: "Checks for \"timeout-minutes\" values exceeding limits of runners or enclosing job",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleTimeoutMinutes) VisitJobPre(n *Job) error {
+	rule.jobTimeout = defaultJobTimeoutMinutes
+	rule.jobTimeoutPos = nil
+
+	t := n.TimeoutMinutes
+	if t == nil {
+		return nil
+	}
+	if t.Expression != nil {
+		rule.jobTimeout = 0
+		return nil
+	}
+	rule.jobTimeout = t.Value
+	rule.jobTimeoutPos = t.Pos
+
+	if t.Value > maxSelfHostedJobTimeoutMinutes {
+		rule.Errorf(
+			t.Pos,
+			"value %v at \"timeout-minutes\" exceeds the maximum job execution time %v minutes (5 days). the job will be terminated at the limit",
+			t.Value,
+			maxSelfHostedJobTimeoutMinutes,
+		)
+		return nil
+	}
+
+	if t.Value > maxGitHubHostedJobTimeoutMinutes && runsOnGitHubHostedRunner(n.RunsOn) {
+		rule.Errorf(
+			t.Pos,
+			"value %v at \"timeout-minutes\" exceeds the maximum job execution time %v minutes of GitHub-hosted runners. the job will be terminated at the limit",
+			t.Value,
+			maxGitHubHostedJobTimeoutMinutes,
+		)
+	}
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleTimeoutMinutes) VisitStep(n *Step) error {
+	t := n.TimeoutMinutes
+	if t == nil || t.Expression != nil || rule.jobTimeout == 0 || t.Value <= rule.jobTimeout {
+		return nil
+	}
+
+	if rule.jobTimeoutPos == nil {
+		rule.Errorf(
+			t.Pos,
+			"value %v at \"timeout-minutes\" of step is larger than the default timeout %v minutes of the job. the step will be terminated by the job timeout",
+			t.Value,
+			defaultJobTimeoutMinutes,
+		)
+		return nil
+	}
+
+	rule.Errorf(
+		t.Pos,
+		"value %v at \"timeout-minutes\" of step is larger than the job's timeout %v minutes at %s. the step will be terminated by the job timeout",
+		t.Value,
+		rule.jobTimeout,
+		rule.jobTimeoutPos.String(),
+	)
+	return nil
+}
+
+// runsOnGitHubHostedRunner returns true when the job obviously runs on GitHub-hosted runner.
+func runsOnGitHubHostedRunner(r *Runner) bool {
+	if r == nil || r.LabelsExpr != nil || r.Group != nil || len(r.Labels) == 0 {
+		return false
+	}
+	for _, l := range r.Labels {
+		if l.ContainsExpression() {
+			return false
+		}
+		if !contains(allGitHubHostedRunnerLabels, strings.ToLower(l.Value)) {
+			return false
+		}
+	}
+	return true
+}
@@ -2,6 +2,7 @@ package actionlint
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -11,6 +12,9 @@ type RuleWorkflowCall struct {
 	workflowCallEventPos *Pos
 	workflowPath         string
 	cache                *LocalReusableWorkflowCache
+	// onlyPullRequest is true when this workflow is triggered only by pull_request event. Secrets are
+	// not passed to the workflow run when the pull request is created from a forked repository.
+	onlyPullRequest bool
 }
 
 // NewRuleWorkflowCall creates a new RuleWorkflowCall instance. 'workflowPath' is a file path to
@@ -24,11 +28,20 @@ func NewRuleWorkflowCall(workflowPath string, cache *LocalReusableWorkflowCache)
 		workflowCallEventPos: nil,
 		workflowPath:         workflowPath,
 		cache:                cache,
+		onlyPullRequest:      false,
 	}
 }
 
 // VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
 func (rule *RuleWorkflowCall) VisitWorkflowPre(n *Workflow) error {
+	rule.onlyPullRequest = len(n.On) > 0
+	for _, e := range n.On {
+		if e, ok := e.(*WebhookEvent); !ok || e.Hook.Value != "pull_request" {
+			rule.onlyPullRequest = false
+			break
+		}
+	}
+
 	for _, e := range n.On {
 		if e, ok := e.(*WorkflowCallEvent); ok {
 			rule.workflowCallEventPos = e.Pos
@@ -52,12 +65,10 @@ func (rule *RuleWorkflowCall) VisitJobPre(n *Job) error {
 		return nil
 	}
 
-	if isWorkflowCallUsesLocalFormat(u.Value) {
-		rule.checkWorkflowCallUsesLocal(n.WorkflowCall)
-		return nil
-	}
-
-	if isWorkflowCallUsesRepoFormat(u.Value) {
+	if isWorkflowCallUsesLocalFormat(u.Value) || isWorkflowCallUsesRepoFormat(u.Value) {
+		// Remote reusable workflows are only checked when fetching them is enabled. Otherwise the
+		// cache returns nil metadata and the check is skipped.
+		rule.checkWorkflowCallUses(n.WorkflowCall)
 		return nil
 	}
 
@@ -76,7 +87,7 @@ func (rule *RuleWorkflowCall) VisitJobPre(n *Job) error {
 	return nil
 }
 
-func (rule *RuleWorkflowCall) checkWorkflowCallUsesLocal(call *WorkflowCall) {
+func (rule *RuleWorkflowCall) checkWorkflowCallUses(call *WorkflowCall) {
 	u := call.Uses
 	m, err := rule.cache.FindMetadata(u.Value)
 	if err != nil {
@@ -140,11 +151,39 @@ func (rule *RuleWorkflowCall) checkWorkflowCallUsesLocal(call *WorkflowCall) {
 				rule.Errorf(s.Name.Pos, "secret %q is not defined in %q reusable workflow. %s", s.Name.Value, u.Value, note)
 			}
 		}
+	} else if rule.onlyPullRequest {
+		rule.checkInheritedSecretsOnPullRequest(u, m)
 	}
+	// Other required secrets are not checked with `secrets: inherit` even if this workflow declares
+	// its secrets at `on.workflow_call.secrets`. When the caller of this workflow also uses
+	// `secrets: inherit`, all secrets of the caller are passed through including undeclared ones.
 
 	rule.Debug("Validated reusable workflow %q", u.Value)
 }
 
+// checkInheritedSecretsOnPullRequest reports required secrets of the called workflow when this
+// workflow is triggered only by pull_request event. No secret is inherited when the pull request
+// is created from a forked repository. This is a warning since the pull request may be created
+// from a branch in the same repository.
+func (rule *RuleWorkflowCall) checkInheritedSecretsOnPullRequest(u *String, m *ReusableWorkflowMetadata) {
+	ns := make([]string, 0, len(m.Secrets))
+	for n, s := range m.Secrets {
+		if s.Required {
+			ns = append(ns, n)
+		}
+	}
+	sort.Strings(ns) // Sort to make the order of errors deterministic
+	for _, n := range ns {
+		rule.ErrorfWithSeverity(
+			u.Pos,
+			"warning",
+			"secret %q is required by %q reusable workflow but it is not inherited with \"secrets: inherit\" when this workflow is triggered by \"pull_request\" event from a forked repository. consider checking the secret is not empty in the reusable workflow",
+			m.Secrets[n].Name,
+			u.Value,
+		)
+	}
+}
+
 // Parse ./{path/{filename}
 // https://docs.github.com/en/actions/learn-github-actions/reusing-workflows#calling-a-reusable-workflow
 func isWorkflowCallUsesLocalFormat(u string) bool {
@@ -190,3 +229,14 @@ func isWorkflowCallUsesRepoFormat(u string) bool {
 
 	return len(u) > 0
 }
+
+// splitWorkflowCallUsesRepoFormat splits {owner}/{repo}/{path to workflow.yml}@{ref} into "{owner}/{repo}",
+// "{path to workflow.yml}", and "{ref}". The argument must be checked with isWorkflowCallUsesRepoFormat
+// in advance.
+func splitWorkflowCallUsesRepoFormat(u string) (string, string, string) {
+	at := strings.IndexRune(u, '@')
+	u, ref := u[:at], u[at+1:]
+	i := strings.IndexRune(u, '/')
+	i += strings.IndexRune(u[i+1:], '/') + 1
+	return u[:i], u[i+1:], ref
+}
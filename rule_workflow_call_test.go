@@ -123,7 +123,7 @@ func TestRuleWorkflowCallWriteEventNodeToMetadataCache(t *testing.T) {
 	}
 
 	cwd := filepath.Join("path", "to", "project")
-	c := NewLocalReusableWorkflowCache(&Project{cwd, nil}, cwd, nil)
+	c := NewLocalReusableWorkflowCache(&Project{cwd, nil, nil}, cwd, nil)
 	r := NewRuleWorkflowCall("test-workflow.yaml", c)
 
 	if err := r.VisitWorkflowPre(w); err != nil {
@@ -159,7 +159,7 @@ func TestRuleWorkflowCallWriteEventNodeToMetadataCache(t *testing.T) {
 
 func TestRuleWorkflowCallCheckReusableWorkflowCall(t *testing.T) {
 	cwd := filepath.Join("testdata", "reusable_workflow_metadata")
-	cache := NewLocalReusableWorkflowCache(&Project{cwd, nil}, cwd, nil)
+	cache := NewLocalReusableWorkflowCache(&Project{cwd, nil, nil}, cwd, nil)
 
 	for i, md := range []*ReusableWorkflowMetadata{
 		// workflow0.yaml
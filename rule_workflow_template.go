@@ -0,0 +1,211 @@
+package actionlint
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// workflowTemplatePlaceholders is a set of placeholders replaced when a workflow template is used.
+// https://docs.github.com/en/actions/using-workflows/creating-starter-workflows-for-your-organization
+var workflowTemplatePlaceholders = []string{"$cron-daily", "$default-branch", "$protected-branches"}
+
+// Placeholders in workflow templates are in kebab-case. Shell variables cannot contain "-" so they
+// are not confused with placeholders.
+var reWorkflowTemplatePlaceholder = regexp.MustCompile(`\$[a-zA-Z][a-zA-Z0-9]*(?:-[a-zA-Z0-9]+)+`)
+
+// RuleWorkflowTemplate is a rule to check workflow templates in "workflow-templates" directory of
+// organization's ".github" repository and their properties files (*.properties.json).
+// https://docs.github.com/en/actions/using-workflows/creating-starter-workflows-for-your-organization
+type RuleWorkflowTemplate struct {
+	RuleBase
+	path string
+	src  []byte
+	fs   fileSystem
+}
+
+// NewRuleWorkflowTemplate creates a new RuleWorkflowTemplate instance. The path parameter is a
+// file path of the workflow template or its properties file. The src parameter is the source of
+// the file.
+func NewRuleWorkflowTemplate(path string, src []byte) *RuleWorkflowTemplate {
+	return &RuleWorkflowTemplate{
+		RuleBase: RuleBase{
+			name: "workflow-template",
+			desc: "Checks for workflow templates and their properties files in \"workflow-templates\" directory",
+		},
+		path: path,
+		src:  src,
+		fs:   osFileSystem{},
+	}
+}
+
+// isWorkflowTemplateFile returns true when the file path is a workflow template in
+// "workflow-templates" directory.
+func isWorkflowTemplateFile(path string) bool {
+	if filepath.Base(filepath.Dir(path)) != "workflow-templates" {
+		return false
+	}
+	return strings.HasSuffix(path, ".yml") || strings.HasSuffix(path, ".yaml")
+}
+
+// isWorkflowTemplatePropertiesFile returns true when the file path is a properties file of workflow
+// template in "workflow-templates" directory.
+func isWorkflowTemplatePropertiesFile(path string) bool {
+	return filepath.Base(filepath.Dir(path)) == "workflow-templates" && strings.HasSuffix(path, ".properties.json")
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleWorkflowTemplate) VisitWorkflowPre(n *Workflow) error {
+	for i, l := range bytes.Split(rule.src, []byte{'\n'}) {
+		if bytes.HasPrefix(bytes.TrimSpace(l), []byte{'#'}) {
+			continue // Placeholders in comments are not replaced
+		}
+		for _, idx := range reWorkflowTemplatePlaceholder.FindAllIndex(l, -1) {
+			p := string(l[idx[0]:idx[1]])
+			if contains(workflowTemplatePlaceholders, p) {
+				continue
+			}
+			rule.errorfWithSuggestions(
+				&Pos{Line: i + 1, Col: idx[0] + 1},
+				p,
+				workflowTemplatePlaceholders,
+				"unknown placeholder %q in workflow template. available placeholders are %s",
+				p,
+				sortedQuotes(workflowTemplatePlaceholders),
+			)
+		}
+	}
+
+	p := strings.TrimSuffix(rule.path, filepath.Ext(rule.path)) + ".properties.json"
+	if _, err := rule.fs.Stat(p); errors.Is(err, os.ErrNotExist) {
+		rule.Errorf(
+			&Pos{Line: 1, Col: 1},
+			"properties file %q of workflow template is not found. workflow template is not shown in \"Actions\" tab without its properties file",
+			filepath.Base(p),
+		)
+	}
+	return nil
+}
+
+// CheckProperties checks the properties file of workflow template. It is called instead of
+// visiting a workflow syntax tree since the properties file is not a workflow.
+// https://docs.github.com/en/actions/using-workflows/creating-starter-workflows-for-your-organization#creating-a-starter-workflow
+func (rule *RuleWorkflowTemplate) CheckProperties() {
+	var v any
+	if err := json.Unmarshal(rule.src, &v); err != nil {
+		pos := &Pos{Line: 1, Col: 1}
+		var serr *json.SyntaxError
+		if errors.As(err, &serr) && serr.Offset > 0 {
+			pos = posAtOffset(rule.src, int(serr.Offset)-1) // Offset is after the invalid character
+		}
+		rule.Errorf(pos, "could not parse properties file of workflow template as JSON: %s", err.Error())
+		return
+	}
+
+	// JSON is a subset of YAML. Parse it as YAML again to know positions of values
+	var n yaml.Node
+	if err := yaml.Unmarshal(rule.src, &n); err != nil || len(n.Content) == 0 {
+		rule.Debug("Could not parse properties file as YAML: %v", err)
+		return
+	}
+	root := n.Content[0]
+	if root.Kind != yaml.MappingNode {
+		rule.Error(posAt(root), "properties file of workflow template must be an object")
+		return
+	}
+
+	keys := []string{"name", "description", "iconName", "categories", "filePatterns", "creator", "labels"}
+	seen := map[string]struct{}{}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		k, v := root.Content[i], root.Content[i+1]
+		seen[k.Value] = struct{}{}
+		switch k.Value {
+		case "name", "description", "creator":
+			rule.checkPropertyString(k.Value, v)
+		case "iconName":
+			if rule.checkPropertyString(k.Value, v) {
+				rule.checkIconName(v)
+			}
+		case "categories", "labels":
+			rule.checkPropertyStrings(k.Value, v)
+		case "filePatterns":
+			for _, p := range rule.checkPropertyStrings(k.Value, v) {
+				if _, err := regexp.Compile(p.Value); err != nil {
+					rule.Errorf(posAt(p), "file pattern %q at \"filePatterns\" is not a valid regular expression: %s", p.Value, err.Error())
+				}
+			}
+		default:
+			rule.errorfWithSuggestions(
+				posAt(k),
+				k.Value,
+				keys,
+				"unexpected key %q in properties file of workflow template. expected one of %s",
+				k.Value,
+				sortedQuotes(keys),
+			)
+		}
+	}
+
+	for _, k := range []string{"name", "description"} {
+		if _, ok := seen[k]; !ok {
+			rule.Errorf(posAt(root), "%q is required in properties file of workflow template", k)
+		}
+	}
+}
+
+func (rule *RuleWorkflowTemplate) checkPropertyString(key string, n *yaml.Node) bool {
+	if n.Kind != yaml.ScalarNode || n.Tag != "!!str" {
+		rule.Errorf(posAt(n), "%q in properties file of workflow template must be a string", key)
+		return false
+	}
+	if strings.TrimSpace(n.Value) == "" {
+		rule.Errorf(posAt(n), "%q in properties file of workflow template must not be empty", key)
+		return false
+	}
+	return true
+}
+
+func (rule *RuleWorkflowTemplate) checkPropertyStrings(key string, n *yaml.Node) []*yaml.Node {
+	if n.Kind != yaml.SequenceNode {
+		rule.Errorf(posAt(n), "%q in properties file of workflow template must be an array of strings", key)
+		return nil
+	}
+	ret := make([]*yaml.Node, 0, len(n.Content))
+	for _, c := range n.Content {
+		if c.Kind != yaml.ScalarNode || c.Tag != "!!str" {
+			rule.Errorf(posAt(c), "element of %q in properties file of workflow template must be a string", key)
+			continue
+		}
+		ret = append(ret, c)
+	}
+	return ret
+}
+
+// checkIconName checks the SVG icon file exists in the "workflow-templates" directory. Octicons
+// are specified with "octicon" prefix like "octicon smiley".
+func (rule *RuleWorkflowTemplate) checkIconName(n *yaml.Node) {
+	if strings.HasPrefix(n.Value, "octicon ") || rule.path == "" {
+		return
+	}
+	f := n.Value + ".svg"
+	if _, err := rule.fs.Stat(filepath.Join(filepath.Dir(rule.path), f)); errors.Is(err, os.ErrNotExist) {
+		rule.Errorf(posAt(n), "icon file %q of \"iconName\" is not found in \"workflow-templates\" directory. use \"octicon {name}\" for Octicons", f)
+	}
+}
+
+// posAtOffset converts the byte offset in the source into the position.
+func posAtOffset(src []byte, offset int) *Pos {
+	if offset > len(src) {
+		offset = len(src)
+	}
+	s := src[:offset]
+	l := bytes.Count(s, []byte{'\n'}) + 1
+	c := offset - bytes.LastIndexByte(s, '\n')
+	return &Pos{Line: l, Col: c}
+}
@@ -0,0 +1,194 @@
+package actionlint
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Values which are treated as booleans by YAML 1.1 parsers. Only "true" and "false" are booleans
+// in YAML 1.2.
+var yamlTruthyValues = map[string]struct{}{
+	"y":     {},
+	"yes":   {},
+	"n":     {},
+	"no":    {},
+	"on":    {},
+	"off":   {},
+	"true":  {},
+	"false": {},
+}
+
+// RuleYAMLStyle is a rule checker for the style of YAML source of workflow files like yamllint.
+// It checks indentation consistency, line length, quoting style, truthy values, and trailing
+// spaces. This rule is disabled by default and enabled by "yaml-style" in config file.
+// https://github.com/adrienverge/yamllint
+type RuleYAMLStyle struct {
+	RuleBase
+	cfg    *YAMLStyleConfig
+	src    []byte
+	indent int
+}
+
+// NewRuleYAMLStyle creates new RuleYAMLStyle instance. The src parameter is a source of the
+// workflow to be checked. It returns an error when the configuration is invalid.
+func NewRuleYAMLStyle(cfg *YAMLStyleConfig, src []byte) (*RuleYAMLStyle, error) {
+	switch cfg.Quotes {
+	case "", "single", "double":
+	default:
+		return nil, fmt.Errorf("\"yaml-style.quotes\" in config must be \"single\" or \"double\" but got %q", cfg.Quotes)
+	}
+	return &RuleYAMLStyle{
+		RuleBase: RuleBase{
+			name: "yaml-style",
+			desc: "Checks for style of YAML source such as indentation, line length, quotes, truthy values, and trailing spaces",
+		},
+		cfg:    cfg,
+		src:    src,
+		indent: cfg.Indentation,
+	}, nil
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleYAMLStyle) VisitWorkflowPre(n *Workflow) error {
+	rule.checkLines()
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(rule.src, &root); err != nil {
+		return nil // Syntax errors are already reported by parser
+	}
+	if len(root.Content) == 0 {
+		return nil
+	}
+	rule.checkNode(root.Content[0], true)
+	return nil
+}
+
+func (rule *RuleYAMLStyle) checkLines() {
+	for i, l := range bytes.Split(rule.src, []byte{'\n'}) {
+		l = bytes.TrimSuffix(l, []byte{'\r'})
+		line := i + 1
+
+		if rule.cfg.TrailingSpaces {
+			if t := bytes.TrimRight(l, " \t"); len(t) < len(l) {
+				rule.Errorf(
+					&Pos{Line: line, Col: utf8.RuneCount(t) + 1},
+					"trailing spaces are found. remove them as configured at \"yaml-style.trailing-spaces\" in config",
+				)
+			}
+		}
+
+		if max := rule.cfg.LineLength; max > 0 {
+			n := utf8.RuneCount(l)
+			if n > max && !isNonBreakableYAMLLine(l) {
+				rule.Errorf(
+					&Pos{Line: line, Col: max + 1},
+					"line is too long (%d > %d characters) configured at \"yaml-style.line-length\" in config",
+					n,
+					max,
+				)
+			}
+		}
+	}
+}
+
+func (rule *RuleYAMLStyle) checkNode(n *yaml.Node, top bool) {
+	switch n.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			// "on:" at top level is the name of section, not a truthy value
+			if !(top && k.Value == "on") {
+				rule.checkScalar(k)
+			}
+			if n.Style&yaml.FlowStyle == 0 && v.Line > k.Line {
+				rule.checkIndent(k, v)
+			}
+			rule.checkNode(v, false)
+		}
+	case yaml.SequenceNode:
+		for _, c := range n.Content {
+			rule.checkNode(c, false)
+		}
+	case yaml.ScalarNode:
+		rule.checkScalar(n)
+	}
+}
+
+// checkIndent checks the indentation of block mapping or block sequence v which is a value of key k.
+func (rule *RuleYAMLStyle) checkIndent(k, v *yaml.Node) {
+	if rule.indent < 0 || v.Style&yaml.FlowStyle != 0 {
+		return
+	}
+	if v.Kind != yaml.MappingNode && v.Kind != yaml.SequenceNode {
+		return
+	}
+	w := v.Column - k.Column
+	if v.Kind == yaml.SequenceNode && w == 0 {
+		return // Sequence items are not indented like "key:\n- item"
+	}
+	if rule.indent == 0 {
+		rule.indent = w // Detect the width from the first indentation
+		return
+	}
+	if w == rule.indent {
+		return
+	}
+	rule.Errorf(
+		&Pos{Line: v.Line, Col: v.Column},
+		"indentation should be %d spaces but it is %d spaces. configure the width at \"yaml-style.indentation\" in config",
+		rule.indent,
+		w,
+	)
+}
+
+func (rule *RuleYAMLStyle) checkScalar(n *yaml.Node) {
+	if n.Kind != yaml.ScalarNode {
+		return
+	}
+	pos := &Pos{Line: n.Line, Col: n.Column}
+
+	switch {
+	case n.Style == 0 && rule.cfg.Truthy:
+		if _, ok := yamlTruthyValues[strings.ToLower(n.Value)]; ok && n.Value != "true" && n.Value != "false" {
+			rule.Errorf(
+				pos,
+				"truthy value %q should be \"true\" or \"false\" since it may be parsed as boolean by some YAML parsers. quote it if it is a string. this is configured at \"yaml-style.truthy\" in config",
+				n.Value,
+			)
+		}
+	case n.Style&yaml.DoubleQuotedStyle != 0 && rule.cfg.Quotes == "single":
+		if !strings.ContainsRune(n.Value, '\'') && isPrintableYAMLString(n.Value) {
+			rule.Errorf(pos, "string %q should be quoted with single quotes as configured at \"yaml-style.quotes\" in config", n.Value)
+		}
+	case n.Style&yaml.SingleQuotedStyle != 0 && rule.cfg.Quotes == "double":
+		if !strings.ContainsAny(n.Value, "\"\\") {
+			rule.Errorf(pos, "string %q should be quoted with double quotes as configured at \"yaml-style.quotes\" in config", n.Value)
+		}
+	}
+}
+
+// isNonBreakableYAMLLine returns true when the line consists of one long word such as URL, or one
+// mapping with the long word value like "uses: owner/repo@ref". Such lines cannot be broken.
+func isNonBreakableYAMLLine(l []byte) bool {
+	l = bytes.TrimSpace(l)
+	l = bytes.TrimPrefix(l, []byte("- "))
+	if i := bytes.Index(l, []byte(": ")); i >= 0 && !bytes.ContainsAny(l[:i], " \t") {
+		l = bytes.TrimSpace(l[i+2:])
+	}
+	return !bytes.ContainsAny(l, " \t")
+}
+
+// isPrintableYAMLString returns true when the string can be put in single quotes without escapes.
+func isPrintableYAMLString(s string) bool {
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleYAMLStyleInvalidQuotes(t *testing.T) {
+	_, err := NewRuleYAMLStyle(&YAMLStyleConfig{Quotes: "backtick"}, nil)
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	want := `"yaml-style.quotes" in config must be "single" or "double" but got "backtick"`
+	if msg := err.Error(); !strings.Contains(msg, want) {
+		t.Fatalf("error message %q does not contain %q", msg, want)
+	}
+}
+
+func TestRuleYAMLStyleCheck(t *testing.T) {
+	src := `on: push
+jobs:
+    test:
+      runs-on: 'ubuntu-latest'
+      steps:
+      - run: 'echo "hello"'
+      - run: 'echo hello'
+`
+
+	tests := []struct {
+		what string
+		cfg  YAMLStyleConfig
+		want []string
+	}{
+		{
+			what: "fixed indentation",
+			cfg:  YAMLStyleConfig{Indentation: 4},
+			want: []string{
+				"4:7: indentation should be 4 spaces but it is 2 spaces",
+			},
+		},
+		{
+			what: "consistent indentation",
+			cfg:  YAMLStyleConfig{},
+			want: []string{
+				"4:7: indentation should be 4 spaces but it is 2 spaces",
+			},
+		},
+		{
+			what: "indentation is not checked",
+			cfg:  YAMLStyleConfig{Indentation: -1},
+			want: []string{},
+		},
+		{
+			what: "double quotes",
+			cfg:  YAMLStyleConfig{Indentation: -1, Quotes: "double"},
+			want: []string{
+				`4:16: string "ubuntu-latest" should be quoted with double quotes`,
+				`7:14: string "echo hello" should be quoted with double quotes`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			r, err := NewRuleYAMLStyle(&tc.cfg, []byte(src))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := r.VisitWorkflowPre(&Workflow{}); err != nil {
+				t.Fatal(err)
+			}
+			errs := r.Errs()
+			if len(errs) != len(tc.want) {
+				t.Fatalf("wanted %d errors but got %d: %v", len(tc.want), len(errs), errs)
+			}
+			for i, err := range errs {
+				have := err.Error()
+				if !strings.Contains(have, tc.want[i]) {
+					t.Errorf("error %q does not contain %q", have, tc.want[i])
+				}
+			}
+		})
+	}
+}
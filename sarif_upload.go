@@ -0,0 +1,209 @@
+package actionlint
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// sarifTemplate is the built-in template of -format to output errors in SARIF format. It is the
+// same as the example template at testdata/format/sarif_template.txt.
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+const sarifTemplate = `{
+  "$schema": "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {
+        "driver": {
+          "name": "GitHub Actions lint",
+          "version": {{ getVersion | json }},
+          "informationUri": "https://github.com/rhysd/actionlint",
+          "rules": [
+            {{- $first := true}}
+            {{- range $ := allKinds }}
+            {{- if $first}}{{$first = false}}{{else}},{{end}}
+            {
+              "id": {{json $.Name}},
+              "name": {{$.Name | toPascalCase | json}},
+              "defaultConfiguration": {
+                "level": "error"
+              },
+              "properties": {
+                "description": {{json $.Description}},
+                "queryURI": "https://github.com/rhysd/actionlint/blob/main/docs/checks.md"
+              },
+              "fullDescription": {
+                "text": {{json $.Description}}
+              },
+              "helpUri": "https://github.com/rhysd/actionlint/blob/main/docs/checks.md"
+            }
+            {{- end}}
+          ]
+        }
+      },
+      "results": [
+        {{- $first := true}}
+        {{- range $ := .}}
+        {{- if $first}}{{$first = false}}{{else}},{{end}}
+        {
+          "ruleId": {{json $.Kind}},
+          "message": {
+            "text": {{json $.Message}}
+          },
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {
+                  "uri": {{json $.Filepath}},
+                  "uriBaseId": "%SRCROOT%"
+                },
+                "region": {
+                  "startLine": {{$.Line}},
+                  "startColumn": {{$.Column}},
+                  "endColumn": {{$.EndColumn}},
+                  "snippet": {
+                    "text": {{json $.Snippet}}
+                  }
+                }
+              }
+            }
+          ]
+        }
+        {{- end}}
+      ]
+    }
+  ]
+}
+`
+
+// SARIFUpload is a destination to upload SARIF reports to GitHub code scanning. The uploaded
+// findings appear in the Security tab of the repository.
+// https://docs.github.com/en/rest/code-scanning/code-scanning#upload-an-analysis-as-sarif-data
+type SARIFUpload struct {
+	// Repository is the slug of the repository like "owner/repo".
+	Repository string
+	// Ref is the full Git reference of the analyzed commit like "refs/heads/main" or
+	// "refs/pull/42/merge".
+	Ref string
+	// CommitSHA is the full SHA of the analyzed commit.
+	CommitSHA string
+	// Token is the API token which has "security_events" write permission.
+	Token string
+	// APIURL is the base URL of REST API. When it is empty, "https://api.github.com" is used.
+	APIURL string
+}
+
+// NewSARIFUploadFromEnv creates a new SARIFUpload instance from the environment variables set on
+// GitHub Actions. $GITHUB_REPOSITORY, $GITHUB_REF, $GITHUB_SHA, $GITHUB_TOKEN, and $GITHUB_API_URL
+// are used.
+func NewSARIFUploadFromEnv() *SARIFUpload {
+	return &SARIFUpload{
+		Repository: os.Getenv("GITHUB_REPOSITORY"),
+		Ref:        os.Getenv("GITHUB_REF"),
+		CommitSHA:  os.Getenv("GITHUB_SHA"),
+		Token:      os.Getenv("GITHUB_TOKEN"),
+		APIURL:     os.Getenv("GITHUB_API_URL"),
+	}
+}
+
+func (u *SARIFUpload) validate() error {
+	if u.Token == "" {
+		return errors.New("token of REST API is necessary to upload SARIF report. set $GITHUB_TOKEN environment variable")
+	}
+	if owner, repo, ok := strings.Cut(u.Repository, "/"); !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+		return fmt.Errorf("repository to upload SARIF report must be \"owner/repo\" but got %q. set -upload-sarif-repo option or $GITHUB_REPOSITORY environment variable", u.Repository)
+	}
+	if !strings.HasPrefix(u.Ref, "refs/") {
+		return fmt.Errorf("reference to upload SARIF report must be a full Git reference like \"refs/heads/main\" but got %q. set -upload-sarif-ref option or $GITHUB_REF environment variable", u.Ref)
+	}
+	if !reCommitSHA.MatchString(u.CommitSHA) {
+		return fmt.Errorf("commit to upload SARIF report must be a full commit SHA but got %q. set -upload-sarif-sha option or $GITHUB_SHA environment variable", u.CommitSHA)
+	}
+	return nil
+}
+
+// Upload gzips the SARIF report and uploads it to the code scanning API with the HTTP client. It
+// returns the ID of the uploaded analysis.
+func (u *SARIFUpload) Upload(client *http.Client, report []byte) (string, error) {
+	return u.UploadContext(context.Background(), client, report)
+}
+
+// UploadContext is the same as Upload but it takes the context. When the context is canceled, the
+// request in flight is aborted and the error is returned.
+func (u *SARIFUpload) UploadContext(ctx context.Context, client *http.Client, report []byte) (string, error) {
+	if err := u.validate(); err != nil {
+		return "", err
+	}
+	if !json.Valid(report) {
+		return "", errors.New("SARIF report to upload is not a valid JSON. check the template given by -format option")
+	}
+
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	if _, err := w.Write(report); err != nil {
+		return "", fmt.Errorf("could not compress SARIF report: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("could not compress SARIF report: %w", err)
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"commit_sha": u.CommitSHA,
+		"ref":        u.Ref,
+		"sarif":      base64.StdEncoding.EncodeToString(gz.Bytes()),
+		"tool_name":  "actionlint",
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not encode request body to upload SARIF report: %w", err)
+	}
+
+	api := strings.TrimRight(u.APIURL, "/")
+	if api == "" {
+		api = "https://api.github.com"
+	}
+	url := fmt.Sprintf("%s/repos/%s/code-scanning/sarifs", api, u.Repository)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("could not create request for %s: %w", url, err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+u.Token)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("could not upload SARIF report to %s: %w", url, err)
+	}
+	defer res.Body.Close()
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("could not read response of uploading SARIF report from %s: %w", url, err)
+	}
+	if res.StatusCode != 202 {
+		var e struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(b, &e); err == nil && e.Message != "" {
+			return "", fmt.Errorf("could not upload SARIF report to %s: %s: %s", url, res.Status, e.Message)
+		}
+		return "", fmt.Errorf("could not upload SARIF report to %s: %s", url, res.Status)
+	}
+
+	var ret struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(b, &ret); err != nil {
+		return "", fmt.Errorf("could not parse response of uploading SARIF report from %s: %w", url, err)
+	}
+	return ret.ID, nil
+}
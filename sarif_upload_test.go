@@ -0,0 +1,340 @@
+package actionlint
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testSARIFUploadSHA = "0123456789abcdef0123456789abcdef01234567"
+
+type testSARIFUploadRequest struct {
+	path   string
+	auth   string
+	commit string
+	ref    string
+	tool   string
+	sarif  []byte
+}
+
+func testSARIFUploadServer(t *testing.T, status int, body string) (*httptest.Server, *[]testSARIFUploadRequest) {
+	t.Helper()
+	reqs := []testSARIFUploadRequest{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("method should be POST but got %s", r.Method)
+		}
+		var params struct {
+			CommitSHA string `json:"commit_sha"`
+			Ref       string `json:"ref"`
+			SARIF     string `json:"sarif"`
+			ToolName  string `json:"tool_name"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			t.Errorf("request body is not a valid JSON: %s", err)
+		}
+		b, err := base64.StdEncoding.DecodeString(params.SARIF)
+		if err != nil {
+			t.Errorf("SARIF report is not encoded in base64: %s", err)
+		}
+		gz, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			t.Errorf("SARIF report is not compressed with gzip: %s", err)
+		} else if b, err = io.ReadAll(gz); err != nil {
+			t.Errorf("could not decompress SARIF report: %s", err)
+		}
+		reqs = append(reqs, testSARIFUploadRequest{
+			path:   r.URL.Path,
+			auth:   r.Header.Get("Authorization"),
+			commit: params.CommitSHA,
+			ref:    params.Ref,
+			tool:   params.ToolName,
+			sarif:  b,
+		})
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &reqs
+}
+
+func TestSARIFUploadOK(t *testing.T) {
+	srv, reqs := testSARIFUploadServer(t, 202, `{"id": "47177e22-5596-11eb-80a1-c1e54ef945c6", "url": "https://example.com"}`)
+	u := &SARIFUpload{
+		Repository: "owner/repo",
+		Ref:        "refs/heads/main",
+		CommitSHA:  testSARIFUploadSHA,
+		Token:      "dummy-token",
+		APIURL:     srv.URL + "/",
+	}
+
+	report := []byte(`{"version": "2.1.0", "runs": []}`)
+	id, err := u.Upload(srv.Client(), report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "47177e22-5596-11eb-80a1-c1e54ef945c6" {
+		t.Errorf("unexpected ID: %q", id)
+	}
+
+	if len(*reqs) != 1 {
+		t.Fatalf("one request should be sent but got %d requests", len(*reqs))
+	}
+	r := (*reqs)[0]
+	if r.path != "/repos/owner/repo/code-scanning/sarifs" {
+		t.Errorf("unexpected path: %q", r.path)
+	}
+	if r.auth != "Bearer dummy-token" {
+		t.Errorf("unexpected Authorization header: %q", r.auth)
+	}
+	if r.commit != testSARIFUploadSHA {
+		t.Errorf("unexpected commit: %q", r.commit)
+	}
+	if r.ref != "refs/heads/main" {
+		t.Errorf("unexpected ref: %q", r.ref)
+	}
+	if r.tool != "actionlint" {
+		t.Errorf("unexpected tool name: %q", r.tool)
+	}
+	if !bytes.Equal(r.sarif, report) {
+		t.Errorf("uploaded SARIF report is different from the original: %q", r.sarif)
+	}
+}
+
+func TestSARIFUploadErrorResponse(t *testing.T) {
+	srv, _ := testSARIFUploadServer(t, 403, `{"message": "Resource not accessible by integration"}`)
+	u := &SARIFUpload{
+		Repository: "owner/repo",
+		Ref:        "refs/pull/42/merge",
+		CommitSHA:  testSARIFUploadSHA,
+		Token:      "dummy-token",
+		APIURL:     srv.URL,
+	}
+
+	_, err := u.Upload(srv.Client(), []byte(`{}`))
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	msg := err.Error()
+	for _, s := range []string{"403 Forbidden", "Resource not accessible by integration"} {
+		if !strings.Contains(msg, s) {
+			t.Errorf("error message %q should contain %q", msg, s)
+		}
+	}
+}
+
+func TestSARIFUploadAbortByCanceledContext(t *testing.T) {
+	srv, aborted := testNewBlockingServer(t)
+	u := &SARIFUpload{
+		Repository: "owner/repo",
+		Ref:        "refs/heads/main",
+		CommitSHA:  testSARIFUploadSHA,
+		Token:      "dummy-token",
+		APIURL:     srv.URL,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := u.UploadContext(ctx, &http.Client{}, []byte(`{}`))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("deadline exceeded error should be returned but got %v", err)
+	}
+	testWaitAborted(t, aborted)
+}
+
+func TestSARIFUploadValidationError(t *testing.T) {
+	testCases := []struct {
+		what string
+		edit func(u *SARIFUpload)
+		want string
+	}{
+		{
+			what: "no token",
+			edit: func(u *SARIFUpload) { u.Token = "" },
+			want: "set $GITHUB_TOKEN environment variable",
+		},
+		{
+			what: "no repository",
+			edit: func(u *SARIFUpload) { u.Repository = "" },
+			want: "repository to upload SARIF report must be \"owner/repo\" but got \"\"",
+		},
+		{
+			what: "no owner",
+			edit: func(u *SARIFUpload) { u.Repository = "/repo" },
+			want: "repository to upload SARIF report must be \"owner/repo\" but got \"/repo\"",
+		},
+		{
+			what: "too many slashes",
+			edit: func(u *SARIFUpload) { u.Repository = "owner/repo/foo" },
+			want: "repository to upload SARIF report must be \"owner/repo\" but got \"owner/repo/foo\"",
+		},
+		{
+			what: "short ref",
+			edit: func(u *SARIFUpload) { u.Ref = "main" },
+			want: "must be a full Git reference like \"refs/heads/main\" but got \"main\"",
+		},
+		{
+			what: "short SHA",
+			edit: func(u *SARIFUpload) { u.CommitSHA = "0123456" },
+			want: "must be a full commit SHA but got \"0123456\"",
+		},
+		{
+			what: "invalid report",
+			edit: func(u *SARIFUpload) {},
+			want: "SARIF report to upload is not a valid JSON",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			srv, reqs := testSARIFUploadServer(t, 202, `{"id": "foo"}`)
+			u := &SARIFUpload{
+				Repository: "owner/repo",
+				Ref:        "refs/heads/main",
+				CommitSHA:  testSARIFUploadSHA,
+				Token:      "dummy-token",
+				APIURL:     srv.URL,
+			}
+			tc.edit(u)
+
+			_, err := u.Upload(srv.Client(), []byte(`{"version":`))
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Errorf("error message %q should contain %q", msg, tc.want)
+			}
+			if len(*reqs) > 0 {
+				t.Errorf("no request should be sent but got %d requests", len(*reqs))
+			}
+		})
+	}
+}
+
+func TestSARIFUploadCommand(t *testing.T) {
+	srv, reqs := testSARIFUploadServer(t, 202, `{"id": "foo"}`)
+	t.Setenv("GITHUB_REPOSITORY", "owner/repo")
+	t.Setenv("GITHUB_REF", "refs/heads/main")
+	t.Setenv("GITHUB_SHA", testSARIFUploadSHA)
+	t.Setenv("GITHUB_TOKEN", "dummy-token")
+	t.Setenv("GITHUB_API_URL", srv.URL)
+
+	var stdout, stderr bytes.Buffer
+	cmd := Command{
+		Stdin:  os.Stdin,
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}
+
+	f := filepath.Join("testdata", "err", "one_error.yaml")
+	status := cmd.Main([]string{"actionlint", "-upload-sarif", "-upload-sarif-ref", "refs/pull/1/merge", "-shellcheck=", "-pyflakes=", "-psscriptanalyzer=", f})
+	if status != ExitStatusSuccessProblemFound {
+		t.Fatalf("exit status should be %d but got %d: %s", ExitStatusSuccessProblemFound, status, stderr.String())
+	}
+
+	if len(*reqs) != 1 {
+		t.Fatalf("one request should be sent but got %d requests", len(*reqs))
+	}
+	r := (*reqs)[0]
+	if r.ref != "refs/pull/1/merge" {
+		t.Errorf("ref should be overridden by -upload-sarif-ref but got %q", r.ref)
+	}
+	if !bytes.Equal(r.sarif, stdout.Bytes()) {
+		t.Errorf("uploaded SARIF report should be the same as stdout: %q vs %q", r.sarif, stdout.Bytes())
+	}
+
+	var sarif struct {
+		Runs []struct {
+			Results []struct {
+				RuleID string `json:"ruleId"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(r.sarif, &sarif); err != nil {
+		t.Fatalf("uploaded SARIF report is broken: %s: %s", err, r.sarif)
+	}
+	if len(sarif.Runs) != 1 || len(sarif.Runs[0].Results) == 0 {
+		t.Fatalf("uploaded SARIF report should contain errors: %s", r.sarif)
+	}
+	if msg := stderr.String(); !strings.Contains(msg, "Uploaded SARIF report to code scanning of owner/repo (id: foo)") {
+		t.Errorf("unexpected stderr: %q", msg)
+	}
+}
+
+func TestSARIFUploadCommandInvalidOptions(t *testing.T) {
+	t.Setenv("GITHUB_REPOSITORY", "owner/repo")
+	t.Setenv("GITHUB_REF", "refs/heads/main")
+	t.Setenv("GITHUB_SHA", testSARIFUploadSHA)
+	t.Setenv("GITHUB_TOKEN", "")
+
+	testCases := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"-upload-sarif", "-json-schema"}, "cannot be used with -init-config"},
+		{[]string{"-upload-sarif", "-stats", "text"}, "cannot be used with -stats"},
+		{[]string{"-upload-sarif", "-offline"}, "cannot be used with -offline"},
+		{[]string{"-upload-sarif"}, "set $GITHUB_TOKEN environment variable"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			cmd := Command{
+				Stdin:  os.Stdin,
+				Stdout: &stdout,
+				Stderr: &stderr,
+			}
+			status := cmd.Main(append([]string{"actionlint"}, tc.args...))
+			if status != ExitStatusInvalidCommandOption {
+				t.Fatalf("exit status should be %d but got %d", ExitStatusInvalidCommandOption, status)
+			}
+			if msg := stderr.String(); !strings.Contains(msg, tc.want) {
+				t.Errorf("error message %q should contain %q", msg, tc.want)
+			}
+		})
+	}
+}
+
+func TestSARIFUploadTemplateIsSameAsExample(t *testing.T) {
+	file := filepath.Join("testdata", "format", "test.yaml")
+	b, err := os.ReadFile(filepath.Join("testdata", "format", "sarif_template.txt"))
+	if err != nil {
+		panic(err)
+	}
+
+	outputs := []any{}
+	for _, format := range []string{sarifTemplate, string(b)} {
+		var out bytes.Buffer
+		l, err := NewLinter(&out, &LinterOptions{Format: format})
+		if err != nil {
+			t.Fatal(err)
+		}
+		l.defaultConfig = &Config{}
+		if _, err := l.LintFile(file, nil); err != nil {
+			t.Fatal(err)
+		}
+		var v any
+		if err := json.Unmarshal(out.Bytes(), &v); err != nil {
+			t.Fatalf("output is not JSON: %v: %q", err, out.String())
+		}
+		outputs = append(outputs, v)
+	}
+
+	if !cmp.Equal(outputs[0], outputs[1]) {
+		t.Fatal(cmp.Diff(outputs[0], outputs[1]))
+	}
+}
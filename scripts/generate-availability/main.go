@@ -200,9 +200,9 @@ func WorkflowKeyAvailability(key string) ([]string, []string) {
 // See the script for more details: https://github.com/rhysd/actionlint/blob/main/scripts/generate-availability/`)
 	fmt.Fprintf(buf, "var SpecialFunctionNames = %#v\n", funcs)
 
-	// This variable is for unit tests
+	// This variable is used for listing all workflow keys (e.g. AllContextAvailabilities) and unit tests
 	sort.Strings(keys)
-	fmt.Fprintf(buf, "\n// For test\nvar allWorkflowKeys = %#v\n", keys)
+	fmt.Fprintf(buf, "\n// allWorkflowKeys is a sorted list of all workflow keys whose availability is known.\nvar allWorkflowKeys = %#v\n", keys)
 
 	formatted, err := format.Source(buf.Bytes())
 	if err != nil {
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var dbg = log.New(io.Discard, "", log.LstdFlags)
+
+type feature struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Since       *string `json:"since"`
+}
+
+type action struct {
+	Action string  `json:"action"`
+	Ref    string  `json:"ref"`
+	Reason string  `json:"reason"`
+	Since  *string `json:"since"`
+}
+
+func (a *action) key() string {
+	if a.Ref == "" {
+		return a.Action
+	}
+	return a.Action + "@" + a.Ref
+}
+
+type dataset struct {
+	Features []*feature `json:"features"`
+	Actions  []*action  `json:"actions"`
+}
+
+var reVersion = regexp.MustCompile(`^\d+\.\d+$`)
+
+func validateVersion(v *string, what string) error {
+	if v != nil && !reVersion.MatchString(*v) {
+		return fmt.Errorf("invalid GHES version %q for %s. version must be in MAJOR.MINOR format like \"3.12\"", *v, what)
+	}
+	return nil
+}
+
+func validate(data *dataset) error {
+	seen := map[string]struct{}{}
+	for _, f := range data.Features {
+		if f.Name == "" || f.Description == "" {
+			return fmt.Errorf("name and description must be set to feature entry: %+v", f)
+		}
+		if err := validateVersion(f.Since, "feature "+f.Name); err != nil {
+			return err
+		}
+		if _, ok := seen[f.Name]; ok {
+			return fmt.Errorf("feature %q is duplicated", f.Name)
+		}
+		seen[f.Name] = struct{}{}
+	}
+
+	seen = map[string]struct{}{}
+	for _, a := range data.Actions {
+		if a.Action == "" || a.Reason == "" {
+			return fmt.Errorf("action and reason must be set to action entry: %+v", a)
+		}
+		if a.Action != strings.ToLower(a.Action) || strings.Count(a.Action, "/") != 1 {
+			return fmt.Errorf("action %q must be lower-case \"owner/repo\"", a.Action)
+		}
+		k := a.key()
+		if err := validateVersion(a.Since, "action "+k); err != nil {
+			return err
+		}
+		if _, ok := seen[k]; ok {
+			return fmt.Errorf("action %q is duplicated", k)
+		}
+		seen[k] = struct{}{}
+	}
+
+	return nil
+}
+
+func since(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func generate(src []byte, out io.Writer) error {
+	var data dataset
+	if err := json.Unmarshal(src, &data); err != nil {
+		return fmt.Errorf("could not parse dataset as JSON: %w", err)
+	}
+	if err := validate(&data); err != nil {
+		return err
+	}
+	if len(data.Features) == 0 && len(data.Actions) == 0 {
+		return errors.New("no feature or action entry was found in the dataset")
+	}
+
+	dbg.Println("Found", len(data.Features), "features and", len(data.Actions), "actions")
+
+	sort.Slice(data.Features, func(i, j int) bool { return data.Features[i].Name < data.Features[j].Name })
+	sort.Slice(data.Actions, func(i, j int) bool { return data.Actions[i].key() < data.Actions[j].key() })
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, `// Code generated by actionlint/scripts/generate-ghes-compatibility. DO NOT EDIT.
+
+package actionlint
+
+// gitHubEnterpriseFeatureCompatibilities is a table from features of GitHub Actions to the GHES
+// versions where they are available first. An empty version means that the feature is not
+// available on any version. This variable was generated by script at
+// ./scripts/generate-ghes-compatibility based on release notes of GitHub Enterprise Server.
+var gitHubEnterpriseFeatureCompatibilities = map[string]*gitHubEnterpriseCompatibility{`)
+	for _, f := range data.Features {
+		fmt.Fprintf(buf, "%q: {%q, %q},\n", f.Name, since(f.Since), f.Description)
+	}
+	fmt.Fprintln(buf, "}")
+
+	fmt.Fprintln(buf, `
+// gitHubEnterpriseActionCompatibilities is a table from actions to the GHES versions where they
+// are available first. Keys are "owner/repo" for all versions of the action or "owner/repo@ref"
+// for the major version of the action. An empty version means that the action is not available
+// on any version. This variable was generated by script at ./scripts/generate-ghes-compatibility
+// based on release notes of GitHub Enterprise Server.
+var gitHubEnterpriseActionCompatibilities = map[string]*gitHubEnterpriseCompatibility{`)
+	for _, a := range data.Actions {
+		fmt.Fprintf(buf, "%q: {%q, %q},\n", a.key(), since(a.Since), a.Reason)
+	}
+	fmt.Fprintln(buf, "}")
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not format Go source: %w", err)
+	}
+
+	if _, err := out.Write(formatted); err != nil {
+		return fmt.Errorf("could not write output: %w", err)
+	}
+
+	return nil
+}
+
+func run(args []string, stdout, stderr, dbgout io.Writer, srcPath string) int {
+	dbg.SetOutput(dbgout)
+
+	if len(args) > 2 {
+		fmt.Fprintln(stderr, "usage: generate-ghes-compatibility [[srcfile] dstfile]")
+		return 1
+	}
+
+	dbg.Println("Start generate-ghes-compatibility")
+
+	if len(args) == 2 {
+		srcPath = args[0]
+	}
+	dbg.Println("Reading dataset from", srcPath)
+	src, err := os.ReadFile(srcPath)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	out := stdout
+	dst := "<stdout>"
+	if len(args) > 0 && args[len(args)-1] != "-" {
+		dst = args[len(args)-1]
+		f, err := os.Create(dst)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		defer f.Close()
+		out = f
+	}
+
+	dbg.Println("Writing output to", dst)
+
+	if err := generate(src, out); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	dbg.Println("Wrote output to", dst)
+	dbg.Println("Done generate-ghes-compatibility script successfully")
+	return 0
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, os.Stderr, "./scripts/generate-ghes-compatibility/ghes-compatibility.json"))
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testRunMain(args []string) (string, string, int) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	status := run(args, stdout, stderr, io.Discard, "ghes-compatibility.json")
+	return stdout.String(), stderr.String(), status
+}
+
+func TestOKWriteStdout(t *testing.T) {
+	f := filepath.Join("testdata", "ok.json")
+	stdout, stderr, status := testRunMain([]string{f, "-"})
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+
+	b, err := os.ReadFile(filepath.Join("testdata", "ok.go"))
+	if err != nil {
+		panic(err)
+	}
+	want := string(b)
+
+	if stdout != want {
+		t.Fatal(cmp.Diff(want, stdout))
+	}
+}
+
+func TestOKWriteFile(t *testing.T) {
+	in := filepath.Join("testdata", "ok.json")
+	out := filepath.Join("testdata", "_test_output.go")
+	defer os.Remove(out)
+
+	stdout, stderr, status := testRunMain([]string{in, out})
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+	if stdout != "" {
+		t.Fatalf("stdout is not empty: %q", stdout)
+	}
+
+	b, err := os.ReadFile(filepath.Join("testdata", "ok.go"))
+	if err != nil {
+		panic(err)
+	}
+	want := string(b)
+
+	b, err = os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	have := string(b)
+
+	if want != have {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
+func TestDefaultDataset(t *testing.T) {
+	stdout, stderr, status := testRunMain([]string{"-"})
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+	if !strings.Contains(stdout, "var gitHubEnterpriseFeatureCompatibilities = ") {
+		t.Fatalf("unexpected output: %q", stdout)
+	}
+}
+
+func TestErrorGenerate(t *testing.T) {
+	tests := []struct {
+		file string
+		want string
+	}{
+		{"broken.json", "could not parse dataset as JSON"},
+		{"invalid_version.json", `invalid GHES version "v3.5" for feature oidc`},
+		{"duplicate_feature.json", `feature "oidc" is duplicated`},
+		{"duplicate_action.json", `action "actions/cache" is duplicated`},
+		{"no_reason.json", "action and reason must be set to action entry"},
+		{"empty.json", "no feature or action entry was found in the dataset"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.file, func(t *testing.T) {
+			f := filepath.Join("testdata", tc.file)
+			stdout, stderr, status := testRunMain([]string{f, "-"})
+			if status == 0 {
+				t.Fatalf("status was zero: %q", stdout)
+			}
+			if !strings.Contains(stderr, tc.want) {
+				t.Fatalf("wanted %q in stderr but got %q", tc.want, stderr)
+			}
+		})
+	}
+}
+
+func TestCmdError(t *testing.T) {
+	_, stderr, status := testRunMain([]string{"a", "b", "c"})
+	if status == 0 {
+		t.Fatal("status was zero")
+	}
+	if !strings.Contains(stderr, "usage:") {
+		t.Fatalf("usage was not shown: %q", stderr)
+	}
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var dbg = log.New(io.Discard, "", log.LstdFlags)
+
+// reScope matches to a line of permission scope in the YAML code block like "  actions: read|write|none"
+var reScope = regexp.MustCompile(`^\s+([a-z][a-z-]*):\s*([a-z]+(?:\|[a-z]+)*)\s*$`)
+
+var allLevels = map[string]struct{}{
+	"read":  {},
+	"write": {},
+	"none":  {},
+}
+
+type scope struct {
+	name   string
+	levels []string
+}
+
+// parse finds the YAML code block listing all permission scopes like below in the document and
+// parses its lines. Template directives like `{% ifversion ghes %}` are ignored so that scopes
+// available on any platform are collected.
+//
+//	permissions:
+//	  actions: read|write|none
+//	  id-token: write|none
+func parse(src []byte) ([]*scope, error) {
+	s := bufio.NewScanner(bytes.NewReader(src))
+	inCode, inPerms := false, false
+	scopes := []*scope{}
+	seen := map[string]struct{}{}
+	for lnum := 1; s.Scan(); lnum++ {
+		l := s.Text()
+		t := strings.TrimSpace(l)
+		if strings.HasPrefix(t, "```") {
+			if inPerms {
+				break // End of the code block listing scopes
+			}
+			inCode = !inCode
+			continue
+		}
+		if !inCode {
+			continue
+		}
+		if !inPerms {
+			inPerms = t == "permissions:"
+			continue
+		}
+		if strings.HasPrefix(t, "{%") || t == "" {
+			continue
+		}
+
+		m := reScope.FindStringSubmatch(l)
+		if m == nil {
+			return nil, fmt.Errorf("line %d: unexpected line in code block of permission scopes: %q", lnum, l)
+		}
+		n := m[1]
+		if _, ok := seen[n]; ok {
+			return nil, fmt.Errorf("line %d: permission scope %q is duplicated", lnum, n)
+		}
+		seen[n] = struct{}{}
+
+		ls := strings.Split(m[2], "|")
+		for _, lv := range ls {
+			if _, ok := allLevels[lv]; !ok {
+				return nil, fmt.Errorf("line %d: unknown access level %q for permission scope %q", lnum, lv, n)
+			}
+		}
+		dbg.Println("Found permission scope", n, "with access levels", ls)
+		scopes = append(scopes, &scope{n, ls})
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("could not read source: %w", err)
+	}
+	if len(scopes) == 0 {
+		return nil, errors.New("no permission scope was found in the document. code block starting with \"permissions:\" is necessary")
+	}
+	return scopes, nil
+}
+
+func generate(src []byte, out io.Writer) error {
+	scopes, err := parse(src)
+	if err != nil {
+		return err
+	}
+	sort.Slice(scopes, func(i, j int) bool { return scopes[i].name < scopes[j].name })
+	dbg.Println("Found", len(scopes), "permission scopes")
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, `// Code generated by actionlint/scripts/generate-permissions. DO NOT EDIT.
+
+package actionlint
+
+// allPermissionScopes is a table from permission scope names to their available access levels.
+// This variable was generated by script at ./scripts/generate-permissions based on
+// https://docs.github.com/en/actions/writing-workflows/workflow-syntax-for-github-actions#permissions
+var allPermissionScopes = map[string][]string{`)
+	for _, s := range scopes {
+		qs := make([]string, 0, len(s.levels))
+		for _, l := range s.levels {
+			qs = append(qs, strconv.Quote(l))
+		}
+		fmt.Fprintf(buf, "%q: {%s},\n", s.name, strings.Join(qs, ", "))
+	}
+	fmt.Fprintln(buf, "}")
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not format Go source: %w", err)
+	}
+
+	if _, err := out.Write(formatted); err != nil {
+		return fmt.Errorf("could not write output: %w", err)
+	}
+
+	return nil
+}
+
+func source(args []string, url string) ([]byte, error) {
+	if len(args) == 2 {
+		return os.ReadFile(args[0])
+	}
+
+	var c http.Client
+
+	dbg.Println("Fetching source from URL:", url)
+
+	res, err := c.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch %s: %w", url, err)
+	}
+	if res.StatusCode < 200 || 300 <= res.StatusCode {
+		return nil, fmt.Errorf("request was not successful for %s: %s", url, res.Status)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch body for %s: %w", url, err)
+	}
+	res.Body.Close()
+
+	dbg.Printf("Fetched %d bytes from %s", len(body), url)
+	return body, nil
+}
+
+func run(args []string, stdout, stderr, dbgout io.Writer, srcURL string) int {
+	dbg.SetOutput(dbgout)
+
+	if len(args) > 2 {
+		fmt.Fprintln(stderr, "usage: generate-permissions [[srcfile] dstfile]")
+		return 1
+	}
+
+	dbg.Println("Start generate-permissions")
+
+	src, err := source(args, srcURL)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	out := stdout
+	dst := "<stdout>"
+	if len(args) > 0 && args[len(args)-1] != "-" {
+		dst = args[len(args)-1]
+		f, err := os.Create(dst)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		defer f.Close()
+		out = f
+	}
+
+	dbg.Println("Writing output to", dst)
+
+	if err := generate(src, out); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	dbg.Println("Wrote output to", dst)
+	dbg.Println("Done generate-permissions script successfully")
+	return 0
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, os.Stderr, "https://raw.githubusercontent.com/github/docs/main/data/reusables/actions/github-token-available-permissions.md"))
+}
@@ -220,9 +220,11 @@ func (g *gen) writeGo(out io.Writer, actions map[string]*actionlint.ActionMetada
 
 package actionlint
 
-// PopularActions is data set of known popular actions. Keys are specs (owner/repo@ref) of actions
-// and values are their metadata.
-var PopularActions = map[string]*ActionMetadata{
+// popularActionsData returns data set of known popular actions. Keys are specs (owner/repo@ref) of
+// actions and values are their metadata. The data set is built on the first access instead of at
+// init since it is large. Use FindPopularAction or PopularActionSpecs to access it.
+func popularActionsData() map[string]*ActionMetadata {
+return map[string]*ActionMetadata{
 `)
 
 	specs := make([]string, 0, len(actions))
@@ -256,7 +258,7 @@ var PopularActions = map[string]*ActionMetadata{
 			fmt.Fprintf(b, "Inputs: ActionMetadataInputs{\n")
 			for _, id := range ids {
 				i := meta.Inputs[id]
-				fmt.Fprintf(b, "%q: {%q, %v},\n", id, i.Name, i.Required)
+				fmt.Fprintf(b, "%q: {%q, %v, %q},\n", id, i.Name, i.Required, i.Type)
 			}
 			fmt.Fprintf(b, "},\n")
 		}
@@ -283,15 +285,17 @@ var PopularActions = map[string]*ActionMetadata{
 		fmt.Fprintf(b, "},\n")
 	}
 
-	fmt.Fprintln(b, "}")
+	fmt.Fprintln(b, "}\n}")
 
-	fmt.Fprintln(b, `// OutdatedPopularActionSpecs is a spec set of known outdated popular actions. The word 'outdated'
-// means that the runner used by the action is no longer available such as "node12".
-var OutdatedPopularActionSpecs = map[string]struct{}{`)
+	fmt.Fprintln(b, `// outdatedPopularActionSpecsData returns spec set of known outdated popular actions. The word
+// 'outdated' means that the runner used by the action is no longer available such as "node12". Use
+// IsOutdatedPopularAction to access it.
+func outdatedPopularActionSpecsData() map[string]struct{} {
+return map[string]struct{}{`)
 	for _, s := range outdated {
 		fmt.Fprintf(b, "%q: {},\n", s)
 	}
-	fmt.Fprintln(b, "}")
+	fmt.Fprintln(b, "}\n}")
 
 	// Format the generated source with checking Go syntax
 	gen := b.Bytes()
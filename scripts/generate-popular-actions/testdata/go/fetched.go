@@ -2,33 +2,39 @@
 
 package actionlint
 
-// PopularActions is data set of known popular actions. Keys are specs (owner/repo@ref) of actions
-// and values are their metadata.
-var PopularActions = map[string]*ActionMetadata{
-	"rhysd/action-setup-vim@v1.3.2": {
-		Name: "Setup Vim",
-		Inputs: ActionMetadataInputs{
-			"configure-args": {"configure-args", false},
-			"neovim":         {"neovim", false},
-			"token":          {"token", false},
-			"version":        {"version", false},
+// popularActionsData returns data set of known popular actions. Keys are specs (owner/repo@ref) of
+// actions and values are their metadata. The data set is built on the first access instead of at
+// init since it is large. Use FindPopularAction or PopularActionSpecs to access it.
+func popularActionsData() map[string]*ActionMetadata {
+	return map[string]*ActionMetadata{
+		"rhysd/action-setup-vim@v1.3.2": {
+			Name: "Setup Vim",
+			Inputs: ActionMetadataInputs{
+				"configure-args": {"configure-args", false, ""},
+				"neovim":         {"neovim", false, ""},
+				"token":          {"token", false, ""},
+				"version":        {"version", false, ""},
+			},
+			Outputs: ActionMetadataOutputs{
+				"executable": {"executable"},
+			},
 		},
-		Outputs: ActionMetadataOutputs{
-			"executable": {"executable"},
+		"rhysd/changelog-from-release/action@v2.2.2": {
+			Name: "Run changelog-from-release",
+			Inputs: ActionMetadataInputs{
+				"commit":       {"commit", false, ""},
+				"file":         {"file", true, ""},
+				"github_token": {"github_token", true, ""},
+				"push":         {"push", false, ""},
+				"version":      {"version", false, ""},
+			},
 		},
-	},
-	"rhysd/changelog-from-release/action@v2.2.2": {
-		Name: "Run changelog-from-release",
-		Inputs: ActionMetadataInputs{
-			"commit":       {"commit", false},
-			"file":         {"file", true},
-			"github_token": {"github_token", true},
-			"push":         {"push", false},
-			"version":      {"version", false},
-		},
-	},
+	}
 }
 
-// OutdatedPopularActionSpecs is a spec set of known outdated popular actions. The word 'outdated'
-// means that the runner used by the action is no longer available such as "node12".
-var OutdatedPopularActionSpecs = map[string]struct{}{}
+// outdatedPopularActionSpecsData returns spec set of known outdated popular actions. The word
+// 'outdated' means that the runner used by the action is no longer available such as "node12". Use
+// IsOutdatedPopularAction to access it.
+func outdatedPopularActionSpecsData() map[string]struct{} {
+	return map[string]struct{}{}
+}
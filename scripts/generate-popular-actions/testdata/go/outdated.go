@@ -2,12 +2,18 @@
 
 package actionlint
 
-// PopularActions is data set of known popular actions. Keys are specs (owner/repo@ref) of actions
-// and values are their metadata.
-var PopularActions = map[string]*ActionMetadata{}
+// popularActionsData returns data set of known popular actions. Keys are specs (owner/repo@ref) of
+// actions and values are their metadata. The data set is built on the first access instead of at
+// init since it is large. Use FindPopularAction or PopularActionSpecs to access it.
+func popularActionsData() map[string]*ActionMetadata {
+	return map[string]*ActionMetadata{}
+}
 
-// OutdatedPopularActionSpecs is a spec set of known outdated popular actions. The word 'outdated'
-// means that the runner used by the action is no longer available such as "node12".
-var OutdatedPopularActionSpecs = map[string]struct{}{
-	"rhysd/action-setup-vim@v1.0.0": {},
+// outdatedPopularActionSpecsData returns spec set of known outdated popular actions. The word
+// 'outdated' means that the runner used by the action is no longer available such as "node12". Use
+// IsOutdatedPopularAction to access it.
+func outdatedPopularActionSpecsData() map[string]struct{} {
+	return map[string]struct{}{
+		"rhysd/action-setup-vim@v1.0.0": {},
+	}
 }
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"time"
+)
+
+var dbg = log.New(io.Discard, "", log.LstdFlags)
+
+type deprecation struct {
+	Labels      []string `json:"labels"`
+	Deprecation string   `json:"deprecation"`
+	Removal     string   `json:"removal"`
+	Alternative string   `json:"alternative"`
+}
+
+type migration struct {
+	Label string `json:"label"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+type dataset struct {
+	Updated      string         `json:"updated"`
+	Deprecations []*deprecation `json:"deprecations"`
+	Migrations   []*migration   `json:"migrations"`
+}
+
+func validateDate(d, what string) error {
+	if _, err := time.Parse("2006-01-02", d); err != nil {
+		return fmt.Errorf("invalid date %q for %s. date must be in YYYY-MM-DD format: %w", d, what, err)
+	}
+	return nil
+}
+
+func validate(data *dataset) error {
+	seen := map[string]struct{}{}
+	for _, d := range data.Deprecations {
+		if len(d.Labels) == 0 {
+			return errors.New("deprecation entry must have at least one label")
+		}
+		l := d.Labels[0]
+		if d.Alternative == "" {
+			return fmt.Errorf("alternative label is not set for %q", l)
+		}
+		if err := validateDate(d.Deprecation, "deprecation of "+l); err != nil {
+			return err
+		}
+		if err := validateDate(d.Removal, "removal of "+l); err != nil {
+			return err
+		}
+		if d.Removal < d.Deprecation {
+			return fmt.Errorf("removal date %s of %q is before its deprecation date %s", d.Removal, l, d.Deprecation)
+		}
+		for _, l := range d.Labels {
+			if _, ok := seen[l]; ok {
+				return fmt.Errorf("label %q is duplicated", l)
+			}
+			seen[l] = struct{}{}
+		}
+	}
+
+	for _, m := range data.Migrations {
+		if m.Label == "" || m.From == "" || m.To == "" {
+			return fmt.Errorf("label, from, and to must be set to migration entry: %+v", m)
+		}
+		if err := validateDate(m.Start, "start of migration of "+m.Label); err != nil {
+			return err
+		}
+		if err := validateDate(m.End, "end of migration of "+m.Label); err != nil {
+			return err
+		}
+		if m.End < m.Start {
+			return fmt.Errorf("end date %s of migration of %q is before its start date %s", m.End, m.Label, m.Start)
+		}
+	}
+
+	if err := validateDate(data.Updated, "updated date of the dataset"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func generate(src []byte, out io.Writer) error {
+	var data dataset
+	if err := json.Unmarshal(src, &data); err != nil {
+		return fmt.Errorf("could not parse dataset as JSON: %w", err)
+	}
+	if err := validate(&data); err != nil {
+		return err
+	}
+	if len(data.Deprecations) == 0 {
+		return errors.New("no deprecation entry was found in the dataset")
+	}
+
+	dbg.Println("Found", len(data.Deprecations), "deprecations and", len(data.Migrations), "migrations")
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, `// Code generated by actionlint/scripts/generate-runner-images. DO NOT EDIT.
+
+package actionlint
+
+// runnerImagesUpdated is the date when the dataset of runner images was last updated. Schedules of
+// runner images are evaluated at this date so that the results don't depend on when actionlint runs.
+const runnerImagesUpdated = %q
+
+// runnerImageDeprecations is a table from runner labels to their deprecation schedules. This
+// variable was generated by script at ./scripts/generate-runner-images based on announcements at
+// https://github.com/actions/runner-images
+var runnerImageDeprecations = map[string]*runnerImageDeprecation{
+`, data.Updated)
+
+	type entry struct {
+		label string
+		dep   *deprecation
+	}
+	entries := []entry{}
+	for _, d := range data.Deprecations {
+		for _, l := range d.Labels {
+			entries = append(entries, entry{l, d})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].label < entries[j].label })
+	for _, e := range entries {
+		fmt.Fprintf(buf, "%q: {%q, %q, %q},\n", e.label, e.dep.Deprecation, e.dep.Removal, e.dep.Alternative)
+	}
+	fmt.Fprintln(buf, "}")
+
+	fmt.Fprintln(buf, `
+// runnerLabelMigrations is a list of migrations of "-latest" runner labels to new images. This
+// variable was generated by script at ./scripts/generate-runner-images based on announcements at
+// https://github.com/actions/runner-images
+var runnerLabelMigrations = []*runnerLabelMigration{`)
+	for _, m := range data.Migrations {
+		fmt.Fprintf(buf, "{%q, %q, %q, %q, %q},\n", m.Label, m.From, m.To, m.Start, m.End)
+	}
+	fmt.Fprintln(buf, "}")
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not format Go source: %w", err)
+	}
+
+	if _, err := out.Write(formatted); err != nil {
+		return fmt.Errorf("could not write output: %w", err)
+	}
+
+	return nil
+}
+
+func run(args []string, stdout, stderr, dbgout io.Writer, srcPath string) int {
+	dbg.SetOutput(dbgout)
+
+	if len(args) > 2 {
+		fmt.Fprintln(stderr, "usage: generate-runner-images [[srcfile] dstfile]")
+		return 1
+	}
+
+	dbg.Println("Start generate-runner-images")
+
+	if len(args) == 2 {
+		srcPath = args[0]
+	}
+	dbg.Println("Reading dataset from", srcPath)
+	src, err := os.ReadFile(srcPath)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	out := stdout
+	dst := "<stdout>"
+	if len(args) > 0 && args[len(args)-1] != "-" {
+		dst = args[len(args)-1]
+		f, err := os.Create(dst)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		defer f.Close()
+		out = f
+	}
+
+	dbg.Println("Writing output to", dst)
+
+	if err := generate(src, out); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	dbg.Println("Wrote output to", dst)
+	dbg.Println("Done generate-runner-images script successfully")
+	return 0
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, os.Stderr, "./scripts/generate-runner-images/runner-images.json"))
+}
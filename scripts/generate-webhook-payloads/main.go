@@ -0,0 +1,529 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var dbg = log.New(io.Discard, "", log.LstdFlags)
+
+// schemaTypes is a value of "type" in JSON schema. It is a string or an array of strings.
+type schemaTypes []string
+
+func (ts *schemaTypes) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*ts = []string{s}
+		return nil
+	}
+	var ss []string
+	if err := json.Unmarshal(b, &ss); err != nil {
+		return fmt.Errorf("\"type\" must be string or array of strings: %w", err)
+	}
+	*ts = ss
+	return nil
+}
+
+// schema is a subset of JSON schema used by octokit/webhooks.
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 schemaTypes        `json:"type"`
+	Properties           map[string]*schema `json:"properties"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+	Items                *schema            `json:"items"`
+	Enum                 []any              `json:"enum"`
+	OneOf                []*schema          `json:"oneOf"`
+	AnyOf                []*schema          `json:"anyOf"`
+	AllOf                []*schema          `json:"allOf"`
+	Definitions          map[string]*schema `json:"definitions"`
+}
+
+type kind int
+
+const (
+	kindAny kind = iota
+	kindString
+	kindNumber
+	kindBool
+	kindNull
+	kindArray
+	kindObject
+)
+
+// exprType is an intermediate representation of actionlint.ExprType. Types created from the same
+// definition are shared by pointers so that they can be emitted as one variable.
+type exprType struct {
+	kind   kind
+	elem   *exprType
+	props  map[string]*exprType
+	mapped *exprType
+	strict bool
+}
+
+// merge merges two types. When all is true, the types are merged for "allOf" so the merged object
+// is strict when one of them is strict. Otherwise they are merged for "oneOf" or "anyOf".
+func merge(l, r *exprType, all bool) *exprType {
+	if l == r {
+		return l
+	}
+	if l.kind == kindNull {
+		return r
+	}
+	if r.kind == kindNull {
+		return l
+	}
+	if l.kind != r.kind {
+		return &exprType{kind: kindAny}
+	}
+
+	switch l.kind {
+	case kindArray:
+		return &exprType{kind: kindArray, elem: merge(l.elem, r.elem, all)}
+	case kindObject:
+		props := make(map[string]*exprType, len(l.props))
+		for n, p := range l.props {
+			props[n] = p
+		}
+		for n, p := range r.props {
+			if q, ok := props[n]; ok {
+				props[n] = merge(q, p, all)
+			} else {
+				props[n] = p
+			}
+		}
+		mapped := l.mapped
+		if mapped == nil {
+			mapped = r.mapped
+		} else if r.mapped != nil {
+			mapped = merge(mapped, r.mapped, all)
+		}
+		strict := l.strict && r.strict
+		if all {
+			strict = l.strict || r.strict
+		}
+		return &exprType{kind: kindObject, props: props, mapped: mapped, strict: strict}
+	default:
+		return l
+	}
+}
+
+type converter struct {
+	defs     map[string]*schema
+	types    map[string]*exprType
+	names    map[*exprType]string
+	visiting map[string]struct{}
+}
+
+func newConverter(defs map[string]*schema) *converter {
+	return &converter{defs, map[string]*exprType{}, map[*exprType]string{}, map[string]struct{}{}}
+}
+
+func (c *converter) resolve(ref string) (*exprType, error) {
+	if !strings.HasPrefix(ref, "#/definitions/") {
+		return nil, fmt.Errorf("unsupported $ref %q. only references to definitions are supported", ref)
+	}
+	name := strings.TrimPrefix(ref, "#/definitions/")
+	if t, ok := c.types[name]; ok {
+		return t, nil
+	}
+	if _, ok := c.visiting[name]; ok {
+		// Recursive definition is typed loosely
+		dbg.Printf("Definition %q is recursive", name)
+		return &exprType{kind: kindObject}, nil
+	}
+	s, ok := c.defs[name]
+	if !ok {
+		return nil, fmt.Errorf("definition %q referenced by $ref is not found", name)
+	}
+
+	c.visiting[name] = struct{}{}
+	t, err := c.convert(s)
+	delete(c.visiting, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert definition %q: %w", name, err)
+	}
+	c.types[name] = t
+	if n, ok := c.names[t]; !ok || name < n {
+		c.names[t] = name // Alias definitions share the same type. Choose the name stably
+	}
+	return t, nil
+}
+
+func (c *converter) mergeAll(ss []*schema, all bool) (*exprType, error) {
+	var ret *exprType
+	for _, s := range ss {
+		t, err := c.convert(s)
+		if err != nil {
+			return nil, err
+		}
+		if ret == nil {
+			ret = t
+		} else {
+			ret = merge(ret, t, all)
+		}
+	}
+	return ret, nil
+}
+
+func (c *converter) convert(s *schema) (*exprType, error) {
+	if s.Ref != "" {
+		return c.resolve(s.Ref)
+	}
+
+	if len(s.AllOf) > 0 {
+		return c.mergeAll(s.AllOf, true)
+	}
+	if len(s.OneOf) > 0 {
+		return c.mergeAll(s.OneOf, false)
+	}
+	if len(s.AnyOf) > 0 {
+		return c.mergeAll(s.AnyOf, false)
+	}
+
+	ty := ""
+	for _, t := range s.Type {
+		if t != "null" {
+			ty = t
+			break
+		}
+	}
+	if ty == "" && len(s.Type) > 0 {
+		ty = "null"
+	}
+	if ty == "" {
+		switch {
+		case s.Properties != nil:
+			ty = "object"
+		case s.Items != nil:
+			ty = "array"
+		case len(s.Enum) > 0:
+			if _, ok := s.Enum[0].(string); ok {
+				ty = "string"
+			}
+		}
+	}
+
+	switch ty {
+	case "string":
+		return &exprType{kind: kindString}, nil
+	case "number", "integer":
+		return &exprType{kind: kindNumber}, nil
+	case "boolean":
+		return &exprType{kind: kindBool}, nil
+	case "null":
+		return &exprType{kind: kindNull}, nil
+	case "array":
+		if s.Items == nil {
+			return &exprType{kind: kindArray, elem: &exprType{kind: kindAny}}, nil
+		}
+		e, err := c.convert(s.Items)
+		if err != nil {
+			return nil, err
+		}
+		return &exprType{kind: kindArray, elem: e}, nil
+	case "object":
+		t := &exprType{kind: kindObject, props: make(map[string]*exprType, len(s.Properties))}
+		for n, p := range s.Properties {
+			p, err := c.convert(p)
+			if err != nil {
+				return nil, fmt.Errorf("could not convert property %q: %w", n, err)
+			}
+			t.props[strings.ToLower(n)] = p
+		}
+		a := bytes.TrimSpace(s.AdditionalProperties)
+		switch {
+		case bytes.Equal(a, []byte("false")):
+			t.strict = true
+		case len(a) > 0 && a[0] == '{' && len(t.props) == 0:
+			var m schema
+			if err := json.Unmarshal(a, &m); err != nil {
+				return nil, fmt.Errorf("could not parse \"additionalProperties\": %w", err)
+			}
+			e, err := c.convert(&m)
+			if err != nil {
+				return nil, err
+			}
+			t.mapped = e
+		}
+		return t, nil
+	default:
+		return &exprType{kind: kindAny}, nil
+	}
+}
+
+// emitter emits Go expressions of the types. Object types referenced multiple times are emitted as
+// local variables to keep the generated source small.
+type emitter struct {
+	defs  map[*exprType]string
+	refs  map[*exprType]int
+	names map[*exprType]string
+	used  map[string]struct{}
+	decls *bytes.Buffer
+}
+
+func (e *emitter) count(t *exprType) {
+	e.refs[t]++
+	if e.refs[t] > 1 {
+		return // Children were already counted
+	}
+	if t.elem != nil {
+		e.count(t.elem)
+	}
+	if t.mapped != nil {
+		e.count(t.mapped)
+	}
+	for _, p := range t.props {
+		e.count(p)
+	}
+}
+
+func (e *emitter) varName(hint string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range hint {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = b.Len() > 0
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	base := b.String()
+	if base == "" || unicode.IsDigit(rune(base[0])) || token.IsKeyword(base) {
+		base = "t" + base
+	}
+	name := base
+	for i := 2; ; i++ {
+		if _, ok := e.used[name]; !ok {
+			break
+		}
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	e.used[name] = struct{}{}
+	return name
+}
+
+func (e *emitter) emit(t *exprType, hint string) string {
+	if n, ok := e.names[t]; ok {
+		return n
+	}
+
+	var s string
+	switch t.kind {
+	case kindString:
+		return "StringType{}"
+	case kindNumber:
+		return "NumberType{}"
+	case kindBool:
+		return "BoolType{}"
+	case kindNull:
+		return "NullType{}"
+	case kindAny:
+		return "AnyType{}"
+	case kindArray:
+		s = fmt.Sprintf("&ArrayType{Elem: %s}", e.emit(t.elem, hint))
+	case kindObject:
+		switch {
+		case t.mapped != nil && len(t.props) == 0:
+			s = fmt.Sprintf("NewMapObjectType(%s)", e.emit(t.mapped, hint))
+		case len(t.props) == 0 && !t.strict:
+			return "NewEmptyObjectType()"
+		default:
+			ctor := "NewObjectType"
+			if t.strict {
+				ctor = "NewStrictObjectType"
+			}
+			s = fmt.Sprintf("%s(%s)", ctor, e.emitProps(t.props))
+		}
+	}
+
+	if e.refs[t] <= 1 {
+		return s
+	}
+	if d, ok := e.defs[t]; ok {
+		hint = d
+	}
+	n := e.varName(hint)
+	e.names[t] = n
+	fmt.Fprintf(e.decls, "\t%s := %s\n", n, s)
+	return n
+}
+
+func (e *emitter) emitProps(props map[string]*exprType) string {
+	names := make([]string, 0, len(props))
+	for n := range props {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("map[string]ExprType{\n")
+	for _, n := range names {
+		fmt.Fprintf(&b, "%q: %s,\n", n, e.emit(props[n], n))
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+func generate(src []byte, out io.Writer) error {
+	var root schema
+	if err := json.Unmarshal(src, &root); err != nil {
+		return fmt.Errorf("could not parse webhook payload schema: %w", err)
+	}
+
+	c := newConverter(root.Definitions)
+	events := map[string]*exprType{}
+	for name := range root.Definitions {
+		if !strings.HasSuffix(name, "_event") {
+			continue
+		}
+		e := strings.TrimSuffix(name, "_event")
+		t, err := c.resolve("#/definitions/" + name)
+		if err != nil {
+			return err
+		}
+		if t.kind != kindObject {
+			return fmt.Errorf("payload of event %q is not an object", e)
+		}
+		dbg.Printf("Found event %q with %d properties", e, len(t.props))
+		events[e] = t
+	}
+	if len(events) == 0 {
+		return errors.New("no event definition was found in the schema. event definitions must be named \"{event}_event\"")
+	}
+
+	names := make([]string, 0, len(events))
+	for n := range events {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	e := &emitter{c.names, map[*exprType]int{}, map[*exprType]string{}, map[string]struct{}{}, &bytes.Buffer{}}
+	for _, n := range names {
+		for _, p := range events[n].props {
+			e.count(p)
+		}
+	}
+
+	table := &bytes.Buffer{}
+	for _, n := range names {
+		fmt.Fprintf(table, "%q: %s,\n", n, strings.TrimPrefix(e.emitProps(events[n].props), "map[string]ExprType"))
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, `// Code generated by actionlint/scripts/generate-webhook-payloads. DO NOT EDIT.
+
+package actionlint
+
+// webhookPayloadProps is a table from event names to properties of their webhook payloads. This
+// variable was generated by script at ./scripts/generate-webhook-payloads based on
+// https://github.com/octokit/webhooks
+var webhookPayloadProps = newWebhookPayloadProps()
+
+func newWebhookPayloadProps() map[string]map[string]ExprType {`)
+	buf.Write(e.decls.Bytes())
+	fmt.Fprintln(buf, "return map[string]map[string]ExprType{")
+	buf.Write(table.Bytes())
+	fmt.Fprintln(buf, "}\n}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not format Go source: %w", err)
+	}
+
+	if _, err := out.Write(src); err != nil {
+		return fmt.Errorf("could not write output: %w", err)
+	}
+
+	return nil
+}
+
+func fetch(url string) ([]byte, error) {
+	var c http.Client
+
+	dbg.Println("Fetching", url)
+
+	res, err := c.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch %s: %w", url, err)
+	}
+	if res.StatusCode < 200 || 300 <= res.StatusCode {
+		return nil, fmt.Errorf("request was not successful for %s: %s", url, res.Status)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch body for %s: %w", url, err)
+	}
+	res.Body.Close()
+
+	dbg.Printf("Fetched %d bytes from %s", len(body), url)
+	return body, nil
+}
+
+func run(args []string, stdout, stderr, dbgout io.Writer, srcURL string) int {
+	dbg.SetOutput(dbgout)
+
+	if len(args) > 2 {
+		fmt.Fprintln(stderr, "usage: generate-webhook-payloads [[srcfile] dstfile]")
+		return 1
+	}
+
+	dbg.Println("Start generate-webhook-payloads script")
+
+	var src []byte
+	var err error
+	if len(args) == 2 {
+		src, err = os.ReadFile(args[0])
+	} else {
+		src, err = fetch(srcURL)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	var out io.Writer
+	var dst string
+	if len(args) == 0 || args[len(args)-1] == "-" {
+		out = stdout
+		dst = "stdout"
+	} else {
+		n := args[len(args)-1]
+		f, err := os.Create(n)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		defer f.Close()
+		out = f
+		dst = n
+	}
+
+	if err := generate(src, out); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	dbg.Println("Wrote output to", dst)
+	dbg.Println("Done generate-webhook-payloads script successfully")
+
+	return 0
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, os.Stderr, "https://unpkg.com/@octokit/webhooks-schemas/schema.json"))
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testRunMain(args []string) (string, string, int) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	status := run(args, stdout, stderr, io.Discard, "")
+	return stdout.String(), stderr.String(), status
+}
+
+func TestOKWriteStdout(t *testing.T) {
+	f := filepath.Join("testdata", "ok.json")
+	stdout, stderr, status := testRunMain([]string{f, "-"})
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+
+	b, err := os.ReadFile(filepath.Join("testdata", "ok.go"))
+	if err != nil {
+		panic(err)
+	}
+	want := string(b)
+
+	if stdout != want {
+		t.Fatal(cmp.Diff(want, stdout))
+	}
+}
+
+func TestOKWriteFile(t *testing.T) {
+	in := filepath.Join("testdata", "ok.json")
+	out := filepath.Join("testdata", "_test_output.go")
+	defer os.Remove(out)
+
+	stdout, stderr, status := testRunMain([]string{in, out})
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+
+	b, err := os.ReadFile(filepath.Join("testdata", "ok.go"))
+	if err != nil {
+		panic(err)
+	}
+	want := string(b)
+
+	if stdout != "" {
+		t.Fatalf("stdout is not empty: %q", stdout)
+	}
+
+	b, err = os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("output file %q cannot be read: %v", out, err)
+	}
+	have := string(b)
+
+	if want != have {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
+func TestOKStableOutput(t *testing.T) {
+	f := filepath.Join("testdata", "ok.json")
+	first, stderr, status := testRunMain([]string{f, "-"})
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+	for i := 0; i < 10; i++ {
+		stdout, stderr, status := testRunMain([]string{f, "-"})
+		if status != 0 {
+			t.Fatalf("status was non-zero: %d: %q", status, stderr)
+		}
+		if stdout != first {
+			t.Fatal(cmp.Diff(first, stdout))
+		}
+	}
+}
+
+func TestErrorGenerate(t *testing.T) {
+	testCases := []struct {
+		file string
+		want string
+	}{
+		{"broken.json", "could not parse webhook payload schema"},
+		{"no_event.json", "no event definition was found in the schema"},
+		{"external_ref.json", "unsupported $ref \"common/user.schema.json\""},
+		{"missing_definition.json", "definition \"user\" referenced by $ref is not found"},
+		{"not_object.json", "payload of event \"push\" is not an object"},
+		{"invalid_type.json", "\"type\" must be string or array of strings"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.file, func(t *testing.T) {
+			f := filepath.Join("testdata", tc.file)
+			stdout, stderr, status := testRunMain([]string{f, "-"})
+			if status == 0 {
+				t.Fatalf("status was zero: %q", stdout)
+			}
+			if !strings.Contains(stderr, tc.want) {
+				t.Fatalf("wanted %q in stderr %q", tc.want, stderr)
+			}
+		})
+	}
+}
+
+type testErrorWriter struct{}
+
+func (w testErrorWriter) Write(b []byte) (int, error) {
+	return 0, errors.New("dummy write error")
+}
+
+func TestErrorWriteResult(t *testing.T) {
+	f := filepath.Join("testdata", "ok.json")
+	stderr := &bytes.Buffer{}
+	status := run([]string{f, "-"}, testErrorWriter{}, stderr, io.Discard, "")
+	if status == 0 {
+		t.Fatal("status was zero")
+	}
+	msg := stderr.String()
+	if !strings.Contains(msg, "dummy write error") {
+		t.Fatalf("write error did not occur: %q", msg)
+	}
+}
+
+func TestFetchError(t *testing.T) {
+	stderr := &bytes.Buffer{}
+	status := run([]string{"-"}, io.Discard, stderr, io.Discard, "foo://bar")
+	if status == 0 {
+		t.Fatal("status was zero")
+	}
+	msg := stderr.String()
+	if !strings.Contains(msg, "could not fetch") {
+		t.Fatalf("unexpected error: %v", msg)
+	}
+}
+
+func TestCmdError(t *testing.T) {
+	f := filepath.Join("testdata", "ok.json")
+	dirNotExist := filepath.Join("dir", "does", "not", "exist", "out.go")
+	testCases := []struct {
+		what string
+		args []string
+		want string
+	}{
+		{"too many args", []string{"foo", "bar", "piyo"}, "usage:"},
+		{"cannot read file", []string{"oops-this-file-does-not-exist.json", "-"}, "oops-this-file-does-not-exist.json"},
+		{"cannot write file", []string{f, dirNotExist}, dirNotExist},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			stdout, stderr, status := testRunMain(tc.args)
+			if status == 0 {
+				t.Fatalf("status was zero: %q", stdout)
+			}
+			if !strings.Contains(stderr, tc.want) {
+				t.Fatalf("stderr does not contain %q: %q", tc.want, stderr)
+			}
+		})
+	}
+}
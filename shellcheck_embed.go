@@ -0,0 +1,17 @@
+//go:build wazero && shellcheck_embed
+
+package actionlint
+
+import (
+	_ "embed"
+)
+
+// shellcheckWASM is shellcheck built for WASI. Put shellcheck.wasm at the root of this repository
+// before building actionlint with "shellcheck_embed" build tag. See docs/install.md.
+//
+//go:embed shellcheck.wasm
+var shellcheckWASM []byte
+
+func init() {
+	embeddedShellcheckWASM = shellcheckWASM // Declared at rule_shellcheck.go
+}
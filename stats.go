@@ -0,0 +1,100 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// lintStats is summary statistics of lint results output by -stats flag.
+type lintStats struct {
+	// Files is a number of checked files.
+	Files int `json:"files"`
+	// FilesWithErrors is a number of files which have at least one error.
+	FilesWithErrors int `json:"files_with_errors"`
+	// Errors is a total number of errors.
+	Errors int `json:"errors"`
+	// Rules is a number of errors per rule.
+	Rules map[string]int `json:"rules"`
+	// FileErrors is a number of errors per file.
+	FileErrors map[string]int `json:"file_errors"`
+	// Severities is a number of errors per severity. Errors reported by actionlint itself are
+	// counted as "error".
+	Severities map[string]int `json:"severities"`
+	// ElapsedMs is elapsed time of linting in milliseconds.
+	ElapsedMs int64 `json:"elapsed_ms"`
+}
+
+func newLintStats(errs []*Error, files int, elapsed time.Duration) *lintStats {
+	s := &lintStats{
+		Errors:     len(errs),
+		Rules:      map[string]int{},
+		FileErrors: map[string]int{},
+		Severities: map[string]int{},
+		ElapsedMs:  elapsed.Milliseconds(),
+	}
+	for _, err := range errs {
+		s.Rules[err.Kind]++
+		s.FileErrors[err.Filepath]++
+		sev := err.Severity
+		if sev == "" {
+			sev = "error"
+		}
+		s.Severities[sev]++
+	}
+	s.FilesWithErrors = len(s.FileErrors)
+	// Files may not be counted, for example, when linting an expression given via command line
+	s.Files = files
+	if s.Files < s.FilesWithErrors {
+		s.Files = s.FilesWithErrors
+	}
+	return s
+}
+
+func printStatsCounts(out io.Writer, title string, counts map[string]int) {
+	if len(counts) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(counts))
+	w := 0
+	for k := range counts {
+		keys = append(keys, k)
+		if len(k) > w {
+			w = len(k)
+		}
+	}
+	// Order by the number of errors descending so that the largest debts come first
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	fmt.Fprintf(out, "%s:\n", title)
+	for _, k := range keys {
+		fmt.Fprintf(out, "  %-*s %d\n", w, k, counts[k])
+	}
+}
+
+// print outputs the statistics in the format. Available formats are "text" and "json".
+func (s *lintStats) print(out io.Writer, format string) error {
+	switch format {
+	case "json":
+		b, err := json.Marshal(s)
+		if err != nil {
+			return fmt.Errorf("could not encode statistics into JSON: %w", err)
+		}
+		_, err = fmt.Fprintf(out, "%s\n", b)
+		return err
+	case "text":
+		fmt.Fprintf(out, "Found %d errors in %d files out of %d checked files in %dms\n", s.Errors, s.FilesWithErrors, s.Files, s.ElapsedMs)
+		printStatsCounts(out, "Errors by rule", s.Rules)
+		printStatsCounts(out, "Errors by file", s.FileErrors)
+		printStatsCounts(out, "Errors by severity", s.Severities)
+		return nil
+	default:
+		return fmt.Errorf("format of statistics must be \"text\" or \"json\" but got %q", format)
+	}
+}
@@ -0,0 +1,110 @@
+package actionlint
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxSuggestions is the max number of names suggested for one error.
+const maxSuggestions = 3
+
+// editDistance returns the edit distance between two strings. Swapping two adjacent characters is
+// counted as one edit since it is a common typo (optimal string alignment distance). The comparison
+// is case insensitive since many names in workflows such as contexts and job IDs are case
+// insensitive.
+func editDistance(a, b string) int {
+	x, y := []rune(strings.ToLower(a)), []rune(strings.ToLower(b))
+	// d[i][j] is the distance between x[:i] and y[:j]
+	d := make([][]int, len(x)+1)
+	for i := range d {
+		d[i] = make([]int, len(y)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(x); i++ {
+		for j := 1; j <= len(y); j++ {
+			c := 1
+			if x[i-1] == y[j-1] {
+				c = 0
+			}
+			m := d[i-1][j-1] + c // Substitution
+			if v := d[i-1][j] + 1; v < m {
+				m = v // Deletion
+			}
+			if v := d[i][j-1] + 1; v < m {
+				m = v // Insertion
+			}
+			if i > 1 && j > 1 && x[i-1] == y[j-2] && x[i-2] == y[j-1] {
+				if v := d[i-2][j-2] + 1; v < m {
+					m = v // Transposition
+				}
+			}
+			d[i][j] = m
+		}
+	}
+	return d[len(x)][len(y)]
+}
+
+// similarNames returns the names similar to the given name in candidates. They are sorted by the
+// edit distance from the name. At most maxSuggestions names are returned. It returns nil when no
+// similar name is found.
+func similarNames(name string, candidates []string) []string {
+	type similar struct {
+		name string
+		dist int
+	}
+
+	// Allow one typo per three characters
+	max := len(name) / 3
+	if max < 1 {
+		max = 1
+	}
+
+	ss := []similar{}
+	seen := map[string]struct{}{}
+	for _, c := range candidates {
+		if _, ok := seen[c]; ok || c == name {
+			continue
+		}
+		seen[c] = struct{}{}
+		if d := editDistance(name, c); d <= max {
+			ss = append(ss, similar{c, d})
+		}
+	}
+	if len(ss) == 0 {
+		return nil
+	}
+
+	sort.Slice(ss, func(i, j int) bool {
+		if ss[i].dist != ss[j].dist {
+			return ss[i].dist < ss[j].dist
+		}
+		return ss[i].name < ss[j].name
+	})
+	if len(ss) > maxSuggestions {
+		ss = ss[:maxSuggestions]
+	}
+
+	ret := make([]string, 0, len(ss))
+	for _, s := range ss {
+		ret = append(ret, s.name)
+	}
+	return ret
+}
+
+// didYouMean returns the hint sentence to suggest the names like `did you mean "foo"?`. It returns
+// an empty string when no name is given.
+func didYouMean(names []string) string {
+	switch len(names) {
+	case 0:
+		return ""
+	case 1:
+		return "did you mean " + strconv.Quote(names[0]) + "?"
+	default:
+		l := len(names) - 1
+		return "did you mean " + quotes(names[:l]) + " or " + strconv.Quote(names[l]) + "?"
+	}
+}
@@ -0,0 +1,131 @@
+package actionlint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSuggestionEditDistance(t *testing.T) {
+	testCases := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{"", "", 0},
+		{"foo", "", 3},
+		{"", "foo", 3},
+		{"foo", "foo", 0},
+		{"foo", "FOO", 0},
+		{"kitten", "sitting", 3},
+		{"node", "nod", 1},
+		{"head_commit", "head_comit", 1},
+		{"push", "pushs", 1},
+		{"ubuntu-latest", "ubuntu-lates", 1},
+		{"build", "biuld", 1},
+		{"ab", "ba", 1},
+		{"abc", "ca", 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a+" vs "+tc.b, func(t *testing.T) {
+			if have := editDistance(tc.a, tc.b); have != tc.want {
+				t.Fatalf("wanted %d but have %d", tc.want, have)
+			}
+			if have := editDistance(tc.b, tc.a); have != tc.want {
+				t.Fatalf("wanted %d but have %d with swapped arguments", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestSuggestionSimilarNames(t *testing.T) {
+	testCases := []struct {
+		what       string
+		name       string
+		candidates []string
+		want       []string
+	}{
+		{
+			what:       "single typo",
+			name:       "nod",
+			candidates: []string{"node", "os", "version"},
+			want:       []string{"node"},
+		},
+		{
+			what:       "no similar name",
+			name:       "platform",
+			candidates: []string{"node", "os"},
+			want:       nil,
+		},
+		{
+			what:       "no candidate",
+			name:       "foo",
+			candidates: nil,
+			want:       nil,
+		},
+		{
+			what:       "sorted by distance and name",
+			name:       "output_1",
+			candidates: []string{"my_output_2", "output_3", "output_1x", "output_11", "my_output_1xyz"},
+			want:       []string{"output_11", "output_1x", "output_3"},
+		},
+		{
+			what:       "exact match is not suggested",
+			name:       "foo",
+			candidates: []string{"foo", "fooo"},
+			want:       []string{"fooo"},
+		},
+		{
+			what:       "case insensitive",
+			name:       "startWith",
+			candidates: []string{"startsWith", "endsWith"},
+			want:       []string{"startsWith"},
+		},
+		{
+			what:       "duplicates are removed",
+			name:       "keys",
+			candidates: []string{"key", "key", "path"},
+			want:       []string{"key"},
+		},
+		{
+			what:       "at most 3 names",
+			name:       "abcdefgh",
+			candidates: []string{"abcdefg1", "abcdefg2", "abcdefg3", "abcdefg4"},
+			want:       []string{"abcdefg1", "abcdefg2", "abcdefg3"},
+		},
+		{
+			what:       "short name allows one typo",
+			name:       "os",
+			candidates: []string{"o", "ox", "arch"},
+			want:       []string{"o", "ox"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			have := similarNames(tc.name, tc.candidates)
+			if !cmp.Equal(tc.want, have) {
+				t.Fatal(cmp.Diff(tc.want, have))
+			}
+		})
+	}
+}
+
+func TestSuggestionDidYouMean(t *testing.T) {
+	testCases := []struct {
+		names []string
+		want  string
+	}{
+		{nil, ""},
+		{[]string{"foo"}, `did you mean "foo"?`},
+		{[]string{"foo", "bar"}, `did you mean "foo" or "bar"?`},
+		{[]string{"foo", "bar", "piyo"}, `did you mean "foo", "bar" or "piyo"?`},
+	}
+
+	for _, tc := range testCases {
+		if have := didYouMean(tc.names); have != tc.want {
+			t.Errorf("wanted %q but have %q for %v", tc.want, have, tc.names)
+		}
+	}
+}
@@ -0,0 +1,366 @@
+package actionlint
+
+import (
+	"reflect"
+	"sort"
+)
+
+// WalkCursor describes the node being visited by Walk. It provides the node, the field name
+// where the node is held by its parent node, and the cursor of the parent node.
+type WalkCursor struct {
+	node   interface{}
+	name   string
+	parent *WalkCursor
+}
+
+// Node returns the node being visited. It is a pointer to a node struct such as *Workflow, *Job,
+// *Step, *String, *WebhookEvent, *ExecRun, *RawYAMLObject, ... Use a type switch to handle each
+// kind of node.
+func (c *WalkCursor) Node() interface{} {
+	return c.node
+}
+
+// Name returns the field name of the parent node which holds the node like "Steps", "Env",
+// "RunsOn". It is an empty string for the root node.
+func (c *WalkCursor) Name() string {
+	return c.name
+}
+
+// Parent returns the cursor of the parent node. It returns nil for the root node. Ancestors can
+// be traversed by calling this method repeatedly.
+func (c *WalkCursor) Parent() *WalkCursor {
+	return c.parent
+}
+
+// ParentNode returns the parent node. It returns nil for the root node.
+func (c *WalkCursor) ParentNode() interface{} {
+	if c.parent == nil {
+		return nil
+	}
+	return c.parent.node
+}
+
+// WalkVisitor is an interface to visit nodes by Walk.
+type WalkVisitor interface {
+	// Enter is called when entering the node before visiting its children. When this method
+	// returns false, the children of the node are skipped and Leave is not called for the node.
+	Enter(c *WalkCursor) bool
+	// Leave is called when leaving the node after visiting all its children.
+	Leave(c *WalkCursor)
+}
+
+// WalkFunc is an adapter to use a function as WalkVisitor which only enters nodes.
+type WalkFunc func(c *WalkCursor) bool
+
+// Enter calls the function.
+func (f WalkFunc) Enter(c *WalkCursor) bool {
+	return f(c)
+}
+
+// Leave does nothing.
+func (f WalkFunc) Leave(c *WalkCursor) {}
+
+// Walk traverses the syntax tree from the root node in depth-first order. Unlike Visitor, which
+// only calls passes for workflows, jobs, and steps, Walk visits every kind of node including
+// events, expressions in *String values, matrix values, and so on. The root can be any node such
+// as *Workflow, *Action, *Job, or *Step. Children are visited in the order of fields of the node
+// struct. Elements of mappings are visited in the order of their keys. Nil nodes are not visited.
+// The syntax tree must not be modified while walking.
+func Walk(root interface{}, v WalkVisitor) {
+	walkNode(v, root, "", nil)
+}
+
+func walkNode(v WalkVisitor, n interface{}, name string, parent *WalkCursor) {
+	if n == nil {
+		return
+	}
+	if rv := reflect.ValueOf(n); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return
+	}
+
+	c := &WalkCursor{n, name, parent}
+	if !v.Enter(c) {
+		return
+	}
+	walkChildren(v, c)
+	v.Leave(c)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+func walkStrings(v WalkVisitor, ss []*String, name string, c *WalkCursor) {
+	for _, s := range ss {
+		walkNode(v, s, name, c)
+	}
+}
+
+func walkChildren(v WalkVisitor, c *WalkCursor) {
+	switch n := c.node.(type) {
+	case *Bool:
+		walkNode(v, n.Expression, "Expression", c)
+	case *Int:
+		walkNode(v, n.Expression, "Expression", c)
+	case *Float:
+		walkNode(v, n.Expression, "Expression", c)
+	case *WebhookEventFilter:
+		walkNode(v, n.Name, "Name", c)
+		walkStrings(v, n.Values, "Values", c)
+	case *WebhookEvent:
+		walkNode(v, n.Hook, "Hook", c)
+		walkStrings(v, n.Types, "Types", c)
+		walkNode(v, n.Branches, "Branches", c)
+		walkNode(v, n.BranchesIgnore, "BranchesIgnore", c)
+		walkNode(v, n.Tags, "Tags", c)
+		walkNode(v, n.TagsIgnore, "TagsIgnore", c)
+		walkNode(v, n.Paths, "Paths", c)
+		walkNode(v, n.PathsIgnore, "PathsIgnore", c)
+		walkStrings(v, n.Workflows, "Workflows", c)
+	case *ScheduledEvent:
+		walkStrings(v, n.Cron, "Cron", c)
+	case *DispatchInput:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Description, "Description", c)
+		walkNode(v, n.Required, "Required", c)
+		walkNode(v, n.Default, "Default", c)
+		walkStrings(v, n.Options, "Options", c)
+	case *WorkflowDispatchEvent:
+		for _, k := range sortedKeys(n.Inputs) {
+			walkNode(v, n.Inputs[k], "Inputs", c)
+		}
+	case *RepositoryDispatchEvent:
+		walkStrings(v, n.Types, "Types", c)
+	case *WorkflowCallEventInput:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Description, "Description", c)
+		walkNode(v, n.Default, "Default", c)
+		walkNode(v, n.Required, "Required", c)
+	case *WorkflowCallEventSecret:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Description, "Description", c)
+		walkNode(v, n.Required, "Required", c)
+	case *WorkflowCallEventOutput:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Description, "Description", c)
+		walkNode(v, n.Value, "Value", c)
+	case *WorkflowCallEvent:
+		for _, i := range n.Inputs {
+			walkNode(v, i, "Inputs", c)
+		}
+		for _, k := range sortedKeys(n.Secrets) {
+			walkNode(v, n.Secrets[k], "Secrets", c)
+		}
+		for _, k := range sortedKeys(n.Outputs) {
+			walkNode(v, n.Outputs[k], "Outputs", c)
+		}
+	case *PermissionScope:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Value, "Value", c)
+	case *Permissions:
+		walkNode(v, n.All, "All", c)
+		for _, k := range sortedKeys(n.Scopes) {
+			walkNode(v, n.Scopes[k], "Scopes", c)
+		}
+	case *DefaultsRun:
+		walkNode(v, n.Shell, "Shell", c)
+		walkNode(v, n.WorkingDirectory, "WorkingDirectory", c)
+	case *Defaults:
+		walkNode(v, n.Run, "Run", c)
+	case *Concurrency:
+		walkNode(v, n.Group, "Group", c)
+		walkNode(v, n.CancelInProgress, "CancelInProgress", c)
+	case *Environment:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.URL, "URL", c)
+	case *ExecRun:
+		walkNode(v, n.Run, "Run", c)
+		walkNode(v, n.Shell, "Shell", c)
+		walkNode(v, n.WorkingDirectory, "WorkingDirectory", c)
+	case *Input:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Value, "Value", c)
+	case *ExecAction:
+		walkNode(v, n.Uses, "Uses", c)
+		for _, k := range sortedKeys(n.Inputs) {
+			walkNode(v, n.Inputs[k], "Inputs", c)
+		}
+		walkNode(v, n.Entrypoint, "Entrypoint", c)
+		walkNode(v, n.Args, "Args", c)
+	case *RawYAMLObject:
+		for _, k := range sortedKeys(n.Props) {
+			walkNode(v, n.Props[k], "Props", c)
+		}
+	case *RawYAMLArray:
+		for _, e := range n.Elems {
+			walkNode(v, e, "Elems", c)
+		}
+	case *MatrixRow:
+		walkNode(v, n.Name, "Name", c)
+		for _, e := range n.Values {
+			walkNode(v, e, "Values", c)
+		}
+		walkNode(v, n.Expression, "Expression", c)
+	case *MatrixAssign:
+		walkNode(v, n.Key, "Key", c)
+		walkNode(v, n.Value, "Value", c)
+	case *MatrixCombination:
+		for _, k := range sortedKeys(n.Assigns) {
+			walkNode(v, n.Assigns[k], "Assigns", c)
+		}
+		walkNode(v, n.Expression, "Expression", c)
+	case *MatrixCombinations:
+		for _, e := range n.Combinations {
+			walkNode(v, e, "Combinations", c)
+		}
+		walkNode(v, n.Expression, "Expression", c)
+	case *Matrix:
+		for _, k := range sortedKeys(n.Rows) {
+			walkNode(v, n.Rows[k], "Rows", c)
+		}
+		walkNode(v, n.Include, "Include", c)
+		walkNode(v, n.Exclude, "Exclude", c)
+		walkNode(v, n.Expression, "Expression", c)
+	case *Strategy:
+		walkNode(v, n.Matrix, "Matrix", c)
+		walkNode(v, n.FailFast, "FailFast", c)
+		walkNode(v, n.MaxParallel, "MaxParallel", c)
+	case *EnvVar:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Value, "Value", c)
+	case *Env:
+		for _, k := range sortedKeys(n.Vars) {
+			walkNode(v, n.Vars[k], "Vars", c)
+		}
+		walkNode(v, n.Expression, "Expression", c)
+	case *Step:
+		walkNode(v, n.ID, "ID", c)
+		walkNode(v, n.If, "If", c)
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Exec, "Exec", c)
+		walkNode(v, n.Env, "Env", c)
+		walkNode(v, n.ContinueOnError, "ContinueOnError", c)
+		walkNode(v, n.TimeoutMinutes, "TimeoutMinutes", c)
+	case *Credentials:
+		walkNode(v, n.Username, "Username", c)
+		walkNode(v, n.Password, "Password", c)
+	case *Container:
+		walkNode(v, n.Image, "Image", c)
+		walkNode(v, n.Credentials, "Credentials", c)
+		walkNode(v, n.Env, "Env", c)
+		walkStrings(v, n.Ports, "Ports", c)
+		walkStrings(v, n.Volumes, "Volumes", c)
+		walkNode(v, n.Options, "Options", c)
+	case *Service:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Container, "Container", c)
+	case *Services:
+		for _, k := range sortedKeys(n.Value) {
+			walkNode(v, n.Value[k], "Value", c)
+		}
+		walkNode(v, n.Expression, "Expression", c)
+	case *Output:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Value, "Value", c)
+	case *Runner:
+		walkStrings(v, n.Labels, "Labels", c)
+		walkNode(v, n.LabelsExpr, "LabelsExpr", c)
+		walkNode(v, n.Group, "Group", c)
+	case *WorkflowCallInput:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Value, "Value", c)
+	case *WorkflowCallSecret:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Value, "Value", c)
+	case *WorkflowCall:
+		walkNode(v, n.Uses, "Uses", c)
+		for _, k := range sortedKeys(n.Inputs) {
+			walkNode(v, n.Inputs[k], "Inputs", c)
+		}
+		for _, k := range sortedKeys(n.Secrets) {
+			walkNode(v, n.Secrets[k], "Secrets", c)
+		}
+	case *Job:
+		walkNode(v, n.ID, "ID", c)
+		walkNode(v, n.Name, "Name", c)
+		walkStrings(v, n.Needs, "Needs", c)
+		walkNode(v, n.RunsOn, "RunsOn", c)
+		walkNode(v, n.Permissions, "Permissions", c)
+		walkNode(v, n.Environment, "Environment", c)
+		walkNode(v, n.Concurrency, "Concurrency", c)
+		for _, k := range sortedKeys(n.Outputs) {
+			walkNode(v, n.Outputs[k], "Outputs", c)
+		}
+		walkNode(v, n.Env, "Env", c)
+		walkNode(v, n.Defaults, "Defaults", c)
+		walkNode(v, n.If, "If", c)
+		for _, s := range n.Steps {
+			walkNode(v, s, "Steps", c)
+		}
+		walkNode(v, n.TimeoutMinutes, "TimeoutMinutes", c)
+		walkNode(v, n.Strategy, "Strategy", c)
+		walkNode(v, n.ContinueOnError, "ContinueOnError", c)
+		walkNode(v, n.Container, "Container", c)
+		walkNode(v, n.Services, "Services", c)
+		walkNode(v, n.WorkflowCall, "WorkflowCall", c)
+	case *Workflow:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.RunName, "RunName", c)
+		for _, e := range n.On {
+			walkNode(v, e, "On", c)
+		}
+		walkNode(v, n.Permissions, "Permissions", c)
+		walkNode(v, n.Env, "Env", c)
+		walkNode(v, n.Defaults, "Defaults", c)
+		walkNode(v, n.Concurrency, "Concurrency", c)
+		for _, k := range sortedKeys(n.Jobs) {
+			walkNode(v, n.Jobs[k], "Jobs", c)
+		}
+	case *ActionInput:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Description, "Description", c)
+		walkNode(v, n.Required, "Required", c)
+		walkNode(v, n.Default, "Default", c)
+		walkNode(v, n.DeprecationMessage, "DeprecationMessage", c)
+	case *ActionOutput:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Description, "Description", c)
+		walkNode(v, n.Value, "Value", c)
+	case *ActionRuns:
+		walkNode(v, n.Using, "Using", c)
+		walkNode(v, n.Main, "Main", c)
+		walkNode(v, n.Pre, "Pre", c)
+		walkNode(v, n.PreIf, "PreIf", c)
+		walkNode(v, n.Post, "Post", c)
+		walkNode(v, n.PostIf, "PostIf", c)
+		for _, s := range n.Steps {
+			walkNode(v, s, "Steps", c)
+		}
+		walkNode(v, n.Image, "Image", c)
+		walkNode(v, n.PreEntrypoint, "PreEntrypoint", c)
+		walkNode(v, n.Entrypoint, "Entrypoint", c)
+		walkNode(v, n.PostEntrypoint, "PostEntrypoint", c)
+		walkStrings(v, n.Args, "Args", c)
+		walkNode(v, n.Env, "Env", c)
+	case *ActionBranding:
+		walkNode(v, n.Icon, "Icon", c)
+		walkNode(v, n.Color, "Color", c)
+	case *Action:
+		walkNode(v, n.Name, "Name", c)
+		walkNode(v, n.Author, "Author", c)
+		walkNode(v, n.Description, "Description", c)
+		for _, k := range sortedKeys(n.Inputs) {
+			walkNode(v, n.Inputs[k], "Inputs", c)
+		}
+		for _, k := range sortedKeys(n.Outputs) {
+			walkNode(v, n.Outputs[k], "Outputs", c)
+		}
+		walkNode(v, n.Runs, "Runs", c)
+		walkNode(v, n.Branding, "Branding", c)
+	}
+}
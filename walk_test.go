@@ -0,0 +1,201 @@
+package actionlint
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type testWalkRecorder struct {
+	events []string
+	skip   string
+}
+
+func (r *testWalkRecorder) Enter(c *WalkCursor) bool {
+	r.events = append(r.events, "enter "+testWalkNodeName(c))
+	return r.skip == "" || c.Name() != r.skip
+}
+
+func (r *testWalkRecorder) Leave(c *WalkCursor) {
+	r.events = append(r.events, "leave "+testWalkNodeName(c))
+}
+
+func testWalkNodeName(c *WalkCursor) string {
+	t := reflect.TypeOf(c.Node()).Elem().Name()
+	if s, ok := c.Node().(*String); ok {
+		return c.Name() + ":" + t + ":" + s.Value
+	}
+	return c.Name() + ":" + t
+}
+
+func TestWalkEnterAndLeave(t *testing.T) {
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    env:
+      FOO: foo
+    steps:
+      - run: echo
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	r := &testWalkRecorder{}
+	Walk(w, r)
+	want := []string{
+		"enter :Workflow",
+		"enter On:WebhookEvent",
+		"enter Hook:String:push",
+		"leave Hook:String:push",
+		"leave On:WebhookEvent",
+		"enter Jobs:Job",
+		"enter ID:String:test",
+		"leave ID:String:test",
+		"enter RunsOn:Runner",
+		"enter Labels:String:ubuntu-latest",
+		"leave Labels:String:ubuntu-latest",
+		"leave RunsOn:Runner",
+		"enter Env:Env",
+		"enter Vars:EnvVar",
+		"enter Name:String:FOO",
+		"leave Name:String:FOO",
+		"enter Value:String:foo",
+		"leave Value:String:foo",
+		"leave Vars:EnvVar",
+		"leave Env:Env",
+		"enter Steps:Step",
+		"enter Exec:ExecRun",
+		"enter Run:String:echo",
+		"leave Run:String:echo",
+		"leave Exec:ExecRun",
+		"leave Steps:Step",
+		"leave Jobs:Job",
+		"leave :Workflow",
+	}
+	if !cmp.Equal(want, r.events) {
+		t.Fatal(cmp.Diff(want, r.events))
+	}
+
+	r = &testWalkRecorder{skip: "Env"}
+	Walk(w, r)
+	for _, e := range r.events {
+		if strings.Contains(e, "EnvVar") || e == "leave Env:Env" {
+			t.Errorf("children of skipped node were visited: %q", e)
+		}
+	}
+	if !cmp.Equal(want[len(want)-8:], r.events[len(r.events)-8:]) {
+		t.Errorf("nodes after the skipped node were not visited: %v", r.events)
+	}
+}
+
+func TestWalkParents(t *testing.T) {
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+        with:
+          fetch-depth: 0
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	found := false
+	Walk(w, WalkFunc(func(c *WalkCursor) bool {
+		s, ok := c.Node().(*String)
+		if !ok || s.Value != "0" {
+			return true
+		}
+		found = true
+		if _, ok := c.ParentNode().(*Input); !ok {
+			t.Errorf("parent is not *Input: %T", c.ParentNode())
+		}
+		names := []string{}
+		for p := c; p != nil; p = p.Parent() {
+			names = append(names, p.Name())
+		}
+		want := []string{"Value", "Inputs", "Exec", "Steps", "Jobs", ""}
+		if !cmp.Equal(want, names) {
+			t.Error(cmp.Diff(want, names))
+		}
+		return true
+	}))
+	if !found {
+		t.Fatal("input value was not visited")
+	}
+}
+
+// testCollectStrings collects all *String nodes reachable from the node with reflection.
+func testCollectStrings(v reflect.Value, seen map[*String]struct{}) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if s, ok := v.Interface().(*String); ok {
+			seen[s] = struct{}{}
+		}
+		testCollectStrings(v.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				testCollectStrings(v.Field(i), seen)
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			testCollectStrings(v.Index(i), seen)
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			testCollectStrings(v.MapIndex(k), seen)
+		}
+	}
+}
+
+func TestWalkVisitAllStrings(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "ok", "*.yaml"))
+	if err != nil {
+		panic(err)
+	}
+
+	for _, f := range files {
+		t.Run(filepath.Base(f), func(t *testing.T) {
+			b, err := os.ReadFile(f)
+			if err != nil {
+				panic(err)
+			}
+			w, errs := Parse(b)
+			if len(errs) > 0 {
+				t.Skip("parse error")
+			}
+
+			want := map[*String]struct{}{}
+			testCollectStrings(reflect.ValueOf(w), want)
+
+			have := map[*String]struct{}{}
+			Walk(w, WalkFunc(func(c *WalkCursor) bool {
+				if s, ok := c.Node().(*String); ok {
+					have[s] = struct{}{}
+				}
+				return true
+			}))
+
+			for s := range want {
+				if _, ok := have[s]; !ok {
+					t.Errorf("string %q at %s was not visited", s.Value, s.Pos)
+				}
+			}
+		})
+	}
+}
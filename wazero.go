@@ -0,0 +1,110 @@
+//go:build wazero
+
+package actionlint
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+)
+
+func init() {
+	newWASMCommand = newWazeroCommand // Declared at process.go
+}
+
+var (
+	wazeroRuntime     wazero.Runtime
+	wazeroRuntimeOnce sync.Once
+	wazeroModules     = map[string]wazero.CompiledModule{}
+	wazeroModulesMu   sync.Mutex
+)
+
+// getWazeroRuntime returns the runtime shared by all WebAssembly commands. It is never closed since
+// compiled modules are reused while linting files.
+func getWazeroRuntime() wazero.Runtime {
+	wazeroRuntimeOnce.Do(func() {
+		ctx := context.Background()
+		// Running modules are stopped when the context passed to them is canceled
+		r := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().WithCloseOnContextDone(true))
+		wasi_snapshot_preview1.MustInstantiate(ctx, r)
+		wazeroRuntime = r
+	})
+	return wazeroRuntime
+}
+
+func compileWazeroModule(r wazero.Runtime, name string, bin []byte) (wazero.CompiledModule, error) {
+	wazeroModulesMu.Lock()
+	defer wazeroModulesMu.Unlock()
+	if m, ok := wazeroModules[name]; ok {
+		return m, nil
+	}
+	m, err := r.CompileModule(context.Background(), bin)
+	if err != nil {
+		return nil, fmt.Errorf("could not compile WebAssembly module %s: %w", name, err)
+	}
+	wazeroModules[name] = m
+	return m, nil
+}
+
+func newWazeroCommand(name string, bin []byte, mount bool) (cmdFunc, error) {
+	r := getWazeroRuntime()
+	m, err := compileWazeroModule(r, name, bin)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, args []string, stdin string) ([]byte, error) {
+		var stdout, stderr bytes.Buffer
+		cfg := wazero.NewModuleConfig().
+			WithName(""). // Anonymous module can be instantiated multiple times at once
+			WithArgs(append([]string{name}, args...)...).
+			WithStdin(strings.NewReader(stdin)).
+			WithStdout(&stdout).
+			WithStderr(&stderr).
+			WithSysWalltime().
+			WithSysNanotime()
+		if mount {
+			fs := wazero.NewFSConfig()
+			seen := map[string]struct{}{}
+			for _, a := range args {
+				if !filepath.IsAbs(a) {
+					continue
+				}
+				d := filepath.Dir(a)
+				if _, ok := seen[d]; ok {
+					continue
+				}
+				seen[d] = struct{}{}
+				fs = fs.WithReadOnlyDirMount(d, filepath.ToSlash(d))
+			}
+			cfg = cfg.WithFSConfig(fs)
+		}
+
+		mod, err := r.InstantiateModule(ctx, m, cfg)
+		if mod != nil {
+			mod.Close(ctx)
+		}
+		if err != nil {
+			var exit *sys.ExitError
+			if !errors.As(err, &exit) {
+				return nil, fmt.Errorf("could not run WebAssembly module %s: %w", name, err)
+			}
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("%s was terminated. stderr: %q", name, stderr.Bytes())
+			}
+			if code := exit.ExitCode(); code != 0 && stdout.Len() == 0 {
+				return nil, fmt.Errorf("%s exited with status %d but stdout was empty. stderr: %q", name, code, stderr.Bytes())
+			}
+			// Reaches here when exit status is non-zero and stdout is not empty as well as running external process
+		}
+		return stdout.Bytes(), nil
+	}, nil
+}
@@ -0,0 +1,140 @@
+//go:build wazero
+
+package actionlint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func testAppendULEB128(b []byte, v uint32) []byte {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+func testAppendSLEB128(b []byte, v int32) []byte {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+func testWASMSection(id byte, items ...[]byte) []byte {
+	body := testAppendULEB128(nil, uint32(len(items)))
+	for _, i := range items {
+		body = append(body, i...)
+	}
+	s := testAppendULEB128([]byte{id}, uint32(len(body)))
+	return append(s, body...)
+}
+
+func testWASMName(n string) []byte {
+	return append(testAppendULEB128(nil, uint32(len(n))), n...)
+}
+
+// testSandboxWASMModule builds a WASI module which writes `ok` to stdout when it cannot access any
+// preopened directory and any environment variable. Otherwise it writes `ng` to stdout.
+func testSandboxWASMModule(ok, ng string) []byte {
+	const (
+		scratch  = 32
+		envCount = 40
+		envSize  = 44
+		okOffset = 64
+	)
+	ngOffset := okOffset + len(ok)
+
+	i32 := func(v int) []byte { return testAppendSLEB128([]byte{0x41}, int32(v)) }
+	var code []byte
+	emit := func(bs ...[]byte) {
+		for _, b := range bs {
+			code = append(code, b...)
+		}
+	}
+	store := func(addr, v int) { emit(i32(addr), i32(v), []byte{0x36, 0x02, 0x00}) } // i32.store
+
+	// fd_prestat_get(3, scratch) == 0 means the first preopened directory is accessible
+	emit(i32(3), i32(scratch), []byte{0x10, 0x01, 0x45}) // call 1, i32.eqz
+	// environ_sizes_get(envCount, envSize) then load the number of environment variables
+	emit(i32(envCount), i32(envSize), []byte{0x10, 0x02, 0x1a}) // call 2, drop
+	emit(i32(envCount), []byte{0x28, 0x02, 0x00, 0x72})         // i32.load, i32.or
+	emit([]byte{0x04, 0x40})                                    // if
+	store(0, ngOffset)
+	store(4, len(ng))
+	emit([]byte{0x05}) // else
+	store(0, okOffset)
+	store(4, len(ok))
+	emit([]byte{0x0b}) // end
+	// fd_write(1, iovs=0, iovs_len=1, nwritten=8)
+	emit(i32(1), i32(0), i32(1), i32(8), []byte{0x10, 0x00, 0x1a, 0x0b}) // call 0, drop, end
+
+	body := append([]byte{0x00}, code...) // No local variable
+	data := append([]byte{0x00}, i32(okOffset)...)
+	data = append(data, 0x0b)
+	data = append(data, testWASMName(ok+ng)...)
+
+	wasi := testWASMName("wasi_snapshot_preview1")
+	bin := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	bin = append(bin, testWASMSection(1,
+		[]byte{0x60, 0x04, 0x7f, 0x7f, 0x7f, 0x7f, 0x01, 0x7f}, // (i32, i32, i32, i32) -> i32
+		[]byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7f},             // (i32, i32) -> i32
+		[]byte{0x60, 0x00, 0x00},                               // () -> ()
+	)...)
+	bin = append(bin, testWASMSection(2,
+		append(append(append([]byte{}, wasi...), testWASMName("fd_write")...), 0x00, 0x00),
+		append(append(append([]byte{}, wasi...), testWASMName("fd_prestat_get")...), 0x00, 0x01),
+		append(append(append([]byte{}, wasi...), testWASMName("environ_sizes_get")...), 0x00, 0x01),
+	)...)
+	bin = append(bin, testWASMSection(3, []byte{0x02})...)
+	bin = append(bin, testWASMSection(5, []byte{0x00, 0x01})...)
+	bin = append(bin, testWASMSection(7,
+		append(testWASMName("memory"), 0x02, 0x00),
+		append(testWASMName("_start"), 0x00, 0x03),
+	)...)
+	bin = append(bin, testWASMSection(10, append(testAppendULEB128(nil, uint32(len(body))), body...))...)
+	bin = append(bin, testWASMSection(11, data)...)
+	return bin
+}
+
+func TestRulePluginWASMModuleInSandbox(t *testing.T) {
+	t.Setenv("ACTIONLINT_TEST_SECRET", "secret")
+
+	wasm := filepath.Join(t.TempDir(), "policy.wasm")
+	bin := testSandboxWASMModule(
+		`[{"line":4,"column":14,"message":"self-hosted runners are not allowed"}]`,
+		`[{"line":1,"column":1,"message":"sandbox is not isolated"}]`,
+	)
+	if err := os.WriteFile(wasm, bin, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	src := "on: push\njobs:\n  test:\n    runs-on: self-hosted\n    steps:\n      - run: echo hello\n"
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	r, err := NewRulePlugin(&PluginConfig{Name: "org-policy", WASM: wasm}, newConcurrentProcess(1), "test.yaml", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := NewVisitor()
+	v.AddPass(r)
+	if err := v.Visit(w); err != nil {
+		t.Fatal(err)
+	}
+
+	errs = r.Errs()
+	if len(errs) != 1 || errs[0].Message != "self-hosted runners are not allowed" || errs[0].Line != 4 || errs[0].Column != 14 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+}
@@ -0,0 +1,212 @@
+package actionlint
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxYAMLAliasExpandedNodes is the maximum number of nodes created by expanding YAML aliases in one
+// file. This prevents exponential expansion of nested aliases like "billion laughs".
+const maxYAMLAliasExpandedNodes = 100000
+
+// yamlAliasUse is a use of YAML alias like "*foo" in source.
+type yamlAliasUse struct {
+	pos *Pos
+	// name is the name of the anchor.
+	name string
+	// anchor is the position of the anchor definition like "&foo".
+	anchor *Pos
+	// anchorEnd is the last line of the anchored value.
+	anchorEnd int
+}
+
+// yamlAliasResolver resolves YAML aliases in the syntax tree by replacing them with copies of the
+// anchored values. Positions of the copied nodes are set to the position of the alias so that
+// errors in the values are reported at the use-site.
+type yamlAliasResolver struct {
+	uses     []*yamlAliasUse
+	errors   []*Error
+	expanded int
+}
+
+func (r *yamlAliasResolver) error(n *yaml.Node, msg string) {
+	r.errors = append(r.errors, &Error{
+		Message: msg,
+		Line:    n.Line,
+		Column:  n.Column,
+		Kind:    "syntax-check",
+	})
+}
+
+func lastLineOfNode(n *yaml.Node) int {
+	l := n.Line
+	for _, c := range n.Content {
+		if cl := lastLineOfNode(c); cl > l {
+			l = cl
+		}
+	}
+	return l
+}
+
+// copyAliased deeply copies the node with setting the position to the given line and column.
+// Aliases nested in the node are also expanded. It returns nil when too many nodes are expanded.
+func (r *yamlAliasResolver) copyAliased(n *yaml.Node, line, col int) *yaml.Node {
+	for n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	r.expanded++
+	if r.expanded > maxYAMLAliasExpandedNodes {
+		return nil
+	}
+	c := *n
+	c.Anchor = ""
+	c.Line = line
+	c.Column = col
+	if len(n.Content) > 0 {
+		c.Content = make([]*yaml.Node, 0, len(n.Content))
+		for _, child := range n.Content {
+			cc := r.copyAliased(child, line, col)
+			if cc == nil {
+				return nil
+			}
+			c.Content = append(c.Content, cc)
+		}
+	}
+	return &c
+}
+
+// expandAlias returns the copy of the value anchored by the alias node and records the use of the
+// alias. It returns nil when too many nodes are expanded.
+func (r *yamlAliasResolver) expandAlias(n *yaml.Node) *yaml.Node {
+	a := n.Alias
+	for a.Kind == yaml.AliasNode {
+		a = a.Alias
+	}
+	expanded := r.copyAliased(a, n.Line, n.Column)
+	if expanded == nil {
+		r.error(n, fmt.Sprintf("too many nodes are expanded from YAML alias %q. the limit is %d nodes", "*"+n.Value, maxYAMLAliasExpandedNodes))
+		return nil
+	}
+	r.uses = append(r.uses, &yamlAliasUse{
+		pos:       &Pos{Line: n.Line, Col: n.Column},
+		name:      n.Value,
+		anchor:    &Pos{Line: a.Line, Col: a.Column},
+		anchorEnd: lastLineOfNode(a),
+	})
+	return expanded
+}
+
+// mergedMapping resolves the value of merge key "<<". The value is a mapping, an alias of mapping,
+// or a sequence of them. It returns nil node when the value is invalid and returns false when the
+// expansion was stopped.
+func (r *yamlAliasResolver) mergedMapping(n *yaml.Node) (*yaml.Node, bool) {
+	if n.Kind == yaml.AliasNode {
+		n = r.expandAlias(n)
+		if n == nil {
+			return nil, false
+		}
+	}
+	if n.Kind != yaml.MappingNode {
+		r.error(n, fmt.Sprintf("value of YAML merge key \"<<\" must be a mapping or an alias of mapping but it is %s node", nodeKindName(n.Kind)))
+		return nil, true
+	}
+	// Merge keys nested in the merged mapping are resolved first
+	if !r.resolve(n) {
+		return nil, false
+	}
+	return n, true
+}
+
+// merge expands merge keys "<<" in the mapping node. Keys explicitly written in the mapping take
+// precedence over the merged keys. When multiple mappings are merged like "<<: [*a, *b]", keys in
+// the earlier mapping take precedence over keys in the later one. The merged key-value pairs are
+// inserted at the position of the merge key. It returns false when the expansion was stopped.
+func (r *yamlAliasResolver) merge(n *yaml.Node) bool {
+	explicit := map[string]struct{}{}
+	hasMerge := false
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if k := n.Content[i]; k.Kind == yaml.ScalarNode && k.Tag == "!!merge" {
+			hasMerge = true
+		} else {
+			explicit[k.Value] = struct{}{}
+		}
+	}
+	if !hasMerge {
+		return true
+	}
+
+	merged := map[string]struct{}{}
+	content := make([]*yaml.Node, 0, len(n.Content))
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, v := n.Content[i], n.Content[i+1]
+		if k.Kind != yaml.ScalarNode || k.Tag != "!!merge" {
+			content = append(content, k, v)
+			continue
+		}
+
+		vs := []*yaml.Node{v}
+		if v.Kind == yaml.SequenceNode {
+			vs = v.Content
+		}
+		for _, v := range vs {
+			m, ok := r.mergedMapping(v)
+			if !ok {
+				return false
+			}
+			if m == nil {
+				continue
+			}
+			for j := 0; j+1 < len(m.Content); j += 2 {
+				mk := m.Content[j]
+				if _, ok := explicit[mk.Value]; ok {
+					continue
+				}
+				if _, ok := merged[mk.Value]; ok {
+					continue
+				}
+				merged[mk.Value] = struct{}{}
+				content = append(content, mk, m.Content[j+1])
+			}
+		}
+	}
+	n.Content = content
+	return true
+}
+
+// resolve replaces all aliases and merge keys in the node recursively. It returns false when the
+// expansion was stopped.
+func (r *yamlAliasResolver) resolve(n *yaml.Node) bool {
+	if n.Kind == yaml.MappingNode {
+		if !r.merge(n) {
+			return false
+		}
+	}
+
+	for i, c := range n.Content {
+		if c.Kind != yaml.AliasNode {
+			if !r.resolve(c) {
+				return false
+			}
+			continue
+		}
+		expanded := r.expandAlias(c)
+		if expanded == nil {
+			return false
+		}
+		n.Content[i] = expanded
+	}
+	return true
+}
+
+// resolveYAMLAliases replaces all YAML aliases like "*foo" in the syntax tree with copies of their
+// anchored values and expands merge keys like "<<: *foo". It returns the errors found while
+// resolving and the uses of the aliases. When the expansion is stopped due to too many nodes, it
+// returns nil node.
+func resolveYAMLAliases(n *yaml.Node) (*yaml.Node, []*Error, []*yamlAliasUse) {
+	r := &yamlAliasResolver{}
+	if !r.resolve(n) {
+		return nil, r.errors, nil
+	}
+	return n, r.errors, r.uses
+}
@@ -0,0 +1,134 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestResolveYAMLAliasesPositions(t *testing.T) {
+	src := `a: &x
+  b: [c, d]
+e: *x
+`
+	var n yaml.Node
+	if err := yaml.Unmarshal([]byte(src), &n); err != nil {
+		t.Fatal(err)
+	}
+	r, errs, uses := resolveYAMLAliases(&n)
+	if r == nil || len(errs) != 0 {
+		t.Fatalf("could not resolve aliases: %v", errs)
+	}
+	if len(uses) != 1 {
+		t.Fatalf("wanted one alias use but got %v", uses)
+	}
+	u := uses[0]
+	if u.name != "x" || u.pos.Line != 3 || u.pos.Col != 4 || u.anchor.Line != 1 || u.anchorEnd != 2 {
+		t.Fatalf("unexpected alias use: %+v", u)
+	}
+
+	var walk func(*yaml.Node)
+	walk = func(n *yaml.Node) {
+		if n.Kind == yaml.AliasNode {
+			t.Fatalf("alias node remains at line:%d,col:%d", n.Line, n.Column)
+		}
+		if n.Anchor != "" && n.Line == 3 {
+			t.Fatalf("anchor %q was copied", n.Anchor)
+		}
+		for _, c := range n.Content {
+			walk(c)
+		}
+	}
+	walk(r)
+
+	v := r.Content[0].Content[3]
+	for _, c := range append([]*yaml.Node{v}, v.Content[1].Content...) {
+		if c.Line != 3 || c.Column != 4 {
+			t.Errorf("copied node should be at the alias but at line:%d,col:%d", c.Line, c.Column)
+		}
+	}
+}
+
+func TestResolveYAMLAliasesTooManyNodes(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("a0: &a0 [x, x, x, x, x, x, x, x, x, x]\n")
+	for i := 1; i < 10; i++ {
+		p := "*a" + string(rune('0'+i-1))
+		b.WriteString("a" + string(rune('0'+i)) + ": &a" + string(rune('0'+i)) + " [" + strings.Repeat(p+", ", 9) + p + "]\n")
+	}
+	var n yaml.Node
+	if err := yaml.Unmarshal([]byte(b.String()), &n); err != nil {
+		t.Fatal(err)
+	}
+	r, errs, _ := resolveYAMLAliases(&n)
+	if r != nil {
+		t.Fatal("aliases were resolved unexpectedly")
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Message, "too many nodes are expanded") {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+}
+
+func TestResolveYAMLAliasesMergeKeys(t *testing.T) {
+	src := `a: &a
+  x: a
+  y: a
+b: &b
+  y: b
+  z: b
+c:
+  <<: [*a, *b]
+  x: c
+`
+	var n yaml.Node
+	if err := yaml.Unmarshal([]byte(src), &n); err != nil {
+		t.Fatal(err)
+	}
+	r, errs, uses := resolveYAMLAliases(&n)
+	if r == nil || len(errs) != 0 {
+		t.Fatalf("could not resolve aliases: %v", errs)
+	}
+	if len(uses) != 2 {
+		t.Fatalf("wanted two alias uses but got %v", uses)
+	}
+
+	c := r.Content[0].Content[5]
+	want := []struct {
+		key, val  string
+		line, col int
+	}{
+		{"y", "a", 8, 8},
+		{"z", "b", 8, 12},
+		{"x", "c", 9, 6},
+	}
+	if len(c.Content) != len(want)*2 {
+		t.Fatalf("wanted %d key-value pairs but got %d", len(want), len(c.Content)/2)
+	}
+	for i, w := range want {
+		k, v := c.Content[i*2], c.Content[i*2+1]
+		if k.Value != w.key || v.Value != w.val {
+			t.Errorf("wanted %s: %s at %d but got %s: %s", w.key, w.val, i, k.Value, v.Value)
+		}
+		if v.Line != w.line || v.Column != w.col {
+			t.Errorf("wanted position of %q at line:%d,col:%d but got line:%d,col:%d", w.key, w.line, w.col, v.Line, v.Column)
+		}
+	}
+}
+
+func TestResolveYAMLAliasesInvalidMergeKey(t *testing.T) {
+	var n yaml.Node
+	if err := yaml.Unmarshal([]byte("a:\n  <<: [x]\n  b: c\n"), &n); err != nil {
+		t.Fatal(err)
+	}
+	r, errs, _ := resolveYAMLAliases(&n)
+	if r == nil {
+		t.Fatal("aliases were not resolved")
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Message, `value of YAML merge key "<<" must be a mapping`) {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if m := r.Content[0].Content[1]; len(m.Content) != 2 || m.Content[0].Value != "b" {
+		t.Fatalf("invalid merge key should be removed: %v", m.Content)
+	}
+}